@@ -23,6 +23,7 @@ import (
 	"github.com/prometheus/prometheus/util/httputil"
 	pgmodel "github.com/timescale/promscale/pkg/pgmodel/model"
 	"github.com/timescale/promscale/pkg/promql"
+	"github.com/timescale/promscale/pkg/query"
 )
 
 var (
@@ -34,6 +35,7 @@ var (
 	noPasswordFlagsSetError       = fmt.Errorf("one of basic-auth-password & basic-auth-password-file must be configured")
 	multiplePasswordFlagsSetError = fmt.Errorf("at most one of basic-auth-password & basic-auth-password-file must be configured")
 	multipleTokenFlagsSetError    = fmt.Errorf("at most one of bearer-token & bearer-token-file must be set")
+	readOnlyResultsCacheDBError   = fmt.Errorf("promql-results-cache-db cannot be used in read-only mode")
 )
 
 type Auth struct {
@@ -85,6 +87,9 @@ type Config struct {
 	ReadOnly        bool
 	AdminAPIEnabled bool
 	TelemetryPath   string
+	// TenantHeader is the header holding the tenant of a request, empty
+	// disables multi-tenancy.
+	TenantHeader string
 
 	Auth *Auth
 
@@ -92,6 +97,9 @@ type Config struct {
 	EnabledFeaturesList  []string
 	MaxQueryTimeout      time.Duration
 	SubQueryStepInterval time.Duration // Default step interval value if the user has not provided.
+	MaxSamples           int
+	MaxQueryBytes        int64
+	ResultsCache         query.ResultsCacheConfig
 }
 
 func ParseFlags(fs *flag.FlagSet, cfg *Config) *Config {
@@ -100,6 +108,7 @@ func ParseFlags(fs *flag.FlagSet, cfg *Config) *Config {
 	fs.BoolVar(&cfg.ReadOnly, "read-only", false, "Read-only mode for the connector. Operations related to writing or updating the database are disallowed. It is used when pointing the connector to a TimescaleDB read replica.")
 	fs.BoolVar(&cfg.AdminAPIEnabled, "web-enable-admin-api", false, "Allow operations via API that are for advanced users. Currently, these operations are limited to deletion of series.")
 	fs.StringVar(&cfg.TelemetryPath, "web-telemetry-path", "/metrics", "Web endpoint for exposing Promscale's Prometheus metrics.")
+	fs.StringVar(&cfg.TenantHeader, "tenant-header", "", "Header holding the tenant of the requests, like X-Scope-OrgID. When set, the series written are labeled with their tenant, and every read and deletion is restricted to the series of the requesting tenant. Empty disables multi-tenancy.")
 
 	fs.StringVar(&cfg.Auth.BasicAuthUsername, "auth-username", "", "Authentication username used for web endpoint authentication. Disabled by default.")
 	fs.StringVar(&cfg.Auth.BasicAuthPassword, "auth-password", "", "Authentication password used for web endpoint authentication. This flag should be set together with auth-username. It is mutually exclusive with auth-password-file and bearer-token flags.")
@@ -120,10 +129,21 @@ func ParseFlags(fs *flag.FlagSet, cfg *Config) *Config {
 		"'/api/v1/query.*' endpoints.")
 	fs.DurationVar(&cfg.SubQueryStepInterval, "promql-default-subquery-step-interval", 1*time.Minute, "Default step interval to be used for PromQL subquery evaluation. "+
 		"This value is used if the subquery does not specify the step value explicitly. Example: <metric_name>[30m:]. Note: in Prometheus this setting is set by the evaluation_interval option.")
+	fs.IntVar(&cfg.MaxSamples, "promql-max-samples", 50000000, "Maximum number of samples a single query may load into memory, both from the database and during its evaluation. "+
+		"Queries exceeding it are aborted. Note: in Prometheus this setting is set by the query.max-samples option. 0 disables the limit.")
+	fs.Int64Var(&cfg.MaxQueryBytes, "promql-max-query-bytes", 0, "Maximum estimated memory, in bytes, the series a single query loads from the database may take. Queries exceeding it are aborted. 0 disables the limit.")
+	fs.BoolVar(&cfg.ResultsCache.Enabled, "promql-results-cache", false, "Cache the results of range queries. Queries are split into step-aligned intervals of an hour or a day, and only the intervals which are not cached yet, usually the most recent one, are executed.")
+	fs.Uint64Var(&cfg.ResultsCache.Size, "promql-results-cache-size", 10000, "Maximum number of range query intervals kept in the in-memory results cache.")
+	fs.DurationVar(&cfg.ResultsCache.MaxFreshness, "promql-results-cache-max-freshness", 10*time.Minute, "Intervals ending more recently than this are not cached, leaving time for late samples to be written.")
+	fs.DurationVar(&cfg.ResultsCache.TTL, "promql-results-cache-ttl", 24*time.Hour, "Time a cached interval is served for. Series deleted or samples written in the past are only reflected in the cached results once they expire.")
+	fs.BoolVar(&cfg.ResultsCache.Persist, "promql-results-cache-db", false, "Also store the cached results in the database, sharing them between connectors and across restarts. Not supported in read-only mode.")
 	return cfg
 }
 
 func Validate(cfg *Config) error {
+	if cfg.ReadOnly && cfg.ResultsCache.Persist {
+		return readOnlyResultsCacheDBError
+	}
 	return cfg.Auth.Validate()
 }
 
@@ -212,23 +232,10 @@ func respondError(w http.ResponseWriter, status int, err error, errType string)
 	})
 }
 
-func respondErrorWithMessage(w http.ResponseWriter, status int, err error, errType string, message string) {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Cache-Control", "no-store")
-	w.WriteHeader(status)
-	_ = json.NewEncoder(w).Encode(&errResponse{
-		Status:    "error",
-		ErrorType: errType,
-		Error:     err.Error(),
-		Message:   message,
-	})
-}
-
 type errResponse struct {
 	Status    string `json:"status"`
 	ErrorType string `json:"errorType"`
 	Error     string `json:"error"`
-	Message   string `json:"message,omitempty"`
 }
 
 type response struct {
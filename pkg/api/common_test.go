@@ -17,6 +17,7 @@ import (
 	"testing"
 
 	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/query"
 )
 
 func TestCORSWrapper(t *testing.T) {
@@ -254,3 +255,19 @@ func TestValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateResultsCacheConfig(t *testing.T) {
+	cfg := &Config{
+		Auth:         &Auth{},
+		ReadOnly:     true,
+		ResultsCache: query.ResultsCacheConfig{Enabled: true, Persist: true},
+	}
+	if err := Validate(cfg); !errors.Is(err, readOnlyResultsCacheDBError) {
+		t.Errorf("unexpected error received: %v", err)
+	}
+
+	cfg.ResultsCache.Persist = false
+	if err := Validate(cfg); err != nil {
+		t.Errorf("unexpected error received: %v", err)
+	}
+}
@@ -5,118 +5,134 @@
 package api
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/NYTimes/gziphandler"
+	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/timescale/promscale/pkg/log"
-	"github.com/timescale/promscale/pkg/pgclient"
-	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	deletePkg "github.com/timescale/promscale/pkg/pgmodel/delete"
 	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/tenancy"
 )
 
-func Delete(conf *Config, client *pgclient.Client) http.Handler {
-	hf := corsWrapper(conf, deleteHandler(conf, client))
+func Delete(conf *Config, queue deletePkg.JobQueue, estimator deletePkg.Estimator) http.Handler {
+	hf := corsWrapper(conf, deleteHandler(conf, queue, estimator))
 	return gziphandler.GzipHandler(hf)
 }
 
-func deleteHandler(config *Config, client *pgclient.Client) http.HandlerFunc {
+// deleteHandler enqueues a delete job for the series matching the request
+// and responds with the job, which is executed in the background. With
+// dry_run=true it responds with the estimated effect of the deletion instead.
+func deleteHandler(config *Config, queue deletePkg.JobQueue, estimator deletePkg.Estimator) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		if config.ReadOnly {
-			respondError(w, http.StatusForbidden, fmt.Errorf("read-only connector cannot perform deletion"), "operation_not_permitted")
+		if !checkDeletePermitted(w, config) {
 			return
 		}
-		if !config.AdminAPIEnabled {
-			respondError(w, http.StatusForbidden, fmt.Errorf("deletion of series requires admin permissions. Use -web-enable-admin-api flag to allow deletion operations"), "operation_not_permitted")
-			return
-		}
-		var (
-			totalRowsDeleted int
-			metricsTouched   []string
-			seriesDeleted    []model.SeriesID
-		)
-		if err := r.ParseForm(); err != nil {
-			respondError(w, http.StatusBadRequest, err, "bad_data")
-			return
-		}
-		if len(r.Form["match[]"]) == 0 {
-			respondError(w, http.StatusBadRequest, fmt.Errorf("no match[] parameter provided"), "bad_data")
-			return
-		}
-		start, err := parseTimeParam(r, "start", model.MinTime)
-		if err != nil {
-			log.Info("msg", "Query bad request:"+err.Error())
-			respondError(w, http.StatusBadRequest, err, "bad_data")
-			return
-		}
-		end, err := parseTimeParam(r, "end", model.MaxTime)
+		matcherSets, start, end, err := parseDeleteParams(r)
 		if err != nil {
 			log.Info("msg", "Query bad request:"+err.Error())
 			respondError(w, http.StatusBadRequest, err, "bad_data")
 			return
 		}
-		if start != model.MinTime || end != model.MaxTime {
-			log.Warn("msg", "Time based series deletion is unsupported.")
-			respondError(w, http.StatusBadRequest, errors.ErrTimeBasedDeletion, "bad_data")
-			return
-		}
-		for _, s := range r.Form["match[]"] {
-			matchers, err := parser.ParseMetricSelector(s)
-			if err != nil {
-				respondError(w, http.StatusBadRequest, err, "bad_data")
+		dryRun := false
+		if s := r.FormValue("dry_run"); s != "" {
+			if dryRun, err = strconv.ParseBool(s); err != nil {
+				respondError(w, http.StatusBadRequest, fmt.Errorf("dry_run must be a boolean"), "bad_data")
 				return
 			}
-			if client == nil {
-				continue
-			}
-			pgDelete := deletePkg.PgDelete{Conn: client.Connection}
-			touchedMetrics, deletedSeriesIDs, rowsDeleted, err := pgDelete.DeleteSeries(matchers, start, end)
+		}
+		if dryRun {
+			estimates, err := estimator.EstimateDeleteSeries(matcherSets, start, end)
 			if err != nil {
-				respondErrorWithMessage(w, http.StatusInternalServerError, err, "deleting_series",
-					fmt.Sprintf("partial delete: deleted %v series IDs from %v metrics, affecting %d rows in total.",
-						distinctValues(seriesDeleted),
-						distinctValues(metricsTouched),
-						totalRowsDeleted,
-					),
-				)
+				log.Error("msg", "Estimating series deletion failed", "err", err)
+				respondError(w, http.StatusInternalServerError, err, "deleting_series")
 				return
 			}
-			metricsTouched = append(metricsTouched, touchedMetrics...)
-			seriesDeleted = append(seriesDeleted, deletedSeriesIDs...)
-			totalRowsDeleted += rowsDeleted
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Cache-Control", "no-store")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(&response{
+				Status: "success",
+				Data:   estimates,
+			})
+			return
 		}
-		respond(w, http.StatusOK,
-			fmt.Sprintf("deleted %v series IDs from %v metrics, affecting %d rows in total.",
-				distinctValues(seriesDeleted),
-				distinctValues(metricsTouched),
-				totalRowsDeleted,
-			),
-		)
+		selectors := r.Form["match[]"]
+		tenant, ok := tenancy.FromContext(r.Context())
+		if ok {
+			// the job parses its selectors again, so they have to keep the
+			// tenant matcher.
+			selectors = formatSelectors(matcherSets)
+		}
+		job, err := queue.EnqueueDeleteJob(tenant, selectors, start, end)
+		if err != nil {
+			log.Error("msg", "Enqueueing delete job failed", "err", err)
+			respondError(w, http.StatusInternalServerError, err, "deleting_series")
+			return
+		}
+		w.Header().Set("Location", fmt.Sprintf("/api/v1/admin/delete_jobs/%d", job.ID))
+		respondDeleteJob(w, http.StatusAccepted, job)
 	}
 }
 
-func distinctValues(slice interface{}) []string {
-	temp := make(map[string]struct{})
-	switch elem := slice.(type) {
-	case []string:
-		for _, element := range elem {
-			if _, ok := temp[element]; !ok {
-				temp[element] = struct{}{}
-			}
+// parseDeleteParams parses the match[], start and end parameters of a series
+// deletion request.
+func parseDeleteParams(r *http.Request) (matcherSets [][]*labels.Matcher, start, end time.Time, err error) {
+	if err = r.ParseForm(); err != nil {
+		return nil, start, end, err
+	}
+	if len(r.Form["match[]"]) == 0 {
+		return nil, start, end, fmt.Errorf("no match[] parameter provided")
+	}
+	if start, err = parseTimeParam(r, "start", model.MinTime); err != nil {
+		return nil, start, end, err
+	}
+	if end, err = parseTimeParam(r, "end", model.MaxTime); err != nil {
+		return nil, start, end, err
+	}
+	if end.Before(start) {
+		return nil, start, end, fmt.Errorf("end timestamp must not be before start time")
+	}
+	matcherSets = make([][]*labels.Matcher, 0, len(r.Form["match[]"]))
+	for _, s := range r.Form["match[]"] {
+		matchers, err := parser.ParseMetricSelector(s)
+		if err != nil {
+			return nil, start, end, err
 		}
-	case []model.SeriesID:
-		for _, element := range elem {
-			str := element.String()
-			if _, ok := temp[str]; !ok {
-				temp[str] = struct{}{}
-			}
+		matcherSets = append(matcherSets, matchers)
+	}
+	return tenancy.EnforceMatcherSets(r.Context(), matcherSets), start, end, nil
+}
+
+// formatSelectors formats the matcher sets as series selectors.
+func formatSelectors(matcherSets [][]*labels.Matcher) []string {
+	selectors := make([]string, 0, len(matcherSets))
+	for _, ms := range matcherSets {
+		matchers := make([]string, 0, len(ms))
+		for _, m := range ms {
+			matchers = append(matchers, m.String())
 		}
+		selectors = append(selectors, "{"+strings.Join(matchers, ",")+"}")
+	}
+	return selectors
+}
+
+// checkDeletePermitted responds with an error and returns false if the
+// connector does not allow modifying the stored series.
+func checkDeletePermitted(w http.ResponseWriter, config *Config) bool {
+	if config.ReadOnly {
+		respondError(w, http.StatusForbidden, fmt.Errorf("read-only connector cannot perform deletion"), "operation_not_permitted")
+		return false
 	}
-	keys := make([]string, 0, len(temp))
-	for k := range temp {
-		keys = append(keys, k)
+	if !config.AdminAPIEnabled {
+		respondError(w, http.StatusForbidden, fmt.Errorf("deletion of series requires admin permissions. Use -web-enable-admin-api flag to allow deletion operations"), "operation_not_permitted")
+		return false
 	}
-	return keys
+	return true
 }
@@ -0,0 +1,102 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/NYTimes/gziphandler"
+	"github.com/prometheus/common/route"
+	"github.com/timescale/promscale/pkg/log"
+	deletePkg "github.com/timescale/promscale/pkg/pgmodel/delete"
+	"github.com/timescale/promscale/pkg/tenancy"
+)
+
+func DeleteJob(conf *Config, queue deletePkg.JobQueue) http.Handler {
+	hf := corsWrapper(conf, deleteJobHandler(conf, queue))
+	return gziphandler.GzipHandler(hf)
+}
+
+// deleteJobHandler responds with the status and progress of a delete job of
+// the tenant of the request.
+func deleteJobHandler(config *Config, queue deletePkg.JobQueue) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !config.AdminAPIEnabled {
+			respondError(w, http.StatusForbidden, fmt.Errorf("delete jobs require admin permissions. Use -web-enable-admin-api flag to allow deletion operations"), "operation_not_permitted")
+			return
+		}
+		id, err := parseDeleteJobID(r)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+		tenant, _ := tenancy.FromContext(r.Context())
+		job, err := queue.GetDeleteJob(tenant, id)
+		if err != nil {
+			respondDeleteJobError(w, err)
+			return
+		}
+		respondDeleteJob(w, http.StatusOK, job)
+	}
+}
+
+func CancelDeleteJob(conf *Config, queue deletePkg.JobQueue) http.Handler {
+	hf := corsWrapper(conf, cancelDeleteJobHandler(conf, queue))
+	return gziphandler.GzipHandler(hf)
+}
+
+// cancelDeleteJobHandler cancels a delete job of the tenant of the request
+// and responds with its state.
+func cancelDeleteJobHandler(config *Config, queue deletePkg.JobQueue) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !checkDeletePermitted(w, config) {
+			return
+		}
+		id, err := parseDeleteJobID(r)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+		tenant, _ := tenancy.FromContext(r.Context())
+		job, err := queue.CancelDeleteJob(tenant, id)
+		if err != nil {
+			respondDeleteJobError(w, err)
+			return
+		}
+		respondDeleteJob(w, http.StatusOK, job)
+	}
+}
+
+func parseDeleteJobID(r *http.Request) (int64, error) {
+	param := route.Param(r.Context(), "id")
+	id, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid delete job id: %s", param)
+	}
+	return id, nil
+}
+
+func respondDeleteJobError(w http.ResponseWriter, err error) {
+	if errors.Is(err, deletePkg.ErrJobNotFound) {
+		respondError(w, http.StatusNotFound, err, "not_found")
+		return
+	}
+	log.Error("msg", "Delete job request failed", "err", err)
+	respondError(w, http.StatusInternalServerError, err, "internal")
+}
+
+func respondDeleteJob(w http.ResponseWriter, status int, job *deletePkg.Job) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(&response{
+		Status: "success",
+		Data:   job,
+	})
+}
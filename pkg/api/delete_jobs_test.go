@@ -0,0 +1,189 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/route"
+	deletePkg "github.com/timescale/promscale/pkg/pgmodel/delete"
+)
+
+type mockJobQueue struct {
+	tenant     string
+	enqueued   []string
+	start, end time.Time
+	jobs       map[int64]*deletePkg.Job
+	// tenants of the jobs, if any.
+	tenants map[int64]string
+	err     error
+}
+
+func (m *mockJobQueue) EnqueueDeleteJob(tenant string, matchers []string, start, end time.Time) (*deletePkg.Job, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.tenant, m.enqueued, m.start, m.end = tenant, matchers, start, end
+	return &deletePkg.Job{ID: 1, Matchers: matchers, Status: deletePkg.JobPending}, nil
+}
+
+func (m *mockJobQueue) GetDeleteJob(tenant string, id int64) (*deletePkg.Job, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	job, ok := m.jobs[id]
+	if !ok || (tenant != "" && m.tenants[id] != tenant) {
+		return nil, fmt.Errorf("get delete job: %w", deletePkg.ErrJobNotFound)
+	}
+	return job, nil
+}
+
+func (m *mockJobQueue) CancelDeleteJob(tenant string, id int64) (*deletePkg.Job, error) {
+	job, err := m.GetDeleteJob(tenant, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status == deletePkg.JobPending {
+		job.Status = deletePkg.JobCancelled
+	}
+	return job, nil
+}
+
+func TestDeleteJob(t *testing.T) {
+	queue := &mockJobQueue{
+		jobs: map[int64]*deletePkg.Job{
+			1: {ID: 1, Matchers: []string{`{__name__="up"}`}, Status: deletePkg.JobRunning, MetricsTouched: []string{"up"}, SeriesDeleted: 2, RowsDeleted: 10},
+			2: {ID: 2, Matchers: []string{`{__name__="up"}`}, Status: deletePkg.JobPending, MetricsTouched: []string{}},
+			3: {ID: 3, Matchers: []string{`{__name__="up",__tenant__="team-a"}`}, Status: deletePkg.JobPending, MetricsTouched: []string{}},
+		},
+		tenants: map[int64]string{3: "team-a"},
+	}
+	tenantConfig := &Config{AdminAPIEnabled: true, TenantHeader: "X-Scope-OrgID"}
+	testCases := []struct {
+		name           string
+		handler        http.Handler
+		id             string
+		tenant         string
+		expectedCode   int
+		expectedStatus deletePkg.JobStatus
+		expectedErr    string
+	}{
+		{
+			name:           "get job",
+			handler:        deleteJobHandler(&Config{AdminAPIEnabled: true}, queue),
+			id:             "1",
+			expectedCode:   http.StatusOK,
+			expectedStatus: deletePkg.JobRunning,
+		},
+		{
+			name:         "get job admin api disabled",
+			handler:      deleteJobHandler(&Config{}, queue),
+			id:           "1",
+			expectedCode: http.StatusForbidden,
+			expectedErr:  "delete jobs require admin permissions. Use -web-enable-admin-api flag to allow deletion operations",
+		},
+		{
+			name:         "get job invalid id",
+			handler:      deleteJobHandler(&Config{AdminAPIEnabled: true}, queue),
+			id:           "foo",
+			expectedCode: http.StatusBadRequest,
+			expectedErr:  "invalid delete job id: foo",
+		},
+		{
+			name:         "get job not found",
+			handler:      deleteJobHandler(&Config{AdminAPIEnabled: true}, queue),
+			id:           "4",
+			expectedCode: http.StatusNotFound,
+			expectedErr:  "get delete job: delete job not found",
+		},
+		{
+			name:           "get job of the tenant",
+			handler:        tenantHandler(tenantConfig, deleteJobHandler(tenantConfig, queue)),
+			id:             "3",
+			tenant:         "team-a",
+			expectedCode:   http.StatusOK,
+			expectedStatus: deletePkg.JobPending,
+		},
+		{
+			name:         "get job of another tenant",
+			handler:      tenantHandler(tenantConfig, deleteJobHandler(tenantConfig, queue)),
+			id:           "3",
+			tenant:       "team-b",
+			expectedCode: http.StatusNotFound,
+			expectedErr:  "get delete job: delete job not found",
+		},
+		{
+			name:         "cancel job of another tenant",
+			handler:      tenantHandler(tenantConfig, cancelDeleteJobHandler(tenantConfig, queue)),
+			id:           "3",
+			tenant:       "team-b",
+			expectedCode: http.StatusNotFound,
+			expectedErr:  "get delete job: delete job not found",
+		},
+		{
+			name:           "cancel pending job",
+			handler:        cancelDeleteJobHandler(&Config{AdminAPIEnabled: true}, queue),
+			id:             "2",
+			expectedCode:   http.StatusOK,
+			expectedStatus: deletePkg.JobCancelled,
+		},
+		{
+			name:         "cancel job read-only",
+			handler:      cancelDeleteJobHandler(&Config{AdminAPIEnabled: true, ReadOnly: true}, queue),
+			id:           "1",
+			expectedCode: http.StatusForbidden,
+			expectedErr:  "read-only connector cannot perform deletion",
+		},
+		{
+			name:         "cancel job not found",
+			handler:      cancelDeleteJobHandler(&Config{AdminAPIEnabled: true}, queue),
+			id:           "4",
+			expectedCode: http.StatusNotFound,
+			expectedErr:  "get delete job: delete job not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/delete_jobs/"+tc.id, nil)
+			req = req.WithContext(route.WithParam(context.Background(), "id", tc.id))
+			if tc.tenant != "" {
+				req.Header.Set("X-Scope-OrgID", tc.tenant)
+			}
+			w := httptest.NewRecorder()
+			tc.handler.ServeHTTP(w, req)
+
+			if w.Code != tc.expectedCode {
+				t.Fatalf("Unexpected HTTP status code received: got %d wanted %d", w.Code, tc.expectedCode)
+			}
+			if tc.expectedErr != "" {
+				var errMessage errResponse
+				if err := json.NewDecoder(w.Body).Decode(&errMessage); err != nil {
+					t.Fatal(err)
+				}
+				if errMessage.Error != tc.expectedErr {
+					t.Errorf("Unexpected error message received: got %s wanted %s", errMessage.Error, tc.expectedErr)
+				}
+				return
+			}
+			var resp struct {
+				Status string        `json:"status"`
+				Data   deletePkg.Job `json:"data"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatal(err)
+			}
+			if resp.Data.Status != tc.expectedStatus {
+				t.Errorf("Unexpected job status: got %s wanted %s", resp.Data.Status, tc.expectedStatus)
+			}
+		})
+	}
+}
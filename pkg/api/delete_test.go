@@ -6,13 +6,59 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	deletePkg "github.com/timescale/promscale/pkg/pgmodel/delete"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
 )
 
+func TestDeleteNotPermitted(t *testing.T) {
+	cases := []struct {
+		name    string
+		config  *Config
+		message string
+	}{
+		{
+			name:    "read_only",
+			config:  &Config{ReadOnly: true, AdminAPIEnabled: true},
+			message: "read-only connector cannot perform deletion",
+		},
+		{
+			name:    "admin_api_disabled",
+			config:  &Config{},
+			message: "deletion of series requires admin permissions. Use -web-enable-admin-api flag to allow deletion operations",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			queue := &mockJobQueue{}
+			resp := doPostDeleteRequest(t, deleteHandler(tc.config, queue, &mockEstimator{}), constructRequestValues("", "", []string{`{__name__="up"}`}))
+			if resp.StatusCode != http.StatusForbidden {
+				t.Fatalf("Unexpected HTTP status code received: got %d wanted %d", resp.StatusCode, http.StatusForbidden)
+			}
+			var errMessage errResponse
+			if err := json.NewDecoder(resp.Body).Decode(&errMessage); err != nil {
+				t.Fatal(err)
+			}
+			if errMessage.Error != tc.message {
+				t.Errorf("Unexpected error message received: got %s wanted %s", errMessage.Error, tc.message)
+			}
+			if queue.enqueued != nil {
+				t.Errorf("Unexpected job enqueued: %v", queue.enqueued)
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	cases := []struct {
 		name         string
@@ -26,12 +72,19 @@ func TestDelete(t *testing.T) {
 		{
 			name:         "normal_single_matcher",
 			matchers:     []string{`{__name__=~".*"}`},
-			expectedCode: http.StatusOK,
+			expectedCode: http.StatusAccepted,
 		},
 		{
 			name:         "normal_multiple_matchers",
 			matchers:     []string{`{__name__=~".*"}`, `{__name__="go_goroutines", job="prometheus"}`},
-			expectedCode: http.StatusOK,
+			expectedCode: http.StatusAccepted,
+		},
+		{
+			name:         "invalid_matcher",
+			matchers:     []string{`{__name__=~".*"}`, `{job=}`},
+			expectedCode: http.StatusBadRequest,
+			fails:        true,
+			message:      `1:6: parse error: unexpected "}" in label matching, expected string`,
 		},
 		{
 			name:         "empty_matchers",
@@ -43,33 +96,36 @@ func TestDelete(t *testing.T) {
 		{
 			name:         "normal_with_start",
 			matchers:     []string{`{__name__=~".*"}`},
-			start:        "1604311719000",
-			expectedCode: http.StatusBadRequest,
-			fails:        true,
-			message:      "time based series deletion is unsupported",
+			start:        "1604311719",
+			expectedCode: http.StatusAccepted,
 		},
 		{
 			name:         "normal_with_end",
 			matchers:     []string{`{__name__=~".*"}`},
-			end:          "1604311719000",
-			expectedCode: http.StatusBadRequest,
-			fails:        true,
-			message:      "time based series deletion is unsupported",
+			end:          "1604311719",
+			expectedCode: http.StatusAccepted,
 		},
 		{
 			name:         "normal_with_start_end",
 			matchers:     []string{`{__name__=~".*"}`},
-			start:        "1604311711000",
-			end:          "1604311719000",
+			start:        "1604311711",
+			end:          "1604311719",
+			expectedCode: http.StatusAccepted,
+		},
+		{
+			name:         "end_before_start",
+			matchers:     []string{`{__name__=~".*"}`},
+			start:        "1604311719",
+			end:          "1604311711",
 			expectedCode: http.StatusBadRequest,
 			fails:        true,
-			message:      "time based series deletion is unsupported",
+			message:      "end timestamp must not be before start time",
 		},
 		{
 			name:         "normal_with_start_end_without_matchers",
 			matchers:     []string{},
-			start:        "1604311711000",
-			end:          "1604311719000",
+			start:        "1604311711",
+			end:          "1604311719",
 			expectedCode: http.StatusBadRequest,
 			fails:        true,
 			message:      "no match[] parameter provided",
@@ -83,7 +139,8 @@ func TestDelete(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			handler := deleteHandler(config, nil)
+			queue := &mockJobQueue{}
+			handler := deleteHandler(config, queue, &mockEstimator{})
 			vals := constructRequestValues(tc.start, tc.end, tc.matchers)
 			// Post delete request.
 			wPost := doPostDeleteRequest(t, handler, vals)
@@ -91,6 +148,14 @@ func TestDelete(t *testing.T) {
 				t.Errorf("post: Unexpected HTTP status code received: got %d wanted %d", wPost.StatusCode, tc.expectedCode)
 				return
 			}
+			if !tc.fails {
+				if !reflect.DeepEqual(queue.enqueued, tc.matchers) {
+					t.Errorf("post: Unexpected matchers enqueued: got %v wanted %v", queue.enqueued, tc.matchers)
+				}
+				if location := wPost.Header.Get("Location"); location != "/api/v1/admin/delete_jobs/1" {
+					t.Errorf("post: Unexpected location header: got %s", location)
+				}
+			}
 			if tc.fails {
 				bstream, err := ioutil.ReadAll(wPost.Body)
 				if err != nil {
@@ -130,6 +195,125 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestDeleteTenant(t *testing.T) {
+	config := &Config{
+		AdminAPIEnabled: true,
+		TenantHeader:    "X-Scope-OrgID",
+	}
+	queue := &mockJobQueue{}
+	handler := tenantHandler(config, deleteHandler(config, queue, &mockEstimator{}))
+
+	vals := constructRequestValues("", "", []string{`{__name__="go_goroutines", job="prometheus"}`})
+	req := httptest.NewRequest("POST", "/delete_series", strings.NewReader(vals.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("X-Scope-OrgID", "team-a")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("Unexpected HTTP status code received: got %d wanted %d", w.Code, http.StatusAccepted)
+	}
+	expected := []string{`{__name__="go_goroutines",job="prometheus",__tenant__="team-a"}`}
+	if !reflect.DeepEqual(queue.enqueued, expected) {
+		t.Errorf("Unexpected matchers enqueued: got %v wanted %v", queue.enqueued, expected)
+	}
+	if queue.tenant != "team-a" {
+		t.Errorf("Unexpected tenant of the job: got %s wanted team-a", queue.tenant)
+	}
+}
+
+type mockEstimator struct {
+	matcherSets [][]*labels.Matcher
+	start, end  time.Time
+	estimates   []deletePkg.MetricEstimate
+	err         error
+}
+
+func (m *mockEstimator) EstimateDeleteSeries(matcherSets [][]*labels.Matcher, start, end time.Time) ([]deletePkg.MetricEstimate, error) {
+	m.matcherSets, m.start, m.end = matcherSets, start, end
+	return m.estimates, m.err
+}
+
+func TestDeleteDryRun(t *testing.T) {
+	estimates := []deletePkg.MetricEstimate{
+		{MetricName: "go_goroutines", SeriesCount: 2, EstimatedRows: 100},
+		{MetricName: "up", SeriesCount: 1, EstimatedRows: 50},
+	}
+	cases := []struct {
+		name         string
+		dryRun       string
+		estimatorErr error
+		expectedCode int
+		expectedBody string
+		enqueued     bool
+	}{
+		{
+			name:         "dry_run",
+			dryRun:       "true",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"status":"success","data":[{"metricName":"go_goroutines","seriesCount":2,"estimatedRows":100},{"metricName":"up","seriesCount":1,"estimatedRows":50}]}` + "\n",
+		},
+		{
+			name:         "no_dry_run",
+			dryRun:       "false",
+			expectedCode: http.StatusAccepted,
+			enqueued:     true,
+		},
+		{
+			name:         "invalid_dry_run",
+			dryRun:       "foo",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"status":"error","errorType":"bad_data","error":"dry_run must be a boolean"}` + "\n",
+		},
+		{
+			name:         "estimator_error",
+			dryRun:       "true",
+			estimatorErr: fmt.Errorf("some error"),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"status":"error","errorType":"deleting_series","error":"some error"}` + "\n",
+		},
+	}
+
+	config := &Config{
+		ReadOnly:        false,
+		AdminAPIEnabled: true,
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			queue := &mockJobQueue{}
+			estimator := &mockEstimator{estimates: estimates, err: tc.estimatorErr}
+			vals := constructRequestValues("1604311711", "", []string{`{__name__="up"}`, `{job="prometheus"}`})
+			vals.Add("dry_run", tc.dryRun)
+			resp := doPostDeleteRequest(t, deleteHandler(config, queue, estimator), vals)
+			if resp.StatusCode != tc.expectedCode {
+				t.Fatalf("Unexpected HTTP status code received: got %d wanted %d", resp.StatusCode, tc.expectedCode)
+			}
+			if tc.enqueued != (queue.enqueued != nil) {
+				t.Errorf("Unexpected enqueued job: %v", queue.enqueued)
+			}
+			if tc.expectedBody == "" {
+				return
+			}
+			body, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(body) != tc.expectedBody {
+				t.Errorf("Unexpected response body:\ngot\n%s\nwanted\n%s", body, tc.expectedBody)
+			}
+			if tc.dryRun == "true" {
+				if len(estimator.matcherSets) != 2 {
+					t.Errorf("Unexpected matcher sets: %v", estimator.matcherSets)
+				}
+				if !estimator.start.Equal(time.Unix(1604311711, 0)) || !estimator.end.Equal(model.MaxTime) {
+					t.Errorf("Unexpected time range: %v - %v", estimator.start, estimator.end)
+				}
+			}
+		})
+	}
+}
+
 func constructRequestValues(start, end string, matchers []string) url.Values {
 	values := make(url.Values)
 	if start != "" {
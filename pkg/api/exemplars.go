@@ -0,0 +1,84 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/NYTimes/gziphandler"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel/exemplar"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/tenancy"
+)
+
+func QueryExemplars(conf *Config, reader exemplar.Reader) http.Handler {
+	hf := corsWrapper(conf, queryExemplarsHandler(reader))
+	return gziphandler.GzipHandler(hf)
+}
+
+// queryExemplarsHandler responds with the exemplars of the series selected
+// by the query expression, like the Prometheus query_exemplars API does.
+func queryExemplarsHandler(reader exemplar.Reader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start, err := parseTimeParam(r, "start", model.MinTime)
+		if err != nil {
+			log.Info("msg", "Query bad request:"+err.Error())
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+		end, err := parseTimeParam(r, "end", model.MaxTime)
+		if err != nil {
+			log.Info("msg", "Query bad request:"+err.Error())
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+		if end.Before(start) {
+			err = fmt.Errorf("end timestamp must not be before start timestamp")
+			log.Info("msg", "Query bad request:"+err.Error())
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+
+		expr, err := parser.ParseExpr(r.FormValue("query"))
+		if err != nil {
+			log.Info("msg", "Query bad request:"+err.Error())
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+
+		matcherSets := tenancy.EnforceMatcherSets(r.Context(), extractSelectors(expr))
+		res, err := reader.QueryExemplars(start, end, matcherSets)
+		if err != nil {
+			log.Error("msg", "Exemplar query error", "err", err.Error())
+			respondError(w, http.StatusUnprocessableEntity, err, "execution")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&response{
+			Status: "success",
+			Data:   res,
+		})
+	}
+}
+
+// extractSelectors returns the label matchers of every vector selector in
+// the expression.
+func extractSelectors(expr parser.Expr) [][]*labels.Matcher {
+	var selectors [][]*labels.Matcher
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		if vs, ok := node.(*parser.VectorSelector); ok {
+			selectors = append(selectors, vs.LabelMatchers)
+		}
+		return nil
+	})
+	return selectors
+}
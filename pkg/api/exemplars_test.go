@@ -0,0 +1,104 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/pgmodel/exemplar"
+)
+
+type mockExemplarReader struct {
+	start, end  time.Time
+	matcherSets [][]*labels.Matcher
+	res         []exemplar.QueryResult
+	err         error
+}
+
+func (m *mockExemplarReader) QueryExemplars(start, end time.Time, matcherSets [][]*labels.Matcher) ([]exemplar.QueryResult, error) {
+	m.start, m.end, m.matcherSets = start, end, matcherSets
+	return m.res, m.err
+}
+
+func TestQueryExemplars(t *testing.T) {
+	testCases := []struct {
+		name            string
+		url             string
+		reader          *mockExemplarReader
+		expectCode      int
+		expectError     string
+		expectBody      string
+		expectSelectors int
+	}{
+		{
+			name:        "Invalid query",
+			url:         "/api/v1/query_exemplars?query=sum(",
+			reader:      &mockExemplarReader{},
+			expectCode:  http.StatusBadRequest,
+			expectError: "bad_data",
+		}, {
+			name:        "Invalid time range",
+			url:         "/api/v1/query_exemplars?query=up&start=20&end=10",
+			reader:      &mockExemplarReader{},
+			expectCode:  http.StatusBadRequest,
+			expectError: "bad_data",
+		}, {
+			name:        "Reader error",
+			url:         "/api/v1/query_exemplars?query=up",
+			reader:      &mockExemplarReader{err: fmt.Errorf("some error")},
+			expectCode:  http.StatusUnprocessableEntity,
+			expectError: "execution",
+		}, {
+			name: "All good",
+			url:  "/api/v1/query_exemplars?query=rate(http_requests_total[5m])/rate(http_requests_count[5m])&start=10&end=20",
+			reader: &mockExemplarReader{res: []exemplar.QueryResult{
+				{
+					SeriesLabels: labels.FromStrings("__name__", "http_requests_total", "job", "api"),
+					Exemplars: []exemplar.Exemplar{
+						{Labels: labels.FromStrings("trace_id", "abc"), Value: 6, Timestamp: 15500},
+					},
+				},
+			}},
+			expectCode:      http.StatusOK,
+			expectBody:      `{"status":"success","data":[{"seriesLabels":{"__name__":"http_requests_total","job":"api"},"exemplars":[{"labels":{"trace_id":"abc"},"value":"6","timestamp":15.5}]}]}` + "\n",
+			expectSelectors: 2,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", c.url, nil)
+			w := httptest.NewRecorder()
+			queryExemplarsHandler(c.reader).ServeHTTP(w, req)
+
+			if w.Code != c.expectCode {
+				t.Fatalf("Unexpected HTTP status code received: got %d wanted %d", w.Code, c.expectCode)
+			}
+			if c.expectError != "" {
+				var er errResponse
+				_ = json.NewDecoder(w.Body).Decode(&er)
+				if c.expectError != er.ErrorType {
+					t.Errorf("expected error of type %s, got %s", c.expectError, er.ErrorType)
+				}
+				return
+			}
+			if len(c.reader.matcherSets) != c.expectSelectors {
+				t.Errorf("unexpected selectors: got %v", c.reader.matcherSets)
+			}
+			if !c.reader.start.Equal(time.Unix(10, 0)) || !c.reader.end.Equal(time.Unix(20, 0)) {
+				t.Errorf("unexpected time range: %v - %v", c.reader.start, c.reader.end)
+			}
+			if w.Body.String() != c.expectBody {
+				t.Errorf("unexpected body:\ngot\n%s\nwanted\n%s", w.Body.String(), c.expectBody)
+			}
+		})
+	}
+}
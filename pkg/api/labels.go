@@ -5,15 +5,19 @@
 package api
 
 import (
-	"context"
 	"encoding/json"
-	"math"
 	"net/http"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/NYTimes/gziphandler"
+	"github.com/pkg/errors"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
 	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
 	"github.com/timescale/promscale/pkg/promql"
 )
 
@@ -34,13 +38,17 @@ func Labels(conf *Config, queryable promql.Queryable) http.Handler {
 
 func labelsHandler(queryable promql.Queryable) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		querier, err := queryable.Querier(context.Background(), math.MinInt64, math.MaxInt64)
+		start, end, matcherSets, err := parseLabelsParams(r)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+		querier, err := queryable.Querier(r.Context(), timestamp.FromTime(start), timestamp.FromTime(end))
 		if err != nil {
 			respondError(w, http.StatusInternalServerError, err, "internal")
 			return
 		}
-		var names labelsValue
-		names, warnings, err := querier.LabelNames()
+		names, warnings, err := queryLabelsForMatcherSets(matcherSets, querier.LabelNames)
 		if err != nil {
 			respondError(w, http.StatusInternalServerError, err, "internal")
 			return
@@ -51,6 +59,63 @@ func labelsHandler(queryable promql.Queryable) http.HandlerFunc {
 	}
 }
 
+// parseLabelsParams parses the optional start, end and match[] parameters
+// used to scope the label names and label values endpoints.
+func parseLabelsParams(r *http.Request) (start, end time.Time, matcherSets [][]*labels.Matcher, err error) {
+	if err = r.ParseForm(); err != nil {
+		return start, end, nil, errors.Wrap(err, "error parsing form values")
+	}
+
+	start, err = parseTimeParam(r, "start", model.MinTime)
+	if err != nil {
+		return start, end, nil, err
+	}
+	end, err = parseTimeParam(r, "end", model.MaxTime)
+	if err != nil {
+		return start, end, nil, err
+	}
+	if end.Before(start) {
+		return start, end, nil, errors.New("end timestamp must not be before start time")
+	}
+
+	for _, s := range r.Form["match[]"] {
+		matchers, err := parser.ParseMetricSelector(s)
+		if err != nil {
+			return start, end, nil, err
+		}
+		matcherSets = append(matcherSets, matchers)
+	}
+	return start, end, matcherSets, nil
+}
+
+// queryLabelsForMatcherSets returns the sorted union of the query results for
+// every matcher set, or the unscoped results if there are no matcher sets.
+func queryLabelsForMatcherSets(matcherSets [][]*labels.Matcher, query func(...*labels.Matcher) ([]string, storage.Warnings, error)) (labelsValue, storage.Warnings, error) {
+	if len(matcherSets) == 0 {
+		return query()
+	}
+
+	var warnings storage.Warnings
+	set := make(map[string]struct{})
+	for _, matchers := range matcherSets {
+		values, callWarnings, err := query(matchers...)
+		if err != nil {
+			return nil, nil, err
+		}
+		warnings = append(warnings, callWarnings...)
+		for _, v := range values {
+			set[v] = struct{}{}
+		}
+	}
+
+	result := make(labelsValue, 0, len(set))
+	for v := range set {
+		result = append(result, v)
+	}
+	sort.Strings(result)
+	return result, warnings, nil
+}
+
 func respondLabels(w http.ResponseWriter, res *promql.Result, warnings storage.Warnings) {
 	setResponseHeaders(w, res, warnings)
 	resp := &response{
@@ -14,7 +14,10 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/prometheus/prometheus/pkg/timestamp"
 	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgmodel/querier"
 	"github.com/timescale/promscale/pkg/query"
 )
 
@@ -26,8 +29,12 @@ func TestLabels(t *testing.T) {
 		name         string
 		querier      *mockQuerier
 		labelsReader *mockLabelsReader
+		params       string
 		expectCode   int
 		expectError  string
+		expectResult []string
+		expectMint   int64
+		expectMaxt   int64
 	}{
 		{
 			name:         "Error on get label names",
@@ -40,12 +47,41 @@ func TestLabels(t *testing.T) {
 			expectCode:   http.StatusOK,
 			querier:      &mockQuerier{},
 			labelsReader: &mockLabelsReader{labelNames: []string{"a"}},
+			expectResult: []string{"a"},
+			expectMint:   timestamp.FromTime(model.MinTime),
+			expectMaxt:   timestamp.FromTime(model.MaxTime),
+		}, {
+			name:         "Invalid matcher",
+			expectCode:   http.StatusBadRequest,
+			expectError:  "bad_data",
+			querier:      &mockQuerier{},
+			labelsReader: &mockLabelsReader{},
+			params:       "match[]=foo{",
+		}, {
+			name:         "End before start",
+			expectCode:   http.StatusBadRequest,
+			expectError:  "bad_data",
+			querier:      &mockQuerier{},
+			labelsReader: &mockLabelsReader{},
+			params:       "start=2&end=1",
+		}, {
+			name:       "Matchers and time range",
+			expectCode: http.StatusOK,
+			querier:    &mockQuerier{},
+			labelsReader: &mockLabelsReader{labelNamesPerMatchers: map[string][]string{
+				`[__name__="foo"]`: {"b", "__name__"},
+				`[__name__="bar"]`: {"a", "b", "__name__"},
+			}},
+			params:       "match[]=foo&match[]=bar&start=1&end=2",
+			expectResult: []string{"__name__", "a", "b"},
+			expectMint:   1000,
+			expectMaxt:   2000,
 		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			handler := labelsHandler(query.NewQueryable(nil, tc.labelsReader))
-			w := doLabels(t, handler)
+			handler := labelsHandler(query.NewQueryable(nil, tc.labelsReader, querier.QueryLimits{}))
+			w := doLabels(t, handler, tc.params)
 
 			if w.Code != tc.expectCode {
 				t.Errorf("Unexpected HTTP status code received: got %d wanted %d", w.Code, tc.expectCode)
@@ -68,8 +104,11 @@ func TestLabels(t *testing.T) {
 			for _, s := range res.Data.([]interface{}) {
 				resStr = append(resStr, s.(string))
 			}
-			if !reflect.DeepEqual(resStr, tc.labelsReader.labelNames) {
-				t.Errorf("expected: %v, got: %v", tc.labelsReader.labelNames, res.Data)
+			if !reflect.DeepEqual(resStr, tc.expectResult) {
+				t.Errorf("expected: %v, got: %v", tc.expectResult, res.Data)
+			}
+			if tc.labelsReader.mint != tc.expectMint || tc.labelsReader.maxt != tc.expectMaxt {
+				t.Errorf("unexpected time range: got [%d, %d] wanted [%d, %d]", tc.labelsReader.mint, tc.labelsReader.maxt, tc.expectMint, tc.expectMaxt)
 			}
 		})
 
@@ -77,8 +116,8 @@ func TestLabels(t *testing.T) {
 
 }
 
-func doLabels(t *testing.T, queryHandler http.Handler) *httptest.ResponseRecorder {
-	req, err := http.NewRequestWithContext(context.Background(), "GET", "http://localhost:9090/labels", nil)
+func doLabels(t *testing.T, queryHandler http.Handler, params string) *httptest.ResponseRecorder {
+	req, err := http.NewRequestWithContext(context.Background(), "GET", "http://localhost:9090/labels?"+params, nil)
 	if err != nil {
 		t.Errorf("%v", err)
 	}
@@ -0,0 +1,55 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/NYTimes/gziphandler"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel/metadata"
+)
+
+func MetricMetadata(conf *Config, reader metadata.Reader) http.Handler {
+	hf := corsWrapper(conf, metricMetadataHandler(conf, reader))
+	return gziphandler.GzipHandler(hf)
+}
+
+// metricMetadataHandler responds with the metadata of the metrics. The
+// metadata is stored per metric name, regardless of the tenant which sent
+// it, so it is not available with multi-tenancy.
+func metricMetadataHandler(config *Config, reader metadata.Reader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if config.TenantHeader != "" {
+			respondError(w, http.StatusForbidden, fmt.Errorf("metric metadata is shared by the tenants and is not available with multi-tenancy"), "operation_not_permitted")
+			return
+		}
+		limit := -1
+		if s := r.FormValue("limit"); s != "" {
+			var err error
+			if limit, err = strconv.Atoi(s); err != nil {
+				respondError(w, http.StatusBadRequest, fmt.Errorf("limit must be a number"), "bad_data")
+				return
+			}
+		}
+
+		md, err := reader.MetricMetadata(r.FormValue("metric"), limit)
+		if err != nil {
+			log.Error("msg", "Metric metadata query error", "err", err.Error())
+			respondError(w, http.StatusInternalServerError, err, "internal")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&response{
+			Status: "success",
+			Data:   md,
+		})
+	}
+}
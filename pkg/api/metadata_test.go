@@ -0,0 +1,99 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+type mockMetadataReader struct {
+	metric string
+	limit  int
+	res    map[string][]model.Metadata
+	err    error
+}
+
+func (m *mockMetadataReader) MetricMetadata(metric string, limit int) (map[string][]model.Metadata, error) {
+	m.metric, m.limit = metric, limit
+	return m.res, m.err
+}
+
+func TestMetricMetadata(t *testing.T) {
+	testCases := []struct {
+		name        string
+		url         string
+		config      *Config
+		reader      *mockMetadataReader
+		expectCode  int
+		expectError string
+		expectBody  string
+		expectLimit int
+	}{
+		{
+			name:        "Invalid limit",
+			url:         "/api/v1/metadata?limit=abc",
+			reader:      &mockMetadataReader{},
+			expectCode:  http.StatusBadRequest,
+			expectError: "bad_data",
+		}, {
+			name:        "Multi-tenancy",
+			url:         "/api/v1/metadata",
+			config:      &Config{TenantHeader: "X-Scope-OrgID"},
+			reader:      &mockMetadataReader{},
+			expectCode:  http.StatusForbidden,
+			expectError: "operation_not_permitted",
+		}, {
+			name:        "Reader error",
+			url:         "/api/v1/metadata",
+			reader:      &mockMetadataReader{err: fmt.Errorf("some error")},
+			expectCode:  http.StatusInternalServerError,
+			expectError: "internal",
+		}, {
+			name: "All good",
+			url:  "/api/v1/metadata?metric=memory_usage&limit=2",
+			reader: &mockMetadataReader{res: map[string][]model.Metadata{
+				"memory_usage": {{MetricFamily: "memory_usage", Type: "gauge", Unit: "bytes", Help: "Memory usage."}},
+			}},
+			expectCode:  http.StatusOK,
+			expectBody:  `{"status":"success","data":{"memory_usage":[{"type":"gauge","unit":"bytes","help":"Memory usage."}]}}` + "\n",
+			expectLimit: 2,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.config == nil {
+				c.config = &Config{}
+			}
+			req := httptest.NewRequest("GET", c.url, nil)
+			w := httptest.NewRecorder()
+			metricMetadataHandler(c.config, c.reader).ServeHTTP(w, req)
+
+			if w.Code != c.expectCode {
+				t.Fatalf("Unexpected HTTP status code received: got %d wanted %d", w.Code, c.expectCode)
+			}
+			if c.expectError != "" {
+				var er errResponse
+				_ = json.NewDecoder(w.Body).Decode(&er)
+				if c.expectError != er.ErrorType {
+					t.Errorf("expected error of type %s, got %s", c.expectError, er.ErrorType)
+				}
+				return
+			}
+			if c.reader.limit != c.expectLimit {
+				t.Errorf("unexpected limit: got %d wanted %d", c.reader.limit, c.expectLimit)
+			}
+			if w.Body.String() != c.expectBody {
+				t.Errorf("unexpected body:\ngot\n%s\nwanted\n%s", w.Body.String(), c.expectBody)
+			}
+		})
+	}
+}
@@ -31,7 +31,9 @@ type Metrics struct {
 	QueryDuration       prometheus.Histogram
 	InvalidReadReqs     prometheus.Counter
 	InvalidWriteReqs    prometheus.Counter
+	ThrottledWriteReqs  prometheus.Counter
 	InvalidQueryReqs    prometheus.Counter
+	RejectedQueries     prometheus.Counter
 	HTTPRequestDuration *prometheus.HistogramVec
 }
 
@@ -50,8 +52,10 @@ func InitMetrics(writeMetricInterval int) *Metrics {
 		metrics.SentSamples,
 		metrics.FailedSamples,
 		metrics.FailedQueries,
+		metrics.RejectedQueries,
 		metrics.InvalidReadReqs,
 		metrics.InvalidWriteReqs,
+		metrics.ThrottledWriteReqs,
 		metrics.SentBatchDuration,
 		metrics.QueryBatchDuration,
 		metrics.QueryDuration,
@@ -129,6 +133,13 @@ func createMetrics(writeMetricInterval int) *Metrics {
 				Help:      "Total number of queries which failed on send to remote storage.",
 			},
 		),
+		RejectedQueries: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: util.PromNamespace,
+				Name:      "rejected_queries_total",
+				Help:      "Total number of queries rejected for loading too many samples or too much memory.",
+			},
+		),
 		InvalidReadReqs: prometheus.NewCounter(
 			prometheus.CounterOpts{
 				Namespace: util.PromNamespace,
@@ -143,6 +154,13 @@ func createMetrics(writeMetricInterval int) *Metrics {
 				Help:      "Total number of remote write requests with invalid metadata.",
 			},
 		),
+		ThrottledWriteReqs: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: util.PromNamespace,
+				Name:      "throttled_write_requests_total",
+				Help:      "Total number of remote write requests rejected because of the ingest in-flight limits.",
+			},
+		),
 		InvalidQueryReqs: prometheus.NewCounter(
 			prometheus.CounterOpts{
 				Namespace: util.PromNamespace,
@@ -71,6 +71,8 @@ func queryHandler(queryEngine *promql.Engine, queryable promql.Queryable, metric
 			case promql.ErrStorage:
 				respondError(w, http.StatusInternalServerError, res.Err, "internal")
 				return
+			case promql.ErrTooManySamples:
+				metrics.RejectedQueries.Add(1)
 			}
 			respondError(w, http.StatusUnprocessableEntity, res.Err, "execution")
 			metrics.FailedQueries.Add(1)
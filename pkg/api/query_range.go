@@ -13,14 +13,17 @@ import (
 	"github.com/pkg/errors"
 	"github.com/timescale/promscale/pkg/log"
 	"github.com/timescale/promscale/pkg/promql"
+	"github.com/timescale/promscale/pkg/query"
 )
 
-func QueryRange(conf *Config, queryEngine *promql.Engine, queryable promql.Queryable, metrics *Metrics) http.Handler {
-	hf := corsWrapper(conf, queryRange(queryEngine, queryable, metrics))
+// QueryRange returns the handler of range queries. The results cache may be
+// nil.
+func QueryRange(conf *Config, queryEngine *promql.Engine, queryable promql.Queryable, resultsCache *query.ResultsCache, metrics *Metrics) http.Handler {
+	hf := corsWrapper(conf, queryRange(queryEngine, queryable, resultsCache, metrics))
 	return gziphandler.GzipHandler(hf)
 }
 
-func queryRange(queryEngine *promql.Engine, queryable promql.Queryable, metrics *Metrics) http.HandlerFunc {
+func queryRange(queryEngine *promql.Engine, queryable promql.Queryable, resultsCache *query.ResultsCache, metrics *Metrics) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		start, err := parseTime(r.FormValue("start"))
 		if err != nil {
@@ -87,7 +90,9 @@ func queryRange(queryEngine *promql.Engine, queryable promql.Queryable, metrics
 
 		metrics.ReceivedQueries.Add(1)
 		begin := time.Now()
-		qry, err := queryEngine.NewRangeQuery(
+		res, err := resultsCache.Exec(
+			ctx,
+			queryEngine,
 			queryable,
 			r.FormValue("query"),
 			start,
@@ -101,7 +106,6 @@ func queryRange(queryEngine *promql.Engine, queryable promql.Queryable, metrics
 			return
 		}
 
-		res := qry.Exec(ctx)
 		metrics.QueryDuration.Observe(time.Since(begin).Seconds())
 
 		if res.Err != nil {
@@ -116,6 +120,8 @@ func queryRange(queryEngine *promql.Engine, queryable promql.Queryable, metrics
 			case promql.ErrStorage:
 				respondError(w, http.StatusInternalServerError, res.Err, "internal")
 				return
+			case promql.ErrTooManySamples:
+				metrics.RejectedQueries.Add(1)
 			}
 			respondError(w, http.StatusUnprocessableEntity, res.Err, "execution")
 			metrics.FailedQueries.Add(1)
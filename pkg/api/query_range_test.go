@@ -17,6 +17,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel/querier"
 	"github.com/timescale/promscale/pkg/promql"
 	"github.com/timescale/promscale/pkg/query"
 )
@@ -170,8 +171,9 @@ func TestRangedQuery(t *testing.T) {
 				ReceivedQueries:  receivedQueriesCounter,
 				InvalidQueryReqs: invalidQueryReqs,
 				QueryDuration:    queryDuration,
+				RejectedQueries:  &mockMetric{},
 			}
-			handler := queryRange(engine, query.NewQueryable(tc.querier, nil), metrics)
+			handler := queryRange(engine, query.NewQueryable(tc.querier, nil, querier.QueryLimits{}), nil, metrics)
 			queryUrl := constructRangedQuery(tc.metric, tc.start, tc.end, tc.step, tc.timeout)
 			w := doRangedQuery(t, handler, queryUrl, tc.canceled)
 
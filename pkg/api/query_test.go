@@ -53,11 +53,15 @@ type mockQuerier struct {
 
 var _ querier.Querier = (*mockQuerier)(nil)
 
-func (m mockQuerier) Query(*prompb.Query) ([]*prompb.TimeSeries, error) {
+func (m mockQuerier) Query(*prompb.Query, *querier.QueryBudget) ([]*prompb.TimeSeries, error) {
 	panic("implement me")
 }
 
-func (m mockQuerier) Select(int64, int64, bool, *storage.SelectHints, []parser.Node, ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+func (m mockQuerier) QueryChunked(*prompb.Query, *querier.QueryBudget, func(*prompb.ChunkedSeries) error) error {
+	panic("implement me")
+}
+
+func (m mockQuerier) Select(int64, int64, bool, *storage.SelectHints, []parser.Node, *querier.QueryBudget, ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
 	time.Sleep(m.timeToSleepOnSelect)
 	return &mockSeriesSet{err: m.selectErr}, nil
 }
@@ -65,6 +69,10 @@ func (m mockQuerier) Select(int64, int64, bool, *storage.SelectHints, []parser.N
 type mockLabelsReader struct {
 	labelNames    []string
 	labelNamesErr error
+	// labelNamesPerMatchers, if set, is returned by MatchingLabelNames for
+	// the string representation of the matchers.
+	labelNamesPerMatchers map[string][]string
+	mint, maxt            int64
 }
 
 func (m mockLabelsReader) PrompbLabelsForIds(ids []int64) (lls []prompb.Label, err error) {
@@ -83,6 +91,18 @@ func (m mockLabelsReader) LabelValues(string) ([]string, error) {
 	return nil, nil
 }
 
+func (m *mockLabelsReader) MatchingLabelNames(mint, maxt int64, matchers ...*labels.Matcher) ([]string, error) {
+	m.mint, m.maxt = mint, maxt
+	if m.labelNamesPerMatchers != nil {
+		return m.labelNamesPerMatchers[fmt.Sprint(matchers)], m.labelNamesErr
+	}
+	return m.labelNames, m.labelNamesErr
+}
+
+func (m *mockLabelsReader) MatchingLabelValues(string, int64, int64, ...*labels.Matcher) ([]string, error) {
+	return nil, nil
+}
+
 func TestParseDuration(t *testing.T) {
 	testCase := []struct {
 		in          string
@@ -187,6 +207,13 @@ func TestQuery(t *testing.T) {
 			metric:      "m",
 			querier:     &mockQuerier{selectErr: fmt.Errorf("some error")},
 			timeout:     "30s",
+		}, {
+			name:        "Too many samples",
+			expectCode:  http.StatusUnprocessableEntity,
+			expectError: "execution",
+			metric:      "m",
+			querier:     &mockQuerier{selectErr: promql.ErrTooManySamples("query execution")},
+			timeout:     "30s",
 		}, {
 			name:       "All good",
 			expectCode: http.StatusOK,
@@ -215,8 +242,9 @@ func TestQuery(t *testing.T) {
 				ReceivedQueries:  receivedQueriesCounter,
 				InvalidQueryReqs: invalidQueryReqs,
 				QueryDuration:    queryDuration,
+				RejectedQueries:  &mockMetric{},
 			}
-			handler := queryHandler(engine, query.NewQueryable(tc.querier, tc.labelsReader), metrics)
+			handler := queryHandler(engine, query.NewQueryable(tc.querier, tc.labelsReader, querier.QueryLimits{}), metrics)
 			queryURL := constructQuery(tc.metric, tc.time, tc.timeout)
 			w := doQuery(t, handler, queryURL, tc.canceled)
 
@@ -5,7 +5,11 @@
 package api
 
 import (
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"hash/crc32"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -16,6 +20,15 @@ import (
 	"github.com/timescale/promscale/pkg/log"
 	"github.com/timescale/promscale/pkg/pgmodel/querier"
 	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/promql"
+	"github.com/timescale/promscale/pkg/tenancy"
+)
+
+const (
+	chunkedReadContentType = "application/x-streamed-protobuf; proto=prometheus.ChunkedReadResponse"
+	// chunkedReadMaxBytesInFrame is the soft limit of a single streamed
+	// frame, same as the Prometheus default.
+	chunkedReadMaxBytesInFrame = 1024 * 1024
 )
 
 func Read(reader querier.Reader, metrics *Metrics) http.Handler {
@@ -46,15 +59,35 @@ func Read(reader querier.Reader, metrics *Metrics) http.Handler {
 			return
 		}
 
+		tenancy.EnforceReadRequest(r.Context(), &req)
+
+		responseType, err := negotiateReadResponseType(req.AcceptedResponseTypes)
+		if err != nil {
+			log.Error("msg", "Read response type negotiation error", "err", err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
 		queryCount := float64(len(req.Queries))
 		metrics.ReceivedQueries.Add(queryCount)
 		begin := time.Now()
 
+		if responseType == prompb.ReadRequest_STREAMED_XOR_CHUNKS {
+			if err := streamChunkedRead(w, &req, reader); err != nil {
+				log.Warn("msg", "Error executing query", "query", req, "storage", "PostgreSQL", "err", err)
+				http.Error(w, err.Error(), readErrorStatus(err, metrics, queryCount))
+				metrics.FailedQueries.Add(queryCount)
+				return
+			}
+			metrics.QueryBatchDuration.Observe(time.Since(begin).Seconds())
+			return
+		}
+
 		var resp *prompb.ReadResponse
 		resp, err = reader.Read(&req)
 		if err != nil {
 			log.Warn("msg", "Error executing query", "query", req, "storage", "PostgreSQL", "err", err)
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			http.Error(w, err.Error(), readErrorStatus(err, metrics, queryCount))
 			metrics.FailedQueries.Add(queryCount)
 			return
 		}
@@ -81,6 +114,127 @@ func Read(reader querier.Reader, metrics *Metrics) http.Handler {
 	})
 }
 
+// readErrorStatus returns the HTTP status of a failed read request. Queries
+// loading too many samples are counted as rejected, and reported the way the
+// PromQL API does.
+func readErrorStatus(err error, metrics *Metrics, queryCount float64) int {
+	var tooManySamples promql.ErrTooManySamples
+	if errors.As(err, &tooManySamples) {
+		metrics.RejectedQueries.Add(queryCount)
+		return http.StatusUnprocessableEntity
+	}
+	return http.StatusInternalServerError
+}
+
+// negotiateReadResponseType picks the first response type accepted by the
+// client that we support. Clients that do not state any accepted response
+// types only understand sampled responses.
+func negotiateReadResponseType(accepted []prompb.ReadRequest_ResponseType) (prompb.ReadRequest_ResponseType, error) {
+	if len(accepted) == 0 {
+		return prompb.ReadRequest_SAMPLES, nil
+	}
+
+	for _, resType := range accepted {
+		switch resType {
+		case prompb.ReadRequest_SAMPLES, prompb.ReadRequest_STREAMED_XOR_CHUNKS:
+			return resType, nil
+		}
+	}
+	return 0, fmt.Errorf("server does not support any of the requested response types: %v", accepted)
+}
+
+// streamChunkedRead writes the results of the read request as a stream of
+// framed ChunkedReadResponse messages, as the series are read from the database.
+func streamChunkedRead(w http.ResponseWriter, req *prompb.ReadRequest, reader querier.Reader) error {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return fmt.Errorf("internal http.ResponseWriter does not implement http.Flusher interface")
+	}
+
+	w.Header().Set("Content-Type", chunkedReadContentType)
+
+	cw := &chunkedWriter{writer: w, flusher: flusher}
+	return reader.ReadChunked(req, func(queryIndex int64, series *prompb.ChunkedSeries) error {
+		return writeChunkedSeries(cw, queryIndex, series, chunkedReadMaxBytesInFrame)
+	})
+}
+
+// writeChunkedSeries writes a single series as one or more frames, splitting
+// its chunks so that each frame stays around maxBytesInFrame. A frame always
+// holds at least one chunk.
+func writeChunkedSeries(w io.Writer, queryIndex int64, series *prompb.ChunkedSeries, maxBytesInFrame int) error {
+	labelsSize := 0
+	for i := range series.Labels {
+		labelsSize += series.Labels[i].Size()
+	}
+
+	chunks := series.Chunks
+	for len(chunks) > 0 {
+		frameBytesLeft := maxBytesInFrame - labelsSize
+		n := 0
+		for n < len(chunks) {
+			frameBytesLeft -= chunks[n].Size()
+			n++
+			if frameBytesLeft <= 0 {
+				break
+			}
+		}
+
+		data, err := proto.Marshal(&prompb.ChunkedReadResponse{
+			ChunkedSeries: []*prompb.ChunkedSeries{
+				{Labels: series.Labels, Chunks: chunks[:n]},
+			},
+			QueryIndex: queryIndex,
+		})
+		if err != nil {
+			return fmt.Errorf("marshal ChunkedReadResponse: %w", err)
+		}
+
+		if _, err := w.Write(data); err != nil {
+			return fmt.Errorf("write to stream: %w", err)
+		}
+		chunks = chunks[n:]
+	}
+
+	return nil
+}
+
+// chunkedWriter writes every buffer as a single frame of the Prometheus
+// streamed remote-read protocol and flushes it right away. Each frame is:
+//
+// 1. uvarint for the size of the data frame.
+// 2. big-endian uint32 for the Castagnoli polynomial CRC-32 checksum of the data frame.
+// 3. the bytes of the given data.
+type chunkedWriter struct {
+	writer  io.Writer
+	flusher http.Flusher
+}
+
+var castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Write implements io.Writer. The returned number of bytes does not include
+// the size and checksum bytes.
+func (w *chunkedWriter) Write(b []byte) (int, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	var buf [binary.MaxVarintLen64 + 4]byte
+	n := binary.PutUvarint(buf[:], uint64(len(b)))
+	binary.BigEndian.PutUint32(buf[n:], crc32.Checksum(b, castagnoliTable))
+	if _, err := w.writer.Write(buf[:n+4]); err != nil {
+		return 0, err
+	}
+
+	n, err := w.writer.Write(b)
+	if err != nil {
+		return n, err
+	}
+
+	w.flusher.Flush()
+	return n, nil
+}
+
 func validateReadHeaders(w http.ResponseWriter, r *http.Request) bool {
 	// validate headers from https://github.com/prometheus/prometheus/blob/2bd077ed9724548b6a631b6ddba48928704b5c34/storage/remote/client.go
 	if r.Method != "POST" {
@@ -5,15 +5,21 @@
 package api
 
 import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/snappy"
 	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/promql"
 )
 
 func TestRead(t *testing.T) {
@@ -24,6 +30,7 @@ func TestRead(t *testing.T) {
 		readerResponse     *prompb.ReadResponse
 		readerErr          error
 		expReceivedQueries float64
+		expRejectedQueries float64
 	}{
 		{
 			name:         "read request body error",
@@ -53,6 +60,16 @@ func TestRead(t *testing.T) {
 			),
 			expReceivedQueries: 1,
 		},
+		{
+			name:         "too many samples",
+			responseCode: http.StatusUnprocessableEntity,
+			readerErr:    promql.ErrTooManySamples("query execution"),
+			requestBody: readRequestToString(
+				&prompb.ReadRequest{Queries: []*prompb.Query{{}}},
+			),
+			expReceivedQueries: 1,
+			expRejectedQueries: 1,
+		},
 		{
 			name:           "happy path",
 			responseCode:   http.StatusOK,
@@ -81,11 +98,13 @@ func TestRead(t *testing.T) {
 			queryDurationHist := &mockMetric{}
 			failedQueriesCounter := &mockMetric{}
 			invalidReadReqs := &mockMetric{}
+			rejectedQueriesCounter := &mockMetric{}
 			metrics := &Metrics{
 				QueryBatchDuration: queryDurationHist,
 				FailedQueries:      failedQueriesCounter,
 				ReceivedQueries:    receivedQueriesCounter,
 				InvalidReadReqs:    invalidReadReqs,
+				RejectedQueries:    rejectedQueriesCounter,
 			}
 			handler := Read(mockReader, metrics)
 
@@ -106,10 +125,176 @@ func TestRead(t *testing.T) {
 			if c.responseCode == http.StatusInternalServerError && failedQueriesCounter.value == 0 {
 				t.Error("expected number of failed queries to be > 0")
 			}
+			if rejectedQueriesCounter.value != c.expRejectedQueries {
+				t.Errorf("expected %f queries to be rejected, got %f", c.expRejectedQueries, rejectedQueriesCounter.value)
+			}
 		})
 	}
 }
 
+func TestStreamedRead(t *testing.T) {
+	series := &prompb.ChunkedSeries{
+		Labels: []prompb.Label{{Name: "__name__", Value: "foo"}},
+		Chunks: []prompb.Chunk{
+			{MinTimeMs: 1, MaxTimeMs: 2, Type: prompb.Chunk_XOR, Data: []byte("chunk 1")},
+			{MinTimeMs: 3, MaxTimeMs: 4, Type: prompb.Chunk_XOR, Data: []byte("chunk 2")},
+		},
+	}
+	testCases := []struct {
+		name          string
+		responseCode  int
+		acceptedTypes []prompb.ReadRequest_ResponseType
+		readerErr     error
+		expFrames     []*prompb.ChunkedReadResponse
+	}{
+		{
+			name:          "unsupported response type",
+			responseCode:  http.StatusBadRequest,
+			acceptedTypes: []prompb.ReadRequest_ResponseType{5},
+		},
+		{
+			name:          "reader error",
+			responseCode:  http.StatusInternalServerError,
+			acceptedTypes: []prompb.ReadRequest_ResponseType{prompb.ReadRequest_STREAMED_XOR_CHUNKS},
+			readerErr:     fmt.Errorf("some error"),
+		},
+		{
+			name:          "too many samples",
+			responseCode:  http.StatusUnprocessableEntity,
+			acceptedTypes: []prompb.ReadRequest_ResponseType{prompb.ReadRequest_STREAMED_XOR_CHUNKS},
+			readerErr:     promql.ErrTooManySamples("query execution"),
+		},
+		{
+			name:          "happy path",
+			responseCode:  http.StatusOK,
+			acceptedTypes: []prompb.ReadRequest_ResponseType{5, prompb.ReadRequest_STREAMED_XOR_CHUNKS, prompb.ReadRequest_SAMPLES},
+			expFrames: []*prompb.ChunkedReadResponse{
+				{ChunkedSeries: []*prompb.ChunkedSeries{series}, QueryIndex: 0},
+				{ChunkedSeries: []*prompb.ChunkedSeries{series}, QueryIndex: 1},
+			},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			mockReader := &mockReader{
+				chunked: []*prompb.ChunkedSeries{series},
+				err:     c.readerErr,
+			}
+			failedQueriesCounter := &mockMetric{}
+			metrics := &Metrics{
+				QueryBatchDuration: &mockMetric{},
+				FailedQueries:      failedQueriesCounter,
+				ReceivedQueries:    &mockMetric{},
+				InvalidReadReqs:    &mockMetric{},
+				RejectedQueries:    &mockMetric{},
+			}
+			handler := Read(mockReader, metrics)
+
+			test := GenerateReadHandleTester(t, handler, false)
+
+			w := test("POST", getReader(readRequestToString(&prompb.ReadRequest{
+				Queries:               []*prompb.Query{{}, {}},
+				AcceptedResponseTypes: c.acceptedTypes,
+			})))
+
+			if w.Code != c.responseCode {
+				t.Fatalf("Unexpected HTTP status code received: got %d wanted %d", w.Code, c.responseCode)
+			}
+			if c.responseCode == http.StatusInternalServerError && failedQueriesCounter.value == 0 {
+				t.Error("expected number of failed queries to be > 0")
+			}
+			if c.responseCode != http.StatusOK {
+				return
+			}
+
+			if contentType := w.Header().Get("Content-Type"); contentType != chunkedReadContentType {
+				t.Errorf("unexpected content type: got %s wanted %s", contentType, chunkedReadContentType)
+			}
+
+			reader := bufio.NewReader(w.Body)
+			frames := make([]*prompb.ChunkedReadResponse, 0, len(c.expFrames))
+			for {
+				frame := &prompb.ChunkedReadResponse{}
+				err := readFrame(reader, frame)
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("unexpected error reading frame: %v", err)
+				}
+				frames = append(frames, frame)
+			}
+
+			if !reflect.DeepEqual(frames, c.expFrames) {
+				t.Errorf("unexpected frames:\ngot\n%v\nwanted\n%v", frames, c.expFrames)
+			}
+		})
+	}
+}
+
+func TestWriteChunkedSeriesSplitsFrames(t *testing.T) {
+	series := &prompb.ChunkedSeries{
+		Labels: []prompb.Label{{Name: "__name__", Value: "foo"}},
+		Chunks: []prompb.Chunk{
+			{MinTimeMs: 1, MaxTimeMs: 2, Type: prompb.Chunk_XOR, Data: []byte("chunk 1")},
+			{MinTimeMs: 3, MaxTimeMs: 4, Type: prompb.Chunk_XOR, Data: []byte("chunk 2")},
+			{MinTimeMs: 5, MaxTimeMs: 6, Type: prompb.Chunk_XOR, Data: []byte("chunk 3")},
+		},
+	}
+
+	var buf bytes.Buffer
+	cw := &chunkedWriter{writer: &buf, flusher: httptest.NewRecorder()}
+	// Room for the labels and a single chunk per frame.
+	maxBytes := series.Labels[0].Size() + series.Chunks[0].Size()
+	if err := writeChunkedSeries(cw, 3, series, maxBytes); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	reader := bufio.NewReader(&buf)
+	for i := range series.Chunks {
+		frame := &prompb.ChunkedReadResponse{}
+		if err := readFrame(reader, frame); err != nil {
+			t.Fatalf("unexpected error reading frame %d: %v", i, err)
+		}
+		expFrame := &prompb.ChunkedReadResponse{
+			ChunkedSeries: []*prompb.ChunkedSeries{
+				{Labels: series.Labels, Chunks: series.Chunks[i : i+1]},
+			},
+			QueryIndex: 3,
+		}
+		if !reflect.DeepEqual(frame, expFrame) {
+			t.Errorf("unexpected frame %d:\ngot\n%v\nwanted\n%v", i, frame, expFrame)
+		}
+	}
+	if err := readFrame(reader, &prompb.ChunkedReadResponse{}); err != io.EOF {
+		t.Errorf("expected no more frames, got %v", err)
+	}
+}
+
+// readFrame reads and verifies a single frame written by chunkedWriter.
+func readFrame(r *bufio.Reader, pb proto.Message) error {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+
+	var checksum uint32
+	if err = binary.Read(r, binary.BigEndian, &checksum); err != nil {
+		return err
+	}
+
+	data := make([]byte, size)
+	if _, err = io.ReadFull(r, data); err != nil {
+		return err
+	}
+	if crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli)) != checksum {
+		return fmt.Errorf("frame checksum mismatch")
+	}
+
+	return proto.Unmarshal(data, pb)
+}
+
 func readRequestToString(r *prompb.ReadRequest) string {
 	data, _ := proto.Marshal(r)
 	return string(snappy.Encode(nil, data))
@@ -118,6 +303,7 @@ func readRequestToString(r *prompb.ReadRequest) string {
 type mockReader struct {
 	request  *prompb.ReadRequest
 	response *prompb.ReadResponse
+	chunked  []*prompb.ChunkedSeries
 	err      error
 }
 
@@ -126,6 +312,21 @@ func (m *mockReader) Read(r *prompb.ReadRequest) (*prompb.ReadResponse, error) {
 	return m.response, m.err
 }
 
+func (m *mockReader) ReadChunked(r *prompb.ReadRequest, handle func(int64, *prompb.ChunkedSeries) error) error {
+	m.request = r
+	if m.err != nil {
+		return m.err
+	}
+	for i := range r.Queries {
+		for _, s := range m.chunked {
+			if err := handle(int64(i), s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func GenerateReadHandleTester(t *testing.T, handleFunc http.Handler, badHeader bool) HandleTester {
 	return func(method string, body io.Reader) *httptest.ResponseRecorder {
 		req, err := http.NewRequest(method, "", body)
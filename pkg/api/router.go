@@ -17,6 +17,7 @@ import (
 	"github.com/timescale/promscale/pkg/log"
 	"github.com/timescale/promscale/pkg/pgclient"
 	"github.com/timescale/promscale/pkg/query"
+	"github.com/timescale/promscale/pkg/tenancy"
 	"github.com/timescale/promscale/pkg/util"
 )
 
@@ -27,7 +28,7 @@ func GenerateRouter(apiConf *Config, metrics *Metrics, client *pgclient.Client,
 
 	router := route.New().WithInstrumentation(authWrapper)
 
-	writeHandler := timeHandler(metrics.HTTPRequestDuration, "write", Write(client, elector, metrics))
+	writeHandler := timeHandler(metrics.HTTPRequestDuration, "write", tenantHandler(apiConf, Write(client, elector, metrics)))
 
 	// If we are running in read-only mode, log and send NotFound status.
 	if apiConf.ReadOnly {
@@ -36,38 +37,69 @@ func GenerateRouter(apiConf *Config, metrics *Metrics, client *pgclient.Client,
 
 	router.Post("/write", writeHandler)
 
-	readHandler := timeHandler(metrics.HTTPRequestDuration, "read", Read(client, metrics))
+	readHandler := timeHandler(metrics.HTTPRequestDuration, "read", tenantHandler(apiConf, Read(client, metrics)))
 	router.Get("/read", readHandler)
 	router.Post("/read", readHandler)
 
-	deleteHandler := timeHandler(metrics.HTTPRequestDuration, "delete_series", Delete(apiConf, client))
+	deleteHandler := timeHandler(metrics.HTTPRequestDuration, "delete_series", tenantHandler(apiConf, Delete(apiConf, client, client)))
 	router.Put("/delete_series", deleteHandler)
 	router.Post("/delete_series", deleteHandler)
 
+	deleteJobHandler := timeHandler(metrics.HTTPRequestDuration, "admin/delete_jobs/:id", tenantHandler(apiConf, DeleteJob(apiConf, client)))
+	router.Get("/api/v1/admin/delete_jobs/:id", deleteJobHandler)
+
+	cancelDeleteJobHandler := timeHandler(metrics.HTTPRequestDuration, "admin/delete_jobs/:id/cancel", tenantHandler(apiConf, CancelDeleteJob(apiConf, client)))
+	router.Post("/api/v1/admin/delete_jobs/:id/cancel", cancelDeleteJobHandler)
+	router.Put("/api/v1/admin/delete_jobs/:id/cancel", cancelDeleteJobHandler)
+
+	tsdbDeleteSeriesHandler := timeHandler(metrics.HTTPRequestDuration, "admin/tsdb/delete_series", tenantHandler(apiConf, TSDBDeleteSeries(apiConf, client)))
+	router.Put("/api/v1/admin/tsdb/delete_series", tsdbDeleteSeriesHandler)
+	router.Post("/api/v1/admin/tsdb/delete_series", tsdbDeleteSeriesHandler)
+
+	cleanTombstonesHandler := timeHandler(metrics.HTTPRequestDuration, "admin/tsdb/clean_tombstones", TSDBCleanTombstones(apiConf, client))
+	router.Put("/api/v1/admin/tsdb/clean_tombstones", cleanTombstonesHandler)
+	router.Post("/api/v1/admin/tsdb/clean_tombstones", cleanTombstonesHandler)
+
 	queryable := client.Queryable()
-	queryEngine, err := query.NewEngine(log.GetLogger(), apiConf.MaxQueryTimeout, apiConf.SubQueryStepInterval, apiConf.EnabledFeaturesList)
+	if apiConf.TenantHeader != "" {
+		queryable = tenancy.NewQueryable(queryable)
+	}
+	queryEngine, err := query.NewEngine(log.GetLogger(), apiConf.MaxQueryTimeout, apiConf.SubQueryStepInterval, apiConf.MaxSamples, apiConf.EnabledFeaturesList)
 	if err != nil {
 		return nil, fmt.Errorf("creating query-engine: %w", err)
 	}
-	queryHandler := timeHandler(metrics.HTTPRequestDuration, "query", Query(apiConf, queryEngine, queryable, metrics))
+	queryHandler := timeHandler(metrics.HTTPRequestDuration, "query", tenantHandler(apiConf, Query(apiConf, queryEngine, queryable, metrics)))
 	router.Get("/api/v1/query", queryHandler)
 	router.Post("/api/v1/query", queryHandler)
 
-	queryRangeHandler := timeHandler(metrics.HTTPRequestDuration, "query_range", QueryRange(apiConf, queryEngine, queryable, metrics))
+	var resultsStore query.ResultsStore
+	if apiConf.ResultsCache.Persist {
+		resultsStore = query.NewResultsStore(client.Connection, apiConf.ResultsCache.TTL)
+	}
+	resultsCache := query.NewResultsCache(apiConf.ResultsCache, resultsStore)
+	queryRangeHandler := timeHandler(metrics.HTTPRequestDuration, "query_range", tenantHandler(apiConf, QueryRange(apiConf, queryEngine, queryable, resultsCache, metrics)))
 	router.Get("/api/v1/query_range", queryRangeHandler)
 	router.Post("/api/v1/query_range", queryRangeHandler)
 
-	seriesHandler := timeHandler(metrics.HTTPRequestDuration, "series", Series(apiConf, queryable))
+	seriesHandler := timeHandler(metrics.HTTPRequestDuration, "series", tenantHandler(apiConf, Series(apiConf, queryable)))
 	router.Get("/api/v1/series", seriesHandler)
 	router.Post("/api/v1/series", seriesHandler)
 
-	labelsHandler := timeHandler(metrics.HTTPRequestDuration, "labels", Labels(apiConf, queryable))
+	labelsHandler := timeHandler(metrics.HTTPRequestDuration, "labels", tenantHandler(apiConf, Labels(apiConf, queryable)))
 	router.Get("/api/v1/labels", labelsHandler)
 	router.Post("/api/v1/labels", labelsHandler)
 
-	labelValuesHandler := timeHandler(metrics.HTTPRequestDuration, "label/:name/values", LabelValues(apiConf, queryable))
+	labelValuesHandler := timeHandler(metrics.HTTPRequestDuration, "label/:name/values", tenantHandler(apiConf, LabelValues(apiConf, queryable)))
 	router.Get("/api/v1/label/:name/values", labelValuesHandler)
 
+	metadataHandler := timeHandler(metrics.HTTPRequestDuration, "metadata", MetricMetadata(apiConf, client))
+	router.Get("/api/v1/metadata", metadataHandler)
+	router.Post("/api/v1/metadata", metadataHandler)
+
+	exemplarsHandler := timeHandler(metrics.HTTPRequestDuration, "query_exemplars", tenantHandler(apiConf, QueryExemplars(apiConf, client)))
+	router.Get("/api/v1/query_exemplars", exemplarsHandler)
+	router.Post("/api/v1/query_exemplars", exemplarsHandler)
+
 	healthChecker := func() error { return client.HealthCheck() }
 	router.Get("/healthz", Health(healthChecker))
 
@@ -118,6 +150,24 @@ func authHandler(cfg *Config, handler http.HandlerFunc) http.HandlerFunc {
 	return handler
 }
 
+// tenantHandler rejects the requests without a tenant when multi-tenancy is
+// enabled, and passes the tenant to the handler in the request context.
+func tenantHandler(cfg *Config, handler http.Handler) http.Handler {
+	if cfg.TenantHeader == "" {
+		return handler
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenant, err := tenancy.FromRequest(r, cfg.TenantHeader)
+		if err != nil {
+			log.Error("msg", "Unauthorized access to endpoint, no tenant", "err", err)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r.WithContext(tenancy.WithTenant(r.Context(), tenant)))
+	})
+}
+
 func withWarnLog(msg string, handler http.Handler) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log.Warn("msg", msg)
@@ -13,6 +13,7 @@ import (
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/timescale/promscale/pkg/tenancy"
 )
 
 type mockHTTPHandler struct {
@@ -212,3 +213,54 @@ func TestAuthHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestTenantHandler(t *testing.T) {
+	testCases := []struct {
+		name         string
+		cfg          *Config
+		headers      map[string]string
+		expectCode   int
+		expectTenant string
+	}{
+		{
+			name:       "multi-tenancy disabled",
+			cfg:        &Config{},
+			expectCode: http.StatusOK,
+		},
+		{
+			name:       "missing tenant header",
+			cfg:        &Config{TenantHeader: "X-Scope-OrgID"},
+			expectCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "tenant header",
+			cfg:          &Config{TenantHeader: "X-Scope-OrgID"},
+			headers:      map[string]string{"X-Scope-OrgID": "team-a"},
+			expectCode:   http.StatusOK,
+			expectTenant: "team-a",
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			var tenant string
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				tenant, _ = tenancy.FromContext(r.Context())
+			})
+
+			req := httptest.NewRequest("GET", "/api/v1/query", nil)
+			for name, value := range c.headers {
+				req.Header.Set(name, value)
+			}
+			w := httptest.NewRecorder()
+			tenantHandler(c.cfg, handler).ServeHTTP(w, req)
+
+			if w.Code != c.expectCode {
+				t.Errorf("unexpected HTTP status code: got %d wanted %d", w.Code, c.expectCode)
+			}
+			if tenant != c.expectTenant {
+				t.Errorf("unexpected tenant: got %q wanted %q", tenant, c.expectTenant)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"testing"
 
 	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel/querier"
 	"github.com/timescale/promscale/pkg/query"
 )
 
@@ -90,7 +91,7 @@ func TestSeries(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			handler := series(query.NewQueryable(tc.querier, nil))
+			handler := series(query.NewQueryable(tc.querier, nil, querier.QueryLimits{}))
 			queryUrl := constructSeriesRequest(tc.start, tc.end, tc.matchers)
 			w := doSeriesRequest(t, handler, queryUrl)
 
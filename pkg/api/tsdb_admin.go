@@ -0,0 +1,74 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/NYTimes/gziphandler"
+	"github.com/timescale/promscale/pkg/log"
+	deletePkg "github.com/timescale/promscale/pkg/pgmodel/delete"
+)
+
+func TSDBDeleteSeries(conf *Config, admin deletePkg.TSDBAdmin) http.Handler {
+	hf := corsWrapper(conf, tsdbDeleteSeriesHandler(conf, admin))
+	return gziphandler.GzipHandler(hf)
+}
+
+// tsdbDeleteSeriesHandler deletes the series matching the request before
+// responding, like the Prometheus TSDB admin API does.
+func tsdbDeleteSeriesHandler(config *Config, admin deletePkg.TSDBAdmin) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !checkDeletePermitted(w, config) {
+			return
+		}
+		matcherSets, start, end, err := parseDeleteParams(r)
+		if err != nil {
+			log.Info("msg", "Query bad request:"+err.Error())
+			respondError(w, http.StatusBadRequest, err, "bad_data")
+			return
+		}
+		if err = admin.DeleteSeries(matcherSets, start, end); err != nil {
+			log.Error("msg", "Deleting series failed", "err", err)
+			respondError(w, http.StatusInternalServerError, err, "internal")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TSDBCleanTombstones(conf *Config, admin deletePkg.TSDBAdmin) http.Handler {
+	hf := corsWrapper(conf, tsdbCleanTombstonesHandler(conf, admin))
+	return gziphandler.GzipHandler(hf)
+}
+
+// tsdbCleanTombstonesHandler removes the series left without data by
+// deletions and retention. It works on the series of every tenant, so it is
+// not available with multi-tenancy. Since nothing is removed if the series
+// were cleaned up less than an hour ago, it responds with an error then.
+func tsdbCleanTombstonesHandler(config *Config, admin deletePkg.TSDBAdmin) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !checkDeletePermitted(w, config) {
+			return
+		}
+		if config.TenantHeader != "" {
+			respondError(w, http.StatusForbidden, fmt.Errorf("cleaning tombstones affects every tenant and is not available with multi-tenancy"), "operation_not_permitted")
+			return
+		}
+		err := admin.CleanTombstones()
+		if errors.Is(err, deletePkg.ErrCleanedRecently) {
+			respondError(w, http.StatusServiceUnavailable, err, "unavailable")
+			return
+		}
+		if err != nil {
+			log.Error("msg", "Cleaning tombstones failed", "err", err)
+			respondError(w, http.StatusInternalServerError, err, "internal")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
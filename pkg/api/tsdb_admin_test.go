@@ -0,0 +1,155 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package api
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	deletePkg "github.com/timescale/promscale/pkg/pgmodel/delete"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+type mockTSDBAdmin struct {
+	matcherSets [][]*labels.Matcher
+	start, end  time.Time
+	cleaned     bool
+	err         error
+}
+
+func (m *mockTSDBAdmin) DeleteSeries(matcherSets [][]*labels.Matcher, start, end time.Time) error {
+	m.matcherSets, m.start, m.end = matcherSets, start, end
+	return m.err
+}
+
+func (m *mockTSDBAdmin) CleanTombstones() error {
+	m.cleaned = true
+	return m.err
+}
+
+func TestTSDBDeleteSeries(t *testing.T) {
+	cases := []struct {
+		name         string
+		config       *Config
+		matchers     []string
+		start        string
+		adminErr     error
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "delete",
+			config:       &Config{AdminAPIEnabled: true},
+			matchers:     []string{`{__name__="up"}`, `{job="prometheus"}`},
+			start:        "1604311711",
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name:         "no matchers",
+			config:       &Config{AdminAPIEnabled: true},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `{"status":"error","errorType":"bad_data","error":"no match[] parameter provided"}` + "\n",
+		},
+		{
+			name:         "admin api disabled",
+			config:       &Config{},
+			matchers:     []string{`{__name__="up"}`},
+			expectedCode: http.StatusForbidden,
+			expectedBody: `{"status":"error","errorType":"operation_not_permitted","error":"deletion of series requires admin permissions. Use -web-enable-admin-api flag to allow deletion operations"}` + "\n",
+		},
+		{
+			name:         "delete error",
+			config:       &Config{AdminAPIEnabled: true},
+			matchers:     []string{`{__name__="up"}`},
+			adminErr:     fmt.Errorf("some error"),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: `{"status":"error","errorType":"internal","error":"some error"}` + "\n",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			admin := &mockTSDBAdmin{err: tc.adminErr}
+			resp := doPostDeleteRequest(t, tsdbDeleteSeriesHandler(tc.config, admin), constructRequestValues(tc.start, "", tc.matchers))
+			if resp.StatusCode != tc.expectedCode {
+				t.Fatalf("Unexpected HTTP status code received: got %d wanted %d", resp.StatusCode, tc.expectedCode)
+			}
+			body, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(body) != tc.expectedBody {
+				t.Errorf("Unexpected response body:\ngot\n%s\nwanted\n%s", body, tc.expectedBody)
+			}
+			if tc.expectedCode != http.StatusNoContent {
+				return
+			}
+			if len(admin.matcherSets) != len(tc.matchers) {
+				t.Errorf("Unexpected matcher sets: %v", admin.matcherSets)
+			}
+			if !admin.start.Equal(time.Unix(1604311711, 0)) || !admin.end.Equal(model.MaxTime) {
+				t.Errorf("Unexpected time range: %v - %v", admin.start, admin.end)
+			}
+		})
+	}
+}
+
+func TestTSDBCleanTombstones(t *testing.T) {
+	cases := []struct {
+		name         string
+		config       *Config
+		adminErr     error
+		expectedCode int
+		cleaned      bool
+	}{
+		{
+			name:         "clean",
+			config:       &Config{AdminAPIEnabled: true},
+			expectedCode: http.StatusNoContent,
+			cleaned:      true,
+		},
+		{
+			name:         "read-only",
+			config:       &Config{AdminAPIEnabled: true, ReadOnly: true},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "multi-tenancy",
+			config:       &Config{AdminAPIEnabled: true, TenantHeader: "X-Scope-OrgID"},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "cleaned recently",
+			config:       &Config{AdminAPIEnabled: true},
+			adminErr:     fmt.Errorf("%w, retry after 2021-01-01T01:00:00Z", deletePkg.ErrCleanedRecently),
+			expectedCode: http.StatusServiceUnavailable,
+			cleaned:      true,
+		},
+		{
+			name:         "clean error",
+			config:       &Config{AdminAPIEnabled: true},
+			adminErr:     fmt.Errorf("some error"),
+			expectedCode: http.StatusInternalServerError,
+			cleaned:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			admin := &mockTSDBAdmin{err: tc.adminErr}
+			resp := doPostDeleteRequest(t, tsdbCleanTombstonesHandler(tc.config, admin), nil)
+			if resp.StatusCode != tc.expectedCode {
+				t.Fatalf("Unexpected HTTP status code received: got %d wanted %d", resp.StatusCode, tc.expectedCode)
+			}
+			if admin.cleaned != tc.cleaned {
+				t.Errorf("Unexpected clean tombstones call: got %v wanted %v", admin.cleaned, tc.cleaned)
+			}
+		})
+	}
+}
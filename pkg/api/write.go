@@ -7,6 +7,7 @@ package api
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -20,11 +21,17 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	io_prometheus_client "github.com/prometheus/client_model/go"
 	"github.com/timescale/promscale/pkg/log"
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	"github.com/timescale/promscale/pkg/pgmodel/ingestor"
 	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/tenancy"
 	"github.com/timescale/promscale/pkg/util"
 )
 
+// writeRetryAfter is the delay in seconds after which clients can retry a
+// write request that was rejected by the ingest limits.
+const writeRetryAfter = "1"
+
 func Write(writer ingestor.DBInserter, elector *util.Elector, metrics *Metrics) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
@@ -60,6 +67,10 @@ func Write(writer ingestor.DBInserter, elector *util.Elector, metrics *Metrics)
 			return
 		}
 
+		if tenant, ok := tenancy.FromContext(r.Context()); ok {
+			tenancy.AddLabel(req.Timeseries, tenant)
+		}
+
 		ts := req.GetTimeseries()
 		receivedBatchCount := 0
 
@@ -71,6 +82,23 @@ func Write(writer ingestor.DBInserter, elector *util.Elector, metrics *Metrics)
 		begin := time.Now()
 
 		numSamples, err := writer.Ingest(req.GetTimeseries(), req)
+		if errors.Is(err, pgmodelErrs.ErrIngestLimitExceeded) || errors.Is(err, pgmodelErrs.ErrSampleRateExceeded) {
+			// let the remote-write queue back off instead of blocking it.
+			log.DebugRateLimited("msg", "Rejecting write requests, ingest limit exceeded")
+			w.Header().Set("Retry-After", writeRetryAfter)
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+			metrics.ThrottledWriteReqs.Inc()
+			return
+		}
+		if errors.Is(err, pgmodelErrs.ErrSeriesLimitExceeded) {
+			// retrying would not help, the data is dropped. The series
+			// within the limits may have been ingested nonetheless.
+			log.DebugRateLimited("msg", "Rejecting write requests, series limit exceeded")
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			metrics.SentSamples.Add(float64(numSamples))
+			metrics.FailedSamples.Add(float64(receivedBatchCount) - float64(numSamples))
+			return
+		}
 		if err != nil {
 			log.Warn("msg", "Error sending samples to remote storage", "err", err, "num_samples", numSamples)
 			http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -24,6 +24,7 @@ import (
 	io_prometheus_client "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/require"
 	"github.com/timescale/promscale/pkg/log"
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
 
 	"github.com/timescale/promscale/pkg/prompb"
 	"github.com/timescale/promscale/pkg/util"
@@ -424,6 +425,33 @@ func TestWrite(t *testing.T) {
 				&prompb.WriteRequest{},
 			),
 		},
+		{
+			name:         "ingest limit exceeded",
+			isLeader:     true,
+			responseCode: http.StatusTooManyRequests,
+			inserterErr:  fmt.Errorf("wrapped: %w", pgmodelErrs.ErrIngestLimitExceeded),
+			requestBody: writeRequestToString(
+				&prompb.WriteRequest{},
+			),
+		},
+		{
+			name:         "sample rate exceeded",
+			isLeader:     true,
+			responseCode: http.StatusTooManyRequests,
+			inserterErr:  fmt.Errorf("%w: some metric", pgmodelErrs.ErrSampleRateExceeded),
+			requestBody: writeRequestToString(
+				&prompb.WriteRequest{},
+			),
+		},
+		{
+			name:         "series limit exceeded",
+			isLeader:     true,
+			responseCode: http.StatusBadRequest,
+			inserterErr:  fmt.Errorf("%w: some metric", pgmodelErrs.ErrSeriesLimitExceeded),
+			requestBody: writeRequestToString(
+				&prompb.WriteRequest{},
+			),
+		},
 		{
 			name:         "elector error",
 			electionErr:  fmt.Errorf("some error"),
@@ -528,19 +556,21 @@ func TestWrite(t *testing.T) {
 			sentSamplesGauge := &mockMetric{}
 			sendBatchHistogram := &mockMetric{}
 			invalidWriteReqs := &mockMetric{}
+			throttledWriteReqs := &mockMetric{}
 			mock := &mockInserter{
 				result: c.inserterResponse,
 				err:    c.inserterErr,
 			}
 
 			handler := Write(mock, elector, &Metrics{
-				LeaderGauge:       leaderGauge,
-				ReceivedSamples:   receivedSamplesGauge,
-				FailedSamples:     failedSamplesGauge,
-				SentSamples:       sentSamplesGauge,
-				SentBatchDuration: sendBatchHistogram,
-				InvalidWriteReqs:  invalidWriteReqs,
-				WriteThroughput:   util.NewThroughputCalc(time.Second),
+				LeaderGauge:        leaderGauge,
+				ReceivedSamples:    receivedSamplesGauge,
+				FailedSamples:      failedSamplesGauge,
+				SentSamples:        sentSamplesGauge,
+				SentBatchDuration:  sendBatchHistogram,
+				InvalidWriteReqs:   invalidWriteReqs,
+				ThrottledWriteReqs: throttledWriteReqs,
+				WriteThroughput:    util.NewThroughputCalc(time.Second),
 			})
 
 			headers := protobufHeaders
@@ -557,6 +587,15 @@ func TestWrite(t *testing.T) {
 				t.Errorf("Unexpected HTTP status code received: got %d wanted %d", w.Code, c.responseCode)
 			}
 
+			if c.responseCode == http.StatusTooManyRequests {
+				if w.Header().Get("Retry-After") == "" {
+					t.Errorf("missing Retry-After header on throttled request")
+				}
+				if throttledWriteReqs.value != 1 {
+					t.Errorf("throttled requests metric not set correctly: got %f", throttledWriteReqs.value)
+				}
+			}
+
 			if c.electionErr != nil && leaderGauge.value != 0 {
 				t.Errorf("leader gauge metric not set correctly: got %f when election returns an error", leaderGauge.value)
 			}
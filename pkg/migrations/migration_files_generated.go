@@ -28,16 +28,23 @@ var MigrationFiles = func() http.FileSystem {
 		"/idempotent/base.sql": &vfsgen۰CompressedFileInfo{
 			name:             "base.sql",
 			modTime:          time.Time{},
-			uncompressedSize: 82709,
+			uncompressedSize: 103109,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\xbd\x7b\x97\xe2\x46\x96\x38\xf8\x3f\x9f\x22\x7e\xbd\x59\x3f\xc0\x0d\xb8\xb2\xdc\xaf\xc9\x34\x75\x16\x67\x52\x65\xa6\xb3\xa0\x06\x48\x3f\xc6\xeb\xc3\x04\x52\x00\x72\x0a\x09\x2b\x42\x49\xd2\xdb\xfb\xdd\xf7\xc4\x8d\x87\x22\xa4\x90\x10\x64\xa6\xdd\xb3\x3b\x75\x4e\xb7\xab\x90\x14\x8f\x1b\x37\xee\xfb\xd1\xed\x8e\x27\xf3\xe1\xac\xd1\xed\xce\x37\x01\x45\x5e\xec\x13\x84\x29\x4d\xb7\x84\x22\xb6\xc1\x0c\x31\xbc\x0c\x09\x8a\x30\xff\xc1\xc3\x11\x8a\xa3\xf0\x80\x96\x04\xfd\xe5\x2b\xe4\x6d\x70\x42\x51\x18\x47\xeb\x46\xa3\x71\x33\x1d\x0e\xe6\x43\x34\x99\xa2\xe9\xf0\xf3\xdd\xe0\x66\x88\x3e\xdc\x8f\x6f\xe6\xa3\xc9\x18\xcd\x6e\xbe\x1d\x7e\x1a\x2c\x6e\x06\xf3\xc1\xdd\xe4\x63\x6f\x4d\xd8\xc2\x27\x2b\x9c\x86\x6c\xe1\x6d\xd2\xe8\x61\x11\x44\x8c\x24\x8f\x38\x6c\xb5\x1b\x08\x21\x34\x1d\xce\xef\xa7\xe3\x19\x1a\x8d\xe7\xc3\xe9\x77\x83\xbb\xc6\x60\x86\x2e\x56\x69\xe4\x5d\xc0\xe3\xd9\xf0\x6e\x78\x33\x47\x8f\x38\x4c\xc9\xd5\x95\x7a\x09\x7d\x98\x4e\x3e\xe5\xa7\x92\xd3\xa0\xef\xbf\x1d\x4e\x87\xe8\x81\x1c\xfa\x4d\x7b\xc6\xe6\x75\x43\x8e\x7c\x37\x18\x7f\xbc\x1f\x7c\x1c\xa2\xd9\x7f\xdc\xa1\xd9\x7c\xf0\xcd\xdd\x10\x7d\x1e\x4c\x07\x77\x77\xc3\x3b\x34\x1b\x7c\x18\x5e\x37\x3e\x4e\x07\xe3\x39\x1a\xfe\x30\xbc\xb9\xe7\x3b\x1d\x9f\xb5\x43\x34\x9f\xa0\x5d\x12\x6f\x17\x09\xc1\x3e\x49\xae\x4f\x85\x1c\x0b\xb6\x84\x7a\x38\x24\x8b\x2d\xfe\x25\x4e\x16\x8f\x24\xa1\x41\x1c\x15\x41\xe7\x86\x1a\xdd\x85\x01\x5b\xec\x70\xc2\x5a\xe4\x89\xc9\x8f\x3b\xa8\xd9\x6b\x76\xd0\x65\x1b\xc0\x29\x20\xb9\x5b\x2f\x3c\xcc\x70\x18\xaf\x7b\xbb\xf5\x82\x3c\x31\x12\xf1\x57\x25\x28\xc9\x13\xe3\x28\xd1\x6f\xea\xe5\xf8\xcb\x26\xba\x1b\x7d\x1a\xcd\xd1\xe5\xab\xc1\xb4\x74\xef\xcf\x05\xaa\x3a\xac\x84\x30\x12\xb1\x20\x8e\x16\x3b\x92\x04\xb1\xff\x5b\x20\x64\x7e\xce\xd7\x47\xc9\xe2\x2e\x9f\x03\xbf\x80\x2e\x0c\x24\x58\x04\x11\x65\x38\x0c\x49\x1e\x76\xdf\x4c\x26\x77\xc3\xc1\xd8\x0d\x3a\x2f\x4e\x23\xd6\xfa\xa2\x8d\xde\xa3\xb7\x1a\xfd\x6a\xe1\x5c\x15\xb0\x4e\x00\x4f\xf9\x26\x9e\x09\x9a\x6d\x1a\xb2\x20\x8a\x7d\x72\x14\x1c\xb7\xc3\x9b\xbb\xc1\x74\x08\x6f\x05\x74\xe1\x07\x94\x25\xc1\x32\x65\xc4\x57\x2f\xa3\x3e\x5a\xe1\x90\x92\xeb\xc6\x37\xc3\x8f\xa3\x31\xbc\x39\xfa\x70\xda\x45\x79\xdf\x47\xef\xd0\xfc\xdb\xa1\xf8\xba\xf2\x08\x6c\x80\xac\xe2\x64\x8b\x39\xd2\xf4\x7c\xcc\xf0\x82\x6f\x89\xea\x31\x60\x25\xe3\xf9\x24\xb7\xf0\x6b\x78\x61\x38\xbe\x45\xa3\x0f\xd7\xc6\xf6\x0b\xaf\x0d\x7f\xb8\x19\x7e\x06\x08\x7e\xff\xed\x70\xcc\x8f\x70\x36\xe7\x30\x6e\xfe\xe9\xdd\xe7\xb7\x97\x4d\x58\x30\xea\x76\xd1\x5c\x2d\x09\x5d\xf6\x9e\x3a\x28\x22\x8f\x24\x41\xc6\x48\xe6\x1c\x12\x54\xc3\xf1\x6d\x01\x45\x3e\xdf\x7d\xfe\x78\x2e\x9a\x18\x07\xfa\x52\x54\xc7\x8b\xb7\xbb\x84\x50\x7e\x42\x0b\x4a\x18\x0b\xa2\xf5\x29\x97\x47\xd2\x1d\x85\x24\x35\xc9\xce\x96\xb0\x24\xf0\xcc\xb9\x7f\x03\x5e\xe8\xda\x68\x11\x8a\xdd\xee\xc0\xf7\xd1\xe5\x1b\x14\xaf\x50\x82\x23\x3f\xde\x46\x84\x52\xc4\x62\xc4\x36\x04\x29\x56\x8a\x68\x2c\x24\x14\xe0\xb0\x14\xe1\x84\xa0\x28\x66\x08\x87\xc1\x3a\x22\xbe\xeb\x31\x65\x78\xbd\x26\x09\xf1\xd1\x2a\x4e\x90\xb1\x1a\xf4\x4b\xbc\xa4\xbd\x13\x8f\x4f\x8f\x96\xe7\xf1\xf6\x3f\x35\xd7\x68\x37\xea\xf1\x91\xdc\xe7\x5f\xa0\xd6\x65\xef\xed\x1f\x5b\x2d\x01\x8a\x56\xfb\x8b\xb7\xbd\xb7\x97\xed\xee\xdb\xde\xdb\xb7\x7f\x6e\xb7\xdd\x87\xf6\xdd\xe4\x6e\x30\x1f\x71\xdc\x3e\x61\x53\x61\xec\x3d\x2c\x24\x5e\xac\xe2\x64\xb1\xc5\x7c\x11\x11\x8e\x3c\xd2\x92\x3f\x07\x3e\x87\x7f\x07\xed\x71\xc0\xd0\x32\x8e\x43\x82\x23\xd4\x47\x2c\x49\x49\x5d\xfa\x66\xd1\xae\xf1\x64\x2e\xc6\xb2\x48\xd2\xe7\xe1\xf4\xc3\x64\xfa\x09\x6d\x7b\x5f\xe8\xdf\x5c\x68\x2d\x16\x85\xb6\xfa\x25\x81\xdf\xdb\x5e\xe0\xa3\x3e\xd2\x4b\xce\xc6\x98\x4c\xd1\x78\x82\xfe\x3e\xfc\x11\xdd\x7f\xbe\xe5\x50\x99\xfd\x7d\xf4\x19\xdd\x4d\x6e\xfe\x3e\xbc\xbd\x6e\xe8\xf7\x24\x05\xf9\x30\xb9\x1f\xdf\x4a\x1a\x76\x37\x1b\xfe\xf6\xcb\xab\x5e\x92\x24\xab\x55\x04\x2e\x43\x83\xda\xf7\xb5\x0a\x09\xe0\xe8\xe5\xa9\x67\xf7\x76\x9f\x04\x8c\xdf\xdb\x6e\xf7\x06\x47\x71\x14\x78\x38\x44\x7c\x14\x14\x27\x3e\x49\x82\x68\x7d\xd5\xe8\x76\xc5\x88\xb4\xd1\xed\x72\xf6\x21\xb4\x8a\x46\xb7\x1b\xe2\x25\x09\xf9\xaf\x94\x24\x01\xa1\x68\x87\x13\x12\x31\xeb\xdf\x2c\xe0\x5c\x87\x53\x05\x2f\x8e\x28\x4b\xf8\x7a\x28\x1f\xb2\x8b\xe6\x1b\x22\x96\x20\x21\xfd\x18\x90\x3d\x62\xf8\x81\x50\x58\x00\x45\x41\x04\x24\x03\x16\x72\x85\xb2\x99\x3b\x28\x3f\x7e\xaf\xd1\x50\x3a\xd0\x2e\x89\x3d\xe2\xa7\x09\x41\xab\x20\xc2\x61\xf0\x0f\x50\x85\x08\xf2\x12\x02\x0c\x90\x93\x25\x2c\x8f\xaf\x07\x6b\x58\x05\x09\x65\x30\x16\x8a\x57\x7a\xb3\xd9\x07\x1b\xbc\xdb\x91\x08\x96\xb3\xc5\x0f\x44\x81\x57\xa8\x56\x38\xf2\x61\x78\x98\x4c\x0c\xa2\xde\xdf\x90\x84\xf4\x1a\xdd\xee\xf7\x44\xc8\xed\x28\x3f\x70\x10\x71\xa2\xb8\x8f\xe1\x33\xa0\x90\xdb\x20\x0a\xb6\xc1\x3f\x08\x0a\x31\x23\x91\x77\x40\x7e\xca\x8f\x00\x05\x11\x25\x09\x00\xb2\xdb\x6d\xed\x37\x81\xb7\x31\x57\xc5\xe7\x2f\xae\x6c\x87\xd9\xa6\xdd\x43\x43\xba\x23\x5e\x80\xc3\xf0\xc0\xe9\x2b\xd9\xc7\x09\xdb\x1c\x50\x20\xf4\xc3\x46\xb7\x8b\x19\xc3\xde\x86\x4f\xc2\x87\xd1\x10\x55\xf4\x5a\x42\x5a\x0c\x69\xee\x0c\x2d\x89\x87\x53\x4a\x50\xc0\x50\x42\x7e\x4d\x83\x84\x70\x4c\xc0\x11\x22\x4f\x5e\x98\xd2\xe0\x91\xc0\x31\x76\x90\x58\x6f\x40\x11\x46\x9b\x60\xbd\xe9\xaa\xbd\xc5\x3b\x92\x08\x99\x04\x8e\x21\x66\x1b\x92\x20\xec\xf1\x5f\xf8\xea\x02\x3e\x1c\xbf\x19\xb0\x1c\x3f\x26\x06\x93\xa0\xc8\x4b\x02\x26\x70\x55\x8c\xd6\xdd\x07\x94\xa0\x65\xca\xe0\x25\x1c\xd2\x18\xde\x8c\x88\x47\x28\xc5\xc9\xa1\xd1\xed\xb2\x18\xed\x48\xc2\x25\x21\x0e\x34\xc0\x2a\xbe\x4b\x01\x5b\x81\x5e\xe2\x34\x53\x31\xd3\x2e\x65\xfa\x0c\x1b\xdd\xee\x38\x66\xe4\x4a\x30\x25\x8c\x38\x32\x93\x5f\x53\x12\x79\x84\x23\x14\x5f\x2d\xf2\x09\x0d\xd6\x91\x02\xad\x09\xbd\x0c\xaa\x1c\x0a\x00\x70\xe2\x8b\x15\xd9\x6f\x91\x88\x21\xbc\x62\x24\x11\xc7\x1a\x50\x44\x19\xd9\x71\xf8\xf0\x35\x29\x04\xda\x06\xeb\x0d\x83\xed\x2d\xf9\xc7\x84\x63\x12\xa2\xf1\x96\x5f\x49\x2f\x89\x29\x55\x28\xfc\x6b\x2a\x46\x4e\xe0\x03\xbc\xc7\x07\x3e\x54\x4c\x89\x7e\xc2\xa7\x6c\x32\xce\x4c\xb7\x1c\xd3\xe3\x3d\xc8\x64\x0a\xa9\x7d\x12\x62\x0e\xb9\x80\xa3\x19\xdf\x5c\xb0\x0a\x3c\x1c\x31\x3e\xdf\x2e\xe1\x47\xe5\x29\xe8\xf0\xa3\xee\xca\x9b\x2a\x67\x97\x77\x15\x04\xce\xc2\xbd\x25\x9c\x18\x15\xc8\x44\x91\xdb\x7d\x9e\x4e\x6e\x86\xb7\xf7\xd3\x61\x9e\xd2\xa9\xdb\xad\x90\x5e\xdd\xaa\x56\x1b\xb8\x16\x27\x03\xb6\x54\x9e\xa0\xe9\xf0\x66\x32\x95\xf4\x17\x5e\x27\xbe\xa2\x87\xa6\x50\xce\x09\x79\x82\x46\x05\x19\xbb\x0e\xbb\xc8\x31\x0b\xce\x20\xd5\xc2\x40\x7e\x0a\x89\x92\x73\xf9\x9f\xc9\xf4\x76\x38\x45\xdf\xfc\x88\x94\x70\x00\x4f\xee\x26\x93\xcf\x05\xf9\xbe\x7c\x10\x90\xdc\xe5\x76\x9e\xc1\xd0\x92\x5e\x8e\x97\x15\x98\xd8\xe8\x83\x86\x9a\xc5\xef\xf9\x9f\x6e\x37\x21\x21\xc1\x94\xa0\x24\xde\xc3\xbd\xb7\x1e\xdf\x4c\x3e\x7d\x1a\xcd\xaf\x73\xbf\x8d\xe7\xa3\xf1\xfd\x30\xfb\x55\xf1\x44\x73\xc6\xfa\x9a\xde\x60\x7c\x7b\x86\xf4\x9a\xdf\x88\x92\x0e\xe4\x48\x9f\xa7\x93\x4f\x3d\x4a\xec\xcf\xe3\xc8\xa2\xb4\xad\xa4\x07\xff\x5d\x70\xfd\xb6\x83\xe6\xd3\xfb\x61\xbb\x62\x53\xdd\xae\x1f\x8b\xbb\xbd\x24\xab\x38\x21\x9c\xe5\x71\xf2\x6b\x93\x4d\x8b\x1b\xec\xe3\xe4\x41\xd2\x05\xf9\xb2\x05\x61\x25\x0d\x39\x8f\x7b\x36\x74\x61\x0f\xea\xc3\x3a\x25\x0a\x68\x04\xb0\x96\xb9\x27\x68\x1f\x84\x21\x8a\x08\xf1\xc5\x82\x61\x61\x5c\xf8\x2e\x63\x1a\x5c\x6a\xc7\x0f\xc0\x13\xa2\x78\x6f\x8c\xc5\x62\x84\x1f\xe3\xc0\x17\x43\xa4\xbb\x75\x82\x7d\xd2\x43\x23\x66\x50\xf2\xc2\x8e\xfd\x38\x22\x9c\x7b\x84\x44\xb0\x83\x6c\x38\x18\x85\x13\x5a\xfc\x40\xa2\x9e\x7e\xc0\x45\x41\x24\x14\x9e\xc9\xf8\xee\xc7\x3c\x44\x24\xb9\x19\x8d\xd1\xe0\xe6\x66\x38\x9b\xa1\xe1\x0f\x37\x77\xf7\xb3\xd1\x77\x43\xb4\x8d\x7d\x62\x6c\x5e\x49\x5a\x42\x6d\x6e\x5d\x5c\x98\x38\x32\xb8\x9b\x0f\xa7\x72\x1a\xf7\x0c\x83\xf9\x7c\x70\xf3\x2d\x57\xba\xe6\x23\x53\x4a\xbb\x1d\xcc\x07\x8b\xd9\x70\x3a\x1a\xce\x7a\x6f\x2e\x2f\x46\x70\xcf\xbe\x1b\xdc\xdd\x0f\xb9\x56\x81\x5a\x6f\xde\x5d\xdc\xb5\xf5\x54\x17\x17\x1d\x64\xa3\x16\x3f\x22\x03\xb5\xcc\x5b\xc5\xd1\x8c\x13\x0e\x90\x28\xaf\x1b\x82\xfe\xa1\xbc\x48\x79\xdd\xe0\xdf\x0c\xc7\x73\x2e\x43\x9e\x43\x5a\x47\x33\xd4\xfc\xa0\xe5\xaa\x9c\x40\xc3\x99\x8b\x25\x81\xd1\x4d\x9c\x86\x3e\xe7\x52\x49\x1a\xa1\xe5\x41\x08\x62\x71\x14\x11\x8f\x71\x2c\x4a\x59\xbc\xc5\x70\xf8\xe1\xa1\xe9\x90\x72\xcf\x58\x61\x41\xae\x55\x72\xa1\x96\x24\x02\x8a\x3c\xa0\x19\x7c\x41\x18\xb1\x24\xe0\x7a\x20\xda\x6f\x48\x84\x30\x8a\xc8\x5e\x6d\x8b\xbf\x28\xe8\x1d\x47\x54\x90\x6a\x19\x45\xe9\x4e\xc8\x5b\xe2\x9d\x5f\x52\xca\x10\x89\xe2\x74\xbd\xc9\xcb\x12\x20\xdd\x05\xac\x87\x3e\xd9\x50\x12\xfc\x34\xbb\x89\x41\x84\x2a\xb6\x83\x97\xf1\x23\xe9\xa1\x19\x21\x12\x78\xdb\x2d\x89\x18\x17\x8d\xb8\x8c\xc6\xe5\x0c\xbd\x31\x7e\x31\xf9\x3b\x09\xc1\x34\x8e\xf8\xe5\x14\xbf\x70\x29\x02\xe4\x4f\x21\xa0\x58\xe2\x8c\x92\x9e\x28\x89\x68\xc0\x38\xf1\x51\xc3\xf5\xd0\x4c\x9c\x1e\xb8\x0c\xbc\x38\x62\x38\x88\xac\xfd\x86\xf1\x3a\xf0\x84\x14\x43\xd3\xdd\x2e\x4e\x98\xdc\x3f\xd5\x4b\x91\x62\x76\x4e\x3e\x30\x25\x79\xa1\x42\xb8\x24\xfa\xfa\x9a\x6f\x41\xf6\xcd\xd9\x5f\xe4\x11\xc3\x6f\x2e\x8b\x1d\xac\x81\x2b\xc7\xa3\xf1\xdc\x10\x04\x72\x44\xa0\x29\x17\x64\x5d\x7c\x7e\xa3\x7b\x6f\x46\x2d\xce\x94\xd0\x7c\xf4\x69\x38\x9b\x0f\x3e\x7d\x9e\xff\x27\x70\xfe\xf1\xfd\xdd\x5d\x47\x18\x78\xd0\xed\xe4\x1e\xec\x30\xd3\xe1\xcd\x68\xc6\xf7\x90\xbd\x20\xb6\xce\xe7\xff\x66\xf4\x71\x34\x9e\xeb\x47\x6d\xf4\xfd\x68\xfe\x2d\x6a\xf1\x7b\xf2\x88\xbd\x34\xdd\x2e\xe4\x7f\xd8\x26\x21\x74\x13\x87\x9c\x6e\xff\xf9\xed\xdb\xb7\x6f\x3b\xc8\x78\x09\x47\x38\x3c\xfc\x83\x14\xdf\x6a\x37\x3b\x16\xb3\x53\x7f\xc6\xc3\xef\x0d\x3a\x23\x28\x4c\xc9\xee\xef\xc7\xa3\xff\xb8\x1f\xa2\xd1\xf8\x76\xf8\x83\x10\xed\xf4\xf2\x81\x33\x2f\xde\x50\x64\x13\xbc\xde\x9b\x11\x6a\xe9\x97\x3a\x60\x98\x6c\xa3\xd1\xf8\xe6\xee\xfe\x76\x88\x5a\x00\x9e\xaa\x85\xf1\x6f\x0a\x0b\x6c\x9c\x2c\x1e\x58\x9c\xde\xf9\xa5\x65\x1b\x2c\x0a\x38\xe2\xc2\xec\x85\x09\x0b\x0c\xf0\xa0\x54\xf9\x42\xd1\xc8\x78\xe0\xf2\x60\x9c\x28\xe8\x0f\xa0\xde\x80\x5b\x6e\x27\x29\x50\x6e\x68\xb8\xc7\x7b\xd2\x0c\x43\xb4\xc1\x8f\x04\x6d\xb9\x58\xf0\x87\x0d\xc1\x8f\x07\x79\x85\xe8\x1f\xf8\x65\x8f\x10\x18\x6e\x33\x35\x45\xcf\xca\x6f\xfb\x97\x41\xe4\x07\x8f\x81\x9f\xe2\xf0\xcb\xdc\x04\x72\x10\xb4\x8f\xb9\xb4\xbf\xe6\x37\x39\xa5\x68\x9b\x7a\x1b\xb8\xaa\xea\xda\xf2\x71\xf7\x8a\x64\xfb\xfc\x1b\x4e\x6c\x70\x08\x2f\x6d\x71\x74\x50\x7a\x43\xcf\x29\x33\x09\x6a\x69\xda\x86\x17\x9b\xc3\x8e\x24\xe2\x4e\x16\x0e\x58\x61\x96\x8d\x2b\xcd\xc2\x69\x17\x51\x03\x7c\x08\x0e\x94\x11\xb6\x37\x40\x43\x65\x80\xeb\xbf\x3f\xc5\xf6\x77\x82\x27\xd0\xb1\x2c\xb5\x7f\xf9\x45\x10\xf9\xe4\x89\xd0\xfe\x7b\xb0\x65\x5b\x6f\x9b\xf2\xa1\x69\x9b\x72\x40\xd3\x80\x60\x6d\x80\x39\x01\xf4\x3b\x03\xa7\x3e\xa4\x1c\xc2\x73\x41\x90\x96\x6a\x91\x63\x49\x71\xb2\x90\xa3\x2b\xb2\xde\x6a\x2e\x00\x2e\x8b\x85\x04\x95\x64\x15\x00\xab\x86\x56\xa1\x66\xf3\xe9\xe8\x66\xae\x99\x81\x98\xb4\xdb\x8d\x62\x46\x04\xa3\x55\x06\x0f\xc1\xb2\x7e\xba\xfc\x99\x4b\x08\x69\x14\xfc\x9a\x12\x84\x41\xef\xce\xee\xa3\xb8\x4b\x82\x58\xb6\xc4\x07\x6d\xd0\xa1\x7d\x43\x5c\x56\xdc\x0f\xac\x0d\xeb\x14\x27\x38\x62\x5c\xd6\x5e\x87\xf1\x12\x68\x8b\x18\xbc\x51\x2d\x91\x96\xb1\x25\x4b\xd0\xb4\x6f\x5f\xe0\xa3\x65\xb0\x0e\x22\x96\x71\x21\xeb\xb9\x65\x2e\x2e\x79\x47\x2e\xdd\xd4\x93\x04\xe8\x70\x92\xe0\x43\xc9\x47\x3e\xe1\x32\xcf\x82\xec\x62\x6f\xa3\xb9\xdd\xfd\xdd\x1d\xba\x1d\x7e\x18\xdc\xdf\xb9\x3e\xb9\xf9\x76\x78\xf3\xf7\x56\x06\xf3\x3e\xe2\x52\x32\x68\x7b\xd9\x8f\xa3\x59\xc6\x34\x5d\x9f\x67\x1b\xea\xa3\x37\x5f\x5d\x14\x5e\x9a\x8c\x67\xf3\xe9\x80\xaf\x46\x92\x6e\x31\x34\x67\x6a\x6f\xbe\xba\xa0\xf9\x83\xd4\xcc\x2b\xf0\x8f\x8e\xb4\x7b\x20\x07\x31\xc8\xe7\xe9\xe8\xd3\x60\xfa\x23\xfa\xfb\xf0\x47\xfe\xa1\xfe\xae\x1e\x9b\xbf\xac\xc1\xe4\x2f\xdf\xbe\x15\xc3\x72\xd5\xc1\x26\x0a\x1d\x8d\xd8\x1d\xc9\x55\x25\x17\x95\xa6\xe9\xf1\xf0\xfb\x17\x37\x46\x3b\xe4\xb2\xa2\x78\x7e\x3b\x9d\x7c\x46\xf3\xe9\xe8\xe3\xc7\xe1\x94\xf3\xe5\xe1\x0f\xa3\xd9\x7c\x56\xb4\x67\x2e\x94\xa0\xee\x98\x47\x08\xd8\x37\x83\xd9\xcd\xe0\x76\x78\xad\x24\x47\x35\x68\xe9\x50\x42\x20\xfc\xc0\xb5\xb9\xd1\x78\x36\x9c\xce\x4b\xc7\xd6\x76\xa1\x21\xd7\xeb\xa6\x93\xef\xad\x3b\x59\xaa\xa6\x38\x00\x70\x0d\x96\x6a\xf7\x9f\x46\xb7\x8b\x46\x9c\x86\x46\x9c\xe5\x4a\x39\x9c\x22\x78\x50\xf2\x05\xff\x64\x4a\x58\x9a\x70\xdd\x25\x0b\xf6\x41\xcb\x34\x08\x19\x5a\x25\xf1\x16\x61\xb4\x4a\xc3\x10\x90\x00\x88\x12\x46\x34\x5d\xad\x82\x27\x2e\x95\x0b\xfb\x77\xca\x95\x7c\xfe\x98\x6b\xd4\x49\x1a\x79\x60\xe3\x51\x1e\x38\xb0\x50\xc2\x17\xc8\x03\xd9\x60\x15\x80\x01\x90\x7f\x06\x63\xc0\xa7\x14\xb4\xf6\x20\x0c\x11\x0e\xf7\xf8\x40\xb9\x04\x41\x9e\xb0\xc7\xc2\x03\xfa\xcb\x3b\x11\x6c\x74\x8a\x4c\xbf\x5b\x0b\x9a\xbd\x0f\xd8\x66\x21\xa6\xcf\x68\x58\xb6\x21\x46\x9e\x58\x47\x2d\x8f\xff\xc3\x96\xfc\xf9\x3b\x6e\x37\x5d\x8b\xa6\x4b\x2e\xa6\x44\xeb\x56\x36\x1a\x17\x73\xfe\xf2\xae\xdb\xe2\xab\x5d\x84\x24\x5a\xb3\x4d\x4b\x8c\xdd\xfe\xe3\x65\xbb\x8d\xfe\xf9\x4f\xd4\x5c\x34\xf9\x7f\xe4\xaf\x57\x57\x30\x83\xcb\x87\x37\xfa\xf4\xe9\xfe\x79\xbe\x57\x17\x08\xc4\x7e\x61\xa3\x2e\xcf\x6b\x86\x0b\x5c\x8f\x95\xbc\x49\x6c\x4d\xa0\x82\xc6\x82\xc0\x97\xe7\x0f\x67\x0e\x26\xfe\x18\x71\xee\xc6\x24\x46\x08\x88\xa8\x73\x46\xdf\xa4\x0c\x05\x2b\xfe\x94\x7f\x96\xa1\x8c\x1f\x13\xca\x65\xca\x55\xc0\x3a\x68\x4d\x22\x92\x60\xc6\x25\xd4\xfc\x02\x60\xb6\xb1\xe6\xa5\x0c\x5c\x08\x1e\x8e\xa4\x15\x1b\x23\x2f\x0e\xc3\x00\xbc\xb9\x4b\xc2\xf6\x84\x80\x36\x9e\x52\x92\xf0\x0f\x7d\xb2\x0a\x22\xe2\x23\x03\x89\xe1\xaf\x1c\x34\x1a\xa1\x35\x83\x76\x7d\x45\x51\xbc\x42\xe2\x48\x39\x3e\x4a\x24\x5d\x13\x96\x7d\x8e\x23\x9f\x7f\xe5\xc5\xd1\x23\x49\x28\x09\x0f\x1d\x84\xe5\x36\x69\x6e\x26\xce\xb0\xf5\x60\x3d\x80\xfc\xf7\x30\x2f\xc2\x68\x8b\x9f\xc4\xe2\xe4\x0b\xf1\x8a\x4f\xc8\xf7\xf9\x97\xaf\xf4\x12\xc5\x55\xd5\x9e\x20\x21\xb1\x70\xa1\x81\x0f\x25\x38\x28\x3b\xec\x04\xe8\x7c\xf4\x5f\x82\x7a\xf0\x7f\xfc\x57\x8f\xcf\x24\x4c\x72\x31\x22\x11\x4d\x13\x0d\xd2\x80\xaa\x6b\xcc\x47\x51\x92\x09\x45\x7b\x12\x86\x1d\x7e\x9f\x41\xb9\x60\x31\x4a\x08\x25\xc9\x23\x5f\x2c\xdd\x61\x8f\x68\x75\x3d\x8d\x7c\x92\x50\x2f\x4e\xc8\x39\x57\x55\x4c\xe8\xb8\xa5\x0b\x9c\xac\xcf\xbf\xa9\x37\x03\x43\x40\x86\x00\x13\xf3\x7a\x5a\x93\xb4\xd1\xd7\x1c\xd6\x05\xe5\xcd\x7a\x49\xde\xd9\x52\xf9\xfb\x14\x42\xe4\x9c\x40\xed\xd2\x96\xf8\x4d\x99\xf6\x95\x09\x86\x3c\x88\x23\xb4\xe2\x46\xe1\x1c\x5c\x55\x81\x90\x60\xdb\x45\xeb\xe0\x91\x44\xca\xc2\xa5\x2e\x2f\x50\x8a\x94\x12\xb0\x80\xd1\x98\x93\x7c\xe9\x00\xa3\x1c\xb5\xa8\x61\x2c\x5a\x12\x69\x61\x6b\x74\xbb\x23\xa0\x19\x72\x78\x70\xe2\xf1\x9b\x70\x20\x0c\x91\xa7\x80\x32\x31\x32\x31\xac\x73\x52\x15\x15\xbe\xd1\xcc\xd0\x26\xa3\x19\xa5\xd9\x88\xe3\xb7\xf4\x2b\xc2\x7d\xa2\x25\x3e\x50\x25\x33\xb0\x18\xad\x82\xc4\xfa\x0e\x7b\x2c\x05\x21\x5b\xdd\x3d\xbd\x4c\xfe\x12\x38\xa0\x95\x27\xab\x53\x1c\xf9\xa7\x3a\x36\xac\x9f\x4f\xb8\x44\x52\x67\xb1\x84\x85\x46\x4e\x1e\xcf\xdd\xa5\xc9\xfd\x1c\xa9\x88\x0e\xfe\xf7\x4c\xd8\x43\x42\xb5\x71\xd9\xba\x22\xb2\x97\x72\xbd\xb2\x74\xc9\x5f\xfa\x28\x22\x4f\x8c\x6b\x6f\xbb\xf5\x02\xf4\x40\x92\x04\x38\x5c\xa8\x53\xd6\x6a\xa7\x2d\x19\x35\x3b\xcd\xc0\x6f\xb6\xdb\x57\x57\x30\xa4\xf6\x5d\x49\x81\x4a\x68\x56\x4e\x71\xad\xc5\x45\x51\x63\x67\x1d\x63\x03\xed\xbc\xff\x4b\xae\xbb\xa8\x56\xe6\x40\x53\x7c\xa1\xfa\x8e\xe4\x3f\x97\xf3\x5c\x5d\x65\x14\x6a\x32\xe6\x52\xfd\x87\x3b\xae\x1c\xde\x4e\xb8\x9e\xf1\xed\x68\xfc\xd1\x20\x5e\xa3\xf1\x47\xf7\x16\xc1\x74\xe5\x7e\x92\x6d\x35\x53\x40\xc1\x38\xa6\x7f\x57\xfa\xa7\x20\xca\xe0\x39\xe7\xac\xc9\x4b\x93\x04\xbc\xe7\x22\x98\x0a\xcc\xcd\x5b\x0c\xbe\x7d\x94\x48\xe6\x1f\x1d\xd8\x26\x88\xd6\x40\xf2\x59\x72\xe0\x64\x9e\x84\xc4\x63\xc0\x39\xc3\x38\xde\xa9\xa1\x37\x8c\xed\xe8\xd5\x97\x5f\x52\x86\xbd\x87\xf8\x91\x24\xab\x30\xde\xf7\xbc\x78\xfb\x25\xfe\xf2\xf2\xcf\xff\xf6\xe7\xb7\x5f\xbd\xfb\x93\x94\x74\x47\x73\x41\x7b\x65\x08\x8b\x49\xa0\xb7\xb0\xcf\x6d\x8d\x3d\x35\x6a\xb9\x26\xa5\x5b\x32\x3b\x99\x2c\xdc\x46\x9d\x93\x5c\x40\x61\x59\x96\x17\xe4\xa8\x2a\x83\x4e\xa0\xad\xae\xfb\x69\x93\x56\xc3\xe1\x60\x93\x56\xa1\x78\x3d\x90\x03\xf8\x46\x4d\x12\xfb\x40\x0e\xaf\x49\x5a\x4f\xa6\x3e\x7a\xa5\x19\xe9\xe1\xf7\x81\x2f\x7d\x3e\xfc\x61\xae\x49\xce\x68\x2c\xff\x0e\xc6\xdb\x85\x17\x87\xe9\x36\x12\x47\x35\x1e\x7c\x1a\xaa\xf7\x0a\x0f\x1a\xaf\x4d\x93\xf4\x06\xce\x20\x4b\xd9\x31\x01\x65\x7a\x20\x87\x4e\x71\x7f\x9d\xdc\xb6\xea\x13\x2a\x09\xc8\x53\x09\x94\xfa\xcc\x26\x4c\x67\x8e\x22\x14\x98\xc0\x6f\x76\xb4\xf1\xf5\x0d\x15\xff\x16\xc3\xb7\xcf\x27\x79\x1a\x7c\x2e\xaa\x97\x3d\x74\x40\xb4\x62\x20\xf3\x45\x9b\xa8\x1c\x3d\x99\xff\x3e\xf4\x33\x7c\x00\x90\x85\x0f\x2e\xe0\xc0\xc3\x67\x80\xa1\x94\xe4\x66\xe8\x1e\x3e\x18\x64\x97\xff\xd0\x57\xc8\xfa\x32\x64\xf6\x74\x2a\x9b\xd1\x21\x4e\x76\x9c\x24\xf6\x23\x68\x6e\xf0\x22\x52\xa4\x35\x58\xa1\x38\xca\x54\xd2\xb3\x28\xa1\xcb\x84\x6c\x11\xc4\x17\x23\x86\x6d\x5b\xdd\x91\xc8\x50\xfb\x50\xeb\x9c\xa9\x38\xd2\xf0\xa1\x27\x4e\xb5\x64\x6f\xfc\x29\x7f\xfb\x7e\xcc\xe1\x31\xb8\xbb\x6b\xe4\x62\x9e\x5c\x53\x15\x00\x54\x31\x38\x10\x15\x99\x5d\x74\x24\xde\xf9\xa4\xc0\x74\xd7\x39\x09\x84\x61\x71\x01\x61\x90\xc0\x18\xcd\x90\xa5\x96\xbd\x8b\x69\xa0\xbd\xe7\x06\x42\xf5\xd0\x07\xfe\x43\xa4\x1c\x70\xa0\x3a\xec\x09\xda\xe3\x48\x98\xc4\xd4\x87\x60\x38\x59\x82\x9e\xed\xc5\xdb\x1d\xf6\x20\x3c\x71\x17\x53\x1a\x2c\x43\x92\x19\x59\x80\xbf\x03\x73\xdf\x25\x84\xb1\x03\x12\xee\x3d\x11\xe9\x4a\x85\xed\x85\xee\x70\x12\x44\xeb\x10\xa4\x02\xa5\x83\xe8\xbd\x2d\xd4\x94\x9d\xca\x58\x58\xd4\x0a\x22\x11\x4b\xab\xcc\x0b\xed\xce\x89\x17\x80\x5f\xff\x5d\x4c\x21\x82\xd8\x42\x7e\x53\x28\x13\x4a\x08\x5f\x97\xfe\xa7\xad\xd2\x07\x11\x2b\x49\x90\xd1\x40\x07\xe6\x2c\xb8\xe3\x13\x5b\x14\x7f\xb6\x94\x39\x7e\x69\xcc\x38\xbd\x6e\x97\xc3\xcc\x8f\x53\x30\xa5\x6c\x88\xf7\x00\x20\x0b\xa2\x35\xb8\x5d\xe5\x3b\xab\x80\x32\x14\xef\x58\xb0\x0d\x28\xe3\x8a\x24\x7f\xf1\xca\xa0\xbf\x7a\x73\xbb\x98\x6a\x6a\xd9\x28\xe1\xab\xc5\xc3\x40\xe1\xc3\x2e\xa3\x9f\xfa\xbb\xf0\x61\xd7\xb3\x45\x58\x07\x60\xcd\x37\xf4\x97\xe0\xd9\x78\xd8\x19\x77\x36\xff\x95\x82\x79\xc6\x0a\xd4\x62\x32\xc7\x38\x50\x6a\xdb\x12\x22\x2d\xfd\xd9\xbb\x65\x5e\xb5\x1a\x02\xbb\x7d\xfd\x2c\xe3\x3a\xff\xae\x75\x64\xb3\x86\xdb\xcd\xfc\x56\xf1\x6c\x08\xef\xc2\x22\x8c\xc4\x8c\xb6\x52\xd6\xb3\x3d\x01\x0b\x5c\x10\x21\xb2\x5a\x71\xc6\xec\x6d\x70\xb4\x56\xe1\x68\xd4\xdb\x90\x2d\x36\x71\x00\xc2\x81\xb7\x10\x59\x2e\xed\x65\x24\x87\x71\x4b\x12\x72\x06\xc2\xef\x70\x92\xf0\x11\x83\x08\x31\x92\x6c\xc1\x6c\x68\x88\x0d\x2e\x5f\x5c\xd3\x08\x3b\xcb\xc5\x3d\x8c\xc6\x68\xf6\xed\x60\x3a\x54\x21\x7a\x59\xc0\xd9\xa7\xc9\xed\xb0\xd9\xb1\x76\xdf\x56\xdb\xa7\xc4\x8b\x23\x5f\xa2\xb4\x08\xfb\xd3\xf1\x7e\xff\x1d\x70\xb6\x12\x69\x5f\x14\x61\x47\x1f\x32\x02\xd4\x47\x99\x9f\xd7\x1a\xc7\x3e\xe9\xab\x3e\xba\xbc\xe6\xc2\xdb\x65\x57\xb8\x9d\x7d\xc1\x09\x68\x07\xa9\xcf\x01\xf5\x20\x29\x80\x84\x64\x4b\x22\x56\x4c\x22\xc9\x1d\x03\xd0\x2a\xfc\xd4\xda\xc5\xb4\x8d\xfe\x88\x2e\xad\x38\xdc\x2a\xeb\x62\xc5\xd9\x14\xcf\xe7\xac\x33\x12\xf0\xb6\x60\x60\x47\xd8\xda\xe0\x19\xcd\x84\x43\xb6\x60\x43\x2d\x40\xf1\x1d\x40\x51\x42\x08\x5d\x2a\xa3\xb2\xc8\xce\x52\xa0\x3c\xee\xc9\xcf\x05\xdc\x1e\xe3\xef\xea\xb8\x75\x0c\x50\x0d\x85\x4e\x2f\x5b\xaf\x46\xc6\x5c\xb6\x2c\xf3\x93\x1a\xba\x63\xef\xb5\xa0\x12\xe9\x51\xca\x54\x23\xf3\x76\x96\xa1\xfb\x78\x32\x77\xa2\xfc\x60\x34\x1b\xa2\xe6\x0d\x68\xfc\x5c\x27\x59\x05\xc2\xdb\x41\xf6\x7a\x90\x66\x7d\x28\x4a\xf0\x49\x57\x34\x17\x0a\xcc\x2d\x4b\x82\x53\xfd\xad\x7c\xdf\xf1\x6d\xc3\x79\x47\x5f\x58\x23\x70\x89\x23\x2e\xc3\xb6\x21\xe9\x39\xed\x25\x92\x8e\x62\x49\x55\xa5\xc7\x44\xba\x37\x85\xd4\xa7\xf4\x06\xd0\x19\xce\x90\x98\x74\xbc\x89\x25\x13\x29\x71\xde\xf8\x21\x53\x1c\x4c\x1d\x40\x08\x36\x2e\x4b\x45\x25\x61\x6f\x65\x86\x0a\x81\xa9\x02\xb7\xf5\x37\x7a\x35\x9d\x6c\x1d\xcf\xd4\xf2\x55\xa6\x80\xd4\x42\xcb\xb4\x44\x17\xbf\xca\x7f\x5b\xad\x9e\xa2\xd0\xc1\xa5\x04\x8f\xd1\x30\x1e\x8c\x6f\xf5\x23\x11\x6a\xd9\x37\x20\xfe\x9b\x6b\xb0\x05\x64\x30\x91\xd5\xa1\x96\xec\x13\xbc\xdb\x71\xc4\x4c\xe2\x34\xf2\xd1\x2f\x34\x8e\x96\x0b\x82\xbd\xcd\x82\x7f\xc2\xbf\x58\x07\xe0\xa9\x5b\x12\xc6\x11\x38\x89\xf7\x0b\x42\x59\xb0\xc5\x8c\x34\xba\x5d\x4e\x6b\x65\x24\x4e\xeb\xf2\x2d\x50\x0c\x08\x02\x39\x21\x6d\x14\x16\x9a\x9b\xb7\xf5\x0b\x15\x4b\x11\xc8\xca\x41\x9e\xa1\xae\x80\xb2\x94\xf7\x95\xb0\x3f\x1b\xce\x27\x1f\x50\x42\xbc\x38\xf1\x1b\xc8\xd4\xee\x1a\x65\x9e\x2d\x15\x71\x35\x9d\x7c\x3f\x43\x97\x6f\xf5\x55\xe0\x74\xe4\x42\xfb\xe9\x8b\x2b\x6b\xb7\x7b\x5f\x18\x6f\x9e\x92\x1d\x59\xb2\xd7\x38\x5a\x66\x87\x63\xb8\xc8\x72\x87\x93\x46\x11\xa1\xd9\x99\x64\x27\x82\xd4\x89\x3c\xef\x10\xc4\xf8\x2d\x33\x8c\x0a\x47\x07\xf8\x4b\x01\xd2\x38\x3a\x68\xe1\xe4\xe5\xa0\x5d\x5c\x41\xfb\x39\x90\x96\xc3\xe9\x4d\xb8\x60\x8c\x28\x5e\x91\x05\xde\xed\x92\xf8\x09\x60\xb8\xe0\x28\x0e\xf5\x0c\xa4\x41\x4e\xb8\xe6\x8c\x37\x00\xe4\xe2\x0d\x48\xe6\xcc\x42\x24\x21\x44\x21\x0b\x00\x46\x22\x71\x8d\x29\x8b\x39\x22\x21\x25\x35\x46\x95\x39\x95\x11\x97\xef\x43\xa1\x0e\xe9\xdc\x06\xf2\x48\x22\x46\x11\x49\x92\x38\x81\xec\x4b\x73\x08\xf1\xb9\x87\x43\x2f\x0d\x55\xb0\xbf\x63\x4d\x1c\x43\xf4\xba\x8c\x04\x49\x88\xf0\xc7\x14\x34\x9b\x5d\x88\xf9\xff\xc7\x94\xad\x13\xd0\x5b\x20\xc2\xfe\x14\x53\x56\x39\x60\x5b\x99\xa6\xb6\x08\xa2\x5d\xca\xd0\x74\xf8\xf1\xe6\x6e\x30\x9b\xb5\xb3\x14\x70\x88\xce\x13\x09\x69\x39\xba\xd8\x18\xcc\x1a\x17\x17\x2f\x5a\xc6\x42\x8a\x11\x2d\x65\x76\x12\x3c\xa1\xde\xe2\xdb\x6d\x47\x96\xf7\x29\xb1\xe1\x96\x9c\xcb\x55\x99\x96\xa3\xaa\x46\xc1\xe2\x0e\x2b\xb4\x86\x54\x15\x77\x32\x7c\x2c\x7c\x24\x2c\x72\xda\xf8\x3e\x12\xf1\xbb\x42\x63\x2d\x7a\x41\xaf\xae\x12\xb2\xf6\x42\x4c\x69\xbf\xb0\x69\x3d\x74\x41\x52\x77\xc0\xd3\xe4\x1a\x62\xe1\xd9\x1a\x17\xa7\x41\x39\x2f\xcc\xbb\x66\x23\x21\x4b\x77\x21\xa1\x57\x57\x32\xc6\x53\xd7\x24\xe2\x7b\x91\x40\x88\x03\xbf\xb8\xab\x42\x72\xfc\x75\xe3\xe2\xe2\xa4\x32\x08\x32\xc4\x54\x8a\xbc\xf2\x48\xf8\xbe\x5a\x45\x8b\x12\x00\x1c\x7e\xd6\x11\xfb\x54\x46\xc6\xfe\xf4\x73\x23\xbb\x0b\xdf\x4d\x46\xb7\x28\x8f\xf4\x8a\x0a\x72\xd9\x79\x30\xcf\x6c\x64\x4d\x3b\x1d\xaf\x10\x8a\x3b\x1b\xce\xed\x38\xd8\x3e\x12\xd6\x05\x26\xfe\xfd\xc7\x4b\xa7\x40\x14\xf8\x54\xbe\x2f\xc0\x67\x0d\xa1\xb4\x36\x8e\xbc\xe0\x37\x1b\x8c\x7f\x6c\x5d\x5c\x9a\x69\x15\xe6\xc6\x1b\x22\xec\xf4\x7e\xc6\x25\xbc\x6c\xeb\x66\x91\x17\x0d\xfc\x46\x31\x87\xac\x34\x1c\xb1\xe2\x8f\xeb\x1b\xf4\x39\x5d\x86\x81\x87\x06\x9f\x47\x14\xd5\xfc\xe6\xe8\x3c\x27\x0a\xee\x05\xd3\xd5\x22\x58\x2d\x40\x03\xa0\xe5\x66\x4f\xdb\xce\x29\x98\x6d\x4b\x85\x62\x54\x84\x61\xd8\x66\xfe\xec\xc5\x2c\x24\xe9\x98\x73\x5c\xa5\xec\x16\x4d\x00\x15\x1b\xb1\x4c\x36\xaf\x54\x24\xa6\x0a\x8e\xb6\xf0\x6b\xf2\x7e\x89\x00\x3a\xfa\x87\x8b\x56\x44\xe8\x64\xb0\xb5\xd8\x74\x71\x17\x83\x93\xb4\x71\x1d\x02\x4f\x85\xc2\x6a\x06\x0d\x69\x99\x20\x60\xcf\x74\x90\x1f\xb3\x77\x56\x58\xc8\x8f\x84\xe9\x88\x1f\xa5\xbf\xe0\xc0\x75\x07\x55\x7d\xa5\x3e\xe6\x74\x90\x4e\x31\x39\x1f\x81\x2a\xb6\x97\xb7\xf9\x39\x3d\x45\x1d\xa8\x23\x73\xc4\x5f\x64\xc5\x4f\x9c\x30\xeb\xeb\xbb\x90\x8a\x67\x5a\xaa\xb3\xed\xca\xb1\xb6\xda\xa9\xf4\x7c\x3f\xe4\x62\x17\xd3\x23\xee\x18\x07\x85\x52\xf5\x04\x2f\xa4\x81\x19\x4c\x23\xe4\x89\x78\xa9\x0a\x7c\x83\x8c\x33\xf2\xb4\x23\x11\x64\xa0\x2b\x05\x38\x0b\xcb\x5b\x95\x1a\x4a\x7e\x1f\xab\x74\x09\x6c\x6a\x7a\x54\xca\xbe\x96\x9e\x50\x1b\xc1\xf3\xbb\xab\x61\xa1\xaa\xb9\xc2\xce\xb1\xc5\xc8\x82\x10\x0a\xef\x5f\xcd\x6d\x0a\x68\x75\xc4\x52\x21\x1d\x91\x1e\x4e\x7c\x48\x57\x66\x07\x4b\x93\x32\x7f\x07\xad\x4c\xbc\xbe\xc3\x41\x22\xc8\x5f\xa1\x9c\x4c\x4f\xe4\x3b\x20\x1a\x6c\x77\x21\x11\xee\x96\x0e\x82\x3a\x39\x58\x0e\x1a\xa5\xdb\x25\x49\x80\x0d\x70\x39\xdb\x1a\xf5\x4b\xf1\xd7\x2d\x66\xde\x86\x24\x48\xb8\x58\x41\xcb\x93\xc9\x58\x38\x0c\x8d\x39\xeb\x50\x7b\x23\x8b\xc9\xd8\x4e\xcb\xcc\x0f\x2e\x5e\x2c\x4b\x43\xca\xb4\x23\x47\x71\x3e\xa3\x3e\xa7\xbb\x6e\x80\x12\x8d\x69\x4f\xda\x74\xfe\xcf\xf7\x82\xa2\xfc\xa4\x96\xf0\x33\x17\xc9\x4a\xf8\xf5\x73\x28\x93\x64\x92\x82\x61\x37\xc0\xb3\xba\x4a\x43\x7e\x6a\xa0\x8f\xa6\x94\x24\x54\xfa\xbe\x63\xb4\x4e\xe2\x74\x27\xb2\xe7\xa1\xb8\xd0\x2a\xf0\x4e\xa2\x71\x06\x98\xcd\xfb\xff\x5c\xba\xf6\xdb\x12\xa1\xe2\xa7\x35\x68\x8f\xe3\x23\x45\x72\xca\x2e\xf9\x99\xb2\x59\x19\x8c\x5d\x97\xdc\x94\xc8\xfc\x24\xde\xa9\x82\x5c\x42\xc5\x30\x0a\x0f\xe1\xc8\xe7\xca\x9d\x48\x0f\x12\x28\x6b\xd8\x5e\x20\xc5\x04\xca\x06\x61\x86\x97\x1c\x6d\x70\x18\x47\x6b\x91\x3a\x21\x0a\x13\x98\x9f\x76\x20\x48\x41\x24\x4a\xa6\x51\x42\x56\x24\x21\x91\x47\x7c\x69\xcf\xac\x7d\x5f\x8d\x15\x5b\xe1\xbc\x2c\x5e\x2c\xc9\x82\x3f\xdd\x11\x5f\xee\xd8\x54\xe8\x8c\x7b\x6a\xc6\x26\xf0\x3f\x86\x72\x0c\xa7\x3d\x1f\xfe\x60\x14\xaf\x01\xb0\xc0\xc3\x2c\xad\x70\x34\x9e\x0f\x3f\x0e\xa7\xe2\xa5\x4c\x47\x94\x96\x08\xa5\x18\x8f\x27\x73\xae\x05\xb3\xe4\xb0\xc0\xfe\x63\x40\xe3\xe4\xb0\x78\xc2\x1e\x5b\x84\xb1\xf7\xa0\xf2\x6b\xef\x26\x37\x7f\x5f\x8c\x6e\xdb\x8e\x24\x74\xe1\x1d\x1a\x4f\xe6\xa3\x9b\x21\x6a\x9a\x47\xe5\xe1\x08\x6a\x6c\x00\x67\x87\x52\x16\x51\x8c\x3e\x27\xf1\x56\xd4\xdb\xd4\x35\x37\x44\xae\x69\x92\x46\x51\x10\xad\x7b\xe8\xb3\xa8\xd9\x43\x37\x29\xf3\xe3\xbd\x20\xd1\xae\xaf\x9a\xd7\xce\x14\xe5\xdd\xba\xc6\x3e\xca\xcd\x06\x85\x70\x83\x8e\x74\x8b\x4c\xf2\x27\xd0\x71\x02\xbd\x42\xd6\x2d\xc4\x10\xf7\x4b\x51\xe3\xba\x51\x02\x5e\x3e\x63\x10\xad\xd1\x1f\xde\xfc\x41\x5d\x00\x40\xe5\x6c\x01\x98\xc2\x43\x48\xc7\xcf\xb0\x45\xfc\xda\xb4\x82\xdb\xed\x29\x9d\xdb\xe9\xe4\x37\x7d\x5d\x28\x47\x23\x4d\x0d\x4d\xc8\x99\xfc\x6e\x34\xfc\x5e\xed\xde\xb0\x2f\x5c\x37\x0b\x03\xb5\x4f\x18\xe9\xd3\x70\x3e\x1d\xdd\x9c\x3b\x52\x65\x12\xf2\x4b\x8c\x57\x63\xa0\xdb\xe1\xdd\x90\x8f\x73\x0c\x39\x02\xbf\xef\x38\x85\x6b\xa3\xca\x10\xf2\xa0\x40\x66\xba\x73\xd1\xa7\x4e\x46\xcc\x05\x0d\x0b\x18\xd5\xec\xb5\x57\x67\x35\x0e\xe6\x73\x16\xda\xd6\x99\xc2\x88\xee\xe4\x44\x68\x34\x46\x2d\x19\xd3\xca\x7f\x02\xca\x7d\x74\x75\x99\x71\xce\xb6\x0b\xed\xc2\xdd\x9a\xfe\x1a\xea\xb0\x4c\xad\x28\xf0\x2b\x22\xe4\x8c\xcc\x47\x89\xb8\xe8\x06\x59\xa3\xb1\xc8\x62\x93\x5a\x3d\x31\x0a\xd9\x00\x11\xc3\x54\x49\x1d\x50\x83\x4a\xa4\x09\xa6\x14\xaa\x21\xfa\x14\xf9\x41\x42\x3c\x16\x3e\x57\xa5\x0a\x7c\x2b\xb2\xb3\xc2\x6d\x5b\xad\x51\x89\x70\x11\x09\x52\xe0\xab\xe0\x27\xd0\x81\xfc\x02\xc4\x4b\xc2\x97\xcf\xc5\x54\x94\xaa\x20\xe2\x34\x52\x35\x0a\x83\xf0\xe0\x92\x63\x8e\x39\x49\x9f\xeb\x22\x3d\x5b\xe1\x29\xf8\xbb\x4d\x98\xfd\x26\x9a\xcb\x71\xf7\x2a\x58\x87\xcc\xb4\xd4\x2c\xe2\x0b\x53\x15\xfa\x93\x49\x2e\xe0\x0a\x6c\x74\xbb\x6f\x29\x4a\xc8\x2e\x21\x94\x9f\x21\xdc\x70\x51\xf7\x51\xd6\x9f\xa4\x84\xa1\xd6\x9e\x20\x1f\xca\xa9\xa4\x94\x80\xf5\xb5\xd1\xed\xd2\x80\x9f\x75\x10\x31\x31\xae\xb6\x39\xe9\xfa\x48\xac\xad\x13\x3e\x02\xfd\x88\x24\xaa\x30\x25\xe6\x9f\xeb\x82\x68\x62\x34\x59\x09\x33\xa0\xe2\x5e\x00\xf6\xc4\x91\x19\xbf\xee\x85\x01\x14\x8e\xe4\x44\x88\x22\x0f\xaa\x4b\x8a\x0c\x5b\x3e\xd9\x94\x60\x5f\xd7\x7b\xe4\x72\x82\xca\xf2\x25\xbf\x1a\x57\x2e\x11\xf5\x37\x69\x26\xad\x01\x2c\x44\xe6\x5c\xe4\x23\xf2\x6b\x0a\xca\xd0\x33\xef\x1b\xc0\x45\x7b\x97\xb3\x9a\xca\x65\x65\x24\xb2\x3b\x06\x35\x12\x02\xff\x69\xf1\x88\x43\xfe\x73\xab\x2a\x16\xab\xdb\x95\x65\xab\x94\x0e\x98\x65\xd3\xb3\x58\x19\x0a\x23\xb2\x87\x9b\xa2\x23\x79\xf3\x43\x40\xb8\x3f\xe8\x35\x9c\xe2\x08\x13\xc8\x41\x1e\x3a\x68\x4a\xa8\xc5\xa9\x97\x79\x76\xa2\xf6\x16\xb5\x5d\x4a\x5e\x8c\x43\x42\x3d\xd2\xe2\x8a\xc0\x2e\xa6\xf9\xec\x8d\x13\x74\xf4\x5f\x68\xf7\xfd\x7b\xb3\x9e\x09\x01\x33\x41\x9b\x43\xa6\x53\x32\x69\xaf\x98\x8e\x52\xef\x6e\xc1\xd8\x7c\x0a\x11\x5d\xd2\xe6\xd7\xdb\xae\x30\x59\xe6\x50\x6f\xa3\x9c\xeb\xeb\x6e\xf8\x61\x8e\xfe\x7d\x32\x1a\x57\xc5\x79\x18\x7f\x26\x63\xd4\x0a\xa5\xd2\x04\xcb\x10\x8a\x54\x4f\x91\x2f\xb5\xa6\x46\xfd\x49\xca\xa3\xec\xf4\x9c\xf9\x5f\x5a\x8e\x2a\x36\x45\x4d\x30\x77\x26\x16\xb9\xb5\xbf\x33\xf6\x93\x7f\xa3\x6d\xc8\x1d\x9c\x2f\x02\xa2\x8a\x1a\xb5\xcb\x83\x50\x7f\x33\xae\xe2\x13\xec\xcb\x12\xc9\x2b\xe4\x3e\x3c\x5d\xbd\x0e\xaa\x45\x8a\x3a\xcd\x85\xb2\xa3\xa1\x5e\x49\xdb\xb4\x57\x0c\xa6\xd3\xc1\x8f\xad\x62\x8b\x01\x89\x50\xf2\x12\xf2\x13\xe8\xa0\xb7\xed\xf2\x58\x47\x45\x77\xa5\x33\xae\xe5\xac\x09\x74\xe9\x2e\x15\xa4\x54\xa6\x2d\x7e\xe2\x13\xb6\x05\xbe\xc9\xa9\xed\x63\x6f\xa3\x75\x09\x1a\x28\x72\xc1\xb1\x49\xad\x3a\xf0\x9f\x50\x1f\xad\xc5\x10\xed\xab\xab\x12\xca\x53\xc1\xb2\x8c\x12\x8a\xe7\x90\x3e\xa0\x7b\xa3\x19\x6a\x8a\x42\x03\x8c\x73\x25\x4d\x6b\xb1\x99\x9c\xd0\x7c\x26\x7b\x34\x67\x2c\x06\xca\xbd\x04\x21\x37\x2f\x82\x48\x8a\x31\x84\x62\x4e\x0b\x7e\xfa\x59\xfd\x04\xf7\x55\xfd\xf8\x3f\x84\xff\x54\xc2\x5f\x7a\x06\xb6\x3d\xf9\xe1\xf1\x15\xf9\x81\x18\x1c\x26\x29\xe5\x08\x10\x5e\xc4\xff\xd6\xb2\x62\x89\x38\x42\xb4\x3b\xe8\x7e\x3c\x1e\xce\xe6\x2d\x13\x23\xda\x6d\x7e\xa8\x0f\x8f\x85\x38\xc6\x97\x60\x1d\x62\xc5\x39\xde\xa1\x97\xff\xaf\xc0\x3c\x6a\x9d\xeb\x51\x96\x22\xf6\x59\xce\x53\x34\xc5\x37\x5e\xfc\x1f\x92\xff\x1b\x91\xfc\x4c\x45\xe1\xd4\x50\x11\xc0\x1c\x07\x30\xaa\x6d\x74\xa4\x56\x12\xaf\x40\xf5\xe8\x88\x82\x37\xea\x27\x45\x47\x5f\x85\x57\x08\x1a\x9e\x5b\xaa\x2b\xc6\x5a\x16\x1a\xa2\x9c\x40\x76\x90\xb6\x81\xc8\xc5\x19\xce\x05\x09\xda\x6e\x37\x6b\xe5\xa0\xf3\x6d\x97\x42\x0f\xa1\xd2\x68\x26\x5e\x08\xd6\xd0\x49\x41\xb7\x96\x40\x76\xf5\x5e\x2d\x1b\x2d\x89\xcc\xea\xfa\x87\x34\x22\x18\xd4\xf8\x24\x1f\x04\x85\xf6\x48\xad\xd1\x78\x72\x3f\x57\xb1\xb4\xa3\xf1\x9c\x03\x40\xc6\xbe\x66\xac\x4c\x86\xbf\x66\x6c\xac\xd4\xfb\x00\xdb\x5e\xe0\xf5\x1a\xe8\x6d\xbb\x63\xfd\xc0\x49\xb4\xfd\x8b\x41\x90\x8c\x3b\x55\x0c\x0b\xa5\x6d\x6d\x5a\x69\xa8\xf2\xef\xe3\xe1\xb4\x8a\x3e\x4a\x82\x08\x61\x41\xea\xdb\x76\x4d\x37\x43\x05\xde\x3b\x00\x38\x2f\xe2\x75\x94\x21\x6e\xc6\x50\xa1\xb2\x4b\x42\xa4\x4f\x8a\x5e\x41\xd6\x2e\xd4\x21\xe5\xc8\xa4\xfe\xa1\x91\x0a\x47\xa2\x35\x04\xff\x51\x20\x58\xf3\x24\x07\x88\xb5\xbe\x73\xba\x3e\xc1\x50\x9c\x01\x08\xf3\x86\xb8\x2a\xd5\xf5\x0f\xcf\xc1\x1d\x79\xdb\xe1\x1d\xd3\xda\x53\xd8\x89\xc4\x84\x17\x3a\xc3\xfc\xc6\x4a\x76\x54\xa0\x58\xba\x76\x25\x14\xab\x12\x8d\x4c\xf2\x07\xfa\x12\x67\x58\x77\x7d\xae\x1a\x47\x53\xc3\x41\x2d\x64\x6c\x41\x9a\xa4\xf3\x4a\x15\x08\x03\x57\xa6\x49\xae\x6a\xe2\x04\x0c\x79\x04\x13\x32\x51\x57\x2c\xa0\x94\x62\x08\x2d\x3a\x5e\xfe\x42\x3c\xd6\xca\x50\xa1\x40\x14\x8e\x23\xe5\x4b\x61\x46\xbd\xed\x1d\x41\x0b\x8c\xfe\x7d\x36\x19\x7f\x83\xc4\xc6\x6a\x9f\xba\x98\xfb\xdc\xb3\x36\x35\x09\x11\x30\x86\xb3\x38\xc7\xd3\xb8\x43\x2b\x5f\x9e\xfb\x14\xdd\x25\x9f\xfd\x92\xe9\xe1\x55\x5e\x69\x31\xa3\x51\x94\x01\x68\x77\xb6\xfe\x97\xa4\xdd\x8e\xed\xf1\x03\x5d\x11\xe6\x6d\x08\xb5\x4f\x53\x95\x89\x13\x10\x95\x77\x28\xf0\x4f\xa4\xc6\xc5\x19\x5d\xa7\x79\x2b\xca\x6a\x83\x1e\x27\xfb\x64\x40\xe6\x96\x48\xf2\xb5\xdb\xeb\x14\x43\xfb\x4e\x2f\x7d\x93\x97\x57\xed\x8e\x69\xce\x28\x5a\xa3\xc6\x83\x7d\xc2\x12\x0d\xca\x58\x43\x26\x95\xdf\xcf\x51\x11\xfc\xce\x94\xf9\x88\xec\xb3\x42\xeb\x32\x44\x39\xcb\x85\xb7\x9f\x66\x55\x73\x9a\x4e\xc4\x5a\x04\x7e\xb3\x6d\xd4\xc4\xb1\xd3\x99\x91\x59\x5b\xd8\x91\x5d\x69\x79\xf5\x46\x66\x11\x2f\x28\x62\x22\x09\x50\x4e\x91\xb8\xb8\xec\xa0\x8b\x77\x1d\x74\xf1\x55\xc3\x50\xd3\xca\xb2\xcf\xec\x0c\x34\x29\xe9\x5c\x5c\x74\x8a\xd0\x37\xf2\xc8\xb3\xeb\x01\xfe\x0c\x08\x6d\xb6\xe0\x52\x5c\xa7\x38\x8f\x42\x8a\x58\x06\x49\x69\xa2\x8f\xd2\x30\xd4\x6f\x95\x95\x61\xd6\xe1\x99\xce\x1e\x3c\x36\xd4\x72\x1d\x78\xe4\x25\xeb\xa3\x8b\xcb\xb3\xb7\x7a\xc6\x86\x5e\xbb\x8a\x8b\xbc\x52\x10\xf8\x6a\x55\xfa\x29\x27\xe7\xa6\x86\x31\x87\x12\xa1\x22\x99\x33\x6a\xca\x2a\xa1\xaa\xf0\x25\x54\xb2\xc4\x88\x13\x0c\x61\xf0\x91\xc9\x25\xba\x19\x17\x94\xbd\x6c\x86\x21\x4a\x29\x51\xda\x07\xf9\x55\xfb\x39\x90\x0a\x1b\xb3\xec\x43\xe0\xe9\xd0\x74\x8d\xa2\x30\x78\x10\xfe\x97\x1e\xfa\x56\xb4\xc6\xea\xc8\xb1\x12\x51\x81\x40\x95\xf9\x00\x9f\x8a\x87\xa9\x6a\xbb\x2d\x5b\xe6\x90\x6c\x30\xed\xb0\x2c\xe8\x2a\x30\x20\xd4\xff\x97\x8d\xbd\x54\x98\x15\x25\x10\xbb\xbc\xcf\xca\x7d\x4a\x37\x52\x07\x05\x91\xae\x7e\x48\x09\xc2\x7c\x8c\x22\x28\xc4\x68\xe0\x35\x55\xc1\x5c\xab\x94\xa5\xee\xe2\x9e\x35\x95\x45\x8d\x4a\x42\x2c\xc8\xbb\x71\x64\xba\x87\x60\x80\x19\xf9\x2a\x52\x2e\x94\x0f\x83\xe6\x3f\x59\x34\xd7\xa8\xf4\xd1\xed\xce\x08\x41\x25\x0b\x11\x31\x97\x8f\x8b\x8c\x45\x45\x31\xb8\xfa\x96\x71\xca\x54\x41\x10\x23\x4e\x79\xcb\x22\x51\xaf\x8e\x45\x46\xc5\xba\xb3\x8a\x5c\x00\x08\x2c\xdb\x7f\x9b\x0f\xdb\xc8\x95\xb6\xc8\xd7\xf5\x6b\xd4\xee\x54\x14\x44\xaa\x53\x91\xa8\x22\x91\x75\x29\xca\xd3\xa1\x5f\x53\x92\x1c\x0c\x7b\xe9\xcd\x7c\xe8\xb2\x95\xd6\xb7\x04\x5c\x5c\xb6\x8b\x56\x22\x87\x2b\xba\x90\xde\x82\x29\x2a\xc8\x2f\x9a\xc0\xe5\xf2\xbb\x3c\x26\xc9\x9a\xcb\xfd\x5c\x4d\x55\xde\xbc\xbb\xb8\xeb\xa0\x37\x97\xfc\xff\x1d\xa3\xda\xee\x67\x4e\x53\x05\x84\x3a\x56\xb4\x91\x3e\x20\x78\xdd\x20\xa4\x8d\x02\xa9\xb5\x8a\xa5\x1b\xbf\x02\xe9\xac\x24\x9b\x27\xdb\x8e\xb2\x3b\x66\x38\x0b\xcc\x50\xdb\x8c\xa8\x00\xe1\x50\x65\xb6\x05\x49\xa3\x99\xc4\x2d\x75\x6e\x7a\xbe\x6d\x28\xbf\x94\x97\xf3\x22\xb8\xef\xef\xd9\x0e\x85\x42\x6a\x45\x56\x7a\xab\x9e\x84\x55\x4e\x7b\x14\xf1\xdd\xe2\x07\x92\x95\x8f\x29\x54\x5d\x92\xa1\xb3\xaf\x42\x68\xcc\x44\x88\xba\x14\x46\xf2\xbc\xac\x52\x94\xac\xde\xbe\x14\xfd\xe5\x88\xaf\x7a\x8b\x66\x31\xc0\xba\x43\x55\xe6\x05\xd9\xa6\x94\x19\x9f\xa8\x8e\x75\xc5\xa6\x95\x9e\x17\x27\x3e\x08\xe7\xb1\xdd\x3e\xf6\x08\xb5\xaa\x68\xdb\xa6\xe3\xbd\x8c\x8e\x6d\x82\x0e\x7e\x9a\xdc\x8a\x60\x17\xc7\xad\x6e\xd7\x23\x90\x1e\x23\xcf\x25\x90\x4a\xa4\x95\x84\xb2\x23\x50\x80\xc3\xc0\x35\x70\xa0\xa2\xfe\x64\xce\xde\x71\x31\xb1\x48\x4d\x4f\xa0\xa8\xed\x0e\x4a\x77\x3e\x04\xbd\x58\xab\x39\x3d\x39\x11\x42\x5b\xec\xd9\x21\x4a\x53\x4f\xad\x22\x31\xf5\xf6\x4b\x12\x14\x55\x83\x0e\x17\x5f\xb1\x47\xf8\x57\xe3\x09\x96\x8b\x2d\x23\x48\x36\x25\xaa\xe6\x19\xaf\x52\x68\xe2\x28\x39\xad\x67\xcf\x17\x9d\x27\x3e\xe3\x04\x6f\x09\x03\xd9\x38\x0a\x76\x32\x81\x3d\x13\x90\x1b\xa7\x25\x57\x42\xd3\x4c\xab\x2d\x50\xa1\x6f\x66\x91\xd8\x43\x15\xd2\xf2\xce\xeb\x8f\x71\xe0\x17\x2a\xa6\x18\x05\x75\xce\xec\x06\x26\xe2\x7e\x87\x3f\xdc\x0c\x3f\xc3\x4e\x9a\xb2\x2f\x01\x25\xb2\xff\x3c\x64\x9f\x64\xad\xeb\xf7\x01\xdb\x70\xe1\xd2\xca\xea\x57\x83\xe7\xaa\xf3\xa8\x8a\x5e\xcc\xf8\x1c\x3a\x5a\x62\x1f\xc8\xe5\x0b\xf5\xc9\x97\xf3\x9c\xd0\x2d\xdf\xd4\x66\xb3\xb3\xb2\xda\x43\x19\xa5\xfc\x4b\x3a\x4f\xb5\x0a\x21\xdc\xcf\xe6\x6f\x6d\x23\xeb\xdd\xf0\x13\x9e\xd2\xa8\xca\xc4\xa2\x76\xbb\xee\xcd\xab\x6b\x16\xa4\xe5\x7d\xaf\x72\xbd\xff\x8b\x08\x6c\xf6\xd5\xbf\x38\x56\xbf\x4a\x4e\x62\x3a\xc6\x65\x71\x9f\x56\xd3\x9e\xa9\xd9\x41\xf6\x0f\x65\xf5\xcb\xf9\x58\x6d\x74\x3b\xd1\x6c\x60\x38\xd7\x11\xa1\x50\x9b\xee\x76\x78\x2b\x7c\x51\x95\x7d\xb6\x4e\xbb\xdb\xf9\xc5\xb5\x1b\x35\x7a\x28\x2b\x1e\xe2\x82\x73\xae\x5a\x61\xc2\x57\x7b\x96\xef\xf6\xd8\x79\x66\x07\xc8\x45\x70\x2a\x63\x9b\x05\x49\xd0\xa7\xbc\xb2\xea\x84\x52\xd4\xd2\x7c\x90\x0b\x50\x11\xd9\xb7\x35\xc1\x80\xe6\xe8\xbb\x30\xf0\x02\x86\xe2\x47\x92\x24\x81\x4f\x9a\xa7\x61\x9e\xea\x10\x6a\x2f\xb4\x48\x49\x4f\x42\xc5\xac\xe7\x86\xa8\xa9\x79\xe4\xbe\x9a\x75\x18\x95\xb1\x62\x49\x90\xe8\xb8\x10\x03\xd9\xfc\x52\x48\x8a\x5f\x02\x64\x44\x3b\x54\xae\xce\xae\x09\x55\x5d\xaa\x8d\x30\x37\x68\x0e\x2b\x24\x4b\x21\xb3\x70\xaa\x06\x65\x75\x40\xa6\xb6\x9f\xf5\x6a\xf7\x7f\x2b\xd2\x99\x52\x00\xf6\x1c\x95\xcd\x8e\x76\x55\x76\x23\x0d\xea\x67\xc9\xd8\x95\xe2\xd2\x89\x49\xf4\xf5\xd6\xde\x7e\xcd\x7b\xeb\xbc\x77\x95\xb9\xd8\x75\xee\x9e\x1b\xa3\x05\x16\x17\x2f\x20\x76\x5e\xbf\x2c\x0f\x51\x35\x97\x00\x23\xa0\xba\x63\x54\x76\xb3\x87\xf3\x6a\x9f\x70\xe3\x12\x52\xff\xce\x1d\xbb\x5a\xe7\xe3\x93\xca\xab\x37\xbd\x41\xcf\xc4\xa6\x57\xc3\x99\xaa\xa4\x81\xd2\x6e\x91\x2f\x8f\x58\x55\x07\x27\x0e\x4b\x18\x55\xa0\xa5\x73\x19\x51\x2f\x60\x15\xb4\xc8\x52\x65\x66\xe5\x6e\x9a\xd7\x67\x96\x1c\x49\x88\x6c\x23\xbb\xd8\x91\x24\x88\xfd\x0a\x84\x52\xd7\xa0\x18\x32\x70\x33\x19\xdc\x0d\x67\x37\xc3\xd6\xb6\x97\x1f\xaf\x53\x75\x04\x85\xc9\xdb\xed\x53\x9a\x73\xbc\x08\x45\xab\x80\x85\x4d\xd3\x6a\xab\x83\xd5\x3b\x7c\x8d\x34\xdb\x3a\xe7\x6a\xd7\xb0\x3f\x35\xee\x84\x56\xed\x29\xff\xc3\x6b\x8a\x9c\xf9\xb9\x9a\x1d\x94\xff\xe9\x25\xc4\xce\x57\x92\xec\x0a\xa0\x73\xcb\x76\xfa\x35\x24\x01\xfa\xbb\x48\x77\x47\x49\x83\xd0\x94\x4f\x3c\xfd\xff\x1f\x4a\x79\x95\x74\xa5\xae\x9c\x57\x00\x73\xdf\x09\xfd\x57\x14\xf8\xaa\xc9\xe3\xab\x8a\x65\x4e\x6a\xe6\x16\xcc\xdc\x77\xe7\x37\x11\xcd\x4e\xe0\xa5\x67\x0a\x67\x0e\x24\xd0\x86\xd1\x97\x13\xcb\x2a\x37\x95\x3f\xf5\xd7\x14\x99\xdc\x4c\x2c\x2f\x34\xd5\x3c\xf1\x17\x15\x9b\x0c\x4b\xd6\x82\x12\xc6\x49\x71\xcd\xd3\xb6\x7b\x50\x78\x38\xd2\x63\xa1\x65\x1c\x87\x04\xcb\x0a\xf3\x09\xa1\x69\xc8\xec\xdf\x8a\xd4\x91\x73\x3a\xb3\x1b\x85\x3c\x09\x7d\x79\x21\x13\xf8\x0b\x91\x5a\xba\x5b\x2f\x76\x49\xec\xa1\x3d\x04\x0e\xec\x92\x58\x15\xac\x57\x0b\x10\x22\x6a\xb3\xd8\x8d\xdc\x5c\xa5\x5d\x3c\xdc\x5a\xbf\xab\x96\xe6\x87\xc1\xdd\x6c\x58\xbb\xc9\x83\x39\x69\x61\xb3\x67\xb7\x81\x70\x90\xdb\xb3\x6a\x85\xda\x1b\xd4\xd9\x09\x19\x26\x90\x88\x4f\x9a\x8b\xbd\xb1\x8d\xbf\xc2\x86\x19\xc4\x91\x51\x9f\x33\xef\x56\x31\xaa\x0a\xc8\x2e\x12\x7d\xd3\xe6\xd9\x6c\xd8\x75\x42\xf3\x75\x42\xfa\x25\xa0\xcb\x03\x58\x60\xd8\x75\x59\x5b\x01\xd5\x03\xdb\xce\x9e\x90\xcf\xea\x94\x1d\x45\x9b\x46\xb1\xe8\xe8\xa6\x67\x94\x19\xe5\xc8\x57\xb2\x31\xb9\xb5\x5e\xcd\x7d\x65\x1f\xa8\xf3\x20\xfe\xc2\x00\x0c\xe7\x85\x79\xe7\x8f\x86\x87\x05\x08\xc3\x62\x2e\x51\x58\x3d\x2e\x73\xb2\xbf\x9c\xd0\xee\xa2\x2a\x2f\x27\xb7\xbb\x46\x77\xfc\x96\x15\xfe\x3b\x91\x7c\xc9\xd7\xae\x6d\x8f\x88\x6b\x86\x7e\xa5\x5e\xee\x58\x66\xdb\x49\x5b\xe6\xd3\xfb\x0a\xd2\xf2\xfb\xd0\x40\x8e\x84\xae\x2d\x57\xbb\x7a\x6e\x84\xab\x47\xd0\x0f\x2d\xe5\x1b\xe3\x74\xd0\x8a\x60\x96\x4a\xb7\xcb\x2a\x4e\x23\xdf\xd5\x80\xe1\x4c\xa5\xaa\x88\x7e\xcd\x8e\x6b\x17\x2f\x66\xd0\xcf\x35\x7b\xd0\xa8\x6a\xce\x99\xb7\xef\x98\xee\x51\xc7\xda\xce\xb1\xe7\x67\xa3\x58\x17\x5e\xc8\x31\xcf\x8a\xa9\xa9\x75\xf9\xf4\x45\xb3\xec\xfa\x86\x8f\x4c\x21\xcf\xef\x63\xdc\xaf\x21\xe2\x08\x0d\xf0\x64\x22\x52\xec\xc5\x55\x2e\x07\x1d\x97\x79\xba\xdd\x60\x85\x70\xc8\x49\xe3\x01\x14\x42\x16\x23\x9f\xd0\x20\x21\x32\x15\xac\xc3\x2f\xcd\x46\x86\x85\xf8\x71\x85\x00\x50\x6f\xeb\x6d\xa9\x7b\x1d\xbf\xe7\xff\x1a\x74\x0a\x00\x64\xe2\x55\x40\xd1\x36\xa0\x14\xba\x4b\x7a\x16\xe9\x09\x58\x25\x65\xab\xb7\xeb\xd7\xa2\x6e\xff\xcd\x0c\x06\xbf\x89\x6c\x5b\x7d\x61\x5d\x96\x86\x73\x68\x6f\x61\xde\xd2\x8b\x5f\xc7\x9e\x21\x81\x34\x77\x11\x62\x87\xe3\xaa\x5a\x04\xb4\x3b\x00\x19\xa4\xfb\xa8\xab\xfd\x14\xb7\x50\x89\x60\xd6\x41\x05\x1a\x8e\xcb\x29\xf8\x6b\x19\x21\x4e\x3e\x3d\xe5\x9d\xad\x43\xb7\x73\xd1\x2e\x8a\x68\x1f\x95\xf1\x2c\x92\x50\x9e\xb0\x00\x82\xbb\xd1\x5c\x2f\xd7\xba\x8e\x63\x49\x31\x7d\xdb\xd0\x3b\xb4\xbc\xdf\xa9\xf5\xd6\x82\x92\xf5\x96\x44\x6c\x79\xe0\xc4\x34\xcb\x44\xa9\xf9\x35\x84\x13\x8a\x6f\xf9\x73\x29\x48\xd9\x7a\x4b\xfb\xba\x24\x75\xc2\x68\x78\xd6\xed\x26\xde\x9f\x50\xbc\x42\xdb\x34\x64\x41\x14\xfb\x59\xbf\xd8\x5d\x12\xef\x48\x12\x1e\xd0\x86\xf3\x76\xa8\x87\x68\x22\x14\x54\x55\x64\x69\x12\x41\xfd\x25\x63\xc0\x20\xa2\x81\x4f\x44\xd1\x5b\x15\x2d\x75\x2d\xb2\x10\xd6\x84\x51\xd5\x3d\x86\x6d\x30\xeb\x55\xf7\xa7\xd0\x6b\x6a\x39\x8a\x3f\xde\x0c\xee\xee\x90\x1f\x50\x96\x04\xcb\x94\x11\x7f\x41\x9e\x88\x57\x3c\x21\xf7\x41\x9f\x75\xd8\xf5\x0f\xfc\xd9\x67\xfe\x9c\x63\xaf\x3a\xf9\xc2\x4c\x2c\xc1\x11\xc5\x70\x46\x38\x44\xfd\xf7\x82\xe6\x39\x8a\x54\x1a\x07\x2c\xa3\xaa\x84\x44\x00\x89\x24\xfc\x38\x21\x24\x4c\x73\xa1\x28\xde\xb7\xda\xdd\x4b\xb4\x89\xd3\x44\x94\xac\x5b\x66\x12\xa5\x61\x98\xe8\x76\x77\x24\xe9\x6e\x98\x85\x5a\xbb\x38\x0c\xbc\x83\x51\xdf\x0b\x32\xed\x14\x3c\xd0\x65\xef\xa9\x02\x6f\xaa\xcd\x27\x5f\xe7\x3b\xad\x98\x8c\x08\xfb\xfe\xc2\x16\x6b\xe8\x42\xac\xa5\x55\x16\xf1\xe5\x82\xb1\xb6\x06\xa3\xa6\x00\x40\xb3\xa4\xe8\xe7\x91\x0e\x2d\xcf\xd8\x49\x42\xb6\xf1\x23\x79\x81\xcd\x54\x61\x02\xdc\xc0\x82\x72\x97\x9f\x13\x1a\xcd\x15\x29\xbf\xaa\xd4\x0f\x1b\x64\x78\xbb\x63\xff\x40\xcd\xee\x28\x5a\x05\x51\xc0\x0e\xcd\x8e\x8d\x99\xfd\xf7\x9c\x9f\x9a\x84\xeb\x37\x20\xe4\x96\x04\x50\x83\xa8\xe6\x5a\xb5\xbc\x10\xe3\xaf\xe2\xa7\xb5\x38\x7f\x89\x11\x9a\x0f\xc0\x59\xfb\x99\xc1\x1f\x67\x9b\x9d\x8b\x2a\x57\x6d\x63\xf2\x6f\x22\xc7\x1e\xdb\xe6\xa9\x3e\xb3\x23\x32\x66\x2e\x98\xa5\x96\x88\xf9\x42\x82\xf3\xa9\xa6\xaf\xf6\xf5\x6b\x09\xb8\x47\x51\xcb\x1d\xa3\x52\x5b\xbc\x7d\xbe\xc7\x05\xc2\xf6\x17\x78\x19\x27\xac\x95\x52\x92\xc8\x38\xfe\x7c\x0a\xbc\xec\x8c\x94\xc3\x72\xe4\x2f\xad\xf7\x1d\xa8\x6d\xb5\x3c\x52\x95\x7e\x54\x87\x23\x23\x46\x3f\xb3\x15\xab\x31\xe5\xa1\xe4\x55\x5d\xf1\xe5\x1b\x28\x9b\x17\x42\xc2\x8e\x17\x47\x2c\x88\x52\x22\x6d\x73\x1d\x35\xe7\x15\x7a\x63\x48\x20\xd9\xe6\x3a\x7a\x8a\x2c\x5b\x02\xc2\xff\x87\xd3\xe9\xcd\xe4\x76\xd8\x6f\x7e\x9e\xbd\x7d\x7b\xd9\x54\xad\x91\x60\xcb\xe8\x79\x99\x5f\x26\x98\xcd\xfc\xfb\xc1\x37\x93\xe9\x1c\xe1\x48\xd9\x15\x0d\xe6\x80\xfc\x94\xa8\x28\xf1\xd1\x2d\x12\xfb\x16\x95\x65\xe3\x14\x3a\xe2\x71\x7d\xf8\xb4\xd3\xde\xe2\xe4\x61\x91\x46\x5c\xf6\xb0\x32\xe1\xad\x6e\x59\x82\xf8\xc6\xa1\x4f\x92\x05\xdb\xe0\x08\xcd\x47\x9f\x86\xb3\xf9\xe0\xd3\xe7\xf9\x7f\x76\x44\x76\x3e\xb0\x6f\xf3\xf7\x62\x13\xad\x62\xf0\x3e\x17\xb0\x70\xe4\x11\x11\xb7\xae\x93\xfb\x41\x92\x12\x5d\x5b\x01\x8b\x93\x78\x87\x76\x71\x10\x31\x21\x5e\x41\x06\xb0\x68\x5d\x42\x19\xa2\xc1\x36\x08\x71\xa2\xc3\xed\x45\x97\x89\x18\xed\xf9\x68\x01\x45\xba\xf0\x36\x8d\x65\x6b\xe7\x55\x10\x32\x51\x5c\x11\x87\xa1\xee\x4b\xc1\x5f\x87\x91\x97\x84\x44\xea\x2b\x39\xea\x32\x65\xba\x0c\x2c\xd7\x17\xa0\xa5\x05\x66\x72\x3c\xb1\x5c\x90\xf3\x49\x64\x27\x8b\x1d\xac\x2f\x44\x4e\x16\x25\xcc\x95\x50\x6e\x66\x35\x65\xbe\xa9\xd1\xfc\x5b\xb4\x8b\xc1\xd7\x8a\xc3\xf0\x00\x25\xa0\xe5\x39\xd9\xd9\x3d\xc6\x05\xf3\xc1\x4e\xe9\xb1\x5c\xb6\x78\x59\xd6\x11\xa4\xff\x38\xbc\x46\x70\xa0\x5f\xa3\x37\xef\x2e\xee\xac\xa7\xe2\xe6\xbd\xf6\xc4\xef\xfb\x30\x33\x58\xc0\xd4\x4a\xbe\x32\x56\xd2\xe6\xaa\x74\xb4\x0a\x92\x2d\xf1\x6b\x41\xa5\x62\x4d\x25\x00\x76\x2c\x6d\x3c\x29\x71\xd1\x19\x13\x5d\xba\xfb\x05\x16\x73\xdb\x00\x1b\x16\x59\xf6\xa0\xa3\x5d\xa0\xf1\x46\xcf\x2c\xf3\x50\xb2\x62\xe3\x1d\x0d\x37\x0e\xc8\xaf\x72\x47\x98\x19\xf6\x44\xf0\x23\xde\xed\xb8\x62\xf3\x47\xd1\x0c\x28\x0c\x1e\x48\x78\xc8\xb2\xe2\xe3\x2d\x11\x96\x5c\xca\x70\x22\x2c\xe0\x0c\x11\x9c\x84\x01\xf4\x44\x0d\xb6\xc5\x66\x87\xba\xea\x1c\x2c\x61\x30\xbb\x29\xbc\x91\x27\xf4\x76\xd5\xba\x36\xea\x76\x33\x63\x22\xd7\xa7\x23\xf2\xc4\xf8\x02\x98\x68\x3a\x2e\x0c\x8c\xa2\xe0\x0b\x65\x50\x75\x48\x5e\x31\xf6\x14\xc9\xf2\xc9\x01\x53\x89\x8f\x90\x96\x28\xf1\x03\xea\x9b\xa0\xd6\x32\x66\x1b\xd9\x64\x6c\xab\x8c\x8c\x66\xde\x5a\xfb\x19\x79\x73\xb9\xa6\x7e\xce\x7c\xbf\x42\x73\xbf\x9c\x3f\xda\xd5\xe4\xaf\xe0\x79\xb5\xa3\x8d\x54\xc2\x9b\xeb\x5a\xb4\xed\xa4\x49\x93\xba\x9b\x84\xdd\x24\xe6\xf5\xd3\x67\x4e\xef\xd1\x48\x9e\x76\x41\x72\x9c\xe3\x24\x38\x5a\x60\x56\x8f\xab\x98\x62\x36\xc7\x09\x01\xba\x02\x5b\x12\x32\x84\xe8\xf0\x10\x47\x39\x59\x45\x77\xbb\x2f\xfe\x6c\x96\x77\x81\x26\x91\xb6\x37\x04\x31\xe2\x6d\xa2\xc0\xe3\x37\x92\x23\xae\x87\x23\x79\x84\x60\xf0\x1e\xdd\xa2\xaf\x73\x78\x81\xba\x12\xfb\xbb\x5d\xc4\xf9\x4b\xc0\x9a\x14\xe1\x70\x8f\x0f\x14\x1a\xe3\x42\x3a\x2d\x91\xac\x6e\xab\x4c\x49\x42\xe8\x5b\x06\x0c\x85\x71\xb4\x26\x89\x29\x58\xc1\xae\x05\x2a\x2f\x84\xc9\xc4\x9a\xb0\xfb\xa7\xce\x79\x98\xe9\x16\xca\x72\x30\xee\xe4\x60\xda\x31\x00\xa9\x5d\x09\x48\x57\x4e\x57\x5e\x02\x09\x23\x16\xc7\x88\xc6\xd2\xb6\x36\xfa\xa0\x0e\xfe\xeb\xc2\x49\xfe\x51\x1b\x1a\x5c\x5e\x1f\x87\xff\xe2\x48\x02\x32\x94\xcf\xb0\x1a\xc3\xeb\xfc\xe6\xd1\x2d\xcd\x2a\x73\xa8\xb3\x4c\x08\xc2\x1e\x4b\xe1\x98\x7d\x82\x7d\x9b\x53\xf3\x5f\x8e\xf1\xa1\x5c\x7c\x58\x9e\x9e\x94\x31\x02\x93\x1c\x7c\xdd\x2f\x72\x65\x93\x2c\x54\x72\xa9\x3c\xb7\xaa\xc1\x96\x8b\xcb\xc9\x95\xa5\x2a\x7b\xd9\x45\xe4\x1d\xc4\x5e\xa5\x47\x97\xc3\xce\xd1\xe8\xa2\x12\x70\x27\x00\xad\x48\x47\xd5\x09\x19\xa7\x09\xfc\xc8\x8b\x23\x71\x7f\xbc\x83\x6c\x54\xa6\x0b\xb4\x70\x0e\xf5\x6b\x8a\xa1\xd2\x0a\xe7\x2c\x8d\x62\x47\x5e\x51\xd0\xa8\x93\xaf\xc8\xdd\xee\x40\xac\x4b\x92\x10\xaf\x0a\x00\xd5\x4c\x28\x87\x67\xd5\x89\xdc\xe7\xc1\x47\xf5\x0e\x79\x05\x18\x15\x6a\x46\x89\x92\xb4\xf2\x1f\xb7\xa3\xd9\x7c\x34\xce\x75\x28\xa7\x6d\x84\x69\xbe\x1e\xa6\x41\xca\x6c\x7c\xaa\x64\x7e\x36\xd9\x6a\x2b\xe2\x96\xaf\xa9\x64\x78\x27\xa1\x1c\x11\x25\x3b\x9c\x70\xa1\x01\x46\x15\x31\x01\x31\x43\x18\xea\xaf\x64\xed\x1b\xb2\xaa\xa5\x7f\xa0\x84\xfc\x41\x0e\x65\x50\x99\x24\xde\x53\xb5\x5c\x84\x97\xf1\x23\x14\x44\x95\x3f\xf4\xe4\xfb\xe3\x98\x91\x2b\x01\xc9\x47\x92\x28\x62\x64\x14\x8e\x15\xe5\x16\xd5\xb4\xaa\x46\x91\xa0\x6b\x5e\x1c\x51\x96\xe0\x20\x62\xd4\x4c\x18\x4e\xb8\x8c\x07\xdd\x24\x62\x4a\xa0\xe6\x11\x54\x5d\xdb\xe0\x68\x1d\x44\xeb\xde\x31\xe2\x29\x4a\x29\xd8\xa2\x86\x2c\x4d\x50\x46\xf9\xca\x8f\x4b\x1e\xed\xc5\x65\x76\xac\xb4\x95\x55\x2c\x7d\x15\x39\x3c\xdf\x80\x4f\x10\xb3\x4a\x69\xdc\x7a\x47\x35\xea\xfb\xdf\xff\x5b\xe0\xeb\x4f\xb2\x79\x99\xee\xd7\x77\xaa\xc8\x5b\xbb\xab\x4f\x65\x49\x06\xb7\x10\xa8\x2e\x0d\xbf\xcc\x0f\xe4\x80\xfe\x57\x1f\x65\xa5\x8b\xae\xcb\xaf\x47\xbb\xb4\xc6\x18\x67\xe6\x38\x60\x22\xc5\x5c\x48\x15\xb2\x29\x89\x2a\xa9\xb5\x04\x5e\x4f\x44\x11\x63\x12\x49\x95\x98\x2b\xd1\x61\x28\xcb\x51\xa9\x91\x8c\x0f\x41\xc3\xa7\x44\x7a\x5b\x80\x32\x01\x4e\x8a\x77\x8d\x7a\x21\xa5\xd2\x4a\x56\x32\x04\xc2\xa0\x66\xa3\xef\x44\xd9\x90\x4a\x03\x66\x51\x0c\x07\xe3\xb9\x25\x2f\x75\x0a\x12\x56\x1b\xf5\x51\x2b\x93\x73\x3a\xc2\x17\xd4\xce\x1d\x90\x2d\xf6\x7d\x6d\x09\x46\xe8\xe4\x32\x11\x8d\x46\xb7\x0b\x16\x11\xe9\x9b\x82\xd0\x15\x15\xdf\x21\x42\x5b\x01\xe2\x4a\xb2\xda\x10\xd1\x4c\x7f\x97\x04\x10\x48\x51\xda\x12\xf3\xf3\x74\x72\x33\xbc\xbd\x9f\x16\x60\x63\x74\x9b\x93\x9e\x0e\x4b\x60\x37\x9c\xdb\x65\x16\xa2\xa2\x1c\x8f\x6e\x87\x1f\x06\xf7\x77\x73\x01\xb1\x46\x1b\x55\xda\xcb\x55\x6d\x9e\x82\x9a\x30\x1e\x7c\x92\x51\x3e\x6e\x23\x94\x78\x06\xa5\x0d\xfc\x60\x4b\x22\xb0\xb4\x8a\x4e\x73\x0e\xcb\xa4\x5d\x4c\xa7\xcc\xf0\x6e\xd4\x13\xcc\x5a\xaa\xbf\x50\x88\xb4\x5c\x88\x01\xc7\x3f\x16\x5d\x6c\xd9\xc2\xb2\x4d\x67\xb7\xd2\xb4\x17\x5e\x56\x3b\x9d\x6b\x95\xc8\x10\xc3\xaa\xee\x5e\x00\x60\x0d\x4a\x24\xdb\x7c\x15\x9f\x38\xcd\x43\xbd\x1c\xed\x37\x81\x5b\x38\xa3\x22\x97\x38\x2d\xf4\xd9\xa8\x8e\xed\xfc\x30\xdb\x84\x0f\x75\xb2\xfd\x9e\x1d\xba\xdc\x47\x9b\x9e\x9b\xf9\x54\x86\x53\x1f\x0b\xa1\x6e\x38\xcd\x24\x1c\x34\x05\x33\x89\xe4\x17\x86\x27\x30\x3b\x0b\x8c\x18\xe8\x25\x06\xa6\xb4\x34\xb9\x6b\xbb\xe2\xb1\x1e\xa2\x78\xcf\x0f\x2a\x37\x18\x94\x10\x43\x5e\xca\xba\xf1\x6a\xa5\x1d\xdd\x41\xb4\xa6\xda\x97\x6d\xda\x42\x73\x47\x9a\x43\x21\x46\x92\x08\x87\x3d\x16\x2f\xb4\xaf\xb3\x95\x70\xe2\xbd\x20\x51\x0e\x94\x70\xf6\xd9\xea\x6b\x9e\xb6\x30\x57\x79\x27\x1d\xb4\xc8\x01\xce\xa4\x20\xe4\x79\x70\xe0\x9e\x28\x8c\xee\x79\xf2\x8d\xa0\xb0\xc2\x9a\x08\xb4\xa0\x61\xe0\x11\xe4\x53\x81\x47\x54\x8f\x9b\x7b\xa3\x30\x43\xb7\xab\x81\x83\x02\x8a\xc8\x93\x17\xa6\xd0\x84\x1b\x4a\xbb\x88\x46\x61\x5c\xe0\x3b\x08\xf3\xdb\xd7\xd6\x69\x8b\x2a\x90\x01\x45\x38\xa4\x71\xf6\xad\x0b\x61\x7d\xda\xb3\xa8\x5f\xdf\x41\x11\x39\xda\xfa\xb4\x97\x2d\xe8\xeb\x7e\xf9\xe9\xa6\x51\xf0\xb4\xd8\x06\x5e\x12\x53\xe2\xc5\x91\x4f\x5b\xd9\xca\xda\x6e\x0c\xcf\x06\xbe\x1d\x96\xe1\x79\x59\xb7\xd0\x6e\x57\xc4\x5d\x70\x90\x80\x79\x2f\x46\x7b\xd2\x94\x91\x8b\x9b\x38\xf4\x45\x54\xee\x01\x89\xce\x48\xb2\x45\x9a\x3c\x27\x18\xe5\x66\xf2\xe9\xd3\x48\x5a\x72\x72\x2e\x45\x2d\x5a\xc5\xde\x83\x22\xd2\xab\x38\x59\x6c\x39\xae\x90\x08\x47\x9e\xa6\xd4\x81\x6f\xa4\xb2\x18\x67\x21\xcd\x73\x36\xdd\xe4\x8b\x7e\x24\x20\x5f\xa7\xeb\x8d\x21\x95\x43\xfb\xc3\x3d\x1a\xf9\x50\xe5\x11\x74\xa3\x78\xa5\xd5\x90\x8e\x39\xc0\x9e\x40\xc7\x04\xca\xb4\xdb\x23\x0e\x7d\x14\x47\xc2\xc3\x21\x38\x7c\x23\x1f\x10\x51\xcb\xe2\x66\xeb\x40\x82\x3f\x1b\x01\x10\xa5\xe6\x94\x1c\x83\x79\x77\x6c\x76\x87\x7b\xa9\xa6\xed\xf1\x35\x8e\x2d\xb7\xfa\xaf\x5e\x88\x3d\x9e\x95\xd8\x54\x18\xc5\xdc\x24\x88\x5c\xa6\xac\x95\xff\x03\x0d\x95\x83\x38\xea\xbf\x57\x11\x2e\x6f\x46\x22\xb0\xc5\xe2\x46\x39\x69\xde\xdd\x7d\x25\x3b\x82\xfe\xfb\x12\x8a\x2c\xc2\x41\x6c\x37\x90\x19\xd1\x73\xf2\xfa\x33\xfe\xd8\x7f\x6f\x21\x84\xf3\x6d\x83\xdf\xf6\xdf\xe7\x76\x78\xc2\x96\xdc\xef\x7a\x98\x7a\xd8\x87\x1e\xab\x5b\xcc\x48\x12\xe0\x30\xf8\x07\x00\x97\xf6\xdf\x43\x2a\xdd\x51\x50\xe4\xe8\x55\x01\x34\x85\x08\x9e\x32\x83\x56\xef\xcd\x28\xe7\x7d\xbb\x2b\xc4\xe0\x98\x77\xa6\x24\xda\xea\x95\xef\xcd\x9f\x5e\x9c\xe6\xd4\x50\x81\xa0\x34\xf4\x44\xb5\xc5\x13\x65\xd3\x44\xcb\x4a\x2a\xc4\x3f\x4e\x6a\x38\x23\x58\x07\x8f\x84\xa2\x94\x8a\xee\x32\xa2\xef\x4c\x00\x6d\x00\xb4\xf7\x08\xf4\xa3\x60\x05\x7d\x7d\x59\xa3\xdb\xd5\x25\x2b\x81\x24\xeb\x27\xd9\x17\xf4\xac\x8c\x55\xca\xcf\x88\x2d\x38\x89\x5f\x64\x37\xa2\x65\xb4\x11\x18\xce\x27\x1f\x4a\x62\x69\x44\xe2\x57\xa1\xa1\xf8\x33\x8a\xdd\xe5\x5a\x9d\x45\x31\x4a\x08\x0e\x11\xdd\xc4\x09\xf3\x52\x26\x1c\x7e\x6b\xae\xa6\xc7\x29\x43\x41\xc6\x93\x80\x49\x11\x1f\xaa\x3e\xa4\x49\xc4\x15\x78\xe7\xa0\x32\x5c\xe6\x3f\xee\x87\xd3\x1f\x1b\x15\x36\xe7\x6d\xef\x0b\xe7\xe3\xa3\x25\x48\x4a\xdd\x8c\x02\x1d\x5a\xb9\x7b\xe9\xe2\x66\xce\xf0\x3e\xe7\xc2\x1d\x8b\xad\xb5\x40\x71\x7f\xab\x12\x39\x2f\x8b\xdd\xb3\xe8\x26\xde\x2b\x82\x79\x8c\xa2\xf7\xaa\xa2\x5c\xdd\x34\x70\x3c\xf9\xbe\xd5\x46\xdd\x93\x6a\xd2\xd8\x49\xe7\x66\x97\x4a\x79\xf9\xc4\xd5\x02\xf1\xd3\xec\x7b\xcc\x70\xf2\xa8\x8b\xc9\x96\x1d\x53\x75\x5e\xe7\x59\x99\x9c\x65\xb7\xad\x4e\x1e\x67\xa9\xed\x83\x3c\x11\x2f\x65\x64\x01\x8e\x78\x03\x46\x22\xba\xb4\xed\x48\xcf\x4c\xd0\x74\x78\x33\x99\xde\x9a\xf6\x05\x04\x5d\x2d\xe2\x88\xa0\x30\x8e\x77\x66\x2f\x79\x59\xe1\x31\x57\x9f\x57\x25\x96\xed\x71\xc0\xb4\x31\x56\x36\x0f\xc6\x61\x18\xef\x29\x3a\xc4\xa9\xc8\xac\x32\x35\x04\xfe\xa3\x87\x23\x55\x67\xf2\x31\x0e\x40\x34\x06\xf7\xb7\x17\x47\x72\xf5\xd9\x70\x04\xe2\xdc\x09\x5a\x62\xef\x41\x2b\xf2\xda\x96\x04\xa5\xe9\x61\x65\x20\x7a\x0a\x22\x00\xa1\x35\x38\x60\x4a\xca\xe6\x63\xab\x01\xbf\x8d\x77\x64\x95\x86\xe1\xa1\x23\x3e\x86\x79\xa1\xf1\xc0\x1e\xad\x12\x42\xfc\x1e\x9a\x83\xe5\xdb\x8b\xe3\xc8\x97\xb0\xc0\x01\xa3\x7a\x6e\xfe\x85\xb2\x91\xbb\x50\x4a\xcc\xf4\x61\x32\x45\x09\x1a\x15\xd2\xc2\xab\x2f\x6a\x0d\xba\x2c\x4c\x8c\xb2\x39\x86\x60\xa4\xe3\xf9\x68\x7c\x3f\x14\xdd\x14\x1c\xb4\xb7\x8a\x8d\x26\x50\x04\x9a\x6f\xb0\xff\x5e\xc5\x9a\x57\x47\x14\x17\x0d\x6d\x89\xdd\xe4\xf1\x8c\x7b\x9c\xb8\x8a\x47\xe4\x85\x84\xac\x21\xc4\x6f\x0c\xe0\x33\xa4\x13\x0e\x56\x73\x1b\xff\xa2\x80\x94\xcd\xf2\x51\x51\x8e\x31\x82\x03\xcf\xa6\x3b\xa3\x19\x6a\xf2\x5d\x52\xd0\x08\x21\x9f\xce\x2a\xc4\xad\x0b\x80\x1b\x85\x3a\xe0\xdb\x9e\xe8\xaa\xb7\x4b\x62\x8f\xf8\xa9\x6e\xb3\xc1\x89\x4f\x92\x46\x28\x21\xeb\x34\xc4\x49\x78\x10\x22\x93\x97\x88\xaa\xb1\x4d\x90\xbe\x76\xe9\x32\x0c\x3c\xe3\x5b\x61\xe5\xf7\x40\xda\xe0\x52\x19\x7f\xbd\xd1\xed\x26\x60\x9a\xe2\xb7\xfe\x97\x94\x32\xd1\xa4\x27\xb7\x98\x65\xca\x84\xe3\x4a\x35\x69\x0f\x3c\x5d\xd0\xb6\xdb\x95\x01\x14\xd8\xf7\x11\x65\xe9\x6a\x85\x42\x2e\x98\x6b\xb2\xc8\xf1\x8a\xef\x73\x47\xe2\x9d\xc8\x30\x14\x2e\x02\xbe\xeb\x20\x11\x8b\xa6\x5e\x12\xec\x9c\x72\x5b\x01\xe6\x10\x97\xab\x00\x6e\x62\x5a\xbb\x20\x84\xb9\x90\xed\xc8\x51\x5d\x37\x5e\x46\x45\xac\x9a\xda\x8c\x23\xb6\xe7\x35\x82\xf4\xcf\xc0\xc6\x0a\xc8\x70\x04\x1c\x8a\x27\xc8\x78\x82\x18\xa6\x0f\xb2\x7d\x0a\xd8\x0d\xf9\x39\x15\xd1\xf3\xe5\xb0\xf2\x0c\x5e\x6e\x2c\x77\xf1\x4b\xbc\x6c\xfd\x12\x2f\x55\xb7\x27\xe1\x37\x5b\xab\xe6\x26\x55\xc7\x5f\x0e\x1b\x25\xdd\x38\x80\x5d\x37\x01\x41\x2c\x23\xbf\x52\xda\x8a\xd2\xed\x92\x24\xf0\x77\xb1\x5e\xe8\x73\xe4\x6d\x88\x9f\x86\x59\xf5\x66\x94\xd5\xdb\xad\x93\x96\xe0\x81\xeb\xce\xca\x42\xd0\x7a\xbc\x50\xe4\x32\x28\x19\x19\xff\x65\x35\x56\xf8\xe2\x8c\x30\x7f\x7e\xa6\xba\xa4\x0a\xca\xf5\xa5\x12\xe6\x72\x78\x45\x19\xd3\x4b\x4e\x49\xbc\x59\xdc\xea\xff\xea\xbb\x61\x70\xdd\xb0\x3c\x2a\x5e\x9c\x46\xac\xf5\x85\x74\xef\x7b\x11\xfb\xdd\xf6\x91\x59\x10\x39\xdc\xbf\x46\xe6\x91\x5a\x17\xde\x4c\xd5\xe2\x07\xd0\xac\x87\xce\xcd\x12\xa4\xb0\x5b\xf7\x16\xda\xf5\x5e\x76\xcc\x95\x74\xbd\x88\xb5\x5d\xb5\x2e\xc4\xaa\xdf\x1f\x5f\x75\x09\xe6\xd4\x87\xfa\xcb\x43\xbe\x61\x1b\x9c\x5b\x5e\xc4\xba\xc6\x3e\x5c\xfb\xb5\x8a\x09\xbc\x4c\x02\x48\xd9\xd5\x86\xeb\x9c\x1d\x16\xa7\xaf\x37\xf0\xaa\xea\x3b\x22\x96\x0a\x09\xac\xe2\x5b\x8f\x40\x41\x77\x91\x2d\x7f\x10\x9d\x2e\xe3\xa5\xbe\x23\x49\x47\xb4\xc2\x0a\x43\xd9\x62\x37\x48\xf4\x33\x5f\xcf\xd4\xbc\xae\x9f\x16\x15\xd0\x05\x65\x82\x5b\x25\x0f\x24\x69\x89\x72\x23\x7e\x9c\x2e\x43\xc2\x85\x75\x2f\xe0\x1c\xe8\x58\xc1\x35\x79\x23\x57\x61\x8c\xd9\xdf\x28\x89\xfc\x96\xac\x8c\xd2\x47\xcd\xff\xeb\xe9\xaf\xab\xd5\x5b\xe3\xcf\xbb\xa6\xb3\xb6\xd9\xe8\xd3\xa7\xfb\xb3\x5a\x1f\xe6\xb7\x50\x5c\xbc\xd5\x72\x27\x49\x89\x6c\x02\x2f\x6b\xab\x70\x05\x0c\x7d\x4e\x20\x24\x9a\xa4\x14\xc1\x60\x48\x0c\x56\xbb\x1b\xe2\xd1\x45\x9c\x5d\x7a\x28\xa0\x8b\x88\x5f\xa5\x70\x11\xe1\xe8\xb5\xce\xe7\x6f\xc6\xf9\x5c\xbe\xfc\xf9\x18\x1b\x38\xeb\x74\xc6\x78\x7c\xca\x49\x54\x4d\x77\xf6\x39\x58\x2d\x14\x54\x44\x10\x82\x1c\x9f\x8c\xac\xcc\x20\x6a\xa2\xbc\x91\x34\xec\xa9\x34\xba\x20\x0b\x05\x02\x2a\xa9\xbf\xb2\xba\x3d\xbf\x50\xc3\x50\x59\x11\xbf\xd8\x8f\x4a\x74\x10\x12\xc0\x87\x40\x14\xac\x9b\x3d\xd7\x3e\x03\x35\xf8\x39\xc0\x36\x85\xe9\xac\x5b\xbf\x17\x87\xe9\x36\x12\xe1\x4d\x5c\x7b\x7c\x0c\xc8\xbe\xa5\x1f\x23\xd1\x95\x3d\xf0\xb3\x6c\x52\xe1\x51\x11\xdb\x1a\x0f\x3e\x0d\x9d\x62\x52\x40\x17\x09\xa1\x24\x79\xe4\xba\x8e\xaa\x96\xa3\x44\x26\x2b\xc4\x4d\x74\xe6\x1f\x8c\x7f\x6c\x89\xc8\x30\x48\x60\x6f\x76\x50\x53\xa4\xb0\x77\xac\x84\x78\xd4\x94\xad\xba\x7e\xe6\xeb\x30\x43\x22\x8c\x09\x81\x1d\x8d\x3e\x58\x6b\xd0\x6c\x37\x9b\xf4\xaa\x2f\x47\x5b\x34\xd1\x3f\xff\x99\x3d\xe0\x9c\xcd\xe0\x6b\x7c\x20\xe3\x7b\xc9\xe4\x5a\x35\x1a\x20\x3d\x90\x43\x06\xc8\x76\xbb\x17\xf8\x26\xb0\x61\x1a\xe5\xfb\x78\xc6\xa8\xa2\xaf\x71\x7e\xe0\x93\xd2\x8d\x4f\xb2\x1f\x1e\xc1\x1c\x81\x2f\x0a\x59\x9e\xd3\x08\xcd\x6e\xd9\x03\x83\xe7\xfb\xcb\xea\xa4\x60\x9b\x52\x54\xa6\x15\x1b\xbd\x88\xf8\x0e\xa8\x4c\x2a\xe6\x24\x24\x20\x7b\x33\xcf\x18\x95\xb7\x3b\xcb\x53\x9f\x66\x07\x70\x88\xb2\x24\x88\xd6\xd0\xab\xbe\x51\x2c\x36\x81\xb4\x54\xaa\xaf\xb2\x0c\x30\x13\x48\xfd\xd3\x1b\xfa\x33\xc4\x76\xbd\x19\x35\x3b\xbb\x98\x5e\x5d\x81\x98\x73\xfa\x19\x40\x05\x35\x61\x44\xcb\x04\xc9\x0e\xe2\xd7\x27\x33\x2f\xef\x62\x5a\xac\xcd\x94\x07\x4e\x35\x41\x85\x15\xec\x62\x2a\x3a\xbb\x85\x0f\x3b\xb3\x97\xe1\xc3\xce\x34\x01\xa1\x3e\x2a\x9e\xa7\xf5\x02\x8e\x7c\x57\xb4\x65\xc3\xf2\x2e\x58\x1d\xa8\x54\x84\x94\xb9\x01\x7d\x86\x46\x87\xaa\x53\x2a\xb6\x6f\x4f\x5a\x74\x2e\x6d\x82\xcb\xf2\x83\xb9\x59\x73\xa0\x88\xed\xdf\x8d\x86\xdf\xab\x75\x98\x99\x51\x83\x59\xce\x7e\x68\x21\x10\x04\x3c\x65\x39\x04\xb6\x23\x23\x17\x24\xcf\xff\xbc\x79\x77\x41\x2d\x15\xc2\xf6\xac\x94\x64\x67\xe9\x29\xea\xa6\x57\x5d\x5c\x74\x4c\x88\xe7\xb1\xe7\xfc\xc4\xf0\xf3\x7a\xe8\x66\x14\xe8\x25\x08\x8f\x3c\xe7\xdf\x80\xf0\x14\x2a\x1c\xbc\x02\xe5\x29\x50\x9a\x17\x23\x34\x50\x81\xe3\x5f\x8f\xce\x18\xc7\xf7\x0a\x74\xc6\xd9\x0a\xef\x05\x08\x4d\xc9\xaa\x9f\x49\x68\x3e\x0d\xf9\xaa\xeb\x10\x1a\x1f\x33\xdc\x83\x70\x0a\xae\x06\x07\xdb\x5c\x8c\x09\x3c\x16\x12\x2c\xa6\xb2\x76\x61\xf1\x05\x23\xb1\xb6\x94\x68\x59\xf8\x78\x1e\xed\xd2\x44\x8b\x4f\x6a\x07\xc9\x0d\x3f\xcc\x45\x30\xe2\x51\x52\x07\x61\x88\x72\x31\xa0\x0d\xd8\x3b\x68\x6b\x3a\x67\x9e\xf8\xef\x47\xe8\x4c\xa2\xf4\x6c\x42\x27\xe9\xba\xdc\x2c\x57\x49\xe4\xf8\x2d\x4d\x8b\x3a\xd9\xf9\x51\xd9\x95\xfa\xa7\x9f\xf3\x8d\xac\x0b\x1b\x6c\x0c\x66\x8d\x8b\xf2\x62\x2e\xba\x93\x35\x52\xac\x85\xb2\x2d\xcb\x68\x9f\xfa\x55\x64\x0b\x65\x3f\x27\xf1\x9e\x2e\xf0\x6a\x05\xd9\x5f\x46\x8f\x6c\x84\xa2\x74\xbb\x80\xa7\x2a\x39\x48\x3c\xe4\x32\xfe\xdb\xca\x82\x31\x56\x7f\x58\xb1\xb8\xaa\x0b\xec\xba\xbc\xfd\x6c\x37\xe7\x47\xc6\x7d\x98\x4c\x2d\x58\x8c\xc6\xce\xa2\xd6\xe2\xde\x37\xcd\x80\x28\x8e\xce\xbd\x37\xef\x2e\x46\x76\xde\x0d\xe0\x32\xd7\xaa\x2e\x2e\xdb\xcd\x8e\x19\x14\x66\x75\x81\x2d\x86\x12\x97\xa6\x08\xb5\x74\x13\x1d\x6f\xe3\x75\x90\xb7\x69\xb7\x7b\x32\x81\x66\xb7\x5e\x40\x23\xc2\x5c\xb0\xb1\x23\x38\x78\xb7\x86\x79\xe9\x0e\x7b\x04\x45\x1c\xe1\xbd\x5e\x42\xc2\xec\xb7\x3e\x8a\x7a\x71\x49\x96\x64\xad\xe0\x65\xe4\x6d\x44\xc4\xb2\x15\x79\xce\xd7\x6b\x86\x82\x40\x2e\x4a\x2f\xa2\x3b\xf9\x50\x2d\xa2\xed\x9c\x38\xa3\x27\x95\xf3\x42\xa8\xb4\x67\x55\xf8\x56\xd1\xd2\x9c\xc2\x6f\xbc\x9e\x63\x63\x32\xed\x85\x6f\xda\x4c\x4d\xaa\x88\x71\xa9\x0a\x5a\x6c\x5f\x5d\xc5\xf9\xc8\x69\x51\x23\x00\x1b\x7d\x84\x0d\x9f\xb2\xc9\x56\x4c\x04\x14\xa9\x4c\xd9\xe5\xb7\x83\x85\x3e\x0e\xe7\xe8\x76\x34\xf8\x38\x9e\xcc\xe6\xa3\x9b\x59\xee\x66\xf6\xd1\x74\xf2\xfd\xe2\x66\x72\xaf\xf2\xc1\xd5\x9f\xc2\x35\xed\x17\x7f\xfa\xa3\x3d\x98\x1d\x88\x24\xbc\xc5\x85\xb0\xc1\x1c\x5f\x6c\x96\x06\x0c\x5e\x1e\xb9\x26\xae\x74\x2e\x17\x0c\xce\xd8\xff\xd9\x7b\x37\x63\x15\xab\x43\x08\xe5\x4a\x25\x5a\xf2\xa1\x5b\x02\xbd\xb3\x2d\xd8\x9c\x2a\xbf\x00\xed\xfa\x04\x0f\xf6\x91\x3f\xe8\xbb\x80\xec\x29\x3a\xf6\xda\x49\xf5\x75\x0c\xee\x96\x31\x19\xb0\xc2\xb5\x94\xd3\x31\x2f\x81\x9b\xe4\x0c\xe5\x5b\x0f\xeb\x9e\xbe\x85\xbe\x24\xca\xa4\x9f\x8f\x09\xcb\x75\x95\xd3\xaf\x19\x45\x0a\x5d\x4f\x59\xcc\x70\xe8\x78\x90\x1b\x5d\x54\x41\xb4\x5c\xd0\xcb\x03\x23\x8a\xb5\x76\x44\x21\x9f\xf2\xe7\xb9\xe1\xc4\xac\x34\xf8\x07\xc9\x0d\x93\x3d\x90\x30\x32\x47\x4c\x30\x0b\x62\x7e\xf6\x24\x09\x3c\xf7\x90\x32\x5d\x4e\x0d\x97\x27\x68\xea\x49\xdb\xd9\x65\xf8\x65\x82\x2f\x2b\xe3\x23\x1d\x92\xab\xd6\x94\x9d\x51\x7e\xce\xde\x7b\xce\x10\x6b\xe7\x63\xa3\x99\x75\xc3\x19\x60\x9e\xeb\x29\xe7\x7a\xc9\xc6\x2c\xe7\x2b\x5c\xb1\x06\x65\x4c\x60\x91\x03\xe5\xea\x7c\x66\xe3\xa2\xf3\x8b\xdd\xda\xc0\x9a\x56\x86\x2d\x90\x52\x5c\x86\xa4\x15\x73\x4b\x71\x0b\xd3\x32\x04\x3e\x7d\x15\x79\xdc\x76\x1f\x9b\x7e\xc9\x0d\xf2\x3c\xd6\x57\x0c\x22\x10\xbb\x72\x18\x8d\xfe\xce\x64\xe9\xc2\x8f\xad\x8a\xb0\xde\xd2\x48\x54\xae\x6d\x76\x2a\x9e\x1e\x43\x64\xf9\xda\x11\x7c\x16\xc5\x0a\xa0\x72\x40\x79\x4c\x6c\x26\xfa\x3d\x90\x43\xe5\x6b\x27\x6a\xee\xd5\xe5\x32\x8a\x1a\xbd\xb5\xeb\xca\x11\xb4\xd5\x01\xfa\x3d\x1c\xb9\xb5\x65\xfd\x37\xeb\x05\xf9\x72\x1c\xad\x75\xef\x5d\x57\x14\xd3\x3a\xe4\xc0\xba\x24\xbb\x84\x30\x76\x68\xed\xd6\x0b\x81\xaf\x2a\xa9\x05\x9e\x56\x94\x6e\x35\xa5\xde\xac\x65\x78\x3b\x77\xc7\xca\xe7\x7f\xdb\x7b\x0b\xcb\xad\x75\x95\x9c\x24\xe1\xe8\xfd\x72\x7e\x75\xfc\xd2\x9d\x1c\x05\x0f\xc6\xf5\xe0\xba\x71\x52\xb8\xfb\xcb\xe4\x2b\x95\x72\xb3\x12\x72\xe0\x26\x03\x47\xae\x7f\xf5\xb5\xaf\xb8\xee\x47\xae\xf9\x33\xaf\xf7\xf9\xd7\xba\xfe\x75\x7e\xe5\x6b\xec\x07\x5b\xda\xb3\x9a\xfe\xd7\xb9\xc2\x5e\xd0\xab\xc5\xc2\xbd\xa0\x77\x8c\x67\x6f\x3c\xda\x73\xf0\x65\xf1\x19\xf0\x47\x75\x77\xdc\xdf\x16\xd9\x72\xbd\x4f\x7d\xda\x73\xbc\x58\x8f\x3f\xe7\x28\x57\x6e\xac\xa3\x04\xa8\x75\xd9\x7b\x8b\xba\xa8\x55\x63\xf9\xe3\xfb\x4f\xc3\xe9\xe8\x06\x7d\x59\x0b\x4e\xf2\xed\x76\x1b\x7d\x81\x2e\xdf\xd6\xa5\x6e\x7c\x64\x93\x92\x5d\x5d\x09\xe3\x97\xfb\x4d\x19\x2a\x55\x20\x62\xea\xab\xe3\x14\xae\x36\x65\xcb\x8c\x13\x65\x61\x62\x3a\x77\x99\x02\x22\xa3\x89\x3b\xcd\xa9\x05\x58\xee\xe8\xed\xe6\x48\xda\xcf\xbf\xaa\xaf\x74\x99\x6d\x29\x5b\xe5\xdd\x60\x3e\x9c\x0e\xee\xb4\xa5\x63\x76\xff\xa9\xb5\x29\xc1\x0c\xf8\x77\xc3\x45\x8e\x8c\xb9\x7d\xc2\x70\x10\x12\xdf\xe6\x84\x75\x12\x82\x0c\x7e\x98\x2b\x88\xd0\xe6\xa8\x8f\x26\xe3\xac\x0e\xf3\xd1\x8d\x14\x69\x12\x6c\xac\x12\x75\xdb\x6e\x91\xd9\x78\xa3\x53\x32\x6c\x35\x92\x97\xc9\xf1\x35\x06\x36\x71\xbc\x7d\x9c\x7d\x8b\x8f\xca\xd0\x1d\x06\x28\x7b\xd8\xa8\x3a\x54\xab\xda\x33\xc3\x8c\xbe\xdc\xc1\x7a\xb5\x0f\xf6\x04\xbd\x53\x1b\x47\x39\x40\x74\xfe\xbe\xac\x59\x00\xb5\x40\xdb\xe8\xc3\xe8\x6e\x3e\x9c\xb6\x64\x25\x22\x6a\x40\xc4\x6a\x0e\xf0\xb6\x09\x82\x4a\x5d\xed\xaf\xc6\xcc\x8e\xd1\x6d\x86\xe3\xae\xfe\x54\x46\x4f\xa4\x0d\xc0\x2b\xb1\x65\x16\x28\x48\xdf\x51\xf4\xc3\x26\x1d\x7d\xf3\xf4\x72\xe7\xe5\x05\xfc\xb8\xb2\x16\x55\xce\xa4\xe1\x33\xad\x05\x15\xea\x56\x0d\x55\xeb\xb8\x9a\x75\x44\xc5\x3a\x45\xbd\x5a\x80\x97\x47\x99\x9c\x4f\xd4\xae\x9e\xa7\x59\x99\x62\x98\xbb\x3c\xe1\x51\x55\xcb\x5e\xfd\x6b\x68\x59\x47\xa1\x5c\x5a\xa0\x43\x5d\x82\x96\xfa\xcb\x22\x24\xd1\x9a\x6d\xda\x35\x0e\xe5\x48\xb1\x9c\x23\x07\xe2\x2e\xa3\x73\xfc\x1c\x54\x05\x9c\xea\x9a\x91\x75\xb5\xcc\xba\x62\x6a\x4d\x51\xb5\x68\xd9\xf1\x36\xb4\x97\x46\xc6\x1c\x35\x38\x55\xb9\xcd\xc7\x31\x78\xc5\xd0\xa7\xd8\xa3\x72\x23\x6f\xd4\x5e\x73\x36\xa9\x8a\x01\xac\x4f\xea\x68\xd8\x4a\xc8\xad\xbd\xa7\xab\x2b\x69\xb8\x45\x5f\x9e\x02\x65\xfd\xd9\x89\x52\xaf\x30\x5c\xda\x92\x6f\xf9\x5b\x65\x9c\xbe\x9e\x3e\xef\x20\x73\x95\x09\xed\xc7\x05\x5f\xb3\xde\x55\xe0\x12\x7b\xe1\x8c\x73\xb2\x2e\x2c\xc0\xc7\x0c\x4b\x4e\x15\xf4\x6a\x8a\xb8\xf5\xd7\xe5\x6e\x01\x0c\x62\x0e\x87\xa3\x73\xa5\x1c\xbe\x45\x81\xbb\x20\x14\x65\xab\x2f\x17\x89\xce\xf1\x71\x9a\xa0\x74\x43\x32\x5f\xe8\x2b\x0f\xc7\xf3\xc1\x28\xe5\xb1\x93\x0c\xac\x99\x58\x54\xce\x17\xee\x3f\x55\x5b\x3f\xef\x3f\x7f\x1e\x4e\x5b\x89\x2c\xf4\x44\x7f\xba\xfc\xf9\xea\x6a\x3e\x9b\xff\xe7\x74\x30\xfe\x38\x6c\xa3\x2e\xba\x9b\x7c\x5f\xf1\x42\xe9\xd8\x15\x85\x08\x4c\x39\xad\x84\xaa\xd7\xa1\xbf\xff\xca\x9b\x97\x62\x30\x92\x72\xb0\x97\x91\x3a\x25\xeb\xa7\x94\xe3\xcf\x8d\xbe\x24\xcd\xe7\x01\xcc\xc1\xdc\x1a\x95\x5c\x5d\xa4\xee\xca\x4a\x67\x96\x9d\x55\xd9\x32\x94\xa3\x59\xe3\xb8\xc3\xd8\xda\x46\x09\x2d\x2f\xf6\x7c\x0a\x8d\x10\x0b\x91\xe4\xa1\x5c\x7d\xe7\x90\x84\x37\x45\x1f\x2d\x46\x9e\x18\xea\xa3\x44\xfd\x0a\x4b\x33\x1b\x24\x17\x85\x05\x97\xa4\x5d\x23\x92\xfc\xe4\x3a\x14\x96\x9f\xb7\x4e\x2a\x83\x19\xcc\x36\x1a\x7f\x98\x28\xc6\x20\x82\xd9\x4c\xf9\xfe\x8b\x23\x41\x78\x72\xd2\x7a\xb3\x88\xf4\x0c\x19\x31\x97\xd3\x22\xc2\x87\xde\x03\x39\x74\xcc\x7f\x17\x42\xf1\xad\xa7\x76\xf8\x7f\xc7\xc8\x5c\x11\x1f\x52\x1d\x52\x67\x70\x58\x0f\x27\x7e\x10\xe1\x30\x60\x87\x96\x7e\x51\x69\xd5\x22\x02\xad\x46\xf0\x24\x0a\x1f\x1a\xb9\x00\x1a\x49\x53\x5b\x99\x0a\xd2\x41\x50\xb2\x16\x62\x48\x45\x88\x9f\x96\x37\x65\x4e\x93\x5e\x5f\xf9\x6c\xe8\xe3\x74\x72\xff\x59\x99\x6c\x61\xd2\xc1\x0c\x3d\x62\x08\xc9\x79\xc4\x3d\x91\xed\x21\x60\xd7\xce\x26\xc8\x36\x03\x15\xef\xea\x9d\x0e\x3d\x50\x46\xb6\xf2\x5e\x14\x0f\xa9\x95\x2f\xc8\x90\xaf\x65\x8c\x57\x64\x01\xd5\x5e\x9f\x82\x2d\x66\x64\x91\xc4\xfb\x85\x48\x7d\x6d\xda\x52\x88\x08\x9f\x68\x5e\x5d\x4d\x87\x1f\x6f\xee\x06\xb3\x99\xd8\x18\xe8\xd1\x7c\xe5\x32\xbc\x42\x8c\xd5\x71\x4f\xae\x73\x6a\x8f\x34\x00\xd7\x83\x8a\xdf\xce\x19\x4d\x1f\xbb\x3d\x60\x5e\x45\x3b\x63\x50\xc7\x80\xf4\x94\xfb\xea\x3a\xab\xa2\x6b\xbe\xfe\x39\x29\xe9\x07\x4e\x4b\x06\x71\x02\x21\x2e\x31\x05\x55\x9c\xd7\xc9\x38\x62\xcd\xad\x59\x40\x99\xb3\x4d\xcd\x8c\xb7\xbb\x50\x4f\x7d\x2c\x5e\x58\x5f\x0f\x3b\x12\xb8\xdb\x85\xe2\x8e\x32\xeb\x9f\x6d\x88\xe8\x88\x29\xaa\xda\x24\x69\x84\x64\xa7\x55\x28\x34\x9b\x55\x22\xeb\xa1\x11\x6b\x52\x14\x6c\x77\x71\xc2\x44\xab\x18\xd1\x00\x86\x44\xbe\xd4\x93\xa0\x3a\x85\xe8\x87\x16\x50\xdd\xa4\xb5\x01\xf5\x65\x12\x12\x12\x4c\x45\xd5\x19\x7a\x5a\x90\x29\x3e\x58\x0a\xd8\x2f\xf1\xb2\xb5\x61\x46\x28\xa8\x4c\xc2\x66\x38\x61\x66\xdf\x47\xbb\x7b\x89\xa3\x7a\xd0\x72\xbd\x5f\x64\xf5\x08\xcc\x38\xcf\xaa\xc6\x7b\x52\x9b\x90\x95\xff\x73\x6b\x4b\x23\x16\x84\xb2\x7b\x3a\x2c\xa8\xac\x07\x9f\xda\x40\x96\xeb\xfd\xbc\x2e\x9d\x12\xff\xe4\x76\x20\x2a\x35\xdb\x5e\xa3\xc2\xe8\x00\x69\xcf\x3d\xfe\xae\xa8\x0e\x91\x6f\xee\x89\x76\x8d\xba\xf1\x93\x39\x19\x9f\xcb\xf4\xa2\x81\x92\x6d\xa6\xc8\x97\xec\xae\x2e\xf1\x8b\x23\x3f\x2f\xfb\xe7\x60\x27\x4a\x18\x61\xe8\x33\x66\xe6\x64\x8b\x6a\x48\x4c\x95\x04\x0f\x0f\x66\xc3\x84\x2e\xb4\x7d\x69\x19\xdb\x40\x01\xa5\x29\x41\xff\xc7\xbb\xcb\xbf\xfc\xb9\x5d\x48\xb1\xdf\xad\x17\xd8\x7f\x0c\x68\x9c\x1c\x16\x4f\xd8\x63\x0b\x8e\xc7\xad\xcb\x77\x5f\xfd\xf5\xaf\x1d\x03\xd2\x66\xd5\x21\x5d\x53\x80\xaf\x0c\x9e\xab\x95\xb5\xb2\x0f\x64\xeb\x16\xc0\x95\xfe\xfb\x8f\x70\x2d\x66\xf3\x96\xc6\x9f\x8e\x26\x2d\xd9\x7b\xd5\xd6\x55\x79\x8c\x82\x54\x0a\x08\x4b\xac\xe8\x9b\x0b\x6d\xbb\x3a\x8b\x3a\x2b\x01\x42\x21\x0e\x95\xe7\x0f\x05\xb0\xa0\x72\x71\xa1\x46\x82\x1f\x2f\x4a\xda\xb4\x36\x3b\xe8\x82\x90\x0b\x59\x6c\xea\x96\x58\x1d\x16\x25\x19\xc2\x0f\x04\xed\x42\xec\x11\x51\x76\x24\xab\x4e\x62\x94\x57\x36\xda\xd9\x88\xea\xb3\x1b\x12\xfa\x08\x7b\x49\x4c\xa9\x1c\x3c\xbf\x02\x20\x49\x59\xb7\x06\xcc\x34\x59\x82\x29\x29\x74\xdc\x42\x1b\x82\x1f\x03\x92\xc8\x51\x65\x6b\x1a\x12\xf9\x59\xf5\xae\x94\xe6\x5a\xc7\xa2\x1d\x4e\xf0\x96\x70\xa4\x93\x5b\x48\xa9\x68\x55\xb3\x24\x46\x7f\xd7\x53\x4a\xbf\x97\xc2\xaf\x55\xa8\xc3\xde\x41\xdb\x20\x2a\x54\x60\xcf\x2f\x51\xe6\x13\xa9\x86\xb3\x5a\x9e\x92\x89\x1f\x26\x2d\xcc\x22\xcc\x92\x78\x8f\x12\xe2\xc5\x89\x11\x3b\x9b\x55\x2f\x76\x3d\x35\x6e\xb7\xeb\xb1\x5a\xa9\x36\x9a\x5a\xa1\xf7\x76\xdc\x9f\xc4\xf5\x4d\xef\x0b\x2b\x5d\x26\x37\xc3\x89\x15\xca\x8f\xb4\x40\xd5\xc5\x4e\x4a\x48\x90\x71\xa5\x75\x71\x75\x7b\x79\x36\x78\x6a\x59\x76\x8b\xbe\x0e\x61\xbf\xb5\x36\xca\xc9\xa7\x66\xe2\x81\xef\x28\x52\x3e\xfa\x90\x21\x42\xbf\xac\xe9\x71\x31\x9c\xa4\x78\x24\x57\x7d\xd4\xfd\xb7\x77\xef\xbe\xfa\xea\xaf\xef\xde\x7e\xf5\x97\xbf\xfd\xf9\x4f\x7f\xfd\xeb\x9f\xff\xf6\xf6\x6f\xe5\x2e\x93\x6a\xab\x38\x1f\x5b\x99\xc6\x23\x1c\xb6\xd4\x84\x6d\xbb\xc8\x7f\x7e\x19\x15\x71\x34\x1f\x26\x53\x03\x41\xdd\xf9\x0d\x5e\xae\xd6\x65\x8d\x93\xd0\xf5\xc4\x5f\xa2\xcc\xb9\xb3\x0c\x39\xea\x23\x28\x53\xde\x38\x23\x15\x41\x0e\x6a\x66\x01\xe4\x47\x92\x9e\x00\xbb\xe4\xb8\x85\x90\xf9\x2f\xba\x5d\x20\x6f\xda\xd5\x47\x65\xa5\xec\xa8\x1b\x44\xb2\xb0\x79\xa1\xf9\x5d\x11\x61\xbe\xb6\x2a\x98\x17\x3e\xf0\x9c\x59\x0c\xa3\x19\x84\xf5\x16\x5a\x24\x65\x9e\x09\x35\x66\x26\x3c\xe9\x94\x83\xdc\x26\xa0\xe0\x21\xd4\x1e\x07\x58\xe9\xa2\xec\xbd\xaa\x6a\xc0\x4d\x68\x6a\x02\xd6\xce\xeb\x66\x27\x43\xa8\x7c\xae\x87\xfa\xb9\xd0\x88\x3b\x9b\x5f\x16\xb0\x10\x7d\x7f\xa0\xa1\x9c\x28\x1d\x9e\xed\xbb\xe7\xec\xa1\x5e\x1f\x49\x5d\x05\xf8\x55\xba\x87\x4c\x09\x51\xeb\x94\x45\xe5\x8f\x42\x3d\xd7\xdf\x60\xf4\x01\x7d\x98\xdc\x8f\x6f\xdd\xb5\x6b\x45\xf3\xdf\xf1\x64\x3e\xba\x19\xa2\xe6\xe7\x24\xde\x8a\x46\xf9\x01\x45\x19\xa3\xe2\xe2\x3e\xcc\x74\x85\xde\xf4\xde\x9c\x06\xd3\xeb\xf2\x9a\xd6\x39\x46\x58\xf0\xde\x9f\x72\x72\x86\x26\xe5\xae\x25\x9d\x87\x09\x87\x96\xcd\x49\x1d\xf0\x31\x2b\x11\x96\x16\xa7\xb6\x73\x4e\xc6\xb7\xb2\xfd\x8f\xab\x5c\x19\x97\x90\xda\xa7\x15\x59\x7b\x6d\x71\x81\x8b\x0a\x5c\x10\xb3\xdb\x08\xa3\x51\x04\x1d\x49\x0f\x48\xea\x23\x14\x7a\x7b\x2a\x04\x46\xdb\x34\x64\x41\x14\x4b\x0d\x12\x7b\x1e\xa1\x5c\x10\xf7\x75\x97\x00\x51\xa4\x30\x8a\x55\x77\x2c\x44\x59\x9c\x10\xb4\xdf\x10\xb6\xe1\x4a\xa4\xd0\x3b\xf6\x24\x21\xc6\x65\xea\x88\x4e\x04\xb2\xbf\x59\x0c\x8a\xaa\x18\x9f\xa5\x38\x44\x94\xe0\x44\x36\x14\xea\x76\xa1\xc4\x29\x9f\x31\x57\xf5\xdf\x94\x3b\xe3\xac\x49\xb0\x78\x55\xf4\x4e\xfa\x32\x8a\xd9\x97\xba\x75\x47\xb7\x6b\xae\xff\x1a\x65\xc5\x16\x85\x3c\x0c\xdd\xa0\xa3\xc2\x3e\xa1\x99\x87\x1f\x23\x8c\xc2\x18\x5a\x45\xef\xe3\xe4\x41\x0f\x08\xd5\x58\xbd\x07\xd5\x56\x1c\x4a\x43\xd3\x34\x64\xbd\xf2\x1c\x40\x0d\xd2\x7c\xa6\x03\xc8\xe6\x7e\x40\x59\x12\x2c\x53\x46\xfc\x05\x5f\x97\x2b\x85\xbb\x68\xac\xbf\xe0\x9f\x5d\xc8\x11\xca\x45\xcf\x37\x77\x1d\x24\xfe\xd7\x96\x9f\x38\x02\x47\xad\x62\xe3\x0a\xd5\x72\xe8\x95\x33\xc2\xdb\xa8\xd7\x7f\x8f\x54\xd5\xd6\x82\xb0\x71\x6c\x85\xf5\x66\x77\x68\x3b\x80\xda\xe8\x19\x1a\x8f\x5e\x4f\x1c\x2a\xaf\xa4\xa9\xea\x9c\x70\x93\x1d\x23\xb5\x1c\x3d\x5a\xf5\x6b\xc2\xe3\x6d\x5e\xe6\x5a\xc2\x3d\x0c\x73\x9d\x13\xf8\xa3\x74\x8b\x74\xeb\x55\x5b\x1c\xd7\x42\x57\xc7\x7a\xb7\x4e\x04\xb2\x9b\x60\x0b\x62\xad\x47\x73\x57\xd5\x4e\xe2\xfd\x42\xb8\x82\x5b\x6d\x34\xf9\x6e\x38\x45\xad\x92\xd6\x26\x8e\xfc\xd3\xea\xa0\xa3\x46\x8d\x70\xa3\xbc\xfc\xef\xec\xd2\xe8\x88\x5d\x2c\x6b\x3c\x84\x8c\x4e\xa1\x56\xd4\x96\xf3\x2d\xab\x29\x4c\xee\xbc\x8f\x75\x7b\x31\x9b\x1a\x15\xb2\x34\xed\xf2\xca\xd9\x71\x7e\xdd\x37\xdb\xc1\x58\x92\x8a\xcd\x82\x25\x22\x04\xab\x45\x14\x33\x63\x1b\xfc\xf2\x42\x11\x87\xeb\x46\x15\x7f\x7c\x65\x5e\xa8\x17\x6b\x97\x22\xce\xf7\x4d\x2b\x56\x11\x77\xf4\x38\xab\x48\xf8\x2e\x6b\x54\xf6\xe2\xdd\xc9\x38\xa7\xb0\x19\xab\xbf\xec\xbe\xeb\xbd\xed\x26\xde\x9f\x80\xe1\x58\xa8\x84\x84\x6b\x48\x36\xd9\x56\x2c\xf4\x31\x20\x7b\x14\x28\xd3\x08\x18\xc3\x44\x43\x3d\xdf\xc1\xb5\x3a\xa2\x6d\x0e\xd0\x15\x83\xcf\xc6\x91\xe0\xe3\x6a\xae\x58\x75\xff\xe7\x43\xe0\x30\xd4\x5c\x54\xf0\x5b\x16\x4b\x56\x0c\xbc\xcd\x8c\x27\x41\xc6\x0d\x7c\x09\x26\xa7\xfa\x7b\x00\x4f\xb2\x31\xcf\x51\xbd\xd7\x45\x60\x39\x5b\x83\x96\x78\xa8\x5b\x6c\xfc\x96\x91\x16\xc9\xf5\x72\xdd\x5d\x4e\x65\x60\x27\x12\xfc\xaa\x95\xb9\x0c\x77\xe8\xff\x63\x2d\x3c\x0c\x51\xed\xa4\x1e\x1e\xea\x5e\xdb\x7c\xec\xe5\x7b\x4e\x34\xea\xe4\x8e\x58\x81\x09\x84\x71\x71\x31\x1f\x9f\x38\x18\xdf\x1a\x43\x95\x39\x14\x54\xd3\x2b\x51\xe5\xc1\xf9\xca\xd7\xb2\xbb\xe3\xef\xd7\x07\xc2\x3a\xb2\xa2\x57\xbe\xdb\x05\x37\x13\x74\x1f\x30\xac\xee\xef\x7a\x6f\x39\x12\x5d\xf6\x9e\x38\xd5\x49\x29\x31\xc3\xca\x44\xc5\xea\x80\xd0\x73\x4a\x4f\xbb\x0a\x75\x3b\x7b\x48\x38\x0f\x5c\x96\x0c\x21\x5b\x1c\x44\x41\xb4\x56\xfb\x75\xbf\xfc\xd3\xcf\xba\xc7\x66\xf3\xff\xfe\x7f\x9a\x76\xbb\xfa\xff\xe9\x46\xf1\xaf\xd9\x8d\xc2\x26\x31\x05\x99\xc9\x9d\x82\x7e\x5a\x0f\x8a\xa2\xdd\xa0\x88\x50\x57\x7d\xc7\x8f\xff\xfc\x27\x4a\xae\x9d\xe2\x5b\x85\x89\xb4\x92\xcf\x54\x74\x68\x78\xd1\x3e\x15\xb2\xbd\x74\x61\x4b\xbf\x59\x3f\x8a\x17\xd9\xf1\xcb\x34\x94\x70\x52\xa0\xd1\x0c\x35\xd5\x83\x92\x66\x12\xbf\x41\x9d\x7e\x28\x21\x89\x7d\x5f\x44\xaa\x80\xd4\xc5\xff\xcf\x6c\x30\x8c\x19\xc3\xde\x66\xc1\x62\xd1\x84\xdb\x44\xcf\xcc\x52\x04\x82\xbf\xbb\x60\x1d\x27\x30\x5b\x1c\xf9\x96\x53\x28\x23\x8c\xa0\x5b\x1a\x6f\x14\x31\x4b\x3e\xcd\x89\x5a\x95\x37\x3d\x21\xdb\x58\x00\x9e\x7f\x49\x8b\xdc\x90\x92\x5f\x11\xa6\x9e\xa3\xfb\x8c\x53\xc8\x34\x16\xd8\x53\xcb\x01\x38\x85\x01\x65\xfd\xf7\x10\xf1\xf4\x93\x06\xdc\xcf\x6d\xe7\xcd\x19\x7d\xa8\x82\xa5\xbb\x0e\xbd\x78\x1f\xda\x5c\xd8\x87\xd3\x31\x54\x4f\x90\x3a\xab\xd3\x9a\xcc\x80\xc2\x1a\x62\x8e\x43\xb4\x84\x73\x75\x5c\x84\xff\x37\x00\x00\xff\xff\x5d\x7b\xd3\xc4\x15\x43\x01\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\xbd\x6b\x77\xdb\xc8\xb5\x20\xfa\x9d\xbf\x62\x4f\xae\x3d\x24\x3a\x24\xdb\x72\xe7\x71\x46\x6a\x7a\x5d\x46\xa2\xdd\x3c\x47\x26\x7d\x24\xaa\x1f\xd3\xb7\x17\x07\x02\x8a\x22\x5a\x20\xc0\xa0\x40\xcb\xca\x64\xfe\xfb\x5d\x7b\x57\x15\xaa\x0a\x28\x80\x20\x25\x75\x72\xee\x8d\xd6\x4a\xda\x04\x0a\xf5\xdc\xb5\xdf\x8f\xc1\x60\x36\x5f\x4c\xae\x3b\x83\xc1\x62\x1d\x71\x08\xd2\x90\x81\xcf\xf9\x6e\xc3\x38\xe4\x6b\x3f\x87\xdc\xbf\x8d\x19\x24\x3e\x3e\x08\xfc\x04\xd2\x24\x7e\x84\x5b\x06\x7f\xfa\x06\x82\xb5\x9f\x71\x88\xd3\xe4\xae\xd3\xe9\x9c\x5f\x4d\xc6\x8b\x09\xcc\xaf\xe0\x6a\xf2\xe9\x72\x7c\x3e\x81\xf7\x37\xb3\xf3\xc5\x74\x3e\x83\xeb\xf3\xef\x26\x1f\xc7\xcb\xf3\xf1\x62\x7c\x39\xff\x30\xbc\x63\xf9\x32\x64\x2b\x7f\x17\xe7\xcb\x60\xbd\x4b\xee\x97\x51\x92\xb3\xec\xb3\x1f\xf7\xbc\x0e\x00\xc0\xd5\x64\x71\x73\x35\xbb\x86\xe9\x6c\x31\xb9\xfa\x7e\x7c\xd9\x19\x5f\xc3\xab\xd5\x2e\x09\x5e\xd1\xeb\xeb\xc9\xe5\xe4\x7c\x01\x9f\xfd\x78\xc7\x4e\x4f\x55\x23\x78\x7f\x35\xff\x58\x1e\x4a\x0e\x03\x3f\x7c\x37\xb9\x9a\xc0\x3d\x7b\x1c\x75\xed\x11\xbb\x67\x1d\xd9\xf3\xe5\x78\xf6\xe1\x66\xfc\x61\x02\xd7\xff\x79\x09\xd7\x8b\xf1\x5f\x2e\x27\xf0\x69\x7c\x35\xbe\xbc\x9c\x5c\xc2\xf5\xf8\xfd\xe4\xac\xf3\xe1\x6a\x3c\x5b\xc0\xe4\xc7\xc9\xf9\x0d\xae\x74\x76\xd4\x0a\x61\x31\x87\x6d\x96\x6e\x96\x19\xf3\x43\x96\x9d\x1d\xba\x73\x79\xb4\x61\x3c\xf0\x63\xb6\xdc\xf8\xbf\xa6\xd9\xf2\x33\xcb\x78\x94\x26\xd5\xad\x73\xef\x1a\xdf\xc6\x51\xbe\xdc\xfa\x59\xde\x63\x5f\x72\xf9\x71\x1f\xba\xc3\x6e\x1f\x4e\x3c\xda\x4e\xb1\x93\xdb\xbb\x65\xe0\xe7\x7e\x9c\xde\x0d\xb7\x77\x4b\xf6\x25\x67\x09\x36\x95\x5b\xc9\xbe\xe4\x08\x12\xa3\x6e\x31\x9d\xf0\xb6\x0b\x97\xd3\x8f\xd3\x05\x9c\xbc\xd8\x9e\xd6\xae\xfd\xa9\x9b\xaa\x0e\x2b\x63\x39\x4b\xf2\x28\x4d\x96\x5b\x96\x45\x69\xf8\x5b\x00\x64\x79\xcc\x97\x07\xc9\xea\x2a\x9f\xb2\x7f\x11\x5f\x1a\x40\xb0\x8c\x12\x9e\xfb\x71\xcc\xca\x7b\xf7\x97\xf9\xfc\x72\x32\x9e\xb9\xb7\x2e\x48\x77\x49\xde\xfb\xca\x83\x77\xf0\xa6\x00\xbf\x56\x30\xd7\xb4\x59\x07\x6c\x4f\xfd\x22\x9e\xb8\x35\x9b\x5d\x9c\x47\x49\x1a\xb2\xbd\xdb\x71\x31\x39\xbf\x1c\x5f\x4d\xa8\x55\xc4\x97\x61\xc4\xf3\x2c\xba\xdd\xe5\x2c\x54\x8d\x61\x04\x2b\x3f\xe6\xec\xac\xf3\x97\xc9\x87\xe9\x8c\x5a\x4e\xdf\x1f\x76\x51\xde\x8d\xe0\x2d\x2c\xbe\x9b\x88\xaf\x1b\x8f\xc0\xde\x90\x55\x9a\x6d\x7c\x04\x9a\x61\xe8\xe7\xfe\x12\x97\xc4\x8b\x3e\xf0\x6f\x3a\x5b\xcc\x4b\x13\x3f\xa3\x06\x93\xd9\x05\x4c\xdf\x9f\x19\xcb\xaf\x34\x9b\xfc\x78\x3e\xf9\x44\x3b\xf8\xc3\x77\x93\x19\x1e\xe1\xf5\x02\xf7\xb8\xfb\x87\xb7\x9f\xde\x9c\x74\x69\xc2\x30\x18\xc0\x42\x4d\x09\x4e\x86\x5f\xfa\x90\xb0\xcf\x2c\x03\xa3\x27\x73\x0c\xb9\x55\x93\xd9\x45\x05\x44\x3e\x5d\x7e\xfa\x70\x2c\x98\x18\x07\xfa\x5c\x58\x27\x48\x37\xdb\x8c\x71\x3c\xa1\x25\x67\x79\x1e\x25\x77\x87\x5c\x1e\x89\x77\x64\x9b\xb6\x68\x67\xc3\xf2\x2c\x0a\xcc\xb1\x7f\x03\x5a\xe8\x5a\x68\x75\x17\x07\x83\x71\x18\xc2\xc9\x6b\x48\x57\x90\xf9\x49\x98\x6e\x12\xc6\x39\xe4\x29\xe4\x6b\x06\x8a\x94\x02\x4f\x05\x87\x42\x14\x96\x83\x9f\x31\x48\xd2\x1c\xfc\x38\xba\x4b\x58\xe8\x7a\xcd\x73\xff\xee\x8e\x65\x2c\x84\x55\x9a\x81\x31\x1b\xf8\x35\xbd\xe5\xc3\x03\x8f\xaf\xe8\xad\x4c\xe3\xed\x9f\x05\xd5\xf0\x3a\xed\xe8\x48\xe9\xf3\xaf\xa0\x77\x32\x7c\xf3\xfb\x5e\x4f\x6c\x45\xcf\xfb\xea\xcd\xf0\xcd\x89\x37\x78\x33\x7c\xf3\xe6\x8f\x9e\xe7\x3e\xb4\xef\xe7\x97\xe3\xc5\x14\x61\xfb\x80\x45\xc5\x69\x70\xbf\x94\x70\xb1\x4a\xb3\xe5\xc6\xc7\x49\x24\x7e\x12\xb0\x9e\x7c\x1c\x85\xb8\xff\x7d\x78\xf0\xa3\x1c\x6e\xd3\x34\x66\x7e\x02\x23\xc8\xb3\x1d\x6b\x8b\xdf\x2c\xdc\x35\x9b\x2f\x44\x5f\x16\x4a\xfa\x34\xb9\x7a\x3f\xbf\xfa\x08\x9b\xe1\x57\xc5\x33\x17\x58\x8b\x49\xc1\xa6\x68\x24\xe0\x7b\x33\x8c\x42\x18\x41\x31\x65\xdd\xc7\xfc\x0a\x66\x73\xf8\x8f\xc9\x4f\x70\xf3\xe9\x02\x77\xe5\xfa\x3f\xa6\x9f\xe0\x72\x7e\xfe\x1f\x93\x8b\xb3\x4e\xd1\x4e\x2c\x02\xde\xcf\x6f\x66\x17\x12\x87\x5d\x5e\x4f\x7e\xfb\xe9\x35\x4f\x49\xa2\xd5\x26\x04\xa7\xc1\xa0\xf5\x7d\x6d\x02\x02\x3a\x7a\x79\xea\xfa\xde\x3e\x64\x51\x8e\xf7\x76\x30\x38\xf7\x93\x34\x89\x02\x3f\x06\xec\x05\xd2\x2c\x64\x59\x94\xdc\x9d\x76\x06\x03\xd1\x23\xef\x0c\x06\x48\x3e\x84\x54\xd1\x19\x0c\x62\xff\x96\xc5\xf8\x94\xb3\x2c\x62\x1c\xb6\x7e\xc6\x92\xdc\xfa\x9d\x47\x48\x75\x10\x2b\x04\x69\xc2\xf3\x0c\xe7\xc3\xb1\xcb\x01\x2c\xd6\x4c\x4c\x41\xee\xf4\xe7\x88\x3d\x40\xee\xdf\x33\x4e\x13\xe0\x10\x25\x84\x32\x68\x22\xa7\xa0\x47\xee\x43\xb9\xff\x61\xa7\xa3\x64\xa0\x6d\x96\x06\x2c\xdc\x65\x0c\x56\x51\xe2\xc7\xd1\xdf\x48\x14\x62\x10\x64\x8c\x08\x20\xa2\x25\x5f\x1e\xdf\x90\xe6\xb0\x8a\x32\x9e\x53\x5f\x90\xae\x8a\xc5\xea\x0f\xd6\xfe\x76\xcb\x12\x9a\xce\xc6\xbf\x67\x6a\x7b\x69\x2a\xe0\x27\x21\x75\x4f\x83\x89\x4e\x54\xfb\x35\xcb\xd8\xb0\x33\x18\xfc\xc0\x04\xdf\x0e\xe5\x8e\xa3\x04\x91\xe2\x43\x4a\x9f\x11\x86\xdc\x44\x49\xb4\x89\xfe\xc6\x20\xf6\x73\x96\x04\x8f\x10\xee\xf0\x08\x20\x4a\x38\xcb\x68\x23\x07\x83\xde\xc3\x3a\x0a\xd6\xe6\xac\x70\xfc\xea\xcc\xb6\x7e\xbe\xf6\x86\x30\xe1\x5b\x16\x44\x7e\x1c\x3f\x22\x7e\x65\x0f\x69\x96\xaf\x1f\x21\x12\xf2\x61\x67\x30\xf0\xf3\xdc\x0f\xd6\x38\x08\x76\x53\xec\xa8\xc2\xd7\x72\xa7\x45\x97\xe6\xca\xe0\x96\x05\xfe\x8e\x33\x88\x72\xc8\xd8\x5f\x77\x51\xc6\x10\x12\xfc\x04\xd8\x97\x20\xde\xf1\xe8\x33\xa3\x63\xec\x83\x98\x6f\xc4\xc1\x87\x75\x74\xb7\x1e\xa8\xb5\xa5\x5b\x96\x09\x9e\x84\x8e\x21\xcd\xd7\x2c\x03\x3f\xc0\x27\x38\xbb\x08\xbb\xc3\x9b\x81\x0f\x20\x4c\x99\x41\x24\x38\x04\x59\x94\x0b\x58\x15\xbd\x0d\x1e\x22\xce\xe0\x76\x97\x53\x23\x3f\xe6\x29\xb5\x4c\x58\xc0\x38\xf7\xb3\xc7\xce\x60\x90\xa7\xb0\x65\x19\x72\x42\xb8\x69\x04\x55\xb8\x4a\xb1\xb7\x02\xbc\xc4\x69\xee\xc4\x48\xdb\x5d\x5e\x9c\x61\x67\x30\x98\xa5\x39\x3b\x15\x44\xc9\x07\x04\x66\xf6\xd7\x1d\x4b\x02\x86\x00\x85\xb3\x85\x90\xf1\xe8\x2e\x51\x5b\x6b\xee\x9e\xde\x55\xdc\x05\xda\x70\x16\x8a\x19\xd9\xad\x58\x92\x83\xbf\xca\x59\x26\x8e\x35\xe2\xc0\x73\xb6\xc5\xfd\xc1\x39\x29\x00\xda\x44\x77\xeb\x9c\x96\x77\x8b\x1f\x33\x84\x24\xe0\xe9\x06\xaf\x64\x90\xa5\x9c\x2b\x10\xfe\xeb\x4e\xf4\x9c\xd1\x07\xfe\x83\xff\x88\x5d\xa5\x9c\x15\x6f\x70\xc8\x6e\x8e\xc4\x74\x83\x90\x9e\x3e\x10\x4f\xa6\x80\x3a\x64\xb1\x8f\x3b\x17\x21\x98\xe1\xe2\xa2\x55\x14\xf8\x49\x8e\xe3\x6d\x33\x3c\xaa\x40\xed\x0e\x1e\xf5\x40\xde\x54\x39\xba\xbc\xab\xc4\x70\x56\xee\x2d\x4b\x72\xf3\xa7\x44\x13\x55\x6a\xf7\xe9\x6a\x7e\x3e\xb9\xb8\xb9\x9a\x94\x31\x9d\xba\xdd\x0a\xe8\xd5\xad\xea\x79\x44\xb5\x10\x0d\xd8\x5c\x79\x06\x57\x93\xf3\xf9\x95\xc4\xbf\xd4\x9c\x85\x0a\x1f\x9a\x4c\x39\x22\xf2\x0c\xa6\x15\x1e\xbb\x0d\xb9\x28\x11\x0b\x24\x90\x6a\x62\xc4\x3f\xc5\x4c\xf1\xb9\xf8\x37\xbf\xba\x98\x5c\xc1\x5f\x7e\x02\xc5\x1c\xd0\x9b\xcb\xf9\xfc\x53\x85\xbf\xaf\xef\x84\x38\x77\xb9\x9c\x27\x10\xb4\x6c\x58\xa2\x65\x15\x22\x36\x7d\x5f\xec\x9a\x45\xef\xf1\x6f\x30\xc8\x58\xcc\x7c\xce\x20\x4b\x1f\xe8\xde\x5b\xaf\xcf\xe7\x1f\x3f\x4e\x17\x67\xa5\x67\xb3\xc5\x74\x76\x33\xd1\x4f\x15\x4d\x34\x47\x6c\x2f\xe9\x8d\x67\x17\x47\x70\xaf\xe5\x85\x28\xee\x40\xf6\xf4\xe9\x6a\xfe\x71\xc8\x99\xfd\x79\x9a\x58\x98\xb6\x97\x0d\xe9\xbf\x4b\x94\x6f\xfb\xb0\xb8\xba\x99\x78\x0d\x8b\x1a\x0c\xc2\x54\xdc\xed\x5b\xb6\x4a\x33\x86\x24\x0f\xd1\xaf\x8d\x36\x2d\x6a\xf0\x90\x66\xf7\x12\x2f\xc8\xc6\xd6\x0e\x2b\x6e\xc8\x79\xdc\xd7\x13\x17\xf4\xc0\x88\xe6\x29\x41\xa0\x00\x00\x6b\x9a\x0f\x0c\x1e\xa2\x38\x86\x84\xb1\x50\x4c\x98\x26\x86\xcc\x77\x1d\xd1\x40\xae\xdd\xbf\x27\x9a\x90\xa4\x0f\x46\x5f\x79\x0a\xfe\xe7\x34\x0a\x45\x17\xbb\xed\x5d\xe6\x87\x6c\x08\xd3\xdc\xc0\xe4\x95\x15\x87\x69\xc2\x90\x7a\xc4\x4c\x90\x03\xdd\x1d\xf5\x82\x88\xd6\xbf\x67\xc9\xb0\x78\x81\xac\x20\x08\x81\x67\x3e\xbb\xfc\xa9\xbc\x23\x12\xdd\x4c\x67\x30\x3e\x3f\x9f\x5c\x5f\xc3\xe4\xc7\xf3\xcb\x9b\xeb\xe9\xf7\x13\xd8\xa4\x21\x33\x16\xaf\x38\x2d\x21\x36\xf7\x5e\xbd\x32\x61\x64\x7c\xb9\x98\x5c\xc9\x61\xdc\x23\x8c\x17\x8b\xf1\xf9\x77\x28\x74\x2d\xa6\x26\x97\x76\x31\x5e\x8c\x97\xd7\x93\xab\xe9\xe4\x7a\xf8\xfa\xe4\xd5\x94\xee\xd9\xf7\xe3\xcb\x9b\x09\x4a\x15\xd0\x7b\xfd\xf6\xd5\xa5\x57\x0c\xf5\xea\x55\x1f\x6c\xd0\xc2\x23\x32\x40\xcb\xbc\x55\x08\x66\x88\x38\x88\xa3\x3c\xeb\x08\xfc\x07\x65\x96\xf2\xac\x83\xdf\x4c\x66\x0b\xe4\x21\x8f\x41\xad\xd3\x6b\xe8\xbe\x2f\xf8\xaa\x12\x43\x33\x84\x12\x07\xc6\xd7\xe9\x2e\x0e\x91\x4a\x65\xbb\x04\x6e\x1f\x05\x23\x96\x26\x09\x0b\x72\x84\xa2\x5d\x9e\xa2\x56\x22\x40\xee\xa4\xeb\xe0\x72\x8f\x98\x61\x85\xaf\x45\xc6\x16\xdf\x4a\x36\x90\xb6\x13\x78\x9e\x66\x0a\x7a\xd9\x17\xb6\xd9\xc6\x7e\xc6\x4d\xce\xb0\x0f\xbb\x24\x66\x9c\x23\x24\xfb\x31\xca\xb6\x8f\xc0\xbe\x44\x3c\xe7\x48\xef\x26\xc5\x27\x7e\xc6\x50\x20\x67\xa1\x5a\x9d\xe2\x97\xd6\x0c\xf5\xec\xa8\x5d\x87\x3c\xed\x43\x1c\xdd\x33\xf1\xde\xc7\x1b\xc8\xfb\x05\x5c\xb3\x28\xeb\x0c\x06\xe9\x43\x02\x82\x9b\x86\x1e\x1b\xde\x0d\x21\xcf\xfc\x80\x2d\xa3\xd0\x93\x43\x6c\x89\xeb\xf1\xe1\xdf\xaf\xe7\xb3\xbf\x40\x7a\xfb\x2b\x0b\x72\xbc\x31\xc8\x05\xac\xf0\x96\x62\xe7\xd4\x03\x28\xc5\xef\x01\xb2\xa3\xc0\xeb\x4b\xb5\x15\x12\xaf\x59\xec\xe4\x6c\xfc\x71\xd2\xd7\x62\x0f\x92\x1d\x5b\x66\xfc\x7e\x3e\xbd\x30\x04\x46\x4d\x4f\x4b\x97\xa9\x2b\xa7\x25\x2e\x90\x94\x22\x27\x3f\x4e\xaf\x17\xd7\xd6\x3d\x99\xfc\x38\xf9\xf8\xe9\x72\x7c\x35\x7c\x3d\xed\x21\xce\x87\xc5\xf4\xe3\xe4\x7a\x31\xfe\xf8\x69\xf1\x3f\xe9\x9b\xd9\xcd\xe5\x65\x5f\xe8\x4f\xe0\x62\x7e\x43\x6a\x8e\xab\xc9\xf9\xf4\x1a\x17\xa8\x1b\x88\x13\xc1\x29\xff\x65\xfa\x01\x15\xe4\xfa\x95\xdc\x71\xb1\xa7\xea\x31\x5c\x4c\xde\x8f\x6f\x2e\x17\xd0\xed\xfe\xef\xff\xd3\xed\x7a\xdd\xbe\x45\x20\xd4\x9f\xb9\x39\xde\x59\xd3\x42\x6f\x66\xd3\xff\xbc\x99\xc0\x74\x76\x31\xf9\xb1\xb4\xde\x62\xc3\x8b\x49\x12\x79\x5b\xbe\xe6\x30\x9f\xd5\xed\x06\xf4\x8a\xd6\x7d\x52\xf3\xed\x99\x22\x36\x2b\xcd\xb6\x73\x30\x75\xb5\x08\xe5\x60\xc0\xac\x1b\xb0\x62\x0f\xa4\x8f\xf1\x33\xa4\x14\xa9\x0d\xe8\xa4\xc9\x61\x8f\x1a\x8e\x53\x21\xb1\xf8\x01\xb2\xe6\x80\xca\x38\xb3\xe3\xcf\x82\x9d\x2d\x14\x75\xc0\x59\xbe\xdb\xf2\x61\x45\x7e\x97\x30\xbb\x7e\xdc\xb2\x4c\xc0\xab\xda\xf7\x9a\x7d\xeb\x96\xb6\xa1\x0f\xa4\x8b\x2e\x6d\x9e\xd0\xdf\xd0\x29\x28\x25\xce\xe8\xdd\x01\x16\xa2\x52\x6f\x62\x92\xaa\x71\x94\x84\xec\x0b\xe3\xa3\x77\xa4\xde\xb4\x9b\x46\xab\x65\x92\xe6\x4b\x81\x65\x46\xef\x48\x29\xf3\xa2\xba\x02\xf7\xa5\x17\xf7\x1c\x6f\xb7\x0b\x95\x12\x82\x2f\x84\x32\x34\x39\x12\x80\x20\xf6\xf3\x21\xcf\x22\x54\xa9\xc1\xc3\x9a\x25\xe0\x43\xc2\x1e\x14\x85\xc0\x86\x82\x75\x44\x9a\x4f\x0a\x82\x9c\xc3\x6e\x2b\x44\x57\xd1\xe6\xd7\x1d\xcf\x81\x25\xe9\xee\x6e\x5d\x16\xcb\x48\x50\x8e\xf2\x21\x7c\xb4\x09\x8e\x10\x4d\x34\x53\x13\x25\xd0\x40\x19\xfc\xdb\xf4\x33\x1b\xc2\x35\x63\x92\x0e\x6d\x36\x2c\xc9\x51\xca\x24\x88\xf4\x73\xbd\x30\xe4\x71\xb0\x4d\xc6\x7c\x9e\x26\x48\x29\xc4\x93\x88\x4b\x51\x5e\xc8\x7a\x96\x64\xa8\x04\x51\x8e\x66\x8f\x1c\xf9\x38\xd5\xdd\x10\xae\x05\x21\x24\xeb\x6b\x90\x26\xb9\x1f\x25\xd6\x7a\xe3\xf4\x2e\x0a\x84\x40\xc8\x77\xdb\x6d\x9a\xe5\x72\xfd\xbc\x98\x8a\xd4\x58\x94\x44\x2d\x53\x29\x22\xb0\x99\x4b\x39\xd2\x9e\x10\x54\xd4\x08\x25\x55\xb6\x3c\x62\x7a\xe6\x32\x7e\xd0\x1c\x24\x79\x30\x64\xaa\x46\x12\x60\x5c\xd7\x17\xc3\xf5\x1e\xfc\x30\x5d\x7c\x07\x3d\x64\x39\x3e\xfb\xc1\x6e\xb7\x59\xca\xff\xe4\xeb\x8c\xf1\x75\x1a\x23\x0b\xfc\xc7\x37\x6f\xde\xbc\xe9\x83\xd1\xc8\x4f\xfc\xf8\xf1\x6f\xac\xda\xaa\x0e\xe7\xce\x26\x3f\x18\x2c\x9b\x77\xd6\xb0\x7a\x8b\x2e\x90\x94\xbc\x8f\x0a\x38\x91\x3f\x4c\x67\xe7\x97\x37\x17\x13\xe8\xd1\xf6\x34\x4d\x0c\xbf\xa9\x4c\xf0\x89\xb4\xc0\xf9\xa5\x65\x66\xa9\xca\x8a\xe2\xc2\x3c\x08\x6b\x00\xd9\x32\x49\x3f\x15\x0a\x76\x46\x8b\x13\xb7\x8f\xc6\x89\x92\x2a\x86\x34\x45\xe4\xe1\xb0\x95\x18\xa8\xd4\x35\xdd\xe3\x07\xd6\x8d\x63\x58\xfb\x9f\x19\x6c\xd2\x8c\xc1\xef\xd6\xcc\xff\xfc\x28\xaf\x10\xff\x1d\x5e\xf6\x84\x08\x0f\xd7\x1a\x9f\x62\x54\xbc\xed\x5f\x47\x49\x18\x7d\x8e\xc2\x9d\x1f\x7f\x5d\x1a\x40\x76\x02\x0f\x29\x2a\x4e\xee\xf0\x26\xef\x38\x6c\x76\xc1\x9a\xae\xaa\xba\xb6\xd8\xef\x83\xe2\x7e\x43\xfc\x06\x91\x8d\x1f\x53\xa3\x8d\x9f\x3c\x2a\x15\xcc\xd0\x29\x7e\x2a\xba\xa1\x8d\x63\x26\xa1\xab\x1c\xb0\x83\xf2\x09\x82\x57\x3a\xed\x2a\x68\xb8\x48\xe0\x21\x64\xb0\xd6\x8c\x72\x00\xc9\x74\x4c\xab\x91\x6e\x5a\xad\x4d\x51\xdb\x54\xf3\x1f\xcc\x2a\xb8\x37\xec\x49\x3c\xc2\x0b\x6d\x4e\xfb\x9d\x72\xe8\x21\x2a\x3a\x09\xa9\x61\x72\x4c\x29\xcd\x96\xb2\x77\x85\xd6\x7b\xdd\x25\xed\xcb\x72\x29\xb7\x4a\x92\x0a\xda\xab\x4e\xa1\x8d\xba\x5e\x5c\x4d\xcf\x17\x05\x31\x10\x83\x0e\x06\xa8\x7f\x16\x84\x56\xe9\x8e\xa9\x05\xff\xf9\xe4\x17\x88\x38\xec\x92\xe8\xaf\x3b\xe4\x0b\x51\x85\xa9\xef\xa3\xb8\x4b\x02\x59\xf6\xc4\x07\x1e\xa9\x23\x43\x43\xf3\x50\xf0\xf2\x7e\xc6\xe0\x6e\xe7\x67\x7e\x92\x33\x16\xc2\x5d\x9c\xde\x12\x6e\x11\x9d\x77\x9a\x85\xfb\x3a\xb2\x64\xc9\xec\xf6\xed\x8b\x42\xb8\x8d\xee\xa2\x24\xd7\x54\xa8\xe3\xe4\xc1\xa1\xbe\x8d\x9c\xba\xa9\x72\x12\x5b\xe7\x67\x99\xff\x58\xf3\x51\xc8\x90\xe7\x59\xb2\x6d\x1a\xac\x0b\x6a\x67\x8a\x2f\xd5\x4f\xce\xbf\x9b\x9c\xff\x47\x4f\xef\xf9\x08\x50\xe1\x40\x8a\x33\xfd\x70\x7a\xad\x89\xa6\xeb\x73\xbd\xa0\x11\xbc\xfe\xe6\x55\xa5\xd1\x7c\x76\xbd\xb8\x1a\xe3\x6c\x24\xea\x16\x5d\x23\x51\x7b\xfd\xcd\x2b\x5e\x3e\xc8\x82\x78\x45\xe1\xde\x9e\xb6\xf7\xec\x51\x74\xf2\xe9\x6a\xfa\x71\x7c\xf5\x13\x1a\xdb\xf0\xc3\xe2\xbb\x76\x64\xfe\xa4\x05\x91\x3f\x79\xf3\xc6\xeb\x28\x2d\x8c\x8d\x14\xfa\x05\x60\xf7\x25\x55\x55\x54\xb4\xa4\x3c\xdc\xc3\x68\x97\x3a\xb5\xba\x92\x06\xc3\xd9\xe4\x87\x67\x67\xfb\x1d\x2c\x5e\x95\xd3\xbf\xb8\x9a\x7f\x82\xc5\xd5\xf4\xc3\x87\xc9\x15\x92\x78\x29\xaf\x56\xbe\x5d\x2a\x9e\xdf\x31\x0e\x35\x83\xf3\xf1\xf5\xf9\xf8\x62\x72\xa6\x98\x50\xd5\x69\x6d\x57\x82\xb7\x7c\x8f\x3a\xb6\xe9\xec\x7a\x72\xb5\xa8\xed\xbb\xd0\xd6\x4f\x50\xdb\x76\x35\xff\xc1\xba\xde\xb5\xca\x23\xc7\x06\x9c\x91\xfd\xd0\xfd\xd7\x19\x0c\x60\x8a\xe8\x38\xf1\xe3\x82\xa5\xe7\x40\x2f\x6a\xbe\xc0\x4f\xae\x58\xbe\xcb\x12\xf0\x0d\x17\x4c\xb8\xdd\x45\x71\x0e\xab\x2c\xdd\x80\x0f\xab\x5d\x1c\xd3\xd1\x13\x7e\xf3\x81\xef\x56\xab\xe8\x0b\x32\xf8\xc2\x2a\xb9\x8b\x63\xf1\x55\xc4\xd1\x42\x9f\x04\xa4\x79\x57\x7e\x11\x24\x62\xd3\x17\xe8\xfb\x13\x87\xb0\x8a\xc8\x2c\x83\x9f\x51\x1f\xf4\x29\x27\x5d\x6a\x14\xc7\xe0\xc7\x0f\xfe\x23\xaa\x9c\x81\x7d\xf1\x83\x3c\x7e\x84\x3f\xbd\x15\x2e\xa0\x87\x88\x07\xdb\x3b\x81\xfe\x1f\xa2\x7c\xbd\x14\xc3\x6b\x74\xa8\x17\x94\xb3\x2f\x68\xdd\x11\xd3\xc3\x1f\xb6\x10\x81\x6d\xdc\xce\x13\x3d\xbe\xbb\x45\x8e\x27\xb9\xeb\xe9\xde\x90\x63\xfa\xd3\xdb\x41\x0f\x67\xbb\x8c\x59\x72\x97\xaf\x7b\xa2\x6f\xef\xf7\x27\x9e\x07\x7f\xff\x3b\x74\x97\x5d\xfc\x8f\x7c\x7a\x7a\x4a\x23\xb8\x3c\x2b\xa6\x1f\x3f\xde\x3c\xcd\x23\xc6\xb5\x05\x62\xbd\xb4\x50\x97\x3f\x8c\x86\x05\x14\x89\x25\x99\x13\x4b\x13\xa0\x50\x40\x41\x14\xca\xf3\xa7\x33\x27\x45\x62\x0a\x48\x28\x73\x09\x11\x62\x47\xd4\x39\xc3\x5f\x76\x39\x44\x68\x7e\x44\xd3\x9f\x01\x32\x68\x2d\x45\xf6\x74\x15\xe5\x7d\xb8\x63\x09\x1a\x5a\x19\xaf\x4e\x80\x46\x9b\x15\x64\x39\x27\xc3\x6e\xe0\x27\xd2\xb6\x88\x76\xce\x38\x8e\xc8\xc7\xe6\x96\xe5\x0f\x8c\x91\x60\xbf\xe3\x0c\x75\x96\x10\xb2\x55\x94\xb0\x10\x0c\x20\xa6\x7f\xe2\xd6\x14\x00\x5d\xd0\x7a\xd7\x57\xa4\x73\x15\x47\x8a\xf0\x28\x81\xf4\x8e\xe5\xfa\x73\x3f\x41\x4b\x29\x4a\xcd\xe8\x06\xc7\xe2\xc7\x3e\xf8\x72\x99\xbc\x34\x12\xd2\xfe\xa2\xb3\x21\xed\xfc\x0f\x34\x2e\xf8\xb0\xf1\xbf\x88\xc9\xc9\x06\xe9\x0a\x07\xc4\x75\xfe\xe9\x9b\x62\x8a\xe2\xaa\x16\xf6\xf9\x40\xea\x8d\x7d\x54\x34\x80\x20\xc6\xf9\xe3\x56\x6c\x5d\x08\xff\x4b\x60\x0f\xfc\xf1\xbf\x86\x38\x92\x30\x94\xa4\xc0\x12\xbe\xcb\x8a\x2d\x8d\xb8\xba\xc6\xd8\x8b\x62\x72\x38\x3c\xb0\x38\x26\xed\x18\xc9\x29\x79\x0a\x19\xe3\x2c\xfb\x8c\x93\xe5\x5b\x3f\x60\x85\xe4\xbf\x4b\x42\x96\xf1\x20\xcd\xd8\x31\x57\x55\x0c\xe8\xb8\xa5\x4b\x3f\xbb\x3b\xfe\xa6\x9e\x8f\x0d\x5e\x9b\xdc\xfe\xcc\xeb\x69\x0d\xe2\xc1\xb7\xb8\xd7\x15\x39\xd0\x6a\x24\xef\x6c\x2d\x2b\x7f\x08\x22\x72\x0e\xa0\x56\x69\x0b\x0f\x26\x7b\xfc\xc2\x08\x43\x1e\xc4\x1e\x5c\xa1\x6c\x15\xe2\xaa\x0a\x80\x24\x8b\x1b\xdc\x45\xa8\x1a\x95\x54\x55\x5d\x5e\xc2\x14\x3b\xce\x48\x99\xc6\x53\x44\xf9\xd2\x2d\x81\x23\x68\x71\x43\xef\x74\xcb\xa4\xb2\xae\x33\x18\x4c\x57\x86\x31\x04\x91\x05\xdd\x84\x47\x96\x0b\x23\x87\xe8\x99\x19\x8a\x3e\x29\xd5\x0a\x8f\x15\xad\xb3\x93\xa6\x06\xa9\x81\x42\xf8\x96\xde\x1e\x74\x9f\x78\x8d\x67\x8a\xe2\x19\xf2\x14\x7d\x6f\xac\xef\xfc\x20\xdf\x11\xbf\x1e\x94\x6d\x36\xd8\x88\xdc\x82\x4e\x0b\x13\x4d\xa5\xe7\x9f\xdb\xa8\xc3\x7e\x39\xdc\x2e\x62\x31\x0b\x9d\x12\x6b\x5f\xba\x4b\xf3\x9b\x05\x28\x3f\x3b\xfc\xb7\x66\xf1\x40\x48\x49\x2e\xb5\x59\xc2\x1e\xa4\x88\xa0\x94\x66\xf2\xc9\x08\x12\x74\xf4\xf7\xe3\xde\xf6\x6e\x49\x22\x25\xcb\x22\x3f\x5e\xaa\x53\xee\x75\x4b\x33\x16\x93\xea\xf6\xbb\x51\xd8\xf5\xbc\xd3\x53\xea\xb2\xf0\x28\x90\x0c\x95\x10\xd2\x5c\x1f\x22\x1f\xde\x37\x57\xd6\x37\x16\xe0\x95\xbd\x12\xe4\xbc\xab\x12\x6a\x69\x6b\xaa\x0d\x9a\xef\x48\xf9\x73\x39\xce\xe9\xa9\xc6\x50\xf3\x19\x0a\x08\xef\x2f\x51\xce\xbc\x98\xa3\xc8\xf2\xdd\x74\xf6\xc1\x40\x5e\xd3\xd9\x07\xf7\x12\x49\x0b\xe6\x7e\xa3\x97\xaa\x65\x59\x6c\xad\x9f\x2b\x51\x56\x20\x65\xf2\x67\x42\xd2\x14\xec\xb2\x8c\x7c\x9a\x84\x8b\x2b\x5e\x16\xd8\xf8\xe4\x71\x05\x99\x24\xfe\xc9\x63\x8e\x16\x73\x61\x10\xc9\x1e\xc1\x07\xce\x62\x16\xe4\x44\x39\xe3\x34\xdd\xaa\xae\xd7\x79\xbe\xe5\xa7\x5f\x7f\xcd\x73\x3f\xb8\x4f\x3f\xb3\x6c\x15\xa7\x0f\xc3\x20\xdd\x7c\xed\x7f\x7d\xf2\xc7\xff\xf1\xc7\x37\xdf\xbc\xfd\x83\xe4\x74\xa7\x0b\x81\x7b\xa5\x63\xa1\x89\xa0\x37\xb4\xce\x4d\x8b\x35\x75\x5a\x39\x8c\x48\x67\x11\x7d\x32\x30\x32\x7f\xe1\x39\x9d\x75\xdc\xd3\xb2\x6c\xd3\x7b\x45\x19\x38\xdc\x84\x61\xdd\x4f\x1b\xb5\x3a\xcc\xc0\x02\xb5\x0a\x19\xee\x9e\x3d\x92\xc7\x8a\x89\x62\xef\xd9\xe3\x4b\xa2\xd6\x83\xb1\x4f\x31\x53\x8d\x7a\xf0\x3e\xe0\xd4\x17\x93\x1f\x17\x05\xca\x99\xce\xe4\xbf\x49\x0f\xbc\x0c\xd2\x78\xb7\x49\xc4\x51\x09\xa3\x8e\x68\x57\x79\xd1\x79\x69\x9c\x54\x2c\xe0\x08\xb4\x54\x7c\x2b\x30\xd3\x3d\x7b\xec\x57\xd7\xd7\x2f\x2d\xab\x3d\xa2\x92\x1b\x79\x28\x82\x52\x9f\xd9\x88\xe9\xc8\x5e\x84\x00\x13\x85\xdd\x7e\xa1\xc7\x7d\xcd\xc5\x6f\xd1\xbd\x77\x3c\xca\x2b\xb6\xcf\x85\xf5\xf4\x4b\xc7\x8e\x36\x74\x64\x36\xb4\x91\xca\xde\x93\xf9\xaf\x83\x3f\xe3\x7b\xda\xb2\xf8\xde\xb5\x39\xf4\xf2\x09\xdb\x50\x8b\x72\x35\xb8\xc7\xf7\x06\xda\xc5\x07\x23\x05\xac\xcf\x83\x66\x0f\xc7\xb2\x1a\x0f\x21\xda\x71\xa2\xd8\x0f\x24\xb9\x51\x43\x50\xa8\x35\x5a\x41\x9a\x68\x91\xf4\x28\x4c\xe8\xd2\x46\x5b\x08\xf1\xd9\x90\xa1\x67\x8b\x3b\x12\x18\x5a\x1f\x6a\x9b\x33\x15\x47\x1a\xdf\x0f\xc5\xa9\xd6\xac\x0d\xdf\x62\xeb\x9b\x19\xee\xc7\xf8\xf2\xb2\x53\xf2\x44\x75\x0d\x55\xd9\xa0\x86\xce\x09\xa9\xc8\x98\xcf\x3d\x51\x28\x07\x85\x0b\xb9\xce\x49\x00\x4c\x9e\x56\x00\x06\x04\xc4\x14\x04\x59\x4a\xd9\xdb\x94\x47\x85\x21\xde\x00\xa8\x21\xbc\xc7\x07\x89\xb2\xe5\x91\xe8\x80\x7e\x8a\x7e\x22\x54\x62\xea\x43\x52\x9c\xdc\x92\x9c\x4d\x8e\x29\x01\xb9\x4f\x6d\x53\xce\xa3\xdb\x98\x69\x25\x0b\xd1\x77\x22\xee\xdb\x8c\xe5\xf9\x23\x08\x4b\xa1\x88\x3f\xe0\x42\xf7\xc2\xb7\x3e\x6a\xa4\x62\xe2\x0a\x94\x0c\x52\xac\x6d\xa9\x86\xec\x37\x46\x28\x40\x2f\x4a\x44\x84\x83\x52\x2f\x78\xfd\x03\x2f\x00\x5e\xff\x6d\xca\x29\xae\xc3\x02\x7e\x93\x29\x13\x42\x08\xce\xab\xf8\x69\x8b\xf4\x51\x92\xd7\x84\x2d\x16\x9b\x4e\xc4\x59\x50\xc7\x2f\xf9\xb2\xfa\xb8\xe2\x17\x66\x7a\x4f\x0f\x06\xb8\x67\x61\xba\xc3\x97\xc1\x9a\x05\xf7\xb4\x65\x68\x55\x45\xed\x92\x6c\xb3\x8a\x78\x0e\xe9\x36\x8f\x36\x11\xcf\xa3\x40\x34\x3c\x35\xf0\x6f\xb1\xb8\x6d\xca\x0b\x6c\xd9\xa9\xa1\xab\xd5\xc3\x80\xf8\x7e\xab\xf1\x67\xf1\x5d\x7c\xbf\x1d\xda\x2c\xac\x63\x63\xcd\x16\xc5\x97\x64\x24\xb9\xdf\x1a\x77\xb6\xfc\x95\xda\x73\x4d\x0a\xd4\x64\xb4\x8d\x9d\x30\xb5\xad\x09\x11\xe7\x62\xb4\xad\x33\xd0\xb5\x60\xd8\xed\xeb\x67\x29\xd7\xf1\xbb\xde\x9e\xc5\x1a\x16\x3c\xf3\x5b\x45\xb3\xf1\x18\xf1\x16\xe1\x9d\x34\x7d\x60\x95\xf6\xec\x81\x91\x06\x2e\x4a\x80\xad\x56\x48\x98\x83\xb5\x9f\xdc\x29\x37\x4b\x1e\xac\xd9\xc6\x37\x61\x80\x82\x34\x36\x14\xef\x23\xf5\x65\xac\x04\x71\xe8\x40\xf9\x40\xce\x43\x69\x96\x61\x8f\x51\x02\x39\xcb\x36\xa4\x36\x34\xd8\x06\xa7\xf7\x9d\xe1\x0c\x5c\x72\xa1\x98\xce\xe0\xfa\xbb\xf1\xd5\x44\x39\x4e\x6b\x37\xe0\x8f\xf3\x8b\x49\xb7\xef\xf2\xed\x43\x97\xa5\x20\x4d\x42\x09\xd2\xc2\x19\xbb\xf0\xc2\xfe\xaf\x00\xb3\x8d\x40\xfb\xac\x00\x3b\x7d\xaf\x11\xd0\x08\xb4\xc9\xd8\xea\xc7\x3e\xe9\xd3\x11\x9c\x9c\x21\xf3\x76\x32\x10\x16\xec\x50\x50\x02\xde\x07\xf5\x39\x81\x1e\x85\x6a\xb1\x98\x6d\x58\x92\x57\x43\xfb\x4a\xc7\x80\x7f\x1b\xff\x4b\x6f\x9b\x72\x0f\x7e\x0f\x27\x56\x74\x44\x93\x76\xb1\xe1\x6c\xaa\xe7\x73\xd4\x19\x89\xfd\xb6\xf6\xc0\x8e\x7b\xb0\x5e\x91\x51\x16\x6d\xbb\x15\x1d\x6a\x65\x17\xdf\xd2\x2e\xca\x1d\x82\x13\xa5\x54\x16\x31\xb3\x6a\x2b\xf7\x3b\x05\xd4\x58\x32\xeb\xe8\xbb\x3a\xee\xc2\x9d\xa8\x85\x40\x57\x4c\xbb\x98\x8d\xf4\x84\xef\x59\xea\x27\xd5\x75\xdf\x5e\x6b\x45\x24\x2a\x7a\xa9\x13\x8d\xcc\xdb\x59\x07\xee\x68\xf9\x76\x81\xfc\x78\x7a\x3d\x81\xee\x39\x49\xfc\xc2\xe3\x5a\x58\x3b\xd8\x43\xd1\x49\xb7\xfd\x2e\xca\xed\x93\x56\x6d\x64\x0a\xcc\x25\x7b\x67\x2d\xbe\x95\xed\x1d\xdf\x76\x9c\x77\xf4\x99\x25\x02\x17\x3b\xe2\x52\x6c\x1b\x9c\x9e\x53\x5f\x22\xf1\xa8\x2f\xb1\xaa\xb4\x98\xd0\xff\x29\x6f\x76\x25\x37\x90\xcc\x70\x04\xc7\x54\xb8\xae\x58\x3c\x91\x62\xe7\x8d\x07\x5a\x70\xf0\x2a\x6e\xec\x2e\x4d\x45\x23\x62\xef\x69\x45\x85\xd7\xd1\xb0\x5d\x7c\x53\xcc\xa6\xaf\xe7\xf1\x44\x29\x5f\xc5\x6f\x49\x29\xb4\x4e\x4a\x74\xd1\xab\xf2\xb7\xcd\xe2\x29\xc4\x0e\x2a\x25\x68\x4c\xb1\xc7\xe3\xd9\x45\xf1\x8a\x56\x08\x23\x63\xc7\x7f\x73\x09\xb6\x02\x0c\x26\xb0\x3a\xc4\x92\x87\x0c\x23\x5d\x33\xf0\xb3\x74\x97\x84\xf0\x2b\x4f\x93\xdb\x25\xf3\x83\xf5\x12\x3f\xc1\x2f\x50\x55\x08\x3e\x5a\x45\x11\x80\xb3\xf4\x61\xc9\x78\x1e\x6d\xfc\x1c\x0d\x15\x88\x6b\x55\x34\xc7\xc9\x1b\xc2\x18\xe4\x4f\x72\x40\x30\x3f\x4d\xb4\x34\x6e\xef\x57\x2e\xa6\x22\x80\x15\xb7\x5c\x83\xae\xd8\x65\xc9\xef\x2b\x66\xff\x7a\xb2\x98\xbf\x87\x8c\x05\x69\x16\x76\xc0\x94\xee\x3a\x75\x96\x2d\xe5\xbc\x75\x35\xff\xe1\x1a\x4e\xde\x14\x57\x01\xf1\xc8\xab\xc2\x4e\x5f\x9d\x99\xe7\x0d\xbf\x32\x5a\x1e\x70\x38\x75\x6b\x4d\x93\x5b\x7d\x38\x86\x89\xac\x74\x38\xbb\x24\x61\x5c\x9f\x89\x3e\x11\x50\x27\xf2\xb4\x43\x10\xfd\xf7\x4c\x8f\x2c\x3f\x79\xa4\x7f\x54\x76\xda\x4f\x1e\x0b\xe6\xe4\xf9\x76\xbb\x3a\x03\xef\x29\x3b\x2d\xbb\x2b\x16\xe1\xda\x63\xe0\xfe\x8a\x2d\xfd\xed\x36\x4b\xbf\xd0\x1e\x2e\x11\xc4\x29\xcb\x8c\x54\xc8\x09\xd3\x9c\xd1\x82\xb6\x5c\xb4\xa0\x40\x2a\xed\x6d\x49\x2e\x0a\xda\x97\x18\x44\x38\x71\xae\x34\xe6\xc0\x62\xce\x5a\xf4\x2a\x23\xdd\x13\xe4\xef\x63\x21\x0e\x15\x11\x67\x18\x36\x92\x73\x60\x59\x96\x66\xd8\xbb\xd5\x85\xf8\x3c\xf0\xe3\x60\x17\xab\xb8\x01\xc7\x9c\x10\x42\x8a\x79\x19\x61\xeb\x38\x68\xe0\x73\x92\x6c\xb6\xb1\x8f\xff\x9f\xf2\xfc\x2e\x63\x5c\x39\xeb\x1f\xa2\xca\xaa\xdf\xd8\x9e\x96\xd4\x96\x51\x82\xd1\xe7\x57\x93\x0f\xe7\x97\xe3\xeb\x6b\x4f\x27\xe6\x20\x47\x3f\x11\x26\x5c\xc2\x8b\x9d\xf1\x75\xe7\xd5\xab\x67\x4d\x2e\x24\x46\x85\x9e\x52\x3b\x09\x9a\xd0\x6e\xf2\x9e\xe7\xc8\xbd\x71\x88\x9b\xb9\xc5\xe7\xa2\x28\xd3\x73\xe4\x3a\xaa\x68\xdc\x69\x86\x56\x97\x2a\x1c\x4e\xc3\x63\xe5\x23\xa1\x91\x2b\x94\xef\x53\xe1\x0a\x2c\x24\xd6\xaa\x15\xf4\xf4\x34\x63\x77\x41\xec\x73\x3e\xaa\x2c\xba\xe8\xba\xc2\xa9\x3b\xf6\xd3\xa4\x1a\x62\xe2\x7a\x8e\xcb\xc3\x76\xb9\xcc\xcc\xbb\x46\x63\x71\xbe\xc3\x90\xac\xd3\x53\x01\x45\x3a\x53\x1c\xae\x45\x6e\x42\x1a\x85\xd5\x55\x55\xc2\x90\xce\x3a\xaf\x5e\x11\x9e\xa8\xa4\x88\x30\x62\x28\x41\x7a\xbe\x28\x2e\x2f\xca\xb9\xe1\x0c\xa7\x2e\x58\x18\xad\x56\x2c\xe3\xc2\x15\xca\x70\x2d\x50\xae\x5f\x3a\x21\x47\x42\x2e\x3f\x18\x52\xc9\x32\xe1\xc2\x8f\xcd\xa3\x90\x25\x79\xb4\x8a\x58\x06\x71\xb4\x41\x7f\x27\x9e\x42\x94\x03\x85\x2b\xa7\xf7\x2c\x44\xf3\x9d\xab\xf3\xa1\x70\x72\x2c\xee\xa9\xf6\x72\xac\xa4\x5f\x22\x4f\x5b\xc9\xae\x4b\x70\xc2\x33\xe9\x89\x75\x08\xff\xdf\x9f\x7f\xf1\xce\x0e\x40\x03\xf5\x9d\x76\x9c\x2a\x3d\x7a\x5a\x04\x43\xf0\x62\xd0\x8e\x67\x05\x60\x82\x40\x02\xa6\x5a\xcf\xd2\xd6\x09\xee\x4b\xe3\x88\x8a\x6e\xc9\x72\xe0\x36\x3f\x3d\xc8\x4a\x5c\x12\x86\x6b\x17\x6b\xb6\x2b\x98\x42\x41\xc6\x50\xf8\x19\x2f\xb4\x92\xb3\x6b\x47\xb9\x57\xdc\xb2\xaf\x27\x0b\xdb\x27\x7a\x04\x42\x3d\x94\x8b\xdf\xbf\x3f\x71\xce\x3e\x0a\xb9\x6c\x2f\xa6\x6e\x75\xa1\xc4\x6e\xc4\x3e\x64\xf8\x1c\xcf\x7e\xea\xbd\x3a\x31\x43\x6c\x2a\x5b\xe4\xc1\xcd\x35\xb2\xe8\xfa\xac\xcc\xdc\x69\xc5\xed\xe9\x54\x43\xb3\x6b\xfd\x49\x1b\xfe\x5c\xdf\xc0\xa7\xdd\x6d\x1c\x05\x30\xfe\x34\xe5\x20\x1e\xed\xfd\x66\xdf\xdf\xa1\xc9\xd1\x2a\xba\xc7\x65\xb4\x92\xa1\x8d\xf5\x7a\x6b\x5b\x51\x2d\xb8\xa5\x9e\xf2\xa5\x69\xf0\xa3\xb1\xed\x34\xba\xa1\xf6\x29\xdb\x07\xb7\x6e\xb0\xdd\xb3\x10\xb3\xf5\x4b\xe5\x5e\x6b\xda\x47\x5b\x7a\x31\x98\xb7\x2b\x83\x95\x42\x5c\x91\xae\x0c\xdc\x27\x9d\x26\x65\x78\xae\x74\xeb\x4c\x80\xe7\x7e\x26\x98\x03\xb2\xa7\xb2\x44\xc4\xa0\xa1\xc9\x63\x8e\x22\x3b\x31\x41\x22\x17\x1b\x9a\x54\x63\x7f\xbb\x55\x9a\xdd\xcc\x4f\xee\x84\xea\x17\xe7\xd0\xa7\xb0\xce\x54\x26\x45\xca\x78\x2e\x87\x82\x15\x72\xeb\xc3\xe3\xe0\x08\x6f\x69\x94\xd0\x8c\x96\x34\x9c\x06\x22\x63\xe2\x46\xd0\x60\xbf\x58\x81\xf9\xd4\x06\x30\xc1\xb2\xdb\xb9\x56\xeb\x13\xc6\xd0\xf4\x97\x72\x2d\x32\x3f\x5a\x9b\xac\x31\x35\x7e\x38\xad\xb0\xa9\x95\x09\xa6\xac\xdf\x96\xfd\x4b\x9a\xa5\xa8\xbc\x8d\xe5\x94\xc6\x5b\xc0\x37\x6d\xc7\xbb\x11\xbc\x3a\x21\x8c\x46\x3f\xbf\x1d\xc1\xab\xb7\x5e\xd7\xce\x34\xe1\xd9\xc9\x65\xcc\xa5\x17\x6f\x24\x96\x2b\x76\x5f\xef\xf8\x99\xc9\xee\x99\xdf\x56\x99\xa2\x22\xcc\xe1\xc7\x85\xcc\x3f\x52\xa7\x99\xdc\xaf\x16\x38\x38\x1d\x64\x23\x7c\x59\xb0\x64\x82\xd0\x01\x17\x0e\xa5\x83\x38\x62\xa1\xe2\x90\x5a\x5c\xbc\xce\x60\xa0\xb6\xb1\x0f\xd9\x2e\xa1\x68\xc4\x34\x11\x09\x9b\x1e\x01\xc5\x5e\x09\x21\x6a\x0c\x19\x44\x78\xd8\xb5\xd2\xc4\xa9\xf6\x5a\x15\x2d\xa4\xcc\xf1\xf3\x2f\xfd\x27\xdc\x35\xd5\x47\xab\xab\x26\x0f\xc5\x31\x05\xf1\x3e\x63\x7c\x17\xe7\xc5\x43\x54\x7b\x93\x24\xfb\xf3\x2f\xa7\xa7\xba\x65\x9b\xab\x69\xc6\xdf\x50\x17\x4b\xff\xee\xae\xc7\x31\x0e\x07\xc6\xd7\x78\x96\x8d\x97\x55\x9e\x2b\x37\xae\xcb\x6c\x72\x05\xff\x3e\x9f\xd6\x45\xc3\x6c\x10\x20\x7b\x32\xe5\x52\x41\x41\xcc\x10\x26\x71\x55\xf9\xb0\xe0\x37\xf4\x36\xe8\x46\x1f\xae\xe6\x37\x9f\x30\x71\x54\x05\xaf\x34\xa1\x0c\x3b\x8d\x8d\xda\x85\xd2\xba\x3b\x15\x69\x4a\xea\x0b\x5e\x9d\xd0\x9e\xf0\x5e\xb9\x91\x98\xb1\xc2\x43\x55\x1f\xa8\x26\xc4\xb4\xa9\x91\xc5\x36\xc3\x62\xd9\xb4\x51\x46\x6e\x2a\x53\x24\xdc\x0c\x0b\x9c\xf6\xb6\xa9\x01\x62\xb9\x6f\x6a\x3c\xbf\xcb\x69\x76\x4a\xc8\xaf\x02\x8c\x25\x0c\x88\x68\x8b\xf7\xf7\x20\x42\x09\xb1\xa7\x23\xf5\xaf\xbf\xff\x1d\xce\xe7\xe3\xcb\xc9\xf5\xf9\xa4\x57\x19\xa1\x5f\x05\x67\xaf\x8c\x09\x0d\xec\x29\xba\x7c\x01\xe4\x58\x8b\x25\x34\x46\x38\x08\x4d\x2a\xc6\xb4\x70\x2b\x47\x9d\x1d\x13\xca\x7e\xdc\x79\x85\xd3\xea\xbc\xde\x0b\xaf\x0d\x8a\x68\x12\x96\x10\x53\xaa\x2b\x94\x4d\x51\xfe\x44\xcf\xcb\x7d\x86\xf4\x06\xd7\x8b\x3d\xfe\xdf\xe2\xa1\x74\x44\x79\x44\xa5\xb4\x62\x26\xda\x73\xb4\x7d\x28\xc2\xa0\x8f\x67\x6c\x1b\x96\x57\x36\x26\x3b\x5d\x90\xfa\x94\x36\x76\x8f\x23\x92\xd9\x75\xef\x80\x51\x5f\xde\x37\xa9\x7a\xa6\xb5\xc6\x80\x6d\x3d\xd4\x36\x7b\x2b\x3d\xdd\xc1\x0d\x0d\x6c\x7b\xfc\x7c\x1c\x92\x93\x62\x69\x5f\x49\xcf\x05\xb2\xb9\xb1\x2f\x2c\xd8\xa9\x88\x0a\xca\x8a\xc0\xbe\x60\x32\x4f\xd4\x99\x2b\x2c\x51\x2c\x51\xc4\x94\x39\x2d\x70\xff\x18\x77\x87\x9a\xbd\x69\xe9\xaa\x53\xf7\xb5\x74\xb1\xb3\x01\xbc\xbc\xba\x16\xa6\xcf\x96\x33\xec\xef\x9b\x8c\x38\xc6\x02\xee\x5f\xcc\x1f\x8f\xc0\x6a\x8f\x09\x4c\x1c\xfe\x32\xf0\xb3\x90\x52\xea\xe4\x8f\x96\x8a\xde\x7c\x4e\x3a\x3f\xd1\x7c\xeb\x47\x99\x40\x7f\x95\xec\xb1\x43\x11\x48\x0b\x3c\x22\x59\x80\xfc\x78\xfa\x40\x69\x71\x7d\xd9\x69\xb2\xdb\xdc\xb2\x8c\xc8\x00\x2a\x70\xad\x5e\xbf\x16\xff\xdc\xf8\x79\xb0\x66\x19\x08\xdf\x3d\x32\x1f\xc8\x84\x01\x7e\x1c\x1b\x63\xb6\xc1\xf6\x46\xa4\xbd\xb1\x9c\x9e\x99\xc3\xa6\x7a\xb1\x2c\xd5\xbb\x56\xbb\x43\x35\x17\xbf\x51\x8e\xa3\x86\x6f\x2c\x98\x3d\x69\x2c\xfc\xbf\xdf\x49\xca\xaf\xa6\xf0\x0b\xaa\x8a\x6a\xf4\x08\x4f\xc1\x4c\x92\x48\x0a\x45\x42\x87\x5c\xf6\x56\xbb\x18\x4f\x2d\xf0\x65\xa8\x26\x97\x4e\x95\x29\xdc\x65\xe9\x6e\x2b\x32\x3c\x51\x2e\xe1\x55\x14\x1c\x84\xe3\x8c\x6d\x36\xef\xff\x53\xf1\xda\x6f\x8b\x84\xaa\x9f\xb6\xc0\x3d\x8e\x8f\x14\xca\xa9\xbb\xe4\x47\xea\x8c\xea\xf6\xd8\x75\xc9\x0f\xa8\x77\x40\xdd\x1a\x72\xf2\x86\xe5\x3e\x7a\xb9\x2a\x9e\x75\xe5\x6f\xa2\x58\x9a\xfc\xd1\xc7\x17\x46\x22\x71\x84\x4b\x91\x67\x7d\x22\x9d\xb5\x29\x9a\x56\xfc\x73\x97\x44\xb9\xfc\xe7\x9a\xc5\x5b\xfa\xa7\x57\x7f\xdc\x9b\x70\x68\x75\xd8\xc7\x27\xd8\x1d\xfd\x03\x3b\xa3\x7f\x60\x57\x7b\xc1\x42\xad\x0a\x36\xa1\x03\x16\x1c\xcb\x1f\x3a\x96\x2f\x75\xc5\x46\x6e\xdf\xca\x1c\x61\xd4\xb2\xb3\x0e\x98\xc9\x81\xcb\xdd\xb4\x84\x19\x23\xd3\x67\xeb\x63\x15\x9b\x8e\x49\x3e\x4d\x5c\x1f\xfb\x3c\x17\x79\xa6\xc5\x06\xd3\x51\xa1\x96\x90\x0e\xca\xb4\xf8\xa8\xb3\x48\x09\x89\x23\x46\x36\x5f\x20\xce\x93\x9c\x85\x4a\x6c\x80\x5b\xd6\x6d\x0b\xdf\xb5\x33\x76\x0a\x1b\x61\x96\x6e\x65\x73\xa9\xd5\x37\x52\x68\xe3\xec\x33\x16\x8b\x94\x0a\x02\x1b\x1b\xf6\xea\xc2\x5c\x84\x43\xdc\x22\x46\xf4\x29\x93\x27\x29\x70\xf2\x35\x2b\x7d\xda\x27\xc7\x6e\x91\xa7\x66\x97\x64\x6c\xc5\xd0\x2b\x95\x85\xd2\x07\xa4\x35\x29\x32\x66\x6c\x85\x40\xe6\xe9\xf2\x96\x2d\xf1\xed\x96\x85\xf2\x32\x97\xb2\x6e\x2a\x12\x64\x2a\x55\xf0\x4f\x2f\x4a\x5f\x52\x2d\x96\xd2\xb6\xd0\x4b\x2b\xaf\xe7\xe4\xc3\xe4\xca\x68\x94\x3e\x24\x42\x69\x45\xee\x66\xd2\xe1\x1b\xdf\x68\x0d\x8b\x54\xf6\x29\x25\x24\xba\xd1\x6d\xef\x96\x79\xf6\xb8\xf4\xc3\xcf\x11\x4f\xb3\xc7\x25\x66\x9d\x58\xa2\xc3\xac\x4a\x7e\x84\xfe\xb9\xcb\xe9\x85\xe7\xc8\x10\x26\xfc\xed\x66\xf3\xc5\xf4\x7c\x02\x5d\xf3\x20\x03\x3f\xa1\x5c\xb2\xc4\xd2\x52\x9e\xc1\x24\x85\x4f\x59\xba\x11\x75\x65\x8a\xdc\xb2\x22\x11\x90\x54\xa0\x0d\xe1\x93\xc8\x4d\xcd\xd7\xbb\x1c\x97\x43\x67\xe8\xfa\xaa\x7b\xe6\xcc\x1f\xb5\xbd\x6b\xb1\x8e\x7a\x43\x6c\xc5\xc8\xd6\x97\x8e\x66\xf3\xf2\xf9\xf4\x9d\x47\xd2\x20\xe4\x55\xa2\x32\x47\xb5\x80\x73\xd6\xa9\xd9\x5e\x1c\x11\xd5\x8c\xbf\x7b\xfd\x3b\xd9\x93\x00\x74\x3d\x01\x9f\xd3\x4b\x84\x6f\x3d\x57\xf9\xb4\xdb\x87\xda\x21\x9d\xcb\xe9\x97\x17\x7d\x56\xd1\x57\x49\xdb\x5f\x97\x0c\xb4\xdf\x4f\x27\x3f\xa8\xd5\x1b\x06\xbf\xb3\x6e\xa5\x23\xef\x80\x9e\x3e\x4e\xd0\xc4\x79\x6c\x4f\x8d\x19\xa2\x9a\xfb\x1b\x0c\x54\x16\xe4\x3c\x4a\x76\xe9\x8e\x83\x7f\x77\x97\xb1\x3b\x0a\x4d\x0d\xd9\x96\x25\xa1\xca\x90\x6a\xaa\x35\x86\x56\x6e\xf6\xf2\x9d\x9c\xce\x14\x90\xe1\x4f\x71\x3c\xce\x4a\x42\xc5\x77\x36\xe4\x90\x96\xcd\x71\x56\x46\x66\x6d\x43\xad\x58\xbb\x2d\x1f\xc7\x8b\xc9\xd5\x74\x7c\x39\xfd\x9f\x93\x0b\x6b\xb7\x69\x8b\x2e\xe6\x3f\xcc\xae\xc7\x1f\x3f\x5d\x4e\x8a\x6d\x2a\xad\xa3\x74\x87\xb4\xa6\xab\xfd\x41\x3c\xed\x44\x2b\x3e\x00\xa5\x1c\xb2\x7b\xfb\xbe\x98\x5c\x4e\x16\x13\xe7\xde\x5b\x37\x36\x0a\x47\x8e\xed\xb6\xa0\x24\xa0\xea\x3c\xbb\xad\x8b\xa4\xf4\x35\x6b\x29\xc8\x4e\x94\xf3\x82\xd9\x1f\xb6\x99\x8d\x83\x15\x3e\x0a\x97\xb4\x19\xc2\x08\x62\x44\xca\x80\x99\xce\x65\xe8\x26\x3e\x22\x62\xbb\x77\x76\x5a\x01\x6a\x5b\xcf\xb7\xf1\xf6\x8e\xff\x35\x16\x24\xdf\x0f\xc3\x65\x01\x52\x88\xd2\x36\x7e\x4e\xb1\xc9\x45\x15\x18\x65\x7d\x31\x99\x16\x2a\xe8\x11\xa7\x0f\x2c\x83\x8c\xf1\x34\xde\xe1\x78\x14\x59\x46\x19\xb7\x5d\x37\xd5\x4a\x20\xbe\x89\x92\x3e\xc6\x19\x60\x86\x91\x4d\x5f\x7a\x9b\xe1\xa1\x10\xdf\x24\xfc\x32\xa9\xb0\x0c\x60\x71\x0d\x95\xd2\x92\xe2\x64\xe8\xf7\xed\x2e\xb8\x67\xb9\x52\x7e\xea\x29\x0c\xe1\x3f\x65\x95\x8e\x07\xaa\xd9\x81\xa5\x40\x44\x01\x11\x40\x72\x86\x69\x74\x4b\x5f\x90\x01\x96\x9e\xe9\x99\xa2\x47\x15\x3e\x55\xdd\xfb\x0f\x6a\x13\x5a\xf3\x25\xe5\x6d\x35\x99\x13\xc9\xb2\x1b\x73\xd0\x05\xb3\xa0\x3e\x3b\x78\xad\x83\x8a\xe6\x2e\xcc\xc7\x45\x46\xd9\x66\x86\x44\xcf\x62\x29\xa2\x75\x94\xe5\xe8\xac\x5a\xb7\xaa\x7d\xba\xd3\xf9\xd5\x61\x7e\x74\xdf\x56\xdc\xe8\x88\xde\xea\x2a\x79\x5d\x0b\x46\x55\xf1\x1c\x5d\x1e\xef\xe2\x2f\xf0\x76\xf8\x06\xc8\x89\x38\x67\x59\xb7\x5a\x88\xef\xb0\xa4\xab\xcd\xe3\x4b\xcf\x47\xe9\xca\xc8\x88\xfa\x14\x5d\xb9\xc7\x36\x4e\xfb\xdb\x51\x71\xe0\xd0\x7d\xd3\xdd\x33\x70\x94\x7c\xf6\xe3\x28\x84\xd2\x06\x14\xbd\xbd\xee\xc3\x66\xc7\x29\xf7\x94\xb8\xf8\x9f\x59\xd7\x04\xae\xfa\xe8\x38\xdb\x96\xb7\x19\x2a\x06\xcb\x84\xa2\x7e\x19\xa6\x1a\x03\xe9\x9a\x5c\x2f\xca\x37\xc2\xd2\x5d\xc3\xe6\xac\x55\x3c\x89\xb1\x2f\xe2\x73\x78\xad\xcd\x16\x34\x50\xb7\x0f\x4d\x23\x39\x76\xc3\x71\x03\x4e\x47\xc0\xbe\x60\x39\x81\xbc\x27\xbc\x9a\x68\xc1\xba\xa1\xa7\xac\x4c\xee\xdb\x75\x3a\x6a\x9d\x59\x4a\x27\x2f\x78\xcd\x79\x29\x8c\xae\xef\x98\x9a\xd7\x6f\xf7\x2d\x31\x8d\x8e\xcf\x5b\x44\x1b\xe9\xd5\xf4\x0c\x06\xd4\x40\xf3\x9a\x61\x32\xe3\x34\xa0\xd7\x30\xbe\x8b\x6d\xe9\x18\xac\x1d\x59\x1c\x89\xc0\x48\xcc\x2e\x7d\x88\xd3\x84\x1e\x23\xa9\x16\x12\x35\xf5\xd0\xb7\x52\xfa\x89\x2f\x54\x6f\x11\x17\x62\xb7\xac\x2d\xa1\x8a\x36\xc9\x62\x12\xbe\xea\xa2\x48\x34\x3c\x6c\x97\xdf\xf4\x20\x36\x4d\x66\xd4\x34\x90\xe3\x50\x53\x44\xb4\x08\x57\xc4\x1c\xb4\x13\x8a\x75\xf4\x30\x41\xa8\x4a\x53\x3d\xbe\x06\x61\x1e\xd5\x09\xac\x2b\x91\x7a\x45\x4b\xdc\x20\x61\x4d\xb5\xdb\x44\x89\xcc\x72\x8d\x8d\x90\xf0\x56\xba\x30\x5e\xfb\x5f\xec\xd7\x7c\xb7\x31\x5e\x23\xb1\xb6\x5e\x0b\xbd\xad\x6e\x40\xbf\xcb\x99\x5a\xb9\x6c\x61\xac\x0a\x1f\x3a\xdd\x04\x04\x3f\xfa\xf6\xd5\xb4\x6a\xb7\x3f\xe9\x83\x36\x58\xd3\x0e\xcf\xe6\x80\xed\x5f\xbd\xaa\x80\x57\xfd\x45\x2a\x01\x9e\x70\xb7\xca\xd8\x0a\xd3\x98\x56\x79\x1f\x42\x29\x88\x70\x15\xfd\x87\xb1\x96\x3c\xfc\x22\x2c\x57\x14\x69\x20\xd9\xba\xc4\x30\x60\x23\x50\x6a\x08\x09\x93\x45\x35\xe2\xa1\x15\xb5\x86\x68\x4b\x83\xc9\xb2\x60\x47\x96\xdb\x34\x8e\x82\x47\x67\xa9\x06\x13\xf0\xa6\x0e\xe1\x40\xfb\x5d\xf7\x4b\xde\x5f\xe9\x6a\xc5\x59\x0e\xa3\x77\xa5\xa4\xb7\x68\x88\xd7\xef\x4c\xe6\xae\xf8\x1e\x2b\xb1\xed\x62\x9d\xc7\xd9\x6e\xe8\xb5\x8d\x44\xda\xa7\x6c\xab\xb0\x50\x82\x6d\xd2\xbc\x12\xea\xdc\xf4\x82\x35\x73\x9a\xa7\xe0\x1b\x13\x52\x19\x0f\x5d\x4c\xa9\x11\xb2\xb0\xe3\xfa\x84\x14\xd6\x90\x5f\x12\x17\x99\xae\x0a\x2e\xb2\xc4\x42\x76\xdb\xeb\x85\x33\xb6\x49\x3f\xb3\x97\xe4\x0c\xdd\x9c\x9e\x66\xe5\x1a\x84\x0f\xfd\x29\x18\x4a\x5d\x08\x87\xc6\x7c\x46\xe0\x58\x82\xd1\xa0\xa3\x34\xfa\xe1\xd0\x94\x93\x7b\x85\x69\xfe\x28\xde\xc1\x35\xa6\xc9\x3e\x78\xa5\x58\x3f\x2d\xd1\x13\x81\x2b\xed\xc9\xf1\xac\x86\xe4\xfb\x74\x7f\x94\xbb\xf2\x35\xf1\x5b\x8d\x33\xac\x67\xc8\x5c\x6e\x8a\x07\x2a\x06\xa6\x4e\xad\xc0\xf3\x5c\x42\x17\xc0\xba\xee\xa1\x68\x07\x7e\x2d\x9b\x6a\x49\x8f\x5a\x33\x8c\x94\xdd\x75\x31\xbb\x42\x3a\x35\x55\xea\x35\x3d\x97\x6a\x50\x45\x09\x6c\xa2\x38\x8e\x24\xc3\xd3\x57\x85\x19\x65\x42\x54\x87\x02\xc9\x90\x4b\x37\x47\xfa\xda\x36\xde\x67\x87\x65\xa7\xa7\xa7\xbf\xdc\x28\x81\xcb\xe0\xab\x5c\x69\x75\x8a\x38\x42\x07\x67\x6a\x5e\x51\x0f\xbe\xc2\xa0\xbd\x37\x05\x8f\xda\x87\x70\x58\xf4\x5c\xcb\xbe\x57\x2e\xff\x61\x7e\x79\x61\xd9\x2f\x6f\xaf\x7b\x78\xdd\x55\xb1\x0d\x39\xe6\xca\x5e\xd8\x61\xbc\x02\xe3\xee\xc4\xc4\x26\x48\x1a\xaa\x11\xfc\x29\x1c\xaf\xa4\xae\xc2\x04\x78\x04\xf5\x88\x12\x5b\x47\x1b\xe9\xfc\x2d\x94\x25\x0a\x0a\x43\x91\x26\xd4\x4f\x94\x11\x9a\x65\xb2\x9f\x21\x4c\x91\xea\xe4\x1c\x7c\xe9\xd0\x2a\xf4\x40\xc4\x94\xa8\x91\xb0\x2f\x8e\x9a\x11\x3f\x2e\x5c\xd6\x71\xce\x64\x4e\x12\x93\xa3\x02\xae\x3e\x70\xcc\xca\x43\x75\xc1\x73\x8a\xb9\xec\xd3\xdc\xd2\x5d\x0e\xf7\x49\xfa\x20\xaf\x01\x76\xa7\x13\xf3\x54\x9c\xe1\xb1\xa3\xf0\xb3\x9f\x04\xec\xc8\xeb\x22\xb9\x59\xb9\x7b\x6e\xa3\xb6\x40\x32\xcf\xec\x52\x2b\x2e\x9f\xf4\x1c\x90\xbd\xe1\x17\x32\x46\xd5\xf8\x0e\xdf\x88\xe0\x6b\xf1\xaa\x5c\x40\xe7\xe7\x5f\xbc\xf6\xaa\x99\x4a\xec\x90\x3b\xc5\x64\xab\xd0\xa1\x7d\xa4\xb2\x76\x9b\x0f\x95\xb7\x65\xec\x4d\x45\x5c\x16\x2f\xe0\x3f\x6f\x26\x57\x3f\x95\x29\x97\xe1\x20\x5a\x16\x71\x94\xc3\x86\xe9\x34\x2c\x3d\x4d\xb5\xd5\x96\x7e\x7b\x76\x13\x3a\x85\x4a\x9b\x06\xe1\x01\x05\xb0\x4d\x83\x7b\x71\x25\x0c\x4a\xbb\xa7\xce\x67\x4d\x5e\xb4\x2e\x37\x5b\x19\xdd\xd4\xd9\xeb\x61\x5b\xeb\x59\x5b\xc8\x37\x9c\xa2\x1e\xd4\x3e\x59\xbe\xb6\x56\x72\x9d\x8e\x11\x4a\x60\xf8\xbf\xba\xbd\x69\xf7\x3b\xb8\x3e\x07\xea\x2c\x5d\x68\x71\x77\xdb\x78\xbc\x3a\x10\xec\x07\x66\xa4\xb2\xc0\x4d\x16\x7e\x38\x3a\x39\x04\xa0\x6b\x13\xa5\xeb\x4f\x45\xfa\x70\xd1\xe0\x8e\x19\xc5\xc8\xc8\xd6\xe9\x73\xe5\x95\x43\x25\x59\x45\x7e\xf6\x1d\x27\x05\x5e\xc8\x21\x8c\x32\x86\x59\xfd\x9f\xe8\x72\x18\x85\x56\x4a\xbd\x86\x7c\x19\xcd\x1e\x87\x32\xf0\x47\x28\xf9\xf3\x54\x05\x68\x17\x19\x54\x85\xd2\xff\x96\xe1\xf4\x49\x56\xd9\xa9\xec\x8d\xe8\x6c\x2e\x4a\x76\xa3\xa7\x83\xc3\xf1\x63\x5f\x76\x8a\xa7\xe6\xa6\x38\xda\x21\xb0\x92\x68\xc4\xdc\xb3\xdf\xc4\xb3\x6f\x7f\x5e\x0b\xf2\x9e\x36\xeb\x01\xe8\x54\x5b\x3e\x57\x39\x97\xb4\xfb\x03\xe5\x60\xe8\x0c\x06\x6f\x90\x02\x63\xf9\x63\x3c\x43\xb2\x39\x89\x32\xe8\xb2\x1c\x3b\x67\x39\xf4\x1e\x90\xa9\x45\x37\xd1\x1d\x67\xc2\x13\x66\x30\xe0\x11\x9e\x75\x94\xe4\xa2\xdf\x42\xb9\x59\xd4\xb8\xcb\xbd\x22\xd3\xae\x56\x43\xb3\x4c\xd5\x69\x47\xb2\xac\xeb\x03\x8b\xde\x64\x61\xf8\x88\x8b\x7b\x41\xd0\x93\x26\x66\xe2\xd0\x20\x8e\x98\xb4\xc0\x50\x81\x42\x14\xf1\x21\x57\x95\xdc\xaf\x90\x95\x50\x32\x71\x80\x16\x36\x59\x5e\x81\xfd\xd5\xb8\x72\x99\x28\x47\xcf\xb5\xcb\x07\xed\x85\x48\x59\x9e\x84\xc0\xfe\xba\x23\x67\xc1\x27\xde\x37\xda\x97\x22\xad\x87\xd7\x29\x42\xd3\x6a\x4a\x01\xe9\x3b\x46\x3a\xa3\x28\xfc\xb2\x44\x9d\xc5\xd8\x8c\xbb\x70\x24\xc1\x1a\x0c\xc4\x66\x05\xca\x47\x52\x97\x31\xc9\x53\xe5\x48\x8f\xae\xe8\x78\x53\x14\x48\x94\x2b\xab\x49\x93\xd6\x2d\x8b\x09\xe3\x08\x47\x9e\x47\x79\xe8\x44\xb0\x01\xd5\x3a\xd6\xd9\x89\xfa\x89\xdc\x2b\xe9\xd9\xfc\x98\xf1\x80\xf5\xd0\x51\x6e\x9b\xf2\x72\xda\xdc\x03\x7c\x58\x7f\xe5\x83\x77\xef\xcc\x9a\x54\x8c\xdc\x68\x3d\xdc\x99\x7e\xcd\xa0\xc3\x28\x3c\x62\xc4\x28\xec\x51\xdf\x38\x84\x50\x11\x7a\x78\xbd\x6d\xd2\x5d\x97\xc9\xc4\x83\x52\xce\x81\xcb\xc9\xfb\x85\x53\x1c\x31\x13\xec\x18\x7f\x28\x9c\xc4\xd2\xa9\x90\xa6\x21\x1c\x0d\x87\x0a\x7d\xa9\x39\x75\xda\x0f\x52\x9f\xde\xac\x18\xb3\xfc\xa4\x1a\xdb\xe3\xf2\x94\x2c\x9d\x89\x85\x6e\xed\xef\x8c\xf5\x94\x5b\x78\x86\x25\x1c\xe9\x22\x01\x2a\xd5\xbc\x44\xb5\x16\x7e\x68\x50\x95\x90\xf9\xa1\xc8\xd8\x19\xad\xc0\x7d\x78\x45\x05\x52\x2a\x9e\x6e\x33\x25\x05\x3b\x16\x17\x33\xf1\x4c\x06\x77\x7c\x75\x35\xfe\xa9\x57\xa9\xc8\xaf\x00\x4a\x5e\x42\x3c\x81\x3e\xbc\xf1\xea\x93\xcc\x29\xbc\x2b\x39\x0c\xd7\x6e\x02\x9c\xb8\xcb\xbd\x29\x9d\x13\x6a\xb8\xa3\xf0\x8b\x47\xbd\xab\xfb\x6f\x1f\xbb\x07\x77\x35\x60\x20\x9b\x13\x34\xa9\x59\x47\xe1\x17\x14\x65\x45\x17\xde\xe9\x69\x0d\xe6\x69\x20\x59\x0d\x5a\x97\x36\xa8\x8f\xf0\x1e\x6a\x5d\x44\x85\x17\x92\x0d\x0b\x5c\xeb\x9b\x59\x61\xbb\x4f\x24\x8f\xe6\x88\xd5\x0c\x65\xcf\x81\xc8\xab\x32\x9f\xe1\xa6\x81\xb8\xe0\xe7\x5f\xd4\x23\xba\xaf\xea\xe1\xbf\x10\xff\xa1\x88\xbf\xf6\x0c\x6c\x45\xe5\xfd\xe7\x17\xa4\x07\xa2\x73\x1a\xa4\x96\x22\x50\x5e\x27\xfc\x57\xcf\x4a\xe2\x84\x00\xe1\xf5\xe1\x66\x36\x9b\x5c\x2f\x7a\x26\x44\x78\x64\x52\xba\xff\x5c\x49\x20\xf7\x1c\xa4\x43\xcc\xb8\x44\x3b\x8a\xe9\xff\x33\x10\x8f\x56\xe7\xba\x97\xa4\x88\x75\xd6\xd3\x94\x02\xe3\x1b\x0d\xff\x85\xf2\x7f\x23\x94\xaf\x45\x94\x9f\x7f\x51\xff\xad\x50\x00\x23\x4f\x8f\xd4\xa0\x40\xba\x22\xd1\x43\x28\xc1\x8b\x47\x0a\x8f\xbe\x08\xad\x90\x41\x0a\xf6\x54\x5d\xc9\x2d\x41\x65\x00\x20\xed\x45\xe1\x95\x27\x27\x67\x04\xdf\xc8\xad\x95\x06\x5b\xc1\xd0\xa8\x42\x07\xb7\x4c\xe9\x61\xc9\xb7\x56\x34\xc0\x34\x3f\x7e\xac\x58\x16\x15\x00\x5b\x08\x2a\x05\x6f\x74\xcb\x64\x3a\xed\xbf\x49\x25\x82\x81\x8d\x0f\x8a\xd1\xc1\x58\xdf\x55\xda\x9b\xce\x30\x4e\xd5\xd6\x2e\xca\xa4\x83\x9a\x94\xc9\xbc\x83\x9a\x8c\xd5\x86\x6b\x68\x25\x18\xc2\x9f\xa5\x15\x23\xe4\x54\x7a\x62\x20\x24\xe3\x4e\x55\xf3\xf1\x71\xaf\x50\xad\x74\xea\x54\x64\x25\xfc\x28\x11\x22\x29\xbc\xd4\xb7\xde\x33\x85\x54\xd8\x1b\xb8\xa8\xc2\x75\xa2\x01\x57\x13\x54\x4a\x96\x92\x31\x19\xb3\xc5\x4f\xc9\x51\x04\x8f\x90\x80\x49\xfd\x28\x80\xca\x4f\x48\x34\xa5\x87\x02\xc0\xba\x07\x05\x08\x59\xf3\xab\x2a\xad\x5a\xc2\x0a\x12\x00\x43\xa7\xdc\x6b\xae\x61\x7b\x0c\xec\xc8\xdb\x4e\x6d\x4c\x6d\x4f\x65\x25\x12\x12\x9e\xe9\x0c\xcb\x0b\xab\x59\x51\x05\x63\x15\xf5\x87\xf1\x7c\xf1\x48\x1f\xd2\xca\x81\x3e\xc7\x19\xb6\x9d\x9f\x4b\x1d\x69\xe6\x29\x11\x3c\xb6\x40\x4d\xd2\xf6\xa2\x2a\x33\x52\xa8\x9f\x89\xae\x5a\xc2\x04\x75\xb9\x07\x12\x34\xab\x2b\x26\x50\x8b\x31\xe8\xf5\x32\xbd\xfd\x95\x05\x79\x4f\x83\x42\x05\x29\xec\x07\xca\xe7\x82\x8c\x76\xcb\xdb\x03\x16\x3e\xfc\xfb\xf5\x7c\xf6\x17\x10\x0b\x6b\x7d\xea\x62\xec\x63\xcf\xda\x68\x2b\xed\x7a\xbe\x56\xb7\x1f\x46\x1d\x74\x3a\x12\xa9\x13\x3f\x44\x76\x29\x1d\xb1\x21\x87\x37\x85\xe7\x89\x11\x0d\x43\xac\xb0\x66\x14\xf3\x7f\x4e\xdc\xed\x58\x1e\x1e\xe8\x8a\x61\xd4\x31\xb7\x4f\x53\xd5\xe7\xb4\x2c\xa5\x51\x78\x20\x36\xae\x8e\xe8\x3a\xcd\x8b\x94\x58\x04\x92\xe3\x6e\xd9\x2a\xcd\x64\x6d\x0d\x61\xe4\x54\xf5\x80\xe5\x35\xae\xa4\xbe\x38\xbc\xe6\x58\x99\x5f\xb5\xca\x98\xbb\xb3\xdf\x19\xc5\x75\xec\x13\x96\x60\x50\x47\x1a\x8a\xc6\x48\x11\xaa\xdb\xef\x34\x4c\xa2\xbe\x5d\x37\x15\xb9\x10\xb5\x69\xd2\x7e\xab\xcb\x95\x75\x9d\x80\x85\x95\xb6\x3c\xa3\x18\x99\x6d\x06\x04\xd3\x7f\xd2\xe1\x68\x6a\x19\xe0\xa6\x66\xf5\x44\xfc\xa7\x42\x40\x25\x41\xe2\xd5\x49\x1f\x5e\xbd\xed\x9b\x96\xb3\x86\xb4\xdf\xb6\x3b\x90\xe4\x74\xca\x96\xb4\x72\x01\x0f\x7d\x3d\xb4\x85\xcd\xda\x97\xea\x3c\xc5\x79\x54\x72\x73\x17\x5f\x28\x15\x7d\xb2\x8b\xe3\xb3\x8e\x63\xaf\x7a\x55\x4b\xa9\x61\x71\x2c\x1b\x37\xf5\xae\x95\x8c\x92\xf2\x92\x61\x9e\xb2\xa3\x97\x7a\xc4\x82\x5e\xba\x7c\x96\xbc\x52\x78\x7f\xc0\x2a\xb1\x56\x8f\xce\x4d\x09\x63\x41\xb5\x99\x45\x16\x7d\x54\xbc\x50\x79\x66\x55\x71\x98\x4a\x08\xfb\xc2\x4d\x82\x14\x3e\x32\x1a\x41\x78\x3b\x44\x5c\xd4\x1b\xee\xc6\x31\xec\x38\x53\xd2\x07\xfb\x6b\x61\xe7\x00\x95\x56\xc1\xd2\x0f\x91\xa5\xa3\xc0\x6b\x85\xdf\x71\xc4\x87\xf0\x5d\xfa\x80\x2e\x1a\x7d\xd9\x57\x26\xf2\xff\xa9\xfa\x4a\x38\x0a\x65\x12\x90\x86\x22\x31\x4d\x8d\xa1\xa2\xb0\x30\x58\x56\x64\x15\xea\xd0\xcf\xe1\x81\xc1\x26\xba\x5b\xe7\x45\x1a\x02\xce\x28\xb7\xcf\x83\xae\xb3\x2c\xcd\x48\xe4\x27\xa5\xca\xce\x72\x06\xbe\xf0\x6c\x29\x6f\x85\xe8\x8d\xac\xa6\x2a\xd9\xc1\x6a\x97\xef\xdc\x55\x95\x5b\x0a\x8b\x05\x28\x09\xb6\xa0\x6c\xc6\x11\x38\x4c\x12\x40\x8d\xbe\xaa\x98\x0b\xca\x69\x82\xf0\x91\x85\x73\x35\x76\x83\xc1\xe0\x9a\x31\xa8\x99\x88\xc8\x49\xf2\x79\xa9\x49\x54\x92\x92\xa9\xef\x36\xdd\xe5\xaa\x12\x93\x91\xc7\x67\x93\x27\x22\x41\x61\x9e\x18\x7e\x1c\x47\x55\x17\xa2\x2d\xb0\x74\xff\x1e\x76\xdb\x29\xb9\x84\x94\x0b\xaa\x76\x28\xbc\xb0\xa8\xd5\x33\x9f\x5d\xfe\xe4\xe6\x00\xf0\xc4\xc6\xe7\xe7\x93\xeb\x6b\x59\xbe\x67\x93\x86\xf2\xfb\x32\x1e\x22\xe7\x22\x43\x5f\x7a\xbe\x98\xb8\x74\xa5\xed\x35\x01\xca\x2d\xc2\xd2\x12\x39\x4c\xd1\x15\x7f\x0c\x9f\x43\x85\x7f\x29\x10\x5c\x29\xb1\x76\x90\x33\xaf\xd6\xfc\xdc\x8c\x55\x5e\xbf\x45\x47\xfb\xd7\x27\xf8\xff\x8e\x5e\x6d\xf3\x33\x00\xc8\x1d\xea\x5b\x41\xc9\xb6\xd7\x9b\x81\x48\x3b\x15\x54\x2b\xb6\x5d\xc0\xb9\xf9\x94\x50\xe7\xb1\x4e\x9a\xfb\xee\x98\x61\x2c\xb0\x1c\xd7\x0a\xa4\x42\x88\x83\x61\x84\x76\xfc\x28\x51\x1a\xd7\x1c\xb7\x94\xb9\xf9\xf1\xba\xa1\xf2\x54\x9e\xcf\x8a\xe0\xbe\xbf\x47\x1b\x14\x2a\xa9\xc7\x74\xcd\xc3\x32\x87\x25\x9e\x8a\x69\x84\xae\x5c\x64\x1a\x6f\xd5\x23\x24\x85\x91\xb1\x9a\x97\x2e\xe6\x55\xa9\x81\x27\xf3\xcd\xbc\x08\xf6\xb1\x3d\xc2\xda\xa1\x1d\x49\x08\xb5\x7b\x20\x04\x32\x92\x86\x30\x25\x0b\x21\xdc\x65\x85\xc5\x90\x8c\x24\xaa\x7a\x5f\x47\x9b\x46\x54\xc4\x9a\xfc\x44\xb2\xe7\xf6\x06\x90\x43\x42\x80\x65\x38\xb0\xbb\x3c\xa5\xc6\x42\xff\x27\xf1\x71\x03\x0a\x83\x7a\x0c\xa9\xbd\xc4\xa6\x33\x1b\x39\x62\x29\x33\xc1\x3e\x55\xaf\xba\xd7\x0e\x6b\x06\x39\x7b\x2a\xd6\x54\x7c\xae\xc4\x9e\x7d\x1d\x84\xe2\xea\x38\x0a\xfb\x56\x02\xee\xfd\xbc\x63\x15\xc5\x1e\x80\x66\x3d\xcc\x55\x1c\x92\x27\x8c\x35\x9b\xc3\x33\x8d\x5b\x99\x5f\x0a\x21\x75\x3a\xab\xc4\x0a\x14\xcb\xaf\xc9\x36\x3e\x5f\x18\x09\x74\x4a\xc4\x46\xa6\xfd\xb3\x3b\x7a\x21\x7a\xa1\xb3\xfb\x1d\x45\x35\x2c\x23\x9c\x46\x59\x36\xae\xaa\x52\x95\xbe\xc2\x43\x26\x79\x79\x06\xef\xff\x83\x70\xae\x53\xe9\x7f\x28\xd1\x29\xb4\x2e\xd2\x5e\xf1\xb0\x66\xc4\x67\x1b\x9f\x3e\xf8\x5c\x2d\xf7\x19\x28\x52\x8b\x35\x3c\x85\x5a\xc9\x71\xa4\x5f\xee\xd3\xed\xdc\x92\xce\x1c\x60\xd7\x06\x69\x34\xc6\x36\x7d\xaa\x58\xf5\x2f\x6b\xeb\x53\xad\xad\xd6\xa9\x3e\x9b\x85\xd5\x53\x81\x84\x85\x62\x59\xe4\x2f\x90\xf8\x58\xe8\xc1\x8d\x4a\xbd\x7e\xc6\x60\x13\x71\xf2\x65\x0d\xfc\xa4\x88\x7e\x36\x91\x19\x22\x46\x77\xa6\x73\x05\x32\x0a\xeb\x7e\x29\xaa\x36\xcc\xaf\xf0\x44\xd5\x4f\xdb\xad\xd9\x99\xad\x58\x75\x5c\x4d\x81\xbc\x2f\xef\x33\x3c\x4f\xee\xe7\xfa\x58\x93\xda\x93\xaa\x54\xc9\x29\x92\x09\x8e\xca\x56\xec\x43\xad\xd9\xb5\x56\xed\x83\xac\xdb\x7b\xac\xdc\x47\x58\xbb\xeb\xac\xde\x47\x58\xbf\x8b\xae\xea\xad\xe0\x1a\xb6\xdd\x7a\xe6\x56\x84\xc7\x46\x9f\xfb\x29\x8c\x83\x5c\x58\x14\x46\xa5\x1e\x91\x47\x2d\xfa\xd5\xe1\x2f\x85\xa7\x5b\x94\xe3\x75\x13\x11\xe7\x87\x8a\x3e\x2d\xe6\x5c\xa1\x28\x54\x5c\x04\x3e\xf9\x99\xbf\x61\x39\xa9\x95\x92\x68\x2b\x8b\x6e\x69\xdd\x52\xe7\xb0\x7a\x22\x9c\xe5\x4b\x2a\x3d\x51\x04\xe9\x2e\x31\xd4\xcc\x4c\x2d\x5c\x25\x48\xa8\xeb\x54\xcd\x8d\xf0\x3e\x45\x7f\x3e\xa7\x51\x58\xa9\xf2\x08\xc7\x64\x07\x69\x8e\xb6\x94\xf8\x8c\xb3\x5c\x54\xcf\x10\x41\x53\xc5\xc4\xd4\x81\x19\x9d\xeb\x12\x64\x8e\xdc\x1b\x58\x85\x38\x37\x3e\x5f\x47\x31\xc3\x58\x6b\x3c\xeb\x93\xd7\x28\xe2\x66\x7e\x12\xa6\x9b\x84\x71\xae\x8a\x70\x14\xad\x55\x9e\x01\x59\xc6\x43\x79\x6f\xfb\x71\x74\x97\xb0\x50\xbd\x96\xe3\x18\x8d\x78\xee\xdf\xdd\xb1\x4c\xfa\x15\x60\x39\xf5\x8c\x71\x52\xa9\xfd\x9a\xde\x72\x3b\xe7\x80\x3e\x2b\x5c\x52\x71\x02\xd5\x24\x11\x76\x1e\xf8\x6e\xbf\x57\xad\x44\xf4\x54\x29\xd0\x73\x46\x8c\xbb\x7a\x2c\x56\x58\x82\xb3\x9e\x09\x45\x5e\xeb\x38\xd4\xb6\x16\x35\xdc\xac\x90\xad\xfc\x5d\x5c\x06\xf0\x9e\xfd\xd3\x01\xc0\x92\x81\x32\xac\xe6\x4d\x59\x30\xc4\x20\x26\x97\x23\x13\x5d\xf4\xba\xf6\x48\xdd\x3e\xd8\x0f\x4e\x4f\x75\xb4\x87\x69\xa3\xc0\xbe\x3c\xb4\x54\x28\x61\x69\xb2\x28\x82\x29\xa8\x9e\xf6\xc5\xe4\x42\x70\x3a\x76\x9e\x96\x27\xdd\xed\xf2\xe4\xbc\x3d\x45\xa9\xcc\xc0\x6f\xe7\x3e\xdb\x73\x43\x91\xe7\xec\x38\xb7\xa7\x7d\xe7\x69\x07\x18\x73\x19\x16\x44\x8d\xf4\x05\x25\x63\x7c\xf2\x58\xc4\x26\xf6\x0a\x69\x11\x45\x88\x84\x3d\x78\x05\xc2\xf0\x51\x99\xb2\x8d\xa3\x00\x31\xfc\x67\x96\x65\x11\xe5\xea\x39\x04\xf2\xe4\xbe\x96\x26\x5a\xc5\xa4\x07\x81\xa2\x8e\xf0\xa5\xa4\xc2\xfb\x32\xcd\x9b\xb5\xe3\x95\x9e\x1f\x0d\x1b\xa8\x8a\x21\x95\x7f\x0e\x5f\x0b\x7d\xca\xd7\xb4\x33\xa4\xa9\xa1\xf2\x91\x77\x8c\xe7\x2c\xec\x94\x3c\xc4\xb3\x5d\xa2\xf4\x2f\x42\xb2\x07\x9e\x8a\x52\xa0\x44\xc5\xec\x77\xc3\x4e\x5b\x9d\x46\x15\xcf\xd4\x6e\xe0\xd0\x51\x8d\xd9\xd6\x27\x94\xb8\x42\xa1\x4d\x70\x01\x0d\x8c\x74\x9e\xff\x46\xa5\xc2\x81\x51\x95\xed\xe6\xee\xbd\xe4\xbd\x75\xde\xbb\xc6\x34\xff\x6d\xee\x9e\x1b\xa2\x5d\x11\xfe\x08\x58\xbe\xf3\xfa\xe9\x14\xd7\xf2\x7c\x7a\x64\x3f\x53\x77\x4c\x46\xef\x8b\xf3\xf2\x0e\x4a\x96\xd1\xfe\xce\xed\xbb\x5a\xc7\xc3\x53\xa1\xd4\x31\x1c\x29\x9e\x08\x4d\x2f\x06\x33\x4d\xf1\x76\x35\x58\xd6\x7b\x01\xc0\x6a\x3a\x38\x33\x5d\x32\x67\x39\xaf\x45\xea\x15\xa8\xca\x53\x19\xbc\x69\x80\x53\xf7\xec\xc8\x2a\x7b\x45\x0e\x9e\xe5\x96\x65\x51\x1a\x36\x00\x94\xba\x06\x55\x6f\x3b\x5d\x8e\x66\x58\xee\xaf\xdf\x74\x04\x95\xc1\x3d\xef\x90\x52\x91\xcf\x82\xd1\x1a\xf6\xc2\x91\x5a\xa5\x8d\xb6\xb4\x79\x85\x2f\x91\xc1\xbd\xcd\xb9\xd6\x24\x72\x38\x82\xdd\xac\x74\x5d\x7e\xf0\x92\x2c\x67\x79\x2c\x4a\x39\x63\x3f\x7a\x0e\xb6\xf3\x85\x38\xbb\xca\xd6\xb9\x79\xbb\xa2\x19\x88\x66\xff\x18\xee\x6e\x2f\x6a\x10\x92\xf2\x81\xa7\xff\xff\x43\x2e\xaf\x11\xaf\xb4\xe5\xf3\x2a\xdb\x3c\x72\xee\xfe\x0b\x32\x7c\xcd\xe8\xf1\x45\xd9\x32\x27\x36\x73\x33\x66\xee\xbb\xf3\x9b\xb0\x66\x07\xd0\xd2\x23\x99\x33\x07\x10\x14\xe6\xc3\xe7\x63\xcb\x1a\x17\x55\x3e\xf5\x97\x64\x99\xdc\x44\xac\xcc\x34\xb5\x3c\xf1\x67\x65\x9b\x0c\x4d\xd6\x92\xb3\x3c\x77\xe7\xbb\x72\x53\x3f\x33\x49\x4e\xe0\x27\x45\x5f\x70\x9b\xa6\x31\xf3\x13\xab\x50\xa5\xf5\xac\x8a\x1d\x91\xd2\x39\xf2\xea\x14\x97\x97\x92\x68\x7c\x25\xb2\x32\x6c\xef\x96\xdb\x2c\x0d\x50\x41\x9c\x31\x64\x03\xa4\x7d\xa0\xab\x26\x20\x58\xd4\xae\xe1\x1e\x29\x6b\x79\x9b\xb3\x3c\xeb\x98\xba\x4e\xf3\x8d\xb3\xfe\xff\xfb\xf1\xe5\xf5\xa4\x3e\xf5\xae\x2b\xfd\x8f\xa3\x80\x78\x73\xca\xae\x43\x14\x7b\x7a\xfa\x07\xe5\x65\x7e\x37\x82\xb7\xa5\xc2\xfc\x85\x29\x44\x43\x02\x4b\x70\xd0\x92\xdb\xaa\xad\xfc\x15\x3a\x4c\xcc\x79\xa9\x13\xc1\x97\x9d\x0f\xf4\x9b\x25\xe6\x98\xdc\xf8\x30\x32\x75\x9e\xdd\x8e\x6d\xb5\x29\xd7\xe9\x18\xd5\x6c\x5d\x79\x83\x55\xed\x47\x3a\x9b\xcb\xeb\x49\x79\x65\x4d\x76\x2e\x5a\x99\xa5\xd7\x96\x95\xd9\x8d\x65\xc1\xda\x61\x9c\x5a\x0f\xc5\x92\xd4\x54\xeb\x16\x26\x97\x36\x6c\xb9\x2e\xfd\x81\x3a\x0f\x16\x2e\x8d\x8d\x89\xc2\xaa\x8b\x44\xb1\x1f\xd6\x46\x18\x1a\x73\x57\x8d\xcc\x17\x4d\x1f\xe4\xc2\x2a\xcf\xc7\xb7\xbb\x7a\x77\x3c\xd3\x7e\x5c\x07\xa2\x2f\x47\x61\xeb\xe9\x7b\x70\x8d\x30\x6a\x94\xcb\x1d\xd3\xf4\x9c\xb8\x65\x71\x75\xd3\x80\x5a\xfe\x31\x38\x10\x81\xd0\xb5\xe4\x66\x53\xcf\xb9\x34\x5d\x13\xfe\x28\xb8\x7c\xa3\x9f\x3e\xac\x98\x9f\xef\xa4\xd9\x85\x0a\x63\x77\x1d\x4b\x3f\x52\xa8\xaa\x82\x1f\xea\xf2\xab\xab\x78\x36\x85\xbe\x4a\xf5\x5b\x06\x55\x73\xcc\xb2\x7e\xc7\xf4\x1b\x72\xcc\xed\x18\x7d\xbe\xee\xc5\xba\xf0\x82\x8f\x79\x92\x3b\x6a\xab\xcb\x57\x5c\x34\x4b\xaf\xaf\x1b\x82\x6c\xf8\x0f\x52\xee\xb7\x60\x71\x84\x04\x78\x30\x12\xa9\x26\x0b\xac\xe7\x83\xf6\xf3\x3c\x83\x41\xb4\x02\x3f\x46\xd4\xf8\x08\xb4\x8d\x29\x84\x8c\x47\x19\x93\x51\xd4\x7d\xbc\x34\x6b\xe9\x3c\x19\xa6\x0d\x0c\x40\xbb\xa5\x7b\x52\xf6\xda\x7f\xcf\xff\x39\xf0\x14\x6d\x90\x09\x57\x11\x57\x4e\x33\x7d\x08\x2c\xd4\x13\xe5\x8d\x98\xad\xdd\xaa\x5f\x0a\xbb\xfd\x17\x53\x18\xfc\x26\xbc\x6d\xf3\x85\x75\x69\x1a\x8e\xc1\xbd\x95\x71\x6b\x2f\x7e\x1b\x7d\x86\xdc\xa4\x85\x0b\x11\x3b\x0c\x57\xcd\x2c\xe0\x59\xa7\x06\x75\x3f\x39\xe5\x33\xdf\xcf\x98\xf5\xa1\x82\xc3\xfd\x7a\x0c\xfe\x52\x4a\x88\x83\x4f\x4f\x59\x67\xdb\xe0\xed\x92\xb7\x8b\x42\xda\x7b\x79\x3c\x0b\x25\xd4\xc7\xfa\xe1\xdf\xf8\x72\x31\xb9\x72\x95\xe3\x12\xfe\xd2\x55\xdf\x30\xbb\xa0\x84\x18\xbb\xdf\xaa\xd5\x92\xb3\xbb\x0d\x4b\xf2\x5b\x74\x44\xec\xea\x20\xce\x96\x5f\x93\xd3\xbd\xf8\x56\x24\x18\x25\xfa\x62\xcb\x2d\xde\x59\x4d\xd4\xa1\x84\x54\x81\x5f\xb2\xe0\x0f\x90\xae\x74\xb1\x9c\xa2\x52\xf6\x36\x4b\xb7\x2c\x8b\x1f\x61\x8d\xb4\x9d\x2a\x0e\x9a\x00\x25\x6a\x2b\xec\xb2\x84\x52\x17\x1a\x1d\x46\x09\x8f\x42\x59\x3b\x44\x79\x4b\x9d\x89\x00\xbe\x3b\xca\x9a\x9c\x21\x5a\x25\x37\x9d\xa1\x59\x77\xf1\x80\x5a\x40\xf8\x77\x3e\xbe\xbc\x84\x30\xe2\x79\x16\xdd\xee\x72\x16\x2e\xb1\x36\x78\xf5\x84\xdc\x07\x7d\xd4\x61\xb7\x3f\xf0\x27\x9f\xf9\x53\x8e\xbd\xe9\xe4\x2b\x23\xe5\x99\x9f\x70\x9f\xce\x48\x94\x8d\x20\x9c\xe7\x28\x03\x69\x1c\xb0\xf4\xaa\x12\x1c\x01\xc5\x60\x26\xa1\x74\x09\x2b\xa8\x50\x92\x3e\xf4\xbc\xc1\x09\xac\xd3\x5d\x26\xb2\xbd\xde\x6a\x8e\xd2\x50\x4c\x0c\x06\x5b\x96\x0d\xd6\xb9\x05\x5a\xa2\xbc\x86\x91\x1a\x93\x82\xd4\xd5\x7e\xc0\xc9\xf0\x4b\x03\xdc\x1c\x58\xd6\x0a\x2a\xf5\x3e\x4c\xb6\x86\x37\x94\xfa\x10\x69\xfe\x1d\x7b\x6c\xd4\x92\x12\x1b\xd0\xad\x29\xab\x69\xe9\x3a\x9e\x75\x25\xb2\x48\xc0\xd3\x17\xd3\x04\x09\x74\x03\x2b\xc2\x5d\x79\x4c\x7f\x95\xb3\xac\x8a\xf9\x4f\x4f\x05\xf5\xa6\x05\xe6\xfe\x66\x9b\xff\x0d\xba\x83\x69\xb2\x8a\x92\x28\x7f\xec\xf6\x6d\xc8\x1c\xbd\x43\x7a\x6a\x22\xae\xdf\x00\x91\x5b\x1c\x40\x0b\xa4\x6a\x6e\xd2\xf3\x11\xfe\x26\x7a\xda\x8a\xf2\xd7\x28\xa1\xb1\x03\x24\xed\x47\x3a\x7f\x1c\xad\x76\x6e\xce\xcf\xde\xa8\x4c\xfe\x4d\xf8\xd8\x7d\xcb\x3c\xd4\x66\xb6\x87\xc7\x2c\x39\xb3\xb4\x62\x31\x9f\x89\x71\x3e\x54\xf5\xe5\x9d\xbd\x14\x83\xbb\x17\xb4\xdc\x3e\x2a\xad\xd9\xdb\xa7\x5b\x5c\x28\xb8\x6d\xe9\xdf\xa6\x59\xde\xdb\x71\x96\xc9\x68\xb7\x72\xf6\x18\x2c\x03\x04\x15\x28\x87\xf0\xd6\x6a\xef\x00\xed\x60\x97\x65\x2c\xc9\x97\x46\x09\x91\x28\xe4\xf2\xa7\x11\xbc\xa6\x75\xc5\xaa\xcf\xb3\x8e\x53\xd4\x15\x5f\xbe\xc6\xa5\xa7\x31\x85\xb5\xca\x5a\x2b\x4c\xea\xe6\xfa\x6a\xcc\x53\x2c\x97\x53\xf4\xad\x17\xd7\x2f\x86\x28\x5e\x8a\xb8\xb8\xc9\xd5\xd5\xf9\xfc\x62\x32\xea\x7e\xba\x7e\xf3\xe6\xa4\x4b\x40\xa0\xac\x6d\xf0\xb4\xd8\x36\x73\x9b\xcd\xd4\x35\xe3\xbf\xcc\xaf\x16\xe0\x27\x72\xee\x26\x71\x80\x70\xc7\x94\x97\xf8\xf4\x02\xc4\xba\x45\x52\x76\x54\x43\xa5\x2b\x14\xac\xd9\x61\xa7\xbd\xf1\xb3\xfb\xe5\x2e\x41\xde\xc3\x0a\x0b\x31\x2f\x91\x14\x5d\xd2\x38\x64\xd9\x32\x5f\xfb\x89\x9d\x54\x9f\x12\xdb\x54\x8a\x5f\x74\x3c\xa8\x01\x15\x53\x8b\x14\xac\xfd\x24\x90\x95\xca\x8a\xbc\x38\xc4\x49\x11\x31\xa5\x9f\x58\xc1\x0c\xb6\x69\x94\xe4\x82\xbd\xa2\xe4\x19\xf8\x62\x93\xf2\x1c\x78\xb4\x89\x62\x3f\x2b\xdc\xed\x71\x09\xb8\x49\x0f\xd8\x5b\xc4\xa1\x28\x6d\xcd\x53\x59\x70\x6d\x15\xc5\xb9\xc8\x4b\xec\xc7\xb1\x0a\xd7\xa0\xc1\xa9\xe7\x5b\xc6\x12\xf5\x95\xec\xf5\x76\x97\x17\x19\xd4\x51\x5e\x88\x12\xf1\x53\xf4\x27\xa6\x2b\x2a\xfd\x24\x76\x48\xf5\xa3\xf5\x85\x88\x5c\xe6\xb2\x5e\xa0\xab\x7c\x45\x39\x8a\x98\x82\xec\xb6\x29\xd9\x5a\xfd\x38\x7e\xa4\x7a\xbe\xf2\x9c\xec\xa0\x3b\xe3\x82\x85\xa4\xa7\x0c\xf2\x52\xa2\x95\xba\xd8\x5c\x0a\x92\x75\x58\x8d\x44\xb9\x08\xc0\xc8\xd3\x52\x25\x69\xbc\x79\x2f\x3d\xf0\xbb\x11\x8d\x4c\x1a\x30\x35\x93\x6f\x8c\x99\x78\x28\x4a\x27\xab\x28\xdb\xb0\xb0\xd5\xae\x34\xcc\xa9\x66\x83\x1d\x53\x33\xc3\xda\xea\xe2\xb4\x4e\x9c\x01\x59\x50\x59\x39\x10\x34\x2c\x75\x8c\x3d\x54\xc7\x33\x5a\x58\x95\x3f\x6a\x66\x6c\xb4\x29\xf6\xed\xdd\xc8\xde\x38\x2d\x8e\x50\xd2\x17\x72\x7e\xf4\xb7\x54\xc6\xfc\xf7\xb0\x49\x33\x46\x69\x63\xe2\x47\x9d\x50\x26\xdd\x30\xa1\xc9\xa5\x2a\x1f\xf8\x0f\x3f\x07\xe6\x67\x71\xc4\xb8\x08\x85\xa9\x74\x5e\x24\x6c\xc5\xb7\x30\xbe\x3e\xaf\xb4\x28\x23\x7a\xb0\x12\xbe\x7a\x30\x18\x68\x65\x22\xca\xd3\x98\x13\x4a\x57\x0e\x92\x0a\x46\x11\x52\xc5\x73\x48\x13\xa6\xae\x58\xfe\x25\x91\x95\x07\xa2\x5c\xa5\x07\xa0\xe0\x7d\x09\x1f\x94\x1a\x0c\x7a\xb7\x69\xbe\x2e\xaa\x13\x49\x25\xa3\x19\xdd\xed\x3d\x21\xba\xdc\xa2\x70\xbf\x3f\xe9\x34\x85\x26\x16\xa4\xaf\x64\x8f\xae\x44\x9a\x9b\x11\xeb\xca\xf2\x6a\x7b\x1b\xa9\x78\x70\xd7\xb5\xf0\xec\xd4\x02\x76\x81\x4a\x8d\xd8\x4d\x64\xde\x3e\x7c\x06\xf3\x9d\xd1\x74\xad\x50\x6b\xab\x70\x13\x20\x9d\x91\xe2\x2d\x2d\x8d\x54\xe2\x49\xf1\x6f\xb9\xd0\x34\x93\x80\x95\x69\x84\xdc\x19\x0c\x92\x94\x6e\x02\xc4\x6c\x95\xf7\xcd\x0a\x6e\x46\x4c\x5d\x92\x02\x3e\x67\x59\x51\x4c\x11\x35\xcd\xf2\x5e\x1c\x40\x10\xd5\xc6\x7f\xd9\xa2\x31\xa3\x08\xd8\x96\x33\xac\x25\x8e\xa5\x85\x08\x8a\x5e\x4f\x03\x4d\xa1\xc0\x4c\xba\x16\x25\xf9\xcf\xbf\x98\x4c\xd3\x9e\x34\x13\x94\x39\x89\xce\xac\x92\x56\x6e\x7a\xc1\x75\x52\x26\xb1\x2a\x22\xb3\x7e\x90\xef\x10\x71\x50\x1a\x7e\x9b\xd2\xe0\x93\x7d\x78\xb4\xe4\xdf\x54\xbe\x0f\x75\x88\xcc\x04\xe7\x6f\x47\x55\xaa\x62\x82\x75\x23\x96\x2d\x63\xdb\x16\x64\xa5\x3a\x9d\x52\x46\xc2\xba\xc6\x2e\x24\xe5\x40\x56\xf2\xf4\x59\xfd\xde\x39\x0a\x5f\x36\x6e\xdc\x01\x9b\x56\xc5\x03\xea\x84\x8c\xd3\x24\x7c\x1a\xa4\x89\xc0\x4c\x01\x5a\x35\xfd\x84\xeb\xdc\x5c\x88\x61\xb1\x42\x0a\x5e\x49\xc4\x8c\xd6\x28\x66\x2e\xbb\x7e\xb9\x18\x83\x47\xd5\x25\xb1\xdb\xa0\x69\x03\x9a\x91\x68\x09\xce\x9a\xd3\x75\x1c\xb7\x3f\x08\x53\x2f\xb4\x47\x95\x74\x81\x22\x8e\x5b\xfe\xb8\x98\x5e\x2f\xa6\xb3\xf3\x05\x94\xf2\x20\xfb\xbc\x9c\x0a\xd9\x20\x12\x36\x3c\x35\x22\x6f\x1b\xf1\x78\x42\x62\xaa\xa6\xd3\x33\xac\x6b\x94\x89\x8e\xb3\xad\x9f\x21\xd1\xa3\x5e\x85\x4d\x3b\xcd\xc1\xa7\xd4\x5b\xba\x72\x8f\x4e\x58\xfd\x3b\xce\xd8\xef\x64\x57\x06\x96\xc9\xd2\x07\xae\xa6\x0b\xfe\x2d\xd5\xe1\x2c\x1e\x0c\x65\xfb\x59\x9a\xb3\x53\xb1\x93\x9f\x99\x24\x00\xcc\xcc\x19\x2e\x32\xed\xaa\x61\x55\x7a\x3a\x81\xd7\x82\x34\xe1\x79\xe6\x47\x49\xce\xcd\x80\xd7\x0c\x79\x14\x2a\x24\x94\x72\x86\x12\x24\xcd\x1f\xe5\x89\x3b\x54\x5d\xec\x43\x9e\x22\x61\x8e\x4d\x2a\x25\x25\xa9\xc3\x7c\xf5\xc7\x25\x8f\xf6\xd5\x89\x3e\x56\xde\xd3\xc9\xaa\x5f\x84\x8f\x2c\xe7\x36\x30\xa3\xbf\x6b\x90\xb0\xd5\x46\xa5\x1d\xf8\xef\xff\x5d\xc0\xeb\xcf\xe2\xf7\x50\x4d\xfb\x97\x43\x59\xb6\x4e\x03\xb2\xab\x56\xfc\x82\x1a\x97\x69\x37\x13\xa3\x2e\x0d\x5e\x66\x4c\x46\xf1\xdf\x46\xa0\xb3\xd6\x9d\xd5\x5f\x0f\xcf\x99\x5e\xf2\xd9\xa2\x82\x1b\x79\x86\x5a\x56\x21\xf3\x93\xa5\x9f\xb7\x93\x95\x6d\x3e\x81\x4b\x9e\xb2\x22\x6c\x8b\x2d\x70\xf2\x20\xe2\x15\xb2\xcf\xb5\x8a\x99\xc1\x00\x72\x16\xac\x13\xac\xda\x15\x3f\xe2\x3d\x0d\xfc\xa4\x60\x19\x92\x47\x54\x36\x7c\x5b\x62\x6b\x61\x20\x21\x61\x30\x00\x14\x8f\xa3\xbc\xcb\xc1\x8f\x1f\x7c\xbc\xc7\xfe\x8a\xf4\x14\x31\x93\x92\xfa\x46\x59\xc2\x84\xce\xea\x36\xca\x25\xaf\xd6\xb1\xae\x17\xcf\x97\x82\x13\x97\x95\x04\xad\x01\x07\x7f\xe8\x1f\xc7\x58\xbb\x75\x4a\xa5\xcd\x2c\xe3\xd1\xbe\xb1\x63\x05\x06\x85\xa2\x66\x9a\x72\x72\x90\x7b\x94\xa7\x29\xf0\x54\x9a\x06\xa7\xef\xd5\x09\x7f\x5b\x39\xb2\xdf\x17\x76\x92\x96\x05\x2f\x4b\xfa\xcf\x96\x4c\x6a\x23\x85\x30\x96\xe3\x47\x39\x1d\x89\xdc\x57\x59\x90\x4d\xa5\x13\xbd\xa5\xd5\x32\x91\x10\x89\x25\x52\xa7\xe1\xe7\xa4\x36\x11\xa9\x38\x55\x4f\xc6\x87\xa4\xa2\xe1\x4c\x9a\xcb\xe8\x00\x08\x29\xb3\x4e\x29\x2d\x5a\xed\x79\xe9\xcc\x68\xe4\xc7\x76\x3d\xfd\x5e\x64\x47\x6b\xd4\x40\x57\xe5\x28\xb2\x7e\x58\x10\xd3\xaf\xc0\x18\x7a\x15\xf5\xf4\x49\xf7\x85\x31\xcf\x2b\x61\x28\xab\x13\xf8\xd6\x02\x0d\x38\x38\xef\x55\x59\x5c\x92\x87\x68\x96\xee\x8d\x8d\x4a\xba\xc4\xbe\xfb\x0f\x24\x1b\xf0\x9c\xb2\xa4\xae\xe8\xe4\x94\x0e\x18\xfb\xd8\x20\x79\x64\x89\x4c\xc8\x8a\x69\x1e\xf0\x00\xef\x84\x5f\x16\xd5\x96\x86\x8f\xb2\xc3\x5d\x22\x6a\x58\x14\x1f\x88\x9c\x11\xf7\x11\xea\xbb\x86\x70\x19\xdd\x6b\x41\xd8\x02\xaf\x3e\x44\x39\x59\xcc\x49\x20\x13\x1a\x81\x68\x65\x1c\x33\x26\xc8\x92\xb5\x79\x43\x88\x29\xa3\x05\xea\x0a\xfd\x44\x6a\xc8\xee\x52\x97\x1c\xf6\xe9\x6a\x7e\x3e\xb9\xb8\xb9\x9a\xb4\x83\xf1\x74\xb5\xf4\xe3\x58\x2a\xd0\x79\xaf\x8a\x4b\xe1\x62\xf2\x7e\x7c\x73\xb9\x50\x47\xf9\x9b\xe0\x52\x7c\x9c\xc1\xd5\xe4\x7c\x7e\x75\xe1\x50\x79\xff\xb3\xa3\xb6\x67\x45\x59\xef\xe7\x57\x90\xc1\x74\xe6\x4a\x16\xe8\xf0\x22\xdf\xe3\x3d\x6a\x69\x93\x24\xef\x55\xa4\xfe\x76\x1b\x93\x51\xdb\xa3\x2c\x2c\x98\x65\xcd\x80\xf5\x5e\x46\xe9\x2b\xf1\xfa\x8c\xde\x09\xfb\x7f\xbd\x65\xf9\x30\x9c\x9b\x0d\x4d\x83\x53\x05\xe7\xba\x8c\xe2\xf8\x87\x96\x82\xe9\x42\x6f\xa3\xc8\x68\xfd\x2f\x0c\xfd\x7c\x18\xba\x01\x2b\x8b\x54\xfd\x59\xba\x55\x98\x98\x6d\xb6\xb1\x9f\xd9\xf5\xd3\x49\x3b\x26\x50\x99\xa9\x28\x13\x09\xac\x29\xbb\x93\x55\x8e\x5d\x5a\x0e\x50\x01\x55\x04\x49\x1d\xa4\x7f\x42\x7e\x57\xcd\x44\x7a\x16\xf4\x2a\x75\xbd\xeb\xac\x31\x76\x99\x5f\x64\x26\xeb\xb2\x26\xb5\xcf\x98\xe4\xcc\xbf\x66\xab\x5f\xda\x47\xa5\xec\x8f\x48\x59\x4e\x7e\x9c\x7c\xfc\x74\x39\xbe\xea\xee\x0b\x47\xa1\x4e\x4b\x17\xf8\xa8\x20\xa3\x5a\x14\x40\x87\x21\xcf\xa0\x22\x0f\x65\x4c\x24\xca\x1a\xbd\x53\x5e\x26\xaf\xa7\xc2\xb9\xc4\xbd\x9c\x92\x68\x52\x75\x85\xd2\x47\x3a\x7a\xa7\xff\x5d\xf2\xc9\x28\x7e\x5a\x2e\x35\xad\x27\xad\x37\x73\xf4\xce\x62\x13\x2b\x2d\x8d\x13\x1a\xbd\xab\x5b\x52\xcb\x35\x54\xdb\x05\x3e\x0f\xfc\x90\x2d\xf3\x74\xb9\xf1\x73\x96\x45\x7e\x1c\xfd\x8d\xb6\x93\x8f\xde\x51\x00\x5b\xed\xba\xeb\xdc\x8a\x2a\x7e\x32\xb5\x6a\x37\x35\x7f\x74\x92\xb1\x8d\x5d\x97\x15\x97\x17\xf3\xa6\x1d\xe5\xf9\xd2\x19\x0c\xf0\x44\x54\x1a\x2f\x72\x35\x57\xdc\x1d\x8d\x20\x4a\x0c\x29\x51\x62\xcd\xd0\x1c\x93\xa5\xdb\x2c\x22\xc7\xe7\x5a\x05\x76\x3d\xe3\x84\xe7\x6f\x26\xc0\xb0\x45\x51\xc3\x19\xb5\xce\xa2\xbb\x8f\xab\xea\x78\xd0\xe8\xdf\xa2\x32\x4e\x57\x04\x60\x44\x5d\xe2\xb1\xdb\x68\x2c\xde\xe1\xd3\x65\x18\x6d\x58\x42\x9e\x11\xa4\x20\x70\x79\x12\x38\x38\x0a\x87\xa3\x8c\x51\x3a\x43\x63\x8d\x67\x0a\x69\x94\x13\x31\xf6\xf1\xf7\x55\x97\x38\x3d\x31\xbd\x68\x4d\xe2\x4d\xfb\xfe\x49\xb3\x93\x68\xab\x94\x76\xa2\x5b\x55\xc8\x1e\x3f\x81\x62\x2b\x41\x56\xb4\xaf\xbe\x71\x9a\x73\x87\x25\x5d\x97\xb9\xb9\x95\x33\xaa\x6a\xc5\x0e\x0b\x55\x34\xd2\x71\x3a\x3f\xd4\x8b\x08\x29\x3f\x64\x38\xb4\x43\x0d\x47\xb0\x1e\xba\x95\x6d\x8d\xe1\x8f\xad\x68\x4c\x85\x11\xc5\xad\xa9\x98\x35\x25\x33\x7e\xd6\xb1\x9e\x8a\xb3\xf0\x21\x27\x41\xce\x80\x94\x5e\xc1\x3b\x79\xae\xf8\x89\xfb\x24\x7d\xc0\x83\x2a\x75\x46\x69\x25\x21\xd8\xe5\x83\x74\xb5\x2a\x1c\x53\xa3\xe4\x8e\x17\xbe\xa7\xa6\xef\x42\xe9\x48\x4b\x20\x94\xb3\x2c\xf1\xe3\x61\x9e\x2e\x0b\xdf\x44\x94\xa8\xee\xd8\x92\x25\xa1\x57\x3d\xfb\x1a\xa2\x54\x7f\xda\x84\x7e\x20\x38\xe8\xa0\xe9\x9b\xa5\xd6\xfa\x42\x10\xd0\x81\x07\x22\x11\x6b\x10\xc8\x16\x51\xe8\x1d\xd4\xaf\x06\x56\x1e\x47\x01\x83\x90\x0b\x38\xe2\x45\xbf\xa5\x16\x95\x11\x06\x83\x62\x73\x20\xe2\xc0\xbe\x04\xf1\x8e\x47\x9f\x99\x48\xc5\x28\x6a\xe2\xa3\x82\xfb\x91\x0e\x04\xbe\xb5\x4e\x5b\xf0\x8b\x11\x07\x3f\xe6\xa9\xfe\xd6\x05\xb0\x21\x1f\x5a\xd8\x6f\x54\xbd\x6d\x04\xb6\x21\x1f\xea\x09\x7d\x3b\xaa\x3f\xdd\x5d\x12\x7d\x59\x6e\xa2\x20\x4b\x39\x0b\xd2\x24\xe4\x3d\x83\xa6\xb9\x21\x5c\x77\x7c\x31\xa9\x83\xf3\x3a\x99\x66\x30\x10\x7e\xd2\xb8\x25\x64\x8e\x4f\xb1\x98\x8d\x8c\x34\x5a\xa7\x71\x28\xa2\xe8\x1e\x41\x14\x01\x4f\x85\xe1\x5e\x9e\x53\xa7\x2c\x15\xd5\x88\x63\x4d\x52\x9e\x4e\xd5\xab\x05\x5c\xbd\x62\x65\x4e\xb7\xf1\x26\x4e\xfa\x33\x23\x7b\xc2\xee\x6e\x6d\x58\x21\x92\x94\x2a\x2e\x4f\x43\x0e\x91\x28\xe0\x49\x9e\x56\x52\xc0\xeb\x9b\x1d\xa0\xb9\xd5\x7f\x04\x9e\x17\x6e\x4a\xe8\x90\x96\x26\xc2\x23\x89\x3e\xd1\xf7\xf9\x10\x31\xb3\xa4\xd1\x13\xf4\xd9\xe0\x89\x6a\x85\xf1\x12\x81\x79\xbb\x6f\x74\x87\x3b\x58\x4b\x5f\x81\x97\x38\xb6\xd2\xec\xbf\x79\x26\xf2\xf8\x3c\x32\x42\x6b\x96\xbb\xbd\xac\xb0\x5f\x44\x68\x29\x26\x94\x58\x66\xa7\xb8\x70\xd0\xfc\xdb\x8b\x0d\xcd\xa2\x43\xf7\x90\x25\xb9\xdb\x1e\x28\x39\xb8\xb6\xa2\x84\xaf\x2a\x5b\xd3\x5a\x92\x38\x52\x80\x70\x4d\xa2\x0e\x1d\xec\x53\x07\x38\xba\x7f\xe1\x6b\xf8\x87\x67\x47\x61\x2d\x14\xe8\x24\x47\xcd\xa9\xfe\xf2\xed\xa3\xcc\x9a\x2c\x7c\x80\x38\xf5\x48\x0e\x46\x48\x57\xb0\xba\x23\x87\x1d\x17\x75\x99\x45\xc5\xe6\x28\x01\xdf\x70\x1e\x23\x71\x2b\x5a\xad\x18\xea\x8c\x30\x01\xb6\xaa\xeb\x42\x18\xbe\x78\xa3\xbf\xe0\x47\x25\xac\xe1\x78\x26\xf9\x32\x61\xca\x8a\x49\xa7\xd7\x33\x0a\x70\x4e\x16\xf3\xf7\x35\xba\x4f\x91\xf7\x41\x8b\x3c\xf0\xe4\x5c\xd7\x36\x27\x03\x49\x0a\x19\xf3\x63\xe0\xeb\x34\xcb\x83\x5d\x2e\xfc\xfd\xee\x76\x19\x23\x67\xe2\x48\x93\x38\xa2\x79\x2c\xa4\xa4\x6f\xbb\x2c\x41\xe5\xa2\xb3\x53\xb1\x2c\xf8\xcf\x9b\xc9\xd5\x4f\xce\x06\x2a\x65\xfc\xf0\x2b\xe7\xeb\xbd\x19\x08\xcb\x7f\x26\xb3\x12\xa6\x9b\x5e\xe9\x9a\xbb\x88\x23\xb8\xa2\x7b\x9c\x13\x77\x4c\xb6\xd5\x04\x05\x3a\x68\xac\x57\x50\xcd\xd0\xcf\xd7\xe9\x83\xba\xdc\xfb\x08\xc4\xb0\x29\xc8\xcd\x8d\x52\x67\xf3\x1f\x7a\x1e\x0c\x0e\x4a\x49\x69\xe7\x9c\xf2\x3a\x16\x13\x8c\x97\x4f\x5c\x2d\xe2\x66\xf3\x14\xb6\x19\xfb\x2c\xef\x4c\xf6\xb9\xa8\xb8\x54\x77\x4c\xcd\x69\x5d\x8e\x4a\xe4\x52\x77\xdb\xda\xa4\x71\xa9\x55\xa5\x60\xa0\xe5\x2e\x67\x4b\xf2\xc3\x35\xf6\x48\x04\x97\x79\x8e\xec\x2c\x2e\x2b\x10\x50\x3d\xd8\x34\x61\x10\xa7\xe9\x56\x60\x2d\x15\xae\xb1\xf6\x0b\x2f\xd5\xa2\x22\x95\xca\x2b\x21\xad\x7c\xca\x8b\x86\x2a\xc9\xfb\x71\x8c\x1e\x37\x8f\xe9\x4e\x24\x56\x30\x05\x0e\x7c\x18\xf8\x89\x4a\x33\x8f\x51\xc4\xf8\x18\x7b\xa5\x90\x08\xa5\x8c\x56\xdd\x31\x0a\x73\x65\x70\xeb\x07\xf7\xda\x94\xa8\x54\x53\x54\xd4\x91\x66\x46\x9c\xac\x40\x02\xe4\x59\xef\x47\xb9\x62\xda\xb1\x6f\xd5\xe1\x77\xe9\x16\x0b\x35\xc6\x8f\x7d\xf1\x31\xbe\x13\x25\x3b\x1f\x60\x95\x31\x16\x0e\x61\x41\x8e\x43\x41\x9a\x26\xa1\xdc\x0b\x3f\xca\x79\x31\x36\x7e\x21\x3b\x73\x82\xd4\xb0\xd1\xc0\xd4\x7c\x51\x5b\xe0\xe5\x8a\x6d\xe9\x7c\x3e\x5b\x4c\x67\x37\x13\x51\x87\xd4\x81\x7b\x0f\x33\x35\xed\x0b\x28\xac\xea\xed\xb2\xa1\x95\x95\xf9\x88\x7b\x9c\xb9\x72\xc7\xed\x31\x3c\xfd\x76\x1b\x7c\x04\x77\x82\xdb\xea\x9d\xfd\xd3\x6f\xa4\x08\x14\xaa\x06\x09\x59\xb1\x41\x47\xe3\x1d\x0c\x14\x0a\xc9\x6e\x85\x02\x26\xb6\xb2\xab\xd5\x15\x55\xf2\x8a\x6f\x65\xa4\x31\x5e\xc1\x88\x23\x42\x0c\x58\xb8\x2b\x0a\xd4\xc2\x2d\xa3\xa4\x1b\x19\xbb\xdb\xc5\x3e\x46\xc2\x13\xcb\x14\x64\xa2\x68\x44\x97\xb8\xaf\xed\xee\x36\x8e\x02\xe3\x5b\x61\x81\x0c\x88\xdb\x40\xae\x0c\x9b\x77\x06\x03\xe1\xb2\x80\xb7\xfe\xd7\x1d\x17\x2e\x03\xe5\xc9\xa0\xc7\x10\xee\x23\x50\xa4\x7c\xc2\x10\x15\xaa\x7a\x16\x83\x81\x74\x40\xf2\xc3\x10\x78\xbe\x5b\x61\x1d\xc8\x9c\x65\x05\x5a\x44\xb8\xc2\x75\x6e\x59\xba\x15\x09\x46\x84\xf9\x12\x57\x1d\x65\x62\xd2\x3c\xc8\xa2\xad\x93\x6f\xab\xec\x39\x85\xe5\xa9\x0d\x37\x21\xcd\xab\x30\x61\x2e\x60\xdb\x73\x54\x67\x9d\xe7\x91\x38\x9b\x86\x36\xc3\x08\xed\x71\x0d\x4b\xc5\x11\xd0\xd8\xb0\x33\x08\x80\x13\xf1\xc6\xf2\x25\xc9\x7d\x7e\x2f\x0b\x0f\x93\x1a\x12\xcf\xa9\x0a\x9e\xcf\x07\x95\x47\xd0\x72\x63\xba\xcb\x5f\xd3\xdb\xde\xaf\xe9\xad\xaa\x93\x2e\xdc\x0e\xef\x54\x59\xe0\xa6\xe3\xaf\xdf\x1b\xc5\xdd\x38\x36\xbb\x6d\xfc\xb1\x98\x46\x79\xa6\xbc\x97\xec\x36\xb7\x2c\xa3\x7f\x8b\xf9\x52\x85\xf0\x60\xcd\xc2\x5d\xac\x8b\xb7\x80\x2e\xb7\xd1\x26\x2a\x39\x20\xb7\x02\x2b\x08\xb9\x50\x0b\x08\x41\x4e\xef\x92\x91\xf0\xab\x2e\xc5\x22\x4e\xce\x88\xf2\xc5\x33\x2d\x32\x2a\x42\xa9\xa2\xbb\xd0\xbe\x53\x13\xa5\x9b\xaf\x39\x25\xd1\xb2\xba\xd4\xff\x36\x72\xef\x01\xba\x59\x81\x99\x30\x72\x97\xe4\xbd\xaf\xa4\x77\x74\x90\xe4\xff\xb0\x75\x68\x85\x24\xee\xfb\xb7\x60\x1e\xa9\x75\xe1\xcd\x4c\x0d\x78\x00\xdd\x76\xe0\xdc\xad\x01\x0a\xcf\xa6\xdd\xe5\x52\x60\x27\x7d\x73\x26\x83\x20\xc9\x3d\x57\xaa\x3b\x31\xeb\x77\xfb\x67\x5d\x03\x39\xed\x77\xfd\xf9\x77\xbe\x63\xeb\xaf\x7b\x41\x92\x0f\x8c\x75\xb8\xd6\x6b\xe5\x12\x7b\x9e\xf8\xef\xba\xab\x4d\xd7\x59\x1f\x16\xe2\xd7\x73\x6a\xaa\x8a\xf3\x8a\xa9\x52\xfe\x1a\xf1\x6d\xc0\xa8\x9e\x93\x48\x96\xf5\x48\x6d\x7e\x4d\x6f\x8b\x3b\x92\xf5\x45\x11\xf9\x38\xc6\xff\x0a\xd2\xa8\xde\x85\xc5\x48\x08\x8f\x6d\xb1\x52\xc4\x97\xa8\x5a\xc0\x9d\xcd\xee\x59\xd6\x13\xd9\x06\xc3\x74\x87\xea\x97\x6d\xc6\x82\x08\x29\xd0\xbe\x7c\xcb\xf2\x46\xae\xe2\xd4\xcf\xff\x8d\xb3\x24\xec\xc9\xc4\x88\x23\xe8\xfe\x3f\x5f\xfe\xbc\x5a\xbd\x31\xfe\xde\x76\x9d\xa9\x8d\xa7\x1f\x3f\xde\x38\x13\x80\xee\xdb\xfd\xf2\x12\xaa\x93\xb7\xea\x86\x66\x3b\xa6\x5c\x1c\xc5\x62\x23\x0e\x3e\x7c\xca\x28\x22\x92\xa1\x8e\x29\xf7\xa5\xea\x89\x65\x6d\x6a\xb5\xb5\x9b\xc4\xd1\x99\x47\x23\xbe\x4c\xf0\x2a\xc5\xcb\xc4\x4f\x5e\xea\x7c\xfe\xcd\x38\x9f\x93\xe7\x3f\x1f\x63\x01\x47\x9d\xce\xcc\x9f\x1d\x72\x12\x4d\xc3\x1d\x7d\x0e\x56\x05\x35\x15\x50\x01\x14\xe2\x6f\xba\x67\xa1\x13\x46\xa5\xb0\x47\xf1\x1d\xad\xa9\xd6\x59\x41\x47\x52\x10\x96\x2c\xbe\x22\x73\xa1\x1a\xb2\x65\xad\x8b\x7d\xa7\x22\x0b\x62\x55\x8b\xea\xd2\x38\x72\xf3\xc9\xaf\xc5\x97\x8f\xa2\xb0\xf5\x19\xa8\xce\x8f\xd9\x6c\x93\x99\xd6\x95\x57\x83\x34\xde\x6d\x12\x11\x1d\x82\xd2\xe3\xe7\x88\x3d\xe8\xca\xb2\x94\x71\xa5\x8f\xfb\x54\x24\x93\x01\x00\x50\xcb\x42\x0f\x15\x27\x9b\x14\xf1\x65\xc6\x38\xcb\x3e\xb3\x50\x27\xcb\x54\x2c\x93\x15\x21\x24\x2a\xae\x8e\x67\x3f\xf5\x44\x60\x0d\xe5\xaf\x42\x45\x9e\xc8\x60\xd5\xb7\xf2\x61\x41\x57\x56\x7a\xfc\x05\xe7\x61\x7a\x58\x18\x03\x12\x39\x9a\xbe\x37\x1f\x69\xb2\xab\x07\x3d\x1d\xc9\xde\x96\x5d\xf8\xfb\xdf\xf5\x8b\xb3\x8e\x45\xd7\xb0\x23\xe3\x7b\x49\xe4\x7a\x2d\xaa\x84\xdf\xb3\x47\xbd\x91\x9e\x37\x8c\x42\x73\xb3\xcf\x3a\x86\x29\xe5\x09\xbd\xd2\x36\x55\x3a\x3e\xc8\xe7\xea\x20\xfd\xe1\x1e\xc8\x11\xf0\xa2\x80\xe5\x29\x45\x99\xed\x1a\xd0\xd4\x79\x71\x6f\x4d\xe7\x2c\x9d\xf0\xb7\x0d\xff\x6e\x16\x6e\xc6\x15\x70\x99\x53\x08\x00\x70\x08\x33\xcd\x50\xd5\x43\xab\xec\x53\x55\xcc\xa7\xdb\x27\x18\xe2\x39\xda\x45\x96\xfe\xdd\x9d\xad\xca\x16\x1c\x1b\xf4\xba\xe5\xab\x2c\x16\x27\x83\xcb\x7e\x7e\xcd\x7f\x21\x57\x31\x54\x64\x6f\x53\x7e\x7a\x4a\x6c\xce\xe1\x67\x40\x09\x94\x85\x12\x4d\x33\x92\x7d\xc0\xeb\xa3\xd5\xcb\xdb\x94\x57\x53\xb3\x96\x37\xa7\x19\xa1\xba\x2b\x37\xcb\xf2\xf4\x95\x72\xcc\xd5\xf3\xb4\x1a\xf8\x49\xe8\x0a\x56\xeb\x58\xd6\x05\xab\x32\xbb\x72\xb8\x32\x17\x50\x9c\xa1\x51\xb9\xfd\x90\x82\x4d\x9b\x83\x26\x2d\xa7\xa7\x6e\x0e\xf2\xf2\xe3\x85\x99\x72\xac\x0a\xed\xdf\x4f\x27\x3f\xa8\x79\x98\x89\x11\xc6\xd7\x25\xfd\xa1\x05\x40\xe4\x3f\x65\x54\x92\xb7\x5e\x96\x62\x8c\xf1\xef\xf5\xdb\x57\xdc\x12\x21\xac\xb7\x75\xc9\x19\x8a\x21\xda\x66\x57\x40\xe3\xad\xb1\xe3\x65\xe8\x39\x3e\x2f\x54\x6b\x8c\xe4\x40\x12\x84\x0f\x9e\x01\xf1\xc8\x73\xfe\x0d\x10\x4f\x25\xc1\xd9\x0b\x60\x9e\x0a\xa6\x79\x36\x44\x43\x09\xf8\xfe\xf9\xf0\x8c\x71\x7c\x2f\x80\x67\xac\x06\xcf\x88\x68\x6a\x66\xfd\x44\x44\xf3\x71\x82\xb3\x6e\x83\x68\x50\xfb\x38\x44\x0e\x8c\xc4\xe0\x68\xc3\xfa\xd5\xd7\x74\x6c\xf8\x9e\xfe\xe1\x68\x60\xe4\xd5\xa9\x45\x5a\x16\x3c\x1e\x87\xbb\xd4\x7a\x68\xd0\x8e\xbb\x46\xfa\x5e\x54\x47\x5e\x8d\x72\x32\x24\x0d\xd8\x2b\xf0\x0a\x3c\x67\x9e\xf8\x3f\x0e\xd1\x99\x48\xe9\xc9\x88\x4e\xe2\x75\xb9\x58\x14\x49\x64\xff\xbd\x02\x17\xf5\xf5\xf9\x71\xb8\x8d\xee\x28\xe3\x8a\x21\x14\x8b\xc4\x2d\xe5\x05\x76\xc6\xd7\x9d\x57\xf5\xb9\x1c\x41\xb1\xa9\xa0\x48\x0b\xcf\x37\xb9\xc6\x7d\xea\xa9\x48\xb6\xa0\x1f\x63\xf6\x84\xa5\xbf\x5a\x51\xf2\x0c\x39\x1b\xf1\x26\xd9\x6d\x96\xf4\x56\x7c\xa9\x5e\x22\x8f\xff\xa6\x31\x5f\xa4\xb8\xd4\xd6\xe4\x9a\x2e\xb0\xeb\xf2\x8e\xf4\x6a\x8e\x77\xb4\x43\x23\xa2\xb9\x17\x86\x39\xd1\x98\xb7\xbc\xf7\x5d\xd3\xbf\x0a\xc1\x79\xf8\xfa\xed\xab\xa9\x9d\xb6\x20\x0a\xa5\x54\xf5\xea\xc4\xeb\xf6\x4d\x1f\x33\x13\x94\xbd\xaa\x67\x72\x6d\x86\x85\x5e\x51\x43\x33\x58\x07\xe8\xce\xe8\x79\x43\x99\x7f\x60\x7b\xb7\xa4\x3a\xe4\x10\x54\x3e\x2e\xf9\x1a\x6f\xef\x68\x5c\xbe\xf5\x03\x06\x09\x02\x7c\x30\xcc\x58\xac\x9f\x8d\x20\x19\xa6\x51\xb8\xaf\x9f\x26\xff\xe9\xb5\x70\x80\xb6\x1c\xd9\x71\xbe\xa6\x2b\x08\xc5\xc9\x0d\x13\xbe\x95\x2f\xd5\x24\x3c\xe7\xc0\x1a\x9f\x34\x8e\x4b\x9e\xd7\x81\x55\xe0\x47\x39\x5f\x23\x86\x5f\x07\x43\xc7\xc2\x64\x48\x1e\x2e\xda\xcc\xec\xd0\xe0\xe3\xd2\xe4\x03\xe9\x9d\x9e\xa6\x65\x47\x6c\xfc\xf3\x40\x63\x48\xcb\xa6\x6c\x92\x15\x13\x00\x45\x26\x08\x7d\xf9\x6d\x67\xa1\x0f\x13\x4c\xd5\x32\xfe\x30\x9b\x5f\x2f\xa6\xe7\xd7\xa5\x9b\x39\x82\xab\xf9\x0f\xcb\xf3\xf9\x8d\x0a\xf6\x55\x7f\x95\x6b\x3a\xaa\x3e\xfa\xbd\xdd\x99\xed\x88\x24\xac\xc5\x50\x17\xcb\xe4\xba\x1f\x15\x82\xd1\x74\x4d\x5c\xd9\x30\x5c\x7b\x70\xc4\xfa\x8f\x5e\xbb\x15\xc1\xd5\x6e\xa1\x46\xc8\xd6\x73\xac\xb8\xd9\x71\x51\xb6\x96\x97\x01\x17\xd4\x13\x97\x4a\x77\x63\xd3\xc7\xf2\xb2\x0b\x83\xeb\x59\x39\xd8\x1f\x5b\xa9\xcc\x68\xa2\x37\x32\x6b\x47\x09\xfc\x4c\xd9\xf5\x64\xc4\x36\x4b\x42\xfa\xd7\x2f\x42\xad\xa6\xfd\x72\x86\x70\x5e\x5c\x45\x19\x51\xd6\x19\x0c\xb0\x9a\x41\xec\x0b\x6b\x2b\x8d\x42\xe6\x70\x3f\x63\xa0\x93\x54\xb3\x50\xa4\xab\xec\x8b\xbc\x94\xd5\x44\xe5\xe0\xdf\xf9\x51\x61\x4a\x30\x6c\xca\x68\x56\x18\x76\x06\x83\x6b\x3d\x5f\x51\xf5\xe6\x51\x2c\x07\xb3\xb2\xd1\x60\xae\x1c\x6f\xc8\x9c\x0a\xdd\x1a\x59\x1d\x4a\x99\x8d\x70\x2b\x87\xcf\x41\xdf\x31\x0e\x9b\x56\x2d\x90\x7d\x33\xb5\xef\x83\xde\x6b\x33\x2b\xb7\xde\x77\xeb\x29\xf5\x38\xbf\x59\x80\x83\x1e\xf7\xe9\x45\x75\x51\x06\x67\x71\x3c\x2b\x21\x50\x2d\xd6\x3d\xc9\x18\x5a\xcf\xeb\xa2\xe3\x0a\xa6\x41\x2c\x4b\x45\x7e\x58\xfc\x04\xae\xac\xf2\xe2\xbf\x00\x1b\x61\xc4\xa2\xb5\x8c\x3f\x3b\x30\xf6\xec\xb9\xe3\xce\x9e\x33\xe6\xcc\x8a\x37\xeb\x54\xe2\x6f\x4c\xc7\x60\x03\xa2\x47\x75\x99\xe6\xab\xfe\xc0\x25\x80\x39\x1d\xc1\xe0\x7f\xbc\x7d\xfb\xcd\x37\x7f\x7e\xfb\xe6\x9b\x3f\xfd\xdb\x1f\xff\xf0\xe7\x3f\xff\xf1\xdf\xde\xfc\x5b\x83\xa7\x7c\x73\x5c\x19\x9e\x51\x9e\x16\x0f\x7a\x7a\x92\x9e\x75\x98\xf6\x34\xea\x7d\xe4\xa7\xef\xf5\x0d\x2d\xad\xb2\x61\x91\x16\xe8\x9f\x8e\xa0\xba\xc2\x3f\x3f\xdb\x0a\xd5\xf4\xec\xf5\x99\x33\x68\xf0\x43\x46\x96\x59\xdf\x79\x37\xc3\x1c\x94\x9c\xa7\xeb\xa1\xbd\x1a\xef\xf6\x1c\x61\x78\xce\x30\x39\x14\x32\x79\x35\x5a\xb4\x15\x6f\x2b\x3b\x35\xd9\xca\x72\x4f\xad\xa3\xe2\x4a\xd1\x7a\x9a\x16\x62\x20\x1b\x97\xd1\x5c\xc9\x20\x4a\x64\xf0\x5d\x25\xa1\x6a\xe9\x3e\x7c\x6b\x85\xd8\x55\x5a\x5b\x80\xf5\x6e\xa4\x1b\x53\x37\x95\xe6\x81\x93\x8d\x36\x4a\x64\xd6\xf3\xb3\x22\xe7\xf9\x6c\xbe\x98\x9e\x4f\xa0\x8b\x06\x68\xda\x4d\x88\xb8\x41\xe1\x91\xfa\x53\xb7\xa7\xf0\x7a\xf8\xba\xdb\x17\x3f\x10\x96\xca\x72\x83\x7a\x6c\xa6\xdb\x37\x87\xd3\x5e\x1c\x76\x89\x8b\x32\xdf\x7e\xc8\x08\xde\xe9\x69\xc6\xee\x48\xa8\xea\x43\xb4\x5a\xea\xbd\x28\x2a\x5e\xd4\xf0\xc5\xe6\x35\x79\x1e\xbe\xd8\xca\x8e\xfc\xea\xad\xfe\xf9\xed\x08\x5e\x7d\x53\xe6\x21\x69\xe0\x32\x1f\x69\xf2\x10\x9a\x6f\x38\xeb\xd4\xf2\xd2\x9a\x49\x36\x58\xe9\x97\x60\x81\x5f\x70\x71\xae\xe9\x6a\xbd\xad\xc4\x50\x85\x50\x4d\xe9\xf6\x48\x81\x8b\xb8\xc1\xeb\x03\x3d\xf8\xf9\x97\xd3\xd3\x82\x39\xaa\xa4\x4f\x44\x2d\x56\xcf\x12\xf7\xaa\x09\x13\x9b\x33\xbf\x1a\xbc\x88\xb9\x39\x34\x05\x23\xfa\xcc\x91\x47\xa4\xbc\x0b\xb2\xf8\x40\x85\xb7\x6b\x95\x9a\xad\x56\x86\xa8\xf6\xe7\xd9\xe2\xc2\x95\x61\x60\xdf\xc6\x7e\x92\xb0\xac\xcb\x81\xf1\x3c\xda\xf8\x39\x53\xc2\x83\x76\x07\x3a\x54\xa4\xe8\x60\xdd\x2a\x43\xa4\xe8\xca\x34\x14\x43\x98\xe6\x10\x71\xd8\xec\x28\x21\x10\xf3\xb7\x2a\xed\x0d\x79\xcf\x15\xb2\x05\x0e\x87\xfe\xbb\x81\x9f\xc0\x2d\x83\x28\xf1\x83\x60\x97\x51\x21\xbe\x03\x8a\x4c\xc8\xe5\xa8\x6d\xc0\x5e\x9f\x9f\x75\xdf\xab\xd6\x93\x0e\x11\xed\x59\x72\x52\xe0\x2d\xf1\x58\xc8\x49\xf4\xb7\x65\xa1\xf1\x12\xbc\x9f\xdf\xcc\x2e\x9c\x15\x2f\xdf\xec\x15\xae\xf5\x55\x9d\xfc\xf8\xe9\x72\x8c\xba\x19\xf1\x06\xfe\xfd\x7a\x3e\xf3\xf6\xa4\x54\xae\xc1\x38\x98\x74\xd7\xbc\xd1\xe5\xc4\xf3\xa7\xa7\xc6\x81\x58\xc8\xe8\xf5\x1f\x4a\x6f\x6b\xae\x67\x7f\x2f\x66\x92\x17\x98\xf6\x5b\x9f\x90\x25\xa2\xf7\xf4\xf3\xc1\xbb\x37\x83\x77\xdd\x4f\xb1\x9f\x74\x07\xef\xc4\x3f\xe0\x2a\x7d\xe0\x5d\xef\xf4\x34\xd9\x6d\xd0\x19\x5d\x2d\xc8\xbe\x99\x7b\xfe\xe0\xfb\x88\x3d\x70\xd8\xd7\xec\xa0\xea\x1c\x86\x72\x5c\x03\x33\xc1\x6c\x4f\xf9\x2c\x97\x0d\x78\xa6\x36\x14\x4c\xed\x3e\xe5\xd8\xc5\x16\x78\x8d\xca\xf1\x0d\x85\x47\x60\x39\xa4\x4c\x32\x2a\x65\xdf\xe2\xbe\xa1\x39\x70\xbd\xcd\xd3\xdc\x8f\x1d\x2f\x4a\xbd\x8b\x1a\x6a\x96\x07\xfb\xed\x63\xce\x78\x21\x5e\x53\x19\x90\xfa\xf7\xa5\xee\xc4\xa8\x3c\xfa\x1b\x2b\x75\xa3\x5f\xc8\x3d\x32\x7b\xcc\xd0\xf7\x14\xe4\xd1\xbb\xbb\x14\xaa\x96\xa2\xbb\x32\x23\xa7\xde\x78\xce\x6c\x5b\xcf\x13\xbb\xd9\x18\x5e\xe9\x30\x7c\xc9\xbf\xb2\x99\xaa\x6a\xf6\x75\xbe\x37\x8c\x43\xae\xd7\x1a\xa2\x9c\xaf\xcb\xd0\xe5\x6c\x64\x43\x96\xb3\x09\x72\xc5\xa7\xa7\xaa\x89\x0b\xe4\xda\x7c\x66\xc3\xa2\xf3\x8b\xed\x9d\x01\x35\x3d\x0d\x2d\x94\xd0\xb9\x0e\x48\x1b\xc6\x16\xe0\x80\x1f\xd7\x00\xf0\xe1\xb3\x28\xc3\xb6\xfb\xd8\x8a\x46\xee\x2d\x2f\x43\x7d\x43\x27\x02\xb0\x1b\xbb\x29\xc0\xdf\x99\xaa\xba\xf2\xb0\xd7\x10\x15\xec\x7c\x85\x7f\x68\xac\xee\x37\xbc\xdd\x07\xc8\xb2\xd9\x1e\x78\xa6\x3f\x91\xb7\xbd\xf6\xb5\x9e\x2c\x9a\xdb\x1b\x9b\x1d\x68\xf8\xaf\xfb\xab\x73\x08\xb0\x56\xdd\xd8\x43\xa1\x41\xa2\x6a\xf1\x7b\x6e\xad\x69\x8e\x3e\x3c\x46\x18\x7c\xde\xee\xde\xbb\xae\xa8\xcf\xdb\xa0\x03\xeb\x92\x6c\x33\x96\xe7\x8f\xbd\xed\xdd\x52\xc0\xab\x4a\xb1\x41\x6f\x1b\x0a\x3f\xba\x65\x52\xaf\x74\xc7\xea\xc7\x7f\x33\x7c\x43\xd3\x6d\x75\x95\x9c\x28\x61\xef\xfd\x72\x7e\xb5\xff\xd2\xc1\xa1\x41\xf4\x24\x6f\x45\x67\x0e\x32\xd3\xa0\xa5\x7a\x9e\xec\x29\xb5\xd4\xac\x06\x1d\xb8\xd1\xc0\x9e\xeb\xdf\x7c\xed\x1b\xae\xfb\x9e\x6b\xfe\xc4\xeb\x7d\xfc\xb5\x6e\x7f\x9d\x5f\xf8\x1a\x87\xd1\x86\x0b\xed\xe7\x21\x57\x38\x88\x86\xad\x48\x78\x10\x0d\xf7\xd1\xec\x75\xc0\x87\x0e\xba\x2c\x3e\x23\xfa\xa8\xee\x8e\xfb\xdb\x2a\x59\x6e\xf7\x69\xc8\x87\x8e\x86\xed\xe8\x73\x09\x73\x95\xfa\xda\x8b\x80\x7a\x27\xc3\x37\x30\x80\x5e\x8b\xe9\xcf\x6e\x3e\x4e\xae\xa6\xe7\xf0\x75\xab\x7d\x92\xad\x3d\x0f\xbe\x82\x93\x37\x6d\xb1\x1b\xf6\x6c\x62\xb2\xd3\x53\x21\x64\xbb\x5b\xca\x48\xab\x0a\x12\x53\x5f\xed\xc7\x70\xad\x31\x9b\xf6\x6d\xa8\x8b\x32\x2b\x74\xc6\x9c\x00\x19\xe6\xee\x2c\x29\x3d\x82\x72\xc3\xdc\x53\x89\x42\xd3\xe6\x9c\x72\xd3\xe2\x4a\xd7\xb9\xa6\xe8\x59\x5e\x8e\x17\x93\xab\xf1\x65\xa1\xd8\xba\xbe\xf9\xd8\x5b\xd7\x40\x06\xfd\xee\xb8\xd0\x91\x31\x76\xc8\x72\x3f\x8a\x59\x68\x53\xc2\x36\xf9\x44\x0c\x7a\x58\x4a\xcf\xe8\x21\xe8\xc3\x7c\xa6\xab\xb8\xee\x5d\x48\x15\x27\xd1\xc2\x1a\x41\xd7\x73\xb3\xcc\x46\x8b\x7e\x4d\xb7\xcd\x40\x5e\xc7\xc7\xb7\xe8\xd8\x84\x71\x6f\x3f\xf9\x16\x1f\xd5\x81\x3b\x75\x50\xf7\xb2\xd3\x74\xa8\xe6\xac\x79\xee\xe7\xfc\xf9\x0e\x36\x68\x7d\xb0\x07\xc8\x9d\x85\x1a\x18\x37\xa4\xb0\xa1\x0c\x0c\x03\x89\x07\xef\xa7\x58\xbc\xba\x27\xeb\xc0\x70\x63\x47\xac\xd2\xe2\x6f\xba\xc4\xa8\xb4\x95\xfe\x5a\x8c\xec\xe8\xdd\x26\x38\x4e\x81\xa6\x16\x9f\x88\xe3\x73\xf8\x8a\x49\x63\x70\x19\x83\x8c\x1c\xe6\x60\x1b\x75\x8c\xcc\xd3\x2b\x9d\x57\x10\xc1\x5c\x05\xa5\xd6\x9a\x2d\x8f\xd4\x16\x34\x88\x5b\x2d\x44\xad\xfd\x62\xd6\x1e\x11\xeb\x10\xf1\x6a\x49\x4e\xa2\xca\x9a\x78\xa0\x74\xf5\x34\xc9\xca\x64\xc3\x9c\x8d\xf6\x8b\x5a\xf6\xec\x5f\x42\xca\xda\xbb\xcb\xb5\xe9\x42\xd5\x25\xe8\xa9\x7f\x2c\x63\x96\xdc\xe5\x6b\xaf\xc5\xa1\xec\x71\xa1\xd8\x73\x20\x6e\xe7\x8a\xfd\xe7\xa0\xf2\xf1\x36\x57\xec\x6b\x2b\x65\xb6\x65\x53\x5b\xb2\xaa\x50\xd1\xec\x04\x6b\x3e\xdc\x25\xc6\x18\x2d\x28\x55\xbd\xce\xc7\xd1\x79\x43\xd7\x87\xe8\xa3\x4a\x3d\xaf\xd5\x5a\x4b\x3a\xa9\x86\x0e\xac\x4f\xda\x48\xd8\x8a\xc9\x6d\xbd\xa6\x42\x67\x0f\x5f\x1f\xb2\xcb\xc5\x67\x07\x72\xbd\x40\x8a\x4b\x9b\xf3\xad\x6f\x55\x47\xe9\xdb\xc9\xf3\x0e\x34\xd7\x98\x0f\x6f\x3f\xe3\x6b\x7a\x40\x45\x2e\xb6\x97\xce\xb8\xc4\xeb\xd2\x04\x30\x88\x40\x52\xaa\x68\xd8\x92\xc5\x6d\x3f\x2f\x63\x2f\x4c\x62\x89\x6c\x0e\xee\xa3\x73\xa6\xb8\xbf\x55\x86\xbb\xc2\x14\xe9\xd9\xd7\xb3\x44\xc7\xb8\x48\x9b\x5b\xe9\xde\xc9\xb2\x0b\x58\x79\x1f\x8f\xdf\x46\xc9\x8f\x1d\xa4\x60\xd5\x6c\x51\x3d\x5d\xb8\xf9\xd8\x6b\x44\xf1\x37\x9f\x3e\x4d\xae\x7a\x99\x74\x73\xe1\x3f\x9f\xfc\x72\x7a\xba\xb8\x5e\xfc\xcf\xab\xf1\xec\xc3\xc4\x83\x01\x5c\xce\x7f\x68\x68\x50\xdb\x77\x43\x1e\x43\x93\x4f\xab\xc1\xea\x6d\xf0\xef\x3f\xf3\xe2\x25\x1b\x0c\x92\x0f\x0e\xf8\xd0\xc4\x43\x78\x09\x76\x1c\xe1\x47\x7b\xfe\x76\x9f\xb6\x61\x0e\xe2\xd6\x69\xa4\xea\x22\xf3\x97\xf4\xd1\xb2\xf4\xac\x4a\x97\xa1\x7c\x57\x0a\x18\x77\x28\x5b\x3d\xc8\x78\xed\x38\x07\xe1\x08\x31\x11\x89\x1e\xea\xc5\x77\xdc\x49\x6a\x89\xc3\x9f\x9e\xa2\xe1\x0f\x46\x90\xa9\xa7\x34\x35\xf1\xd8\x19\x5e\xc0\xdd\x9c\x76\x8b\x40\xf4\x83\xd3\x58\x5a\x76\xde\x36\x99\x10\xcc\x58\xb8\xe9\xec\xfd\x5c\xf6\x20\x63\xe1\x4c\xfe\xfe\xab\x3d\x31\x7c\x72\xd0\x76\xa3\x10\x57\x2b\x07\x29\x4b\x11\xf1\xfd\x10\xa3\x27\xcd\xdf\x95\x48\x7e\xeb\x6d\x14\xba\x5f\x7d\x96\x01\x79\xbc\x88\xc8\x33\x28\x6c\xe0\x63\x16\x31\x3f\x8e\xf2\xc7\x5e\xd1\x50\x49\xd5\x22\x80\xad\x45\xec\x25\xc4\xf7\x9d\x92\x8f\xa2\xc4\xa9\x3d\x2d\x82\xf4\x41\x7b\x30\x51\xc7\x9a\xdf\xa4\x9f\x9e\x9e\x5f\xfd\x68\xf0\xe1\x6a\x7e\xf3\x49\xa9\x6c\x69\xd0\xf1\x35\x7c\xf6\xc9\x97\xf8\xb3\x3f\x14\xc9\x22\xc4\xde\x79\x7a\x00\xbd\x18\xca\xbf\xdf\xee\x74\xf8\x23\xcf\xd9\x46\xde\x8b\xea\x21\x55\x8a\x37\x95\xe6\x8b\x65\x3b\x97\x54\x7b\xe6\x8b\xf0\xcd\xc9\x30\x60\x87\x32\x67\x75\x6d\x2e\x44\xf8\x63\x74\x4f\x4f\xaf\x26\x1f\xce\x2f\xc7\xd7\xd7\x9e\xf4\xc0\x18\x5f\xd3\xcc\xc5\x7b\xd9\x57\xdf\x3d\x78\x91\x92\x6b\x4f\x01\xb8\xa2\x53\xf1\xec\x98\xde\x8a\x63\xb7\x3b\x2c\x8b\x68\x47\x74\xea\xe8\x90\xb7\x3b\xab\x30\x7d\x48\xa8\x64\x18\xfa\x55\x59\x67\x55\x12\xb4\x21\x1c\x66\x8c\xa7\xf1\x8e\xaa\x0d\x80\xc3\xae\xb5\xbc\x98\xff\x30\xbb\x1e\x7f\xfc\x74\x39\x11\x58\x37\x1c\x16\x21\xec\x34\xb7\xe2\x57\xed\xc5\x28\x66\xc3\x20\x2c\x5f\x8c\x3a\x77\x25\x04\xe0\x8d\x8c\x30\x1d\xea\x9c\xe1\x1d\x4b\x30\x6b\x58\xcb\x21\x78\xcd\x05\xd3\x55\x17\x86\xf6\xf0\xac\x76\x91\xa0\x5a\xc6\xca\xd2\xd6\xd5\xa8\xcc\x1a\xe0\xfa\xe0\xbb\x64\x8d\x5d\x90\xca\x3a\xa3\xa4\x1a\x99\x0e\x47\x0d\xbd\x07\xa5\x1b\xdb\x6f\x05\x5c\x63\xe1\xee\x88\xab\xe4\x8a\xf9\x9a\x41\x9a\xc4\x8f\xa2\x7c\x0c\xa5\x11\x8b\x12\x1e\x85\x22\xdd\x98\x91\xf0\x1d\x1d\x03\xbb\x1c\xa2\xcd\x36\xcd\x72\xaa\x4e\x83\xef\xbf\x24\x20\x9c\xaa\x51\x9e\xa4\x24\xa0\xc2\x43\x30\xe2\x80\x54\x11\x3f\xec\x50\x1a\xdf\x8c\xc5\xcc\xe7\x22\xb9\x2f\x3f\x2c\xd6\xc7\x7f\xb4\x04\x55\xcc\x26\xb7\xce\x0d\x9f\x3c\x99\xeb\x0e\x55\x7a\x96\xdb\x5f\xb5\x00\x9e\xed\xdc\x77\x7b\xf7\xb0\xd4\x69\x1f\x4d\x27\x3e\x77\xe9\x42\x4b\xea\x92\xf5\xe9\x4b\x73\x43\x0f\xc9\x18\x46\x7a\x42\x46\x64\xbc\xc5\xe7\xab\x05\xe8\x94\x7a\x07\x59\x53\xbf\x2d\x1b\x53\x25\xfc\xc9\xe5\x90\x0b\x9c\x5e\x5e\x53\x50\x0c\x65\x97\x1b\x62\x5b\x91\x84\x73\x69\xfb\x7a\x73\xd8\xb6\x8e\x91\x29\xc9\x42\x28\xfb\x10\x5e\xd8\xda\xea\x9c\xc3\x82\x64\xfc\x24\x2c\xcb\x48\xa5\xbd\x03\xf2\xf2\xf7\x63\x04\x40\x33\xf5\x9d\x48\x3a\x9d\xab\xaa\x90\xf1\xa3\x59\xd6\x7f\x80\x57\x13\x7a\xc6\x32\x20\xe2\x7c\xc7\xe0\xff\x7a\x7b\xf2\xa7\x3f\x7a\x95\x4c\x86\xdb\xbb\xa5\x1f\x7e\x8e\x78\x9a\x3d\x2e\xb1\xf6\xd2\x12\xe1\xb8\x77\xf2\xf6\x9b\x3f\xff\xb9\x6f\xec\xb4\x99\xdc\x59\x7d\x4a\x33\xa3\xf7\x6a\x66\x3d\xfd\x81\x2c\xc3\x4a\xb0\x32\x7a\xf7\x81\xae\xc5\xf5\xa2\x57\xc0\x4f\xbf\x40\x2d\xba\x5d\xb3\x16\x5a\x1e\xa3\x40\x95\x62\x87\xc5\x50\x30\x32\x27\xea\xb9\xaa\xd8\x39\x0b\x2e\x50\xbe\x53\x95\x4e\x91\xf2\x8c\x53\xbd\xa9\x4a\x2a\xca\x30\x5d\x96\x23\x05\x30\xe4\x33\x67\x59\xb7\x0f\xaf\x18\x7b\x25\x73\x7a\x5f\x30\xe3\xc6\x14\x68\xc8\xbf\x27\x3f\xe7\x80\x89\xec\xae\x3a\x09\xac\x51\x14\xcb\xa8\xba\x4d\x68\x04\xd6\x2c\x0e\xc1\xc7\x82\x46\x5c\x76\x5e\x9e\x01\xa1\x24\x0e\x3c\xa2\xb4\xb4\x6b\x3f\x2f\xd0\x12\x0d\xc9\x81\xa7\x1b\x06\x6b\xe6\x7f\x8e\x58\x26\x7b\xf5\x05\x6e\x63\x49\xa8\x93\xa4\xef\x78\xdf\x44\x86\x7e\x0c\x5b\x3f\xf3\x37\x0c\x81\x4e\x2e\x61\xc7\x77\x54\x38\xfd\x96\x89\x1c\x45\xf4\xed\x21\x05\xfb\x6a\xf7\xcf\x55\xf8\x73\x13\x25\x95\xba\x79\xe5\x29\xca\xb4\x2d\x30\x12\x13\x2a\xf8\x4e\x99\x5f\xc3\xc4\x85\x50\x1b\x68\x08\xc2\xce\x2f\xa3\x6b\x5c\x6f\x8d\xdb\xed\x7a\xad\x66\xea\x8e\x48\xb4\xfd\x23\x25\xac\xaf\x87\x5f\x59\x91\x53\xa5\x11\x8e\x2a\x36\x5a\x1b\x67\x57\xe4\x94\xad\x41\x41\x67\x9d\xf2\xf4\xc2\xd2\xf4\xec\xed\x69\xa5\x01\xaf\xda\x84\x84\x9e\xdb\x5a\x28\xa2\x4f\xa3\xea\xb1\x3b\xe4\xaf\x00\x84\x03\x02\xfe\xaa\x47\x72\x70\xcc\xdf\x61\x01\x71\x6a\x40\x3b\x20\xae\x32\x8d\x7f\x45\xc5\x1d\x1b\x15\x67\x87\xc4\x59\x00\x39\x7c\x86\x88\xb8\x2a\xc0\xec\x09\x8a\x6b\x1f\xe5\x66\x5b\x70\xdc\xb1\x73\x14\x09\x57\x5a\x04\xe2\x6a\x5a\x08\xf5\xae\x4b\xe9\x0d\x9b\x6a\x38\x75\xa9\xae\x35\x69\x85\xcf\x0e\x0c\x5c\x3b\x2b\x8d\x2f\xf3\x84\x52\xf5\x33\x88\x90\x35\x16\x05\xdf\xf4\xba\x87\xce\x68\xba\xf6\x40\xea\x2a\x9b\xa8\xb2\x6a\xc8\xcc\x1b\x6a\x9e\x51\xd8\x6e\xd7\x4b\x55\x29\xa7\xef\x5d\x91\x24\xff\xa0\x70\x43\x78\xf9\x90\xc3\x9a\x0a\x60\xe5\x3d\xc1\xdd\xb2\x29\xa9\x63\x7f\xcc\x82\x0f\xe5\x0e\xcd\xdf\x56\x08\xe3\x99\x4c\xbb\xe4\xc8\x0a\x8f\x1c\x92\x77\x58\x2e\xfb\x97\x66\x17\x90\x55\x40\x46\xcc\x8c\x62\x18\x0c\x60\x9a\x00\xa3\x3c\xfc\x52\x1e\x11\x71\x67\x0a\x80\x61\xb3\x8b\xf3\x28\x49\xa5\x04\xe9\x07\x01\xe3\xc8\x88\x87\x45\x6d\x47\x51\x0b\x22\x49\x73\xd5\x21\xcf\xd3\x8c\xc1\xc3\x9a\xe5\x6b\x96\xa9\x0a\xc9\x0f\x2c\x33\xf3\x52\xf4\x45\xfd\x48\x51\x79\x31\x4f\x49\x50\x15\xfd\xe7\x3b\x3f\x06\xce\xfc\x4c\xd6\x94\x1f\x0c\xf0\xb6\xd3\x88\xa5\x5a\x8d\x26\xdf\x29\x2b\xd7\xa0\xe4\x2d\x9a\x8a\xf2\xf9\x5f\x27\x69\xfe\x75\x51\x70\x75\x30\x30\xe7\x7f\x06\xba\xa6\x85\xe0\x87\x11\xf8\xd3\xa4\xb2\x4e\xca\xaa\x11\xa6\xe0\x43\x9c\xe6\x38\xf4\x43\x9a\xdd\x17\x1d\x52\xd1\x9b\x80\xaa\x94\x89\x79\x42\xc6\xf8\x2e\xce\x87\xf5\x39\x12\x8a\x2d\x2d\x47\x84\x10\x6f\x1e\x46\x3c\xcf\xa2\xdb\x1d\xc6\x13\xe2\xbc\x5c\x99\xf2\x7a\x95\xab\xf6\x0a\x3f\x7b\x25\x7b\xa8\x67\x3d\x5f\x5f\xf6\x41\xfc\xcf\x93\x9f\x38\x1c\x6c\xad\x30\x2d\x05\x6a\x25\xf0\x2a\x19\x2b\xac\x77\x30\x7a\x07\xaa\x38\x4e\x85\xd9\xd8\x37\xc3\x76\xa3\x3b\xa4\x1d\x02\x6d\x78\x82\xc4\x53\xcc\x27\x8d\x95\xf5\xd6\x14\x75\x0e\xb8\xc9\x8e\x9e\xac\x75\xc9\x4a\xdd\x45\x33\xe1\x19\x50\x2d\xf9\xbf\x87\xb9\xa7\x6e\xce\xec\x67\xcb\x64\xb7\x11\x95\xb5\xab\xec\x78\xc1\x74\xf5\xad\xb6\x6d\x3c\xb5\xdd\x08\x5b\x20\xeb\xa2\x37\x77\xf1\x32\x54\x92\x09\x93\x79\xcf\x83\xf9\xf7\x68\x13\xab\x29\x48\xeb\x48\xf3\xd5\xec\x9c\xe5\xaa\x0b\xbd\xcf\xb1\xb3\x32\x3f\x87\xa3\x56\x53\xb9\x68\xf5\x09\x52\x15\xcb\xbb\xcd\xd9\xca\x2a\xe5\x5b\x3a\xef\x7d\x35\x7a\xcd\x52\xd4\x95\xe4\x01\x76\x15\x2b\x7d\x9c\xdf\x8e\xcc\x22\xbe\x16\xa7\x62\x93\x60\x09\x08\xd1\x6a\x99\xa4\xb9\xb1\x0c\xbc\xbc\xa5\xd0\x7d\x17\x7d\x7c\x61\x5a\x58\x4c\xd6\xae\xf8\x54\xae\x76\x5f\x2d\xd6\xe6\xa8\x4c\xdf\x10\xcd\x5b\x57\x5e\xfe\xd9\x6b\xca\x23\xa5\xb0\x09\x6b\x78\x3b\x78\x3b\x7c\x33\xc8\x82\x3f\x10\xc1\xb1\x40\x09\x84\x09\x4d\x05\x80\x8b\xc5\x93\xc6\x1f\x22\xa5\x1a\x41\x8a\x0b\xbb\x6d\xe8\xe7\x2c\x74\x50\x2d\xca\x08\x95\x09\xbc\x62\xd0\xd9\x34\x61\x56\x26\xa8\x34\x53\xdd\xa5\x54\x03\xb2\xa0\xa2\x82\xde\xe6\xa9\x24\xc5\x44\xdb\x4c\xbf\x1b\x30\x6e\xe0\x73\x10\x39\x55\x95\x95\x68\x92\x0d\x79\x8e\x22\x49\x2e\x04\x8b\x64\x2d\x49\x1f\xa8\x04\x58\xa5\x5c\xbf\x46\x2d\x92\xea\x95\x6a\xf2\x1e\x4a\xc0\x0e\x44\xf8\x4d\x33\x73\x29\xee\xe0\xff\x63\x95\x52\x0d\x56\xed\xa0\x52\xa9\xea\x5e\xdb\x74\xec\xf9\x4b\x7b\x76\x0c\x02\x58\xb7\x1e\xdb\x59\x9b\xe5\xc8\x2e\x96\xfd\x38\xc7\xb3\x0b\xa3\xab\x3a\x83\x82\x2a\x55\x3e\xbf\xaa\x6d\xf2\xad\x00\x98\x7f\x60\xb9\x4d\xeb\xc8\xaa\xde\x0b\x83\x01\x99\x99\xa8\xc8\xa3\x46\x69\xf0\x76\xf8\x06\xa2\x04\x4e\x86\x5f\xe0\x81\xc1\x8e\xdb\x39\xe7\x48\x99\x1d\x31\x7e\x4c\x85\x2f\x57\x3d\x34\x67\xa9\x4e\xe7\x81\x8b\x4b\x96\x31\x2c\xb3\x83\x19\xa8\xe5\x7a\xdd\x8d\x7f\xfe\x05\x2e\x26\xef\xc7\x37\x97\x0b\xe8\xfe\xef\xff\xd3\x3d\xb3\xe4\xa5\x7f\x15\xfd\xfc\xe7\x2c\xfa\x69\xa3\x98\x0a\xcf\xe4\x0e\xd5\x3f\xac\xd4\x67\x55\x6f\x50\x05\xa8\xd3\x91\xe3\xe1\xdf\xff\x0e\xd9\x99\x93\x7d\x6b\x50\x91\x36\xd2\x99\x86\x42\x98\xcf\x5a\x0e\x54\x66\xf1\xa9\x2c\xe9\x37\x2b\xfb\xf9\x2c\x2b\x7e\x9e\xba\x9d\x4e\x0c\x84\xd5\x73\xd4\x8b\x9a\x9a\x9d\xbf\x41\x39\x44\xaa\xd4\x81\x45\xe5\x08\x49\x13\xd7\x85\xff\xa7\x19\xe5\x3e\xf8\x79\xee\x07\x6b\x54\xe3\xb3\x2f\x11\xcf\x4d\xf0\xd4\x9a\x22\x62\xfc\xdd\x75\x01\x10\xc1\x6c\xfc\x24\xb4\x8c\x42\x1a\x31\x92\x6c\x69\xb4\xa8\x42\x96\x7c\x5b\x62\xb5\x1a\x6f\x7a\xc6\x36\xa9\xd8\x78\xfc\x92\x57\xa9\x21\x67\x7f\x05\x9f\x07\x55\x60\x74\x33\x99\xc6\x04\x87\x6a\x3a\xb4\x4f\x71\xc4\xf3\xd1\x3b\x91\xca\xaa\xd8\xb8\x5f\x3c\xe7\xcd\x99\xbe\x6f\xda\x4b\x77\xb9\x3f\xd1\x1e\xc1\xa3\x74\x38\x7d\x43\xf4\x24\xae\xb3\x39\xfc\xcb\x74\xbc\x6c\xc1\xe6\x38\x58\x4b\x3a\x57\xc7\x45\xf8\x7f\x07\x00\xc2\x5a\xee\x71\xc5\x92\x01\x00"),
 		},
 		"/idempotent/matcher-functions.sql": &vfsgen۰CompressedFileInfo{
 			name:             "matcher-functions.sql",
 			modTime:          time.Time{},
 			uncompressedSize: 5885,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x97\xdf\x4f\xe3\x38\x10\xc7\xdf\xfb\x57\x7c\x57\x5a\x2d\x8d\xae\xa9\x54\x1e\x41\xdd\xdd\xd0\x33\x2c\xab\x34\xe1\xd2\xa0\xbb\x13\x42\x91\x49\x87\x36\x47\xb0\x4b\xec\xb0\xcb\x0b\x7f\xfb\x29\x3f\x5a\xfa\x2b\xa5\x94\x16\x6e\xa5\xf3\x4b\x2b\xdb\x49\x66\xbe\xf3\x99\x19\xbb\xe3\x31\xcb\x67\x70\x3d\x78\xec\xcc\xb6\x3a\x0c\xc7\xe7\x4e\xc7\x3f\x75\x1d\xf4\x3a\xdf\x58\xd7\x0a\xce\x3c\xb7\xdb\xbc\xe5\x3a\x1c\x52\x52\x8f\xf9\x15\xc5\x0a\xff\x28\x29\xae\x8c\x9a\xc7\xfc\x73\xcf\xe9\x2d\xdb\x19\x8c\xa4\x8a\x74\x74\x4f\x35\xab\x87\x8f\xd7\xa9\x08\x3f\xd6\x00\xa0\xc7\x6c\xd6\xf1\x61\x79\x9e\xf5\x77\x3d\x9f\x29\x47\xb9\x10\x4a\x1e\x93\x0a\xa9\x1e\x37\xa3\x7e\x03\x66\xcb\x80\x69\xc2\x6c\x21\x12\xfd\x28\xe4\x9a\x14\x84\x84\x4a\xc3\x21\x72\x5b\xa6\x5f\x71\xec\xb9\xdd\x62\x36\xc8\x0d\x0c\x88\x87\xc3\x40\xd3\x4f\x5d\xda\x6d\xee\x05\x81\xe0\xb7\x14\x04\x7b\x06\x68\xfa\x51\x9b\x1d\xfb\xf8\xee\x9e\x4e\xbc\xee\x58\xbe\x65\xbb\x27\xcd\xfc\x41\xcc\x7c\x26\x1b\xae\x83\x7a\xdc\xbc\xa1\x07\xb4\x41\xf9\xaf\xe5\xfc\x8e\xb8\x79\xcf\xe3\x94\xf2\xb9\xfc\x9f\x31\x79\xce\x38\x38\x58\xa9\x52\x29\x91\x6d\x39\x27\xe7\xd6\x09\x43\xef\x0f\x1b\x3d\xdf\x3a\xb2\x19\xce\x2c\xcf\xb2\x6d\x66\xa3\x67\x1d\xb3\xc3\x5a\xc7\xed\x76\x99\xe3\x67\x26\xac\x0c\x55\x19\xa3\xd3\x1e\xf6\x12\xd2\x69\x22\x14\x38\xca\x45\x5c\xcb\x04\x7a\x48\xf8\xde\x73\x9d\xa3\x06\xc6\xb2\x20\x52\x88\x06\x42\x26\xd4\x6f\xc2\x1f\xd2\x64\x7f\xc8\x05\xae\x08\xa9\xa2\x3e\xb4\x2c\xa6\xc1\x07\x3c\x12\x4a\x83\x17\xa2\x83\x27\x09\x7f\x40\xaa\x22\x31\xc0\xd7\xcf\x90\x09\xbe\x40\x8e\x28\xe1\x5a\x26\x6a\xef\xb0\x76\xe2\x59\x8e\x0f\xf6\x17\xeb\x9c\x67\xc8\xad\x65\x3f\x7c\x17\xa3\x44\xde\x06\x09\xf1\x3e\x25\x87\xb5\x9a\x39\x37\x40\x77\xc8\xc4\xd3\x91\x14\x0a\xe6\xc2\xa8\xd5\xd6\x64\x9c\xee\x4a\x4c\x5a\x33\xd3\x05\x50\xb9\x6f\x8d\xc2\x51\xb5\x5f\xb5\xe1\x29\x25\x8e\x5c\xd7\x66\x96\x33\x47\xbf\x69\x72\xa5\xd2\x5b\x52\xe5\x8b\x30\xe4\xf7\x84\x5b\xd2\x49\x14\x22\x0b\x01\x22\x81\x82\x09\x29\xd0\x02\x17\xfd\x62\x8b\x90\xe8\xa7\xa3\x38\xcf\x00\x90\xd0\x49\x44\x6a\x3a\x9f\xf2\xaf\x07\x31\x89\x81\x1e\x8e\xbd\x68\xa0\x65\xa0\xbd\x6c\x69\x3f\x5f\xca\x89\x2d\x1d\xfe\xfa\x79\xec\xda\xc5\xfe\xc1\xe5\x52\x1a\x4f\xbb\xdd\xf3\x8d\x80\xa4\xbb\x7a\xa5\x9e\x95\x3a\x4e\x63\xab\x93\x94\x10\x5d\x43\xff\x90\xd3\xa4\x29\xf0\x84\x40\x77\x29\x8f\x1b\x05\xb5\x19\x78\x19\xd5\x53\x82\xae\x8d\xdd\x26\x56\x2e\xc2\xb9\x45\xd4\xca\x3c\x50\x2b\xab\xeb\xfa\xc0\x55\x12\xb4\x06\x42\xf5\x99\xb5\xb1\x61\xf9\xe2\x6f\x68\x19\x33\xc5\x71\x8e\xaa\xf1\xe6\xf7\x41\x6a\x51\xae\xa5\x5c\x0d\x69\xa6\x82\x65\x59\x37\x51\x7f\x8a\x31\x3d\xa4\x84\xa0\x86\x32\x8d\xfb\x10\x52\x67\x15\x71\xb1\xa4\xee\x12\xbe\x05\x7f\x5e\x4f\x60\x35\x80\x59\x01\x0e\x96\x37\xfc\x8d\x61\x33\xcd\xbe\xcc\xa5\x0b\x79\x1c\x63\x6c\xc4\x3c\xf2\x46\xd6\x63\x78\x1c\xcb\x1f\x88\x44\x1c\x89\x48\x0c\x9e\x43\x75\x4c\xea\x5c\xfb\x0e\x65\x2a\x74\x79\x1a\xb8\xa1\x07\x55\x9f\x72\x6a\xe6\x34\xb0\x82\xe3\x0c\xe3\xaa\x06\x55\xbe\xca\xd8\x62\xff\x5e\xc5\xc2\x92\x9e\xbe\x00\xb1\xca\xf9\xcd\x77\xbe\x59\x81\x5c\xb7\x57\x17\x0d\x5b\x8e\x32\x49\xcd\x8a\xb1\x0e\xc2\x33\xa7\xb3\x20\x94\x42\x67\x27\x91\xed\x13\x5d\xf2\xb6\x13\x0e\x9e\x55\xbd\xc2\xc9\xed\x05\xe1\xcb\x16\x63\x90\x8b\xf1\x6c\x00\xb6\xd2\xd3\x66\x83\xf2\xe9\xd3\x86\x3d\xe6\x85\xfa\x17\x0e\x6e\xab\x4a\xbf\x87\xbe\x82\x06\xfc\x05\xfa\x3a\xae\x8f\xfa\xa2\xc8\xc6\x7f\x4d\xe5\x89\x5b\x2f\x20\xbf\xdd\xc6\x87\x76\x1b\xed\xf6\x23\x3e\xb4\x1f\xb7\x98\x06\xd7\x91\xe8\x67\x8d\x26\xc8\xab\x6e\x3d\xfb\xa7\x65\xe1\xd5\x92\xa8\xdd\xd0\x43\x03\x23\xae\x67\x96\x46\x5c\x6b\x4a\xc4\x6b\x6e\xd7\x1d\xd7\xb2\x59\xaf\xc3\xca\x73\x1b\x1f\x0c\xf2\xeb\xb4\xd1\x28\x3a\xe7\xc5\xe5\xc1\x41\x24\xf4\xc5\xe5\x73\x97\xd2\xc9\xa5\x7a\xc5\xa5\xf8\xcf\x6f\xcc\x63\x18\xdf\x85\x67\x1c\xce\xfa\xd0\xd3\x95\x78\xc4\xf5\xee\xaa\xe3\x9c\xee\x15\x52\x2f\x93\x79\x93\x13\x54\xc5\xb7\x85\xd4\xbb\x8e\xfb\x18\xf6\x9d\xc4\x7d\xf2\xf2\x5f\x30\xee\x4f\xda\xbf\x4f\xec\x13\x1a\xd0\xcf\xff\xf3\x7d\x12\xf7\xc7\x37\x8a\x7b\xa1\xfb\xfb\xe5\xfb\x8e\xe3\xfe\xcb\xe5\xfb\xe3\x1b\xe6\xfb\xab\x63\xff\x6f\x00\x00\x00\xff\xff\x1f\x98\x81\xb9\xfd\x16\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x97\xdf\x4f\xdb\x48\x10\xc7\xdf\xf3\x57\x7c\x2b\x55\x25\xd6\xc5\x91\xd2\x47\xaa\xb4\x35\xb9\x85\x52\x39\x36\xe7\x18\xdd\x9d\x10\xb2\x16\x67\x88\x7d\x98\xdd\xe0\x5d\xd3\xf2\xc2\xdf\x7e\xf2\x8f\x84\x38\xbf\x08\x90\xc0\x55\xba\x7d\x49\xb4\xbb\xb6\x67\xbe\xf3\x99\x99\xdd\x9e\xc7\x2c\x9f\xc1\xf5\xe0\xb1\x13\xdb\xea\x31\x1c\x9e\x3a\x3d\xff\xd8\x75\x30\xe8\x7d\x63\x7d\x2b\x38\xf1\xdc\x7e\xfb\x9a\xeb\x30\xa2\xb4\x99\xf0\x0b\x4a\x14\xfe\x51\x52\x5c\x18\x0d\x8f\xf9\xa7\x9e\x33\x58\xb6\x33\x18\x4b\x15\xeb\xf8\x96\x1a\xd6\x00\xef\x2f\x33\x11\xbe\x6f\x00\xc0\x80\xd9\xac\xe7\xc3\xf2\x3c\xeb\xef\x66\x31\x53\x8d\x6a\x21\x94\x3c\x21\x15\x52\x33\x69\xc7\xc3\x16\xcc\x8e\x01\xd3\x84\xd9\x41\x2c\x86\x71\xc8\x35\x29\x08\x09\x95\x85\x11\x0a\x5b\x66\x5f\x71\xe8\xb9\xfd\x72\x36\x28\x0c\x0c\x88\x87\x51\xa0\xe9\xa7\xae\xec\x36\xf7\x82\x40\xf0\x6b\x0a\x82\x3d\x03\x34\xfb\xa8\xcd\x0e\x7d\x7c\x77\x8f\xa7\x5e\xf7\x2c\xdf\xb2\xdd\xa3\x76\xf1\x20\x6a\x9f\xc9\x87\xeb\xa0\x99\xb4\xaf\xe8\x0e\x5d\x50\xf1\x6b\x39\xbf\x23\x69\xdf\xf2\x24\xa3\x62\xae\xf8\x67\x4c\x9f\x33\xf6\xf7\xd7\xaa\x54\x49\x64\x5b\xce\xd1\xa9\x75\xc4\x30\xf8\xc3\xc6\xc0\xb7\x0e\x6c\x86\x13\xcb\xb3\x6c\x9b\xd9\x18\x58\x87\xec\x53\xa3\xe7\xf6\xfb\xcc\xf1\x73\x13\xd6\x86\xaa\x8a\xd1\xf1\x00\x7b\x29\xe9\x2c\x15\x0a\x1c\xd5\x22\x2e\x65\x0a\x1d\x11\xbe\x0f\x5c\xe7\xa0\x85\x89\x2c\x88\x15\xe2\x91\x90\x29\x0d\xdb\xf0\x23\x9a\xee\x0f\xb9\xc0\x05\x21\x53\x34\x84\x96\xe5\x34\xf8\x88\xc7\x42\x69\xf0\x52\x74\xf0\x34\xe5\x77\xc8\x54\x2c\x46\xf8\xfa\x19\x32\xc5\x17\xc8\x31\xa5\x5c\xcb\x54\xed\x7d\x6a\x1c\x79\x96\xe3\x83\xfd\xc5\x7a\xa7\x3e\xdb\xd0\x7e\xf8\x2e\xc6\xa9\xbc\x0e\x52\xe2\x43\x4a\x3f\x35\x1a\xe6\xdc\x00\xdd\x20\x17\x4f\xc7\x52\x28\x98\x0b\xa3\xd1\xd8\x90\x71\xba\xa9\x30\xe9\xd4\xa6\x8b\xb9\xa0\xf0\xad\x55\x3a\xaa\x3e\xae\xda\xf0\x90\x12\x07\xae\x6b\x33\xcb\x99\xa3\xdf\x34\xb9\x52\xd9\x35\xa9\xea\x45\x88\xf8\x2d\xe1\x9a\x74\x1a\x87\xc8\x43\x80\x58\xa0\x64\x42\x0a\x74\xc0\xc5\xb0\xdc\x22\x24\x86\xd9\x38\x29\x32\x00\x24\x74\x1a\x93\x9a\xcd\xa7\xe2\xeb\x41\x42\x62\xa4\xa3\x89\x17\x2d\x74\x0c\x74\x97\x2d\x7d\x2c\x96\x0a\x62\x2b\x87\xbf\x7e\x9e\xb8\x76\xf6\x71\xff\x7c\x29\x8d\xc7\xfd\xfe\xe9\xb3\x80\xa4\x9b\xe6\x4a\x3d\x57\xea\x38\x8b\xad\x4e\x33\x42\x7c\x09\xfd\x43\xce\x92\xa6\xc0\x53\x02\xdd\x64\x3c\x69\x95\xd4\xe6\xe0\xe9\xa8\x26\xe8\xc6\xd8\x3d\xc7\xca\x45\x38\xb7\x88\x5a\x95\x07\x6a\x6d\x75\xdd\x1c\xb8\x95\x04\x6d\x80\x50\xb3\xb6\x36\x31\xac\x58\xfc\x0d\x1d\xa3\x56\x1c\xe7\xa8\x9a\x6c\x7e\x1b\xa4\x16\xe5\x5a\xca\x55\x44\xb5\x0a\x96\x67\xdd\x54\xfd\x19\xc6\x74\x44\x29\x41\x45\x32\x4b\x86\x10\x52\xe3\x82\x96\x94\xd4\x5d\xc2\xb7\xe0\xcf\xcb\x09\x5c\x0d\x60\x5e\x80\x83\xe5\x0d\xff\xd9\xb0\x99\xe6\x50\x16\xd2\x85\x3c\x49\x30\x31\x62\x1e\x79\x23\xef\x31\x3c\x49\xe4\x0f\xc4\x22\x89\x45\x2c\x46\x8f\xa1\x3a\x21\x75\xae\x7d\x87\x32\x13\xba\x3a\x0d\x5c\xd1\x9d\x6a\xce\x38\x55\x3b\x0d\xac\xe1\x38\xc7\x78\x55\x83\xaa\x5e\x65\x6c\xb1\x7f\xaf\x63\x61\x49\x4f\x5f\x80\x58\x15\xfc\x16\x3b\x5f\xad\x40\x6e\xda\xab\x8b\x01\x39\xce\x25\x35\x57\x8c\x4d\x10\xae\x9d\xce\x82\x50\x0a\x9d\x9f\x44\xb6\x4f\x74\xc5\xdb\x4e\x38\x78\x54\xf5\x15\x4e\x6e\x2f\x08\x5f\xb6\x18\x83\x42\x8c\x47\x03\xb0\x95\x9e\x56\x0f\xca\x87\x0f\xcf\xec\x31\x4f\xd4\xbf\x74\x70\x5b\x55\xfa\x2d\xf4\x15\x34\xe2\x4f\xd0\xd7\x71\x7d\x34\x17\x45\x36\xfe\x6b\x2a\x4f\xdd\x7a\x02\xf9\xdd\x2e\xde\x75\xbb\xe8\x76\xef\xf1\xae\x7b\xbf\xc5\x34\xb8\x8c\xc5\x30\x6f\x34\x41\x51\x75\x9b\xf9\x3f\x2d\x4b\xaf\x96\x44\xed\x8a\xee\x5a\x18\x73\x5d\x5b\x1a\x73\xad\x29\x15\x2f\xb9\x5d\xf7\x5c\xcb\x66\x83\x1e\xab\xce\x6d\x7c\x34\x2a\xae\xd3\x46\xab\xec\x9c\x67\xe7\xfb\xfb\xb1\xd0\x67\xe7\x8f\x5d\x4a\xa7\x97\xea\x35\x97\xe2\x3f\xbf\x31\x8f\x61\x72\x17\xae\x39\x9c\xf7\xa1\x87\x2b\xf1\x98\xeb\xdd\x55\xc7\x39\xdd\x57\x48\xbd\x4c\xe6\xe7\x9c\xa0\x56\x7c\x5b\x48\xbd\xeb\xb8\x4f\x60\xdf\x49\xdc\xa7\x2f\xff\x05\xe3\xfe\xa0\xfd\xdb\xc4\x3e\xa5\x11\xfd\xfc\x3f\xdf\xa7\x71\xbf\x7f\xa5\xb8\x97\xba\xbf\x5d\xbe\xef\x38\xee\xbf\x5c\xbe\xdf\xbf\x62\xbe\xbf\x38\xf6\xff\x0e\x00\x1f\x98\x81\xb9\xfd\x16\x00\x00"),
+		},
+		"/idempotent/promql-functions.sql": &vfsgen۰CompressedFileInfo{
+			name:             "promql-functions.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 20361,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x3c\x5b\x73\xe2\x38\xb3\xef\xfc\x8a\x7e\xd8\x53\x81\x89\x21\x61\x1f\x21\x4c\x15\x93\x71\x32\x54\x11\x93\x05\x72\x76\xf7\xa4\x52\x94\x00\x01\xae\x18\x9b\x95\x64\x32\x73\xce\x9e\xff\xfe\x95\x6e\xb6\xe4\x0b\x97\x2c\xb3\x3b\xd9\xca\xbc\x4c\x90\x5b\x7d\x53\x77\xab\xbb\x25\xbb\x5e\x87\xf1\x0a\xc3\x22\x0e\x67\xcc\x8f\x42\x0a\x7e\x08\x6c\xe5\x53\x58\xf8\x01\x06\xbc\x45\x41\x8c\x18\x86\x7b\x12\xad\x7f\xe9\x1b\x60\xd1\x16\x13\x60\x2b\x0c\x14\xad\x37\x01\xa6\x10\x2d\x00\x55\xea\x75\xa0\x98\xf8\x98\x3a\xb0\x88\x08\xa0\x20\x90\x30\x0c\x6f\x24\x04\xfc\x11\x63\xf2\x0d\x10\x83\x28\x9c\x61\x07\x68\x04\x6c\x85\x98\x80\xe2\x8f\x7c\x4c\x60\x86\x42\xd8\xc4\x74\xc5\xb1\xb1\x15\x5e\xc3\x3c\x7a\x09\xc1\x0f\x19\x87\xc5\x30\x47\x0c\x4d\x11\xc5\x0d\x18\x1b\xe4\x11\xc1\xb0\x41\x94\xe2\x39\x20\xfe\x8b\xa0\x6f\x14\x22\x32\xc7\x04\xcf\x61\xfa\x4d\xe0\xf2\xd7\xd8\x01\x14\xce\x05\x1a\x82\x69\x1c\x30\x58\x71\x68\xe0\x62\x62\x07\x22\x02\xde\x43\xbf\x2f\x99\xc7\x5b\xce\x29\x67\x1d\x16\x24\x5a\x43\x10\xbd\x60\xca\x26\x1c\x8b\xc0\x16\xc1\x92\x60\xc4\xf4\x98\x64\xe7\xc5\x0f\xe7\xd1\x8b\x14\x55\x4c\xc5\xe1\xdc\x0f\x97\x5c\x60\x06\x3e\x85\x47\x06\x75\x20\x28\x5c\x62\x07\xd8\x53\xc2\x8d\x50\x1c\x43\x01\x86\x35\x22\xcf\x98\x48\x79\xfc\x65\x18\x11\x3c\x77\x00\xa9\x65\x49\xd6\x01\x87\x4b\x3f\xc4\x8d\x0a\x9f\xb7\x21\xd1\x7a\x82\xbf\x32\x82\x36\x51\x80\x18\x9e\x4f\x08\x62\xe9\xd2\x51\xe0\x3f\x1d\xf0\xc3\x19\xc1\x88\x62\x2e\xe4\x1c\x07\x0c\x09\xb4\x8a\xb6\x39\x7d\xc8\x67\xeb\x85\xe6\x92\x70\x9a\x98\xad\x70\x4c\x1b\x95\xeb\xa1\xdb\x1d\xbb\x30\x18\xc2\xd0\xbd\xef\x77\xaf\x5d\xb8\x79\xf0\xae\xc7\xbd\x81\x07\xa3\xeb\x2f\xee\x5d\x77\x72\xdd\x1d\x77\xfb\x83\xdb\x46\x31\x5b\xd5\x0a\xa8\x7f\x86\x3a\x61\xdc\xbb\x73\x47\xe3\xee\xdd\xfd\xf8\x7f\x1c\x5b\xab\xf6\x23\xae\xd1\xc9\x9a\xc2\xa7\xde\x6d\xcf\x1b\x3b\x52\x91\xc6\x40\x82\x5c\x5a\x85\xc0\x40\x4d\x14\x8f\x4f\x8e\x7e\x26\x96\x9c\xc2\xe7\xc1\xc3\xa7\xbe\x0b\xf7\x43\xf7\xba\x37\xea\x0d\x3c\x0e\xe1\xd3\xc9\x2c\x8a\x43\x86\x09\x7c\x1a\x0c\xfa\x6e\xd7\x13\x63\x42\xad\x6a\xa0\x26\x48\x0d\xdd\xf1\xc3\xd0\x1b\x15\x20\xa9\x74\x47\xf0\x13\x57\xe2\x4f\x95\xcf\xee\x75\xbf\x3b\x74\xc5\x04\xc9\x90\xe4\xf6\xf1\xa9\x2d\xc6\xb6\x28\x28\x62\x43\x3e\x94\x2e\x00\x34\x5e\xf3\x95\xe0\x7f\x6a\xce\x08\xa6\x98\x51\x88\x37\xc0\x22\x6d\xab\x42\xb2\x86\x98\x38\x8b\x08\xc1\xca\x57\xcb\x90\x87\xf1\x7a\xa2\xfd\xa7\xe7\x8d\xe5\xa0\xf2\x8b\xfc\x1c\x68\x75\xe0\xec\xff\xfe\xff\x4c\x82\x89\xa5\xc0\xe1\x5c\x09\xc3\x1f\x56\xc5\x6a\xcf\x58\x15\x6f\xa2\xd9\x0a\x6e\x86\x83\x3b\x73\x95\x6b\xf0\x01\x9a\x97\x97\x97\xb5\x56\x4b\xce\x91\x88\x02\x44\xd9\x84\x63\xdb\x83\xc9\x32\x8b\x12\x5c\xd2\x1e\x28\x43\x84\x81\x39\xbe\xf0\x09\x65\x13\x7f\xfe\x15\x14\x81\xa6\x41\xdb\x18\xbe\x34\x55\x20\x2d\x24\xa7\x08\x09\x32\x8f\x09\xe2\xca\x9d\xb0\x48\xd1\xdb\x0f\xc7\xb5\xb5\x1f\xea\x7f\x31\x89\x4a\xc0\xe4\x5a\xcd\x27\x3e\x37\x80\x2d\x0a\x4a\xc0\xd0\x16\x13\xb4\xc4\x13\x8d\xb5\x04\xcc\xf0\x4d\x4e\x77\x07\xd2\x4f\xee\x6d\xcf\x13\x73\x46\x6e\xdf\xbd\x1e\xcb\xf0\x3a\x41\xcb\x65\xb5\x68\xa5\x58\x6e\x75\x60\x30\xfc\xec\x0e\xe1\xd3\xef\x3c\x22\xd7\x1c\x63\xfe\xd6\x7e\x24\x88\xf4\xbc\xf1\x40\x7a\x8a\x23\x9c\x43\x0c\x0a\xcc\x71\x18\x62\xca\xaa\xa6\x77\x67\xfc\xb9\x06\xbf\xf6\xc6\x5f\x38\xd2\x9e\xd7\xed\xf7\xc6\xbf\x43\x77\x04\xb4\xca\x1c\xd8\x3a\x29\x85\x5f\xbf\xb8\x43\x17\xbc\xc1\x58\x07\xad\xfb\xe1\xe0\xae\xe1\xd3\x89\x88\xc1\x13\x19\x83\xab\xdb\x5a\xde\x4d\x5a\x1d\x98\x45\x28\xc0\x74\x86\xab\x52\x8a\x00\x87\x4b\xb6\xaa\x2a\x66\x9a\x35\x07\x2e\x6b\xed\x4a\xce\x07\x5b\x1d\x25\xf5\xc2\x0f\x82\xea\x65\xab\x95\x55\xb3\x03\xdd\xe1\xb0\xfb\xfb\xa3\xb6\xf3\xaa\x41\x96\xe3\x7d\x52\xdc\xdc\x0c\x86\xe0\x43\xcf\x83\x9f\x1b\x0d\x03\x04\xfa\x83\xc1\x7d\x12\xff\x0c\xca\x8f\xfe\x93\xe4\xda\x18\xa9\x37\x9f\xda\x09\x6c\xef\xc6\x0c\x78\x5d\xef\xb3\x50\x3a\x9f\x76\xa5\xfe\xaa\x37\x9f\x60\xfc\xc5\xf5\x92\x29\x07\x90\x78\x82\xf3\x74\x76\x4a\xcc\xf5\x3e\x43\xef\xa6\x5d\xd1\x7f\x73\xae\x95\xb6\x7e\xfd\xd2\xeb\xbb\x69\x5c\xb9\xea\x18\xa1\xc1\x12\xce\xf4\xf2\x56\x27\x9d\x51\x4f\xf6\x83\x94\x9e\x44\x9a\xfa\xff\x55\xc7\x5a\x4e\x2e\xad\x58\xb9\xc7\x04\x84\x8b\x6d\x52\xb0\x48\xdb\xc1\xa4\xd5\x31\x7e\x9c\xeb\xa0\x62\x8b\x66\x33\x92\x04\x9c\xab\x12\x36\x34\xc0\x79\xf3\x09\xae\x0c\xd9\x72\x5c\x24\x98\x5a\x9d\xf4\xef\x12\x1e\x92\xa1\x7a\x1d\x90\x48\x07\x20\xc4\x78\x4e\x01\x31\x08\x30\xa2\x0c\xd8\x4b\xa4\x33\xa9\x86\x69\x18\x09\xe6\xba\xa9\x43\x68\xc2\x60\x98\x61\xf8\x09\x3a\x79\x4d\xe6\x8c\x46\x6d\x2e\xad\x8e\xfe\xeb\xcf\x3f\x45\xc6\x95\x77\x07\x43\x8e\xfe\xc8\x2d\x40\xa2\xc2\x73\xab\x23\xcd\x2c\xe5\xa3\x2e\x07\x0c\x36\xce\x2d\xd3\x34\x21\xcd\xf1\x74\x42\xbb\x62\xd1\xcb\xc7\x7a\xbe\x45\xe5\x84\xad\x9b\x66\x53\x83\x0b\x11\x02\x1b\x97\xed\x52\x5c\x7c\x5d\x39\x26\xc3\x80\x33\x3a\x2d\xc3\x92\xdb\x08\x52\x86\x4c\xe9\xb2\x2c\x96\xa1\xcb\x6d\x18\xad\x4e\x9e\xc4\x05\x54\x8b\xac\xa1\x96\x51\x56\xbd\xae\x33\x14\xca\x13\xf9\x30\x62\x30\xe5\xe6\xb6\x44\xcc\xdf\xca\x84\x7f\x1e\x01\x1f\x36\xf6\x1f\x98\xe2\x20\x7a\x01\xbe\xf5\x35\x2c\x6c\xf9\xd8\x64\x2d\xfe\x47\xb8\x4c\x03\x56\x2a\x27\x7c\xec\xc0\x65\xde\xf8\x0a\x77\xda\x22\x51\x3f\x40\x35\x8b\xf1\xc2\x22\x5c\x6b\xe7\x10\xf7\x6e\xf2\xb8\xaf\x0a\x4c\xa7\x90\xab\x52\x2b\xcb\xa2\xcc\xd3\x35\x03\x6a\x76\xcc\x1a\x2c\xdb\xee\x0b\x14\xd0\xae\xec\x90\x4c\xf2\x76\x95\x37\x9a\x0f\xd0\x6c\x34\x8b\xe5\xdb\x41\xbb\xec\xd1\x79\x9e\x68\x46\xc8\x6c\x58\x78\x35\xa1\x9c\x24\x17\xf0\x73\xb1\x42\x77\xa8\x45\x6c\x59\x7f\xaf\x52\x70\x38\xff\xe7\x55\xb2\x33\x32\x5b\xbf\x3f\x40\xb5\x8c\xe0\x45\xce\x04\x6b\xed\x82\x38\x20\x76\xae\x42\x5d\xee\x24\x7c\x01\xd5\xa4\x50\xd4\x01\xb0\xb6\x7f\x81\x8b\xf6\x2a\x13\x6f\x3e\xa9\x49\x06\x92\x88\x6e\xa6\x27\xe7\xba\x7c\xcd\x66\x3f\x69\x25\xa9\xf0\xb7\x2b\xae\xf7\xb9\xa2\xca\xc7\x7e\xd7\xbb\x7d\xe8\xde\xba\x70\xdf\xbf\xbf\x1d\xfd\xd2\x87\xde\xdd\xdd\xc3\xb8\x2b\x36\xcb\xee\xb0\xdb\xef\xbb\x7d\x18\x75\x6f\xdc\x76\xe5\x7a\x70\x77\xe7\xf2\x64\xdb\x3b\xb6\x28\xb7\x8a\x6c\xeb\x87\xae\xb4\xf5\xff\x99\x5a\xba\xa8\x7a\x4e\x4a\xe6\xa4\x54\xee\x8d\xe0\x2c\x6d\x47\x18\x6d\x0c\x4e\x1c\xaa\x46\x84\xe7\xed\x10\xb5\xd0\x35\xa3\x65\x61\x80\xd4\x92\xfe\x45\xda\xa3\xc8\x74\x6b\xd2\x3e\xd3\x59\xbb\x72\x3b\xec\x7a\x63\x70\x7f\x73\xaf\x1f\xc6\xee\x0f\xa7\x1a\x18\x0f\x64\xff\x86\x60\x34\xc7\xa4\x9d\x36\x74\xfc\x90\x32\x14\xb2\x6c\x2f\xc7\x17\xbf\x23\x02\xbe\xd2\x01\xef\x4c\x71\x8d\x06\x99\x34\x0e\xa2\x05\xc7\x25\xd5\x22\xbb\x52\xba\xe1\x03\x0a\xf7\x7f\x0b\xef\x38\x51\xa7\xc7\xe4\xf7\xad\x34\x79\xfe\x89\x86\xce\xbf\xae\xe7\x72\xda\xde\xca\x7b\xab\xe1\x75\xad\x86\x83\x8b\xe7\xd7\x15\xc4\x05\x35\xf6\xbf\xaa\x30\x7e\x65\xa9\xab\x47\x0a\x1b\x24\x27\xa9\x75\x8d\xc4\x27\x29\x74\x52\x3e\xae\xec\x81\x42\x36\xec\xa2\x4c\xb6\x8d\x1b\x7b\x13\x28\x9b\xd0\x01\x69\xe6\x61\x45\xb9\xc1\xe9\x41\x19\xf6\x5f\x4a\xfa\x4a\x2b\x62\x83\x8b\xda\x7b\x46\x98\xdd\xbc\xbf\x47\xc6\xb3\x23\x07\xf4\x93\x24\x90\xff\x51\x33\x73\x9b\xd3\xe7\x77\xdf\x5f\xd0\xf2\x8c\x8e\x9f\x98\xca\x74\xc7\xd6\x03\xda\x2e\xd3\x67\x0e\xac\xfd\xd0\xfa\x89\xbe\x1a\x3f\x39\x32\x1a\xaf\x4d\x00\xe1\xd8\xe9\x00\xd7\x9f\xb0\xef\x74\x44\xeb\xd1\x81\x10\xad\xc5\x69\x28\x2c\x42\x9e\x0c\xaa\x03\x56\xfb\x50\x11\xe6\x11\x3e\x3e\xfd\x4b\xa8\xbd\x89\xdc\x6f\x11\xc2\xd8\xfd\x6d\xfc\x9e\xf3\xfd\x10\x39\x1f\x00\xc0\x2c\x2c\x3b\x41\xdb\xee\xcc\x12\x7b\x37\x7c\x31\x79\x7a\xd5\xf3\xa0\x7a\x66\xf9\xd2\x99\x03\x67\x96\x37\x89\x01\xf4\xd5\x1e\xb0\xdc\x89\x0f\x64\x1c\x8a\x0f\xd9\x0e\x75\x56\xb3\xf7\xa3\x61\xb7\x37\x72\xc1\xfd\xed\xda\xbd\x17\xfe\x71\x16\x87\x34\xde\x6c\x22\xc2\xf0\x3c\x0d\x62\xff\x75\xc6\xed\xae\x5d\xc9\x6d\x0f\x27\x4f\x74\x69\xe3\x47\x4e\x75\x69\xe3\x3d\xd9\xfd\x71\x92\xdd\x2b\x83\xf7\x92\x0c\x36\x4d\xb0\x78\xf6\x6a\xe5\xaa\xd2\xfb\x3a\x79\x9f\xd9\x8f\xab\x30\xdd\xb6\x39\x37\xf0\x67\x1c\x70\x3f\xfa\xb2\xfc\xd5\x44\x6a\x7b\xfe\x7e\x9c\x99\x53\x93\xe4\x20\x36\xe1\xbf\xd1\x48\xa4\xca\xad\xd4\xde\x84\x55\x1f\x97\x16\x24\xc6\xb6\x0d\x99\x22\xd8\xe1\x2d\x2f\x42\xbd\x0e\x1e\xf2\xc0\xa7\x2a\xac\xf3\x2b\x5b\x28\x04\x14\x7e\xe3\x96\x37\xc5\x04\xfc\x10\xee\x23\xca\x96\x04\x8f\x7e\xe9\x3b\x30\x8d\x19\x87\x0e\x79\xba\x95\xc5\x44\x71\x80\x67\x3c\xa6\x71\x8a\x80\x4c\x0c\x22\x81\x68\xec\x5f\x11\xf3\x8c\xed\xaf\xe9\xb2\x77\x63\x53\xe8\xc0\x99\x87\xbc\x33\x18\x0c\xa1\x9a\x9c\x5f\x7f\x54\x83\xf6\xa1\xb6\x39\xaf\x56\x7e\x20\x53\x28\x40\x76\x79\x76\x1d\xc1\xec\x58\x36\x6b\x13\x3a\xd0\x9a\x7f\x00\xdd\x7d\xfc\xe7\x74\xe7\x66\xad\x31\x93\x36\x23\x0a\x28\x94\x1d\xe3\x35\x0e\x19\x0a\x60\x8d\x51\x08\x2f\x2b\x7f\xb6\x12\x29\xad\x38\x73\xe4\x13\x16\x41\xf4\xd2\x38\xc6\xd1\x79\x6e\x72\x0a\xf7\xdf\xee\xd4\x84\xa2\xc2\xff\xb3\xc2\x60\xd9\xa2\x89\x7c\xa7\x17\x2e\xfc\xd0\x67\xdf\x78\x92\x52\x4f\x7e\xec\x58\x98\xeb\x81\x37\xee\x79\x0f\x2e\xdf\xac\x3d\xd8\xee\xc6\xc2\x97\xbe\x9a\x3d\x70\xad\x41\x07\xaa\x5b\xf1\x57\xfb\x20\x12\x49\x72\x56\x4c\xc6\x91\x76\x56\x3b\xcc\x36\x0e\x09\xa3\x70\x21\xb4\x58\xcf\x36\x04\x66\x21\xdb\x6b\x68\x19\x92\xfb\x2b\xfe\x37\x52\xe1\xa7\xf5\xd9\x49\xab\x5e\x59\x45\x95\xd6\xf6\xc7\x94\xb6\xaf\xaf\x6b\x4f\xd8\x1f\xf8\x9e\x6a\x2a\xef\x0c\x6c\xf1\x8c\x45\x64\x22\xf7\x57\x21\x8b\xd6\xa5\x08\x69\xa2\x65\x01\x12\x08\x34\x50\x4b\xe8\x59\x9a\xb6\x79\xd4\x23\x34\xe0\xcb\x55\x88\x42\xac\x6f\x8d\x72\xd5\xa9\x9c\x5e\x5d\x19\x0d\xa2\xe8\x79\x8a\x66\xcf\x3c\xbb\x9d\xe2\x45\x44\x30\xf8\x4c\x34\x18\xe2\x30\xc0\x94\xca\x7b\xd9\x6a\x8a\x4f\x01\x59\x17\x94\x8f\x6e\x10\x64\x64\x3c\x6d\x9b\xc0\x94\xe5\x94\x9d\x82\x13\x36\x08\x32\x3d\x80\xf2\x82\xc7\x2e\xc3\x74\xdd\xf3\xd6\x3b\x06\x05\x9d\x81\x13\x1f\xf5\x64\x8a\xdc\x9d\xe5\x6d\x79\x3d\xcb\x31\xbd\xae\xca\xfc\x81\x8a\xba\x0e\x5c\x16\x9d\x5a\x58\x95\xad\xe9\x32\x83\xe1\xee\x8a\xdd\xf4\x12\xf3\x72\xda\xf7\xbc\xde\x67\x23\x29\x61\xe1\xcd\x36\xdc\xb3\xd1\xf0\x84\xbb\x4d\x76\x3b\x46\x49\xa3\xbd\x78\x23\x39\xe1\xee\xf9\x3d\xa5\x2a\xdf\x3d\xff\x88\x51\xc8\xfc\x00\x17\x36\xd8\xf3\x0f\xf9\xb5\x12\x86\x89\xb8\xe4\xc1\xdf\xcc\x99\x62\xf6\x82\x71\xa8\x7b\xe1\xe6\x15\x0a\x44\xa2\x58\xbf\x2d\x84\xc2\x67\xbd\x97\x6a\x9c\xfa\x3a\x45\x51\xfb\xbc\x5e\x87\xbe\xff\x8c\xc5\x73\xf9\xc0\x01\x9f\x01\x8d\x08\x93\x93\x78\x59\xae\xe9\x88\x0a\xc2\x81\x97\x15\x26\x18\x51\xa3\x1e\x97\x2f\x30\x89\x3e\x3d\x87\x47\xd3\x68\x8b\xd5\x5a\xc9\xe2\xfb\xe8\x6d\x38\xaf\x8f\x37\xd1\xb0\x4f\x54\x9e\x7d\xf8\xde\xc2\xff\x7b\x5a\xf8\xf2\x06\xd1\x64\xaf\x8e\x24\x1c\x98\x6f\xe4\x3c\x97\x34\xf6\xb9\xbc\x44\x93\x92\x43\xf1\x66\x93\x1d\x7a\xc1\xfe\x72\xc5\xde\x6f\x8a\xbc\xdf\x14\x79\x93\xcd\xf3\xe3\x72\xa1\x23\xdb\xb5\x8d\x4c\x73\x31\x89\x92\xba\xa1\x77\x10\x53\x02\x74\x4f\x86\x66\x22\xbf\x2a\xba\x5b\x5f\x88\x38\x6d\xf2\x1c\x81\xfe\x23\x34\x0f\x44\x7f\x28\x76\x3b\xbd\x34\x23\x59\xab\x23\x5f\xba\xaa\xe6\x7a\x4c\x2a\xa6\x6c\x2d\x17\xb7\xdb\xb0\x2d\x23\x13\xee\x8e\x60\x9b\xc3\x91\x04\x91\x6d\xd2\x4d\x75\x60\x9b\x69\x74\x19\x41\xf3\xa0\x53\x91\x24\xa8\xb6\x3a\xa9\xce\x3e\x40\xd5\xc0\x53\x87\x66\x86\x48\x1a\x6a\xb9\xbf\x05\x51\x44\xf8\x8d\xe8\xe7\x5a\xab\x95\xc4\x59\xfd\x2f\x0d\xc1\xad\x8e\x7c\x39\x28\x83\xda\x31\xb0\x9d\xe7\x28\xa9\x70\xdd\xea\x48\x26\xeb\x26\xb5\xfd\x97\x69\xaa\xc6\xe2\x3c\x26\x64\xb8\x23\x7f\x80\x6a\x13\xea\x0a\x7d\x0d\xce\xcd\x65\x7c\x4c\x78\x96\x90\x0a\xe8\xcd\x16\x07\x05\x39\xda\x49\xbb\x51\xf9\x1c\xaa\xb4\x81\x97\x67\xe5\x7b\x74\xdd\xfe\x76\x81\xcb\x8b\x89\x0d\x22\x14\x4f\x16\x41\x84\x18\x88\xbf\x29\x20\x90\x3f\x11\x35\xee\x47\x8b\x34\xdf\x01\xdc\x58\x36\x84\xbe\x84\x09\xc2\x54\xd4\x0b\x7e\x52\x4c\x04\x18\x02\x34\xc5\x81\x54\xce\xca\xa7\x2c\x5a\x12\xb4\x86\x69\x3c\x7b\xc6\xcc\x01\x82\x59\x4c\x42\x5e\x85\xf0\x9d\x01\xfc\x05\xf8\xf2\xf8\x2d\x62\x9a\xec\xd1\x19\xbe\x21\x41\x55\xb6\x07\xf7\x5f\x75\x31\xb2\xe4\x34\xad\x92\xc0\x70\xdd\x1d\xb9\xd2\xe5\x25\xba\x9a\xb1\x1f\xbb\x1e\x9c\x9d\xfb\xe1\x42\xee\x34\x46\x44\xce\xc0\x1c\x00\x52\x37\x60\xea\x79\x20\x1e\xc6\x65\xb7\x33\x1f\xec\xb5\xbb\xb6\x2b\xe9\x1d\x0c\x81\xd4\x0f\xb7\x28\xf0\xe7\x13\x86\xbf\xb2\x09\xc1\x1b\x82\x29\x3f\x1a\x12\xd6\x9b\x6c\x31\x4a\x4c\x79\xb6\xfd\x2a\xbf\x3e\xce\xd8\xcd\xf5\xd9\xd3\x17\x96\x66\x92\xb8\x87\x51\xd6\x26\xa6\x94\x3e\x4c\x3e\xc8\x21\x67\xa5\x1f\xe4\x48\x60\x81\x1b\xb1\x70\x56\x07\x96\xfe\x16\x87\xaa\x80\xf5\x89\x69\xbe\x54\xbc\x84\x21\xba\xef\xb4\x01\x5d\x69\x99\xe2\x27\x47\x26\x1a\xc1\x92\x02\xbc\xf8\x6c\x15\xc5\x4c\x7f\x3e\x03\xd4\x67\x3c\x38\x81\xa3\xad\x36\x23\x6a\xba\x21\x97\x56\x7e\x8e\xda\xaa\x14\xd3\x45\x7e\x2f\x85\x38\xba\xaf\x5b\x52\x33\x96\x12\x4a\x6e\x30\xed\x2e\x8d\xf4\xba\x24\x5b\x6e\x34\xa5\xfc\x5d\xa7\xe2\x6f\x34\xec\x2d\x9f\xa6\x29\x22\xa5\xbc\xe2\xef\x10\x18\x45\x9c\x82\x13\x6a\x29\xc1\xca\xcf\x64\xca\x9e\xa7\xa1\x41\x85\x37\x2d\x11\x37\x04\xfd\x2d\x18\x3b\x14\x22\x82\x61\x8d\xc9\x12\xcf\x1b\xc5\xa5\x5a\x3c\x4d\xd3\xa4\x78\x6a\x55\x61\x33\xeb\x49\x5a\x84\xc9\x75\x70\x84\xc2\xd3\x2a\x2c\xb5\x18\x45\x23\x9e\x8a\x23\xa5\xea\x4c\x64\x67\xb3\xe4\xb1\x99\xd0\x99\x16\xa4\xcd\x45\x80\xc7\x55\x3e\x7d\x66\xc5\xba\xa1\x0b\x33\xe8\x8d\x44\x85\x26\x9c\x82\x97\x1c\xf1\xd4\x1c\x4a\xc0\x6f\x87\x83\x87\x7b\xc9\xb9\x18\x13\x48\x95\xba\xf2\x16\x51\x5a\xbf\x69\xce\xac\x02\xae\x77\x63\x4d\xce\xbd\xea\x6a\x05\xb4\xec\xa1\xe6\xab\x2a\x8c\xbd\xa5\x85\x22\x29\x9e\xe4\x69\xee\x2c\x1e\xf4\xd4\x34\xe6\xef\x46\x90\x2b\x0f\x34\x82\xdd\xf3\xa5\x26\x1f\x0d\xc5\xc9\x2b\x0e\xc9\x2c\x18\x0c\x2d\xb5\x5e\xc1\xcf\x87\xca\x68\xba\x84\x0a\x39\x6b\xf4\x0d\xd4\xab\xcf\xeb\x28\x8c\x58\x14\xfa\x33\x95\x2d\xbc\xac\x70\x68\x79\x0f\xf7\x11\x0e\x8b\x82\x40\x63\xa2\x33\x82\x36\x78\xae\x3f\x93\xd4\x86\x98\x62\x15\xa9\x71\xb8\xc5\x41\xb4\xc1\x0d\xe8\x26\x1f\x05\xd2\x0d\x46\x24\xd6\x56\xb0\x00\x3e\xd5\xc8\x02\xbc\x10\xc9\x8b\x2f\x23\xbb\xb8\xda\x03\x04\xf9\x54\xa5\x7a\x09\xc6\x4c\x00\x68\x75\xf2\xab\x92\x5c\x7a\x68\xca\x8f\x4f\x68\x19\xac\xda\xba\x77\x23\x7c\x93\x5f\x18\x29\x2d\x44\xf5\x25\x81\x6c\x19\xa8\x27\x7e\x34\x18\xc9\x4d\xb6\x78\x54\x33\xca\x10\x5d\xed\x42\xa4\x81\x5a\x9d\x14\xea\xa0\x6f\x55\x58\xa1\x5b\x33\x61\x1a\x57\x62\x78\x16\x64\x99\xaf\x96\x38\x4e\x41\x9d\x67\xa2\x53\xbc\x4c\x8d\xee\x9d\x6c\x91\x4c\x55\x6f\x44\xaf\x0e\x0f\x54\x82\xc3\xa9\xfa\xb8\xc5\xb3\xbd\x60\x02\xc3\x34\xad\x33\x33\xc2\x72\xf7\x81\x8e\x85\xb1\x48\x88\xbc\x8b\x25\x6f\x79\x64\x9d\x11\x3a\xd0\x14\x5c\xa9\x39\xb2\x53\x73\xb9\x0b\x6d\x11\x2a\x6b\x3f\xd3\xab\x30\x7d\x32\xe8\xe4\xa2\x85\xb5\x55\xb6\x3a\x1a\xfb\xd4\x7a\x21\x25\x8b\xd7\xfa\x5d\x57\x64\xac\x19\x7a\xa5\x54\xd1\x9b\x81\xc8\x8a\x6f\xa2\x3b\xd2\x24\xb4\x52\x4c\x31\xce\xa1\xaa\xc5\x78\x82\xba\xf5\x8c\x37\x3d\x45\xf1\x0d\x17\x16\xd9\xda\x77\x2e\x61\xb3\xe9\x5c\x3e\x79\x3b\xa4\x4e\xcb\x1f\x66\x19\xa5\x69\x41\x0a\x9c\xbe\xca\x5a\x94\x0b\x17\x25\xc2\x47\x97\xaa\x27\x13\x2c\x97\xf5\xff\x67\x00\xcd\x07\x42\xd9\x89\x4f\x00\x00"),
 		},
 		"/preinstall": &vfsgen۰DirInfo{
 			name:    "preinstall",
@@ -48,42 +55,42 @@ var MigrationFiles = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 987,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x92\xcf\x4f\xe3\x3e\x10\xc5\xef\xfe\x2b\xde\x01\x09\x2a\xb5\xa8\xfa\x5e\x23\x0e\x26\x1d\x4a\xf4\x35\x49\x48\xdc\x5d\x38\x55\x21\x19\xb6\x59\x52\x67\xd7\x76\x28\xfd\xef\x57\x09\xe1\x47\x11\x2b\xd6\x37\x7b\xc6\xef\xf3\xe6\x69\x30\x9b\xfd\x62\xbb\x75\xb8\x6f\x2d\x5c\xb9\xe1\x6d\x81\x5d\xdd\x34\xb8\x63\x14\x55\x65\xd9\x39\xae\xd0\x14\x9e\x6d\x20\x10\x66\x24\x35\x21\x0f\x2f\xe9\x4a\x22\xba\x40\x9c\x68\xd0\x4d\x94\xeb\x7c\x7c\x5c\x87\x52\x4b\x95\x2c\x03\x21\x66\x33\x5f\xdc\x35\x0c\xdf\xc2\x15\x8f\x8c\xb2\xdd\x6e\x0b\x53\x39\xb8\x16\x7e\xc3\x7b\x94\x85\xe9\x31\xb6\x33\xd8\x6d\xd8\xf4\xbc\xda\xfc\x80\xe1\x1d\x4c\x5b\xb1\x7b\xe5\x69\x79\xae\xe8\x03\xe0\xd4\xf2\xb6\xf5\xbc\x7e\x51\x3d\x11\x00\xf0\xc0\x7b\x68\xba\xd1\x48\xb3\xe8\x4a\x66\xb7\xf8\x9f\x6e\xa7\x43\xc5\xf1\x6f\xe4\x94\x45\x52\x3d\xdf\xbd\x2d\x8c\x2b\x4a\x5f\xb7\xa6\x68\x70\x9e\x24\x8a\x64\xfc\x5c\x1a\x25\x07\x21\x31\x09\x84\x10\xa3\x8f\x24\x43\x46\xa9\x92\x21\x21\xcd\x92\x90\x16\xab\x8c\xc0\x4f\x5c\x76\x9e\xd7\xfc\xc8\x76\xbf\xdb\xb0\xe5\x93\x51\x60\xdd\xbb\xf1\xfc\xe4\xa7\x07\x92\xd3\xcf\xd9\x38\x83\xb7\x1d\x4f\x84\xcc\x71\x74\xdf\x99\xf2\x48\x9c\xd3\x32\x8a\x07\x4b\x74\x43\xe1\x4a\xd3\x8b\x4e\x20\x86\xd7\x28\xce\x29\xd3\x88\x62\x9d\x7c\x95\xce\x03\xef\x5f\x5d\x7c\x30\x30\xc1\x37\xa9\x56\x94\xbf\xb7\xfd\xd7\xde\x81\x9b\xc4\x08\x93\xf8\x42\x45\xa1\x46\xaf\x3c\xc1\x22\xc1\x2a\x5d\x0c\xbb\x41\xfa\x75\xda\x33\xf0\x53\xd9\x74\x15\x57\xa7\x9f\xcb\xbd\xef\x38\x28\x8c\x03\xbe\x05\xd0\x9f\x50\x2a\x85\xaa\x76\xde\xd6\x77\x9d\xe7\x6a\xdd\x47\xff\xe2\x7a\x12\x8c\x41\x85\x94\xea\x28\x79\xfb\xf5\xfd\x92\x62\x74\xa6\xe2\xfb\xda\x70\xb5\xee\x93\xed\x19\xd0\x97\xf4\xd6\xd4\x9f\xd9\x0c\x3b\x3e\xb6\x0c\xd3\x7a\xf4\x0d\xf5\x96\x5d\x59\x34\x8c\xff\xa6\xf8\xd9\x39\x0f\xcb\xbe\xb3\xe6\xe0\x53\x46\x7a\x95\xc5\xc1\x21\x2d\xbf\x56\xb9\xee\xc3\x38\x9e\xcb\xf9\x7c\x7e\xfc\x05\xcb\x6f\x18\x45\x59\xb2\x73\xc3\xde\xff\x1b\x8c\xe2\x45\x20\x28\x5e\x88\xe7\x5d\x81\x92\xf1\x72\x25\x97\x84\x54\xa5\xcb\xfc\x5a\x05\xe2\x4f\x00\x00\x00\xff\xff\x00\x58\x5c\x9a\xdb\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x92\xcf\x4e\xdb\x4c\x14\xc5\xf7\xf3\x14\x67\x81\x04\x91\x12\x14\x7d\x5b\x8b\xc5\xe0\x5c\x82\xf5\x0d\xb6\xb1\x27\x2d\xac\x22\x63\x5f\x1a\x17\x67\xdc\xce\x8c\x09\x79\xfb\xca\xc1\x21\x04\x51\xd1\xd9\xf9\xfe\x39\xbf\xe3\xa3\x8b\xc9\xe4\x17\xdb\xb5\xc3\x63\x6b\xe1\xca\x15\xaf\x0b\x6c\xea\xa6\xc1\x03\xa3\xa8\x2a\xcb\xce\x71\x85\xa6\xf0\x6c\x03\x81\x30\x23\xa9\x09\x79\x78\x4d\x37\x12\xd1\x15\xe2\x44\x83\xee\xa2\x5c\xe7\x43\x71\x19\x4a\x2d\x55\x32\x0f\x84\x98\x4c\x7c\xf1\xd0\x30\x7c\x0b\x57\x3c\x33\xca\x76\xbd\x2e\x4c\xe5\xe0\x5a\xf8\x15\x6f\x51\x16\xa6\xc7\xd8\xce\x60\xb3\x62\xd3\xf3\x6a\xf3\x03\x86\x37\x30\x6d\xc5\xee\x8d\xa7\xe5\xa5\xa2\x0f\x80\x73\xcb\xeb\xd6\xf3\x72\xaf\x7a\x26\x00\xe0\x89\xb7\xd0\x74\xa7\x91\x66\xd1\x8d\xcc\xee\xf1\x3f\xdd\x8f\x77\x1d\xc7\xbf\x91\x53\x16\x49\xf5\xfa\xed\x6d\x61\x5c\x51\xfa\xba\x35\x45\x83\xcb\x24\x51\x24\xe3\xd7\xd6\x20\xb9\x13\x12\xa3\x40\x08\x31\xf8\x48\x32\x64\x94\x2a\x19\x12\xd2\x2c\x09\x69\xb6\xc8\x08\xfc\xc2\x65\xe7\x79\xc9\xcf\x6c\xb7\x9b\x15\x5b\x3e\x1b\x04\x96\xbd\x1b\xcf\x2f\x7e\x7c\x24\x39\xfe\x9c\x8d\x0b\x78\xdb\xf1\x48\xc8\x1c\x27\x8f\x9d\x29\x4f\xc4\x25\xcd\xa3\x58\x00\x00\xdd\x51\xb8\xd0\xb4\xd7\x09\xc4\xae\x1a\xc5\x39\x65\x1a\x51\xac\x93\xaf\xd2\x79\xe2\xed\x9b\x8b\x0f\x06\x46\xf8\x26\xd5\x82\xf2\xf7\xb6\xff\x3a\xbb\xe3\x26\x31\xc2\x24\xbe\x52\x51\xa8\xd1\x2b\x8f\x30\x4b\xb0\x48\x67\xbb\xdb\x20\xbd\xdf\xc5\x05\xf8\xa5\x6c\xba\x8a\xab\xf3\xcf\xe5\xde\x4f\x1c\x35\x86\x1f\x3c\x04\xd0\xbf\x50\x2a\x85\xaa\x76\xde\xd6\x0f\x9d\xe7\x6a\xd9\x47\xbf\x77\x3d\x0a\x86\xa0\x42\x4a\x75\x94\x1c\xb6\xbe\x5f\x53\x8c\xce\x54\xfc\x58\x1b\xae\x96\x7d\xb2\x3d\x03\xfa\x9a\x0e\x43\xfd\x9b\x4c\xb0\xe1\x53\xcb\x30\xad\x47\x3f\x50\xaf\xd9\x95\x45\xc3\xf8\x6f\x8c\x9f\x9d\xf3\xb0\xec\x3b\x6b\x8e\x96\x32\xd2\x8b\x2c\x0e\x8e\x69\xf9\xad\xca\x75\x1f\xc6\xe9\x54\x4e\xa7\xd3\xd3\x2f\x58\x7e\xc5\x28\xca\x92\x9d\xdb\xdd\xfd\xbf\xc1\x28\x9e\x05\x82\xe2\x99\x78\xbd\x15\x28\x19\xcf\x17\x72\x4e\x48\x55\x3a\xcf\x6f\x55\x20\xfe\x0c\x00\x00\x58\x5c\x9a\xdb\x03\x00\x00"),
 		},
 		"/preinstall/001-users.sql": &vfsgen۰CompressedFileInfo{
 			name:             "001-users.sql",
 			modTime:          time.Time{},
 			uncompressedSize: 670,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x92\xd1\x4a\xc3\x30\x14\x86\xef\xfb\x14\xff\x45\xa0\x0a\x7b\x83\x5e\xd5\xee\xb0\x15\x4a\x22\x31\xa2\x77\xa5\x76\x87\x19\xad\x6b\x49\x33\xb7\xbd\xbd\xc4\x4a\x69\xee\x04\x71\xe7\xea\x0f\x84\xef\xe4\x7c\x39\x45\x5e\x55\xe0\x33\xb7\x47\xcf\x35\x7f\xb2\xbb\x9c\x5e\xd9\xf1\x4d\xda\x3a\x6e\x3c\xd7\x83\xeb\x3f\x6a\xc7\xcd\x8e\x5d\xba\x82\x60\x16\x09\x00\xac\x15\xc4\x94\x42\xdd\xd1\xa6\x94\xf3\x29\x54\xa1\x29\x37\x04\xad\x2a\xc2\x02\x91\xcd\x97\xe8\xb9\xa0\x7b\x53\x2a\x89\xa7\x2d\x49\xec\x8e\x43\x67\xdb\xd0\xb0\x7f\x79\xe3\xd6\xc3\x6c\x29\x26\xea\xbc\x7c\x20\x48\x65\xca\x82\x90\xba\xbe\xe3\x25\x18\x4d\x17\xc2\x05\x7c\xb6\xa3\x1f\x57\x18\xdf\xed\x30\xd8\xc3\x1e\xd3\x1c\x69\x16\xc3\xc8\x3c\x6a\xb9\x78\x8d\x5c\x7f\x67\x21\xb2\x24\xcc\x78\x9b\x25\xc9\xaf\xcc\x9c\x9c\xf5\x7f\x34\x33\x21\xfe\xc1\xcc\x04\xbe\xa6\x99\xbd\x6b\x0e\x7e\xb9\x32\xb1\xa4\xd9\xd1\x46\xe7\xd2\x44\xdf\x67\x54\x2c\xe3\xa7\xd5\x57\x00\x00\x00\xff\xff\x77\x42\x8e\x86\x9e\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x92\xd1\x4a\xc3\x30\x14\x86\xef\xfb\x14\xff\x45\xa0\x0a\x7b\x83\x5e\xd5\xee\xb0\x15\x4a\x22\x31\xa2\x77\xa5\x76\x87\x19\xad\x6b\x49\x33\xb7\xbd\xbd\xc4\x4a\x69\xee\x04\x71\xe7\xea\x0f\x84\xef\xe4\x7c\x39\x45\x5e\x55\xe0\x33\xb7\x47\xcf\x35\x7f\xb2\xbb\x9c\x5e\xd9\xf1\x4d\xda\x3a\x6e\x3c\xd7\x83\xeb\x3f\x6a\xc7\xcd\x8e\x5d\xba\x82\x60\x16\x09\x00\xac\x15\xc4\x94\x42\xdd\xd1\xa6\x94\xf3\x29\x54\xa1\x29\x37\x04\xad\x2a\xc2\x02\x91\xcd\x97\xe8\xb9\xa0\x7b\x53\x2a\x89\xa7\x2d\x49\xec\x8e\x43\x67\xdb\xd0\xb0\x7f\x79\xe3\xd6\xc3\x6c\x29\x26\xea\xbc\x7c\x20\x48\x65\xca\x82\x90\xba\xbe\xe3\x25\x18\x4d\x17\xc2\x05\x7c\xb6\xa3\x1f\x57\x18\xdf\xed\x30\xd8\xc3\x1e\xd3\x1c\x69\x16\xc3\xc8\x3c\x6a\xb9\x78\x8d\x5c\x7f\x67\x21\xb2\x24\xcc\x78\x9b\x25\xc9\xaf\xcc\x9c\x9c\xf5\x7f\x34\x33\x21\xfe\xc1\xcc\x04\xbe\xa6\x99\xbd\x6b\x0e\x7e\xb9\x32\xb1\xa4\xd9\xd1\x46\xe7\xd2\x44\xdf\x67\x54\x2c\xe3\xa7\xd5\xd7\x00\x77\x42\x8e\x86\x9e\x02\x00\x00"),
 		},
 		"/preinstall/002-schemas.sql": &vfsgen۰CompressedFileInfo{
 			name:             "002-schemas.sql",
 			modTime:          time.Time{},
-			uncompressedSize: 3390,
+			uncompressedSize: 4448,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x57\xc1\x6e\xe2\x30\x10\xbd\xe7\x2b\xe6\x40\x15\xd0\x86\xfd\x80\x45\x3d\xb8\xc4\x65\x23\xa5\xa1\x4a\xdc\x55\x6f\xc8\x84\xa1\x44\x82\x18\xd9\x4e\x69\x57\xfd\xf8\x95\x4d\xa0\x69\xb7\x50\xd3\x50\x89\x0b\x8e\xe7\xbd\x99\x97\xf1\xf8\x65\x48\xe2\x18\xf0\x09\xf3\x4a\xe3\x04\x1f\x51\x3e\x6f\x16\x28\xb1\xeb\xe7\x12\xb9\xc6\x89\xca\x17\xb8\xe2\xca\x0f\xa0\x83\xd8\x81\x70\x0c\x9d\x0e\x5c\xd1\x51\x94\x78\x00\x00\xc3\x94\x12\x46\x21\x1b\xfe\xa6\x37\x04\xa2\x6b\x48\xc6\x0c\xe8\x7d\x94\xb1\xac\x5e\x9c\x0c\x09\x23\xf1\x78\x34\x80\x7e\x1f\x72\xae\xf9\x52\x3c\x80\xe6\xd3\x25\x2a\xf8\x01\x45\xa9\x51\x96\x7c\x09\xf3\xaa\xcc\x75\x21\x4a\x65\x61\x47\x29\x49\x18\xdc\x65\x64\x44\x61\x9c\xec\xe0\xdf\x02\x02\x1b\xc3\x5a\x8a\xd5\x44\x22\x9f\xa1\x1c\x34\x02\x33\x1a\xd3\x21\x33\x91\xa6\x3a\x46\xae\x62\x9a\x41\x74\x0a\x0e\x89\x19\x4d\x21\xa4\xd7\xe4\x2e\x66\x70\x9b\x46\x7f\xa2\x98\x8e\x8e\xa1\xbc\x67\xae\x59\x0f\x27\xe9\x58\xdd\x46\x16\xfa\xa3\xea\x02\x88\x92\x8c\xa6\x2c\x80\xbb\xdb\x90\x30\x1a\x40\x48\x63\xca\xe8\xa9\x55\x37\xf1\xdb\x55\x7d\x2c\xa3\x77\x6a\xec\x48\x5d\x7b\xe8\x36\x1d\xdf\xd8\x06\x5a\x57\xd3\x65\x91\x9f\xd2\x2d\x26\xf4\xbf\xb7\xe0\xca\x4b\xef\x99\xa5\x15\x6b\x5d\xac\x8a\xbf\x38\x83\x47\x94\xca\x10\x83\x98\xbf\x66\x01\xdb\xc3\x32\x83\xe9\x33\xe8\x05\x02\x3e\x69\x2c\xcd\xb6\xcf\xd3\xa3\xf7\xec\xcb\xd9\x65\x34\x8d\x68\x66\x13\x54\x28\x0b\x54\xf0\x58\xe0\xc6\x41\x93\x6d\x60\xeb\x03\x74\x04\xc6\xbd\x93\x6a\x10\xc7\xe3\xe3\x2a\xcd\x0d\x65\x69\x34\xb4\xd2\xac\x50\xcb\x22\x77\x95\x66\x1b\xd8\x5a\x9a\x23\x30\xee\xd2\xd4\x20\x67\x96\x26\x24\x8c\x38\xcc\x21\xb3\xad\xb5\x0c\x07\x41\xdc\x45\xb0\x10\xe7\x1a\xae\x6f\xf2\x39\xf7\x64\x3d\x08\xde\xa2\xd8\x6f\x9c\xa9\x86\x6b\x37\x42\xdc\x94\x3b\xd7\xdc\xf8\x0c\xeb\x34\xbd\x4e\x9c\x20\x5f\xaa\xf4\x3b\x5a\xe5\x18\x47\x7b\x05\xbe\xd2\x38\xae\x9d\x13\x25\xd7\x63\x07\x21\xcd\xb6\xd6\xbd\x72\x10\xc4\x5d\x22\x0b\xe1\xd8\x1d\x34\x09\x8d\xc1\x35\x5e\xb7\x37\xf0\xbc\x7e\xdf\xde\xe7\x66\x8b\xca\xf9\xb2\x71\xb3\x43\x2e\x4a\xcd\x0b\x63\x04\xde\x7b\x03\x63\x0d\x94\x58\xa1\x89\x16\x73\x10\x95\x6c\x38\x05\x5e\xce\x40\xac\x51\x72\x2d\xa4\xfa\x09\x4c\x00\x96\xaa\x92\x68\x79\x72\x21\x25\xe6\xba\x09\x64\x96\xb9\xb4\x58\x95\xc2\x59\xd0\xf4\x0d\xab\x4a\x69\x98\x22\x4c\x71\x2e\x24\x02\x5f\x2e\x77\x7c\x42\x2f\x50\x42\xed\xdd\xa1\x28\x2d\x8c\x42\x2e\xf3\x05\xac\xb9\x5e\x78\xd6\xc7\x7b\x21\x1d\xc6\x24\xa5\x46\xcf\x12\x37\x13\xf3\x04\x34\x3e\xe9\x81\xb7\x77\xf8\xfb\xf5\x5f\x97\x90\x57\x52\x62\xa9\x27\x0a\xb5\x2e\xca\x87\xae\xbf\x45\xb4\xcf\xfd\x1e\xbc\xbc\xc0\x5c\xc8\x15\xd7\x5d\x3f\xb8\x88\xf7\x3f\x3f\x00\xff\x35\xe9\xc6\x3f\xe3\xcc\x1a\x7f\xb7\xb7\x5d\x63\xa1\xf6\x98\x7e\xcf\xbe\xf0\xfa\x1b\x65\x4f\x51\xbf\x7f\xc2\xc8\x15\xc9\x28\x5c\x44\x90\x51\x06\x8d\x8c\xe0\x12\x2e\xcc\x77\xcb\x2e\xeb\x19\xd7\x7c\xca\x15\x76\x7b\xc1\xbe\xaa\x8f\xa1\x0f\x00\x35\x82\x68\x12\x7a\x9d\xce\xc0\xfb\x17\x00\x00\xff\xff\x1d\xe9\x64\x37\x3e\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x58\xdd\x6e\xea\x38\x10\xbe\xcf\x53\xcc\x05\x15\xad\x16\xf6\x01\x16\x9d\x0b\x17\x5c\x36\x52\x08\x28\x71\x77\x7b\x87\x4c\x32\x40\xa4\x24\x8e\x6c\xa7\xb4\xab\xf3\xf0\x2b\x3b\x84\xe6\x9c\x1e\xa8\xf9\x39\x12\x37\x71\xec\x6f\x66\x3e\x7f\x33\xcc\x64\x4c\x82\x00\xf0\x0d\x93\x5a\xe3\x12\x5f\x51\xbe\xef\xb6\x28\xf1\xbe\x9f\x48\xe4\x1a\x97\x2a\xd9\x62\xc1\x55\x7f\x00\x3d\xc4\x1e\x4c\xe6\xd0\xeb\xc1\x23\x9d\xfa\xa1\x07\x00\x30\x8e\x28\x61\x14\xe2\xf1\xdf\x74\x46\xc0\x7f\x82\x70\xce\x80\xbe\xf8\x31\x8b\xf7\x8b\xcb\x31\x61\x24\x98\x4f\x47\x30\x1c\x42\xc2\x35\xcf\xc5\x06\x34\x5f\xe5\xa8\xe0\x0f\xc8\x4a\x8d\xb2\xe4\x39\xac\xeb\x32\xd1\x99\x28\x95\x85\x9d\x46\x24\x64\xf0\x1c\x93\x29\x85\x79\xd8\xc2\xff\x08\x08\x6c\x0e\x95\x14\xc5\x52\x22\x4f\x51\x8e\x3a\x07\x63\x1a\xd0\x31\x33\x27\x4d\x74\x8c\x3c\x06\x34\x06\xff\x1c\x1c\x12\x30\x1a\xc1\x84\x3e\x91\xe7\x80\xc1\x22\xf2\xff\xf1\x03\x3a\x3d\x85\xf2\xb3\xe5\xbd\xd5\xe3\x4e\x3a\x46\xb7\x93\x99\xfe\x55\x74\x03\xf0\xc3\x98\x46\x6c\x00\xcf\x8b\x09\x61\x74\x00\x13\x1a\x50\x46\xcf\x8d\xba\x8b\x7f\x5d\xd4\xa7\x3c\xfa\x89\x8d\xd6\xa8\xab\x86\x16\xd1\x7c\x66\x05\x54\xd5\xab\x3c\x4b\xce\x51\x8b\x39\xfa\xe9\x16\x5c\xed\xd2\x17\x66\xcd\x8a\x4a\x67\x45\xf6\x1f\xa6\xf0\x8a\x52\x19\xc3\x20\xd6\x1f\x5e\x40\x93\x2c\x29\xac\xde\x41\x6f\x11\xf0\x4d\x63\x69\xb6\x7d\xed\x1e\x7d\x61\x17\x7b\x17\xd3\xc8\xa7\xb1\x75\x50\xa1\xcc\x50\xc1\x6b\x86\x3b\x07\x4e\x9a\x83\x57\x27\xd0\x09\x18\x77\x25\xed\x41\x1c\xd3\xc7\x95\x9a\x19\x65\x91\x3f\xb6\xd4\x14\xa8\x65\x96\xb8\x52\xd3\x1c\xbc\x9a\x9a\x13\x30\xee\xd4\xec\x41\x6e\x4c\xcd\x84\x30\xe2\x50\x87\xcc\xb6\xab\x69\x38\x0a\xe2\x4e\x82\x85\xb8\x55\x71\xfd\xc1\x9f\x5b\x57\xd6\xa3\xe0\x57\x04\xfb\x1b\x6b\xaa\xb1\xd5\x96\x10\x37\xe6\x6e\x55\x37\xbe\xc2\x3a\x8f\xaf\x33\x2b\xc8\x45\x91\xfe\x0e\xa9\x9c\xb2\x71\x3d\x03\x97\x08\xc7\x55\x39\x7e\xf8\x34\x77\x20\xd2\x6c\xbb\x5a\x2b\x47\x41\xdc\x29\xb2\x10\x8e\xea\xa0\xe1\xc4\x34\xb8\xa6\xd7\x7d\x18\x79\xde\x70\x68\x7a\xe3\xa2\xca\xb9\x54\xc0\x25\x82\x28\xf3\x77\x50\x5a\x48\x4c\x41\x94\xf6\xcf\x9e\x27\x09\x2a\x05\xa5\x48\x71\x00\x4a\x98\xb5\x4c\x42\xd3\x37\x43\x66\x5e\xe8\x43\x7f\xf0\xd1\x60\x7b\xae\x19\x4a\x5f\xe8\x6c\x11\x90\x68\xe4\x7d\xad\xda\x76\xef\xa7\xb0\xce\xce\xce\xa3\x48\x67\x2a\xf3\x80\xe3\xc8\xff\x05\x31\xb6\xf2\xbd\x59\x5e\x1e\xb5\x70\x8b\xd8\x2f\xc9\x4b\x33\x38\x89\x52\x67\x65\x2d\x6a\x05\x7c\xb3\x91\xb8\xe1\x1a\x1b\x45\x1a\x79\xa9\xba\xaa\x84\xd4\x8d\x26\xd3\x4c\x69\x99\xad\x6a\xf3\xb8\x7d\xaf\x50\x36\xc3\x56\xab\x4d\x83\x96\x8a\x5d\xa9\x78\x51\xe5\x98\x42\xca\xb5\x95\xa9\x55\xf6\x67\x49\x3b\xeb\x74\x32\xff\x37\x8c\xc9\x6c\x11\x50\x97\x5b\xfc\xd8\x7d\xbd\x56\x4f\x60\x9d\x79\x63\x1d\x24\xd7\xa6\x6b\x38\xb4\x8c\x99\x45\x95\xf0\xbc\xd3\xfb\xdb\x3b\xe3\x59\xa9\x3e\x4f\x0f\x66\x78\x50\xa2\xb0\x77\x21\xd6\x20\x6a\xd9\x99\x25\x78\x99\x82\xa8\x50\x72\x2d\xa4\xfa\x13\x98\x00\x2c\x55\x2d\xd1\xda\x49\x84\x94\x98\xe8\x2e\x90\xbd\x30\x69\xb1\x6a\x85\xe9\xa0\x3b\x59\x14\xb5\xd2\xb0\x42\x58\xe1\x5a\x48\x04\x9e\xe7\xad\x3d\xa1\xb7\xd8\x56\x29\x05\x59\x73\xef\x0a\xb9\x4c\xb6\x50\x71\xbd\xf5\xec\xa4\xef\x4d\xe8\x38\x20\x11\xf5\x00\xa0\xc4\xdd\xd2\xbc\x01\x8d\x6f\x7a\xe4\x1d\xbe\x01\x1c\xd6\xff\xfa\x06\x49\x2d\x25\x96\x7a\xa9\x50\xeb\xac\xdc\xdc\xf7\x1b\x44\xfb\xbe\xff\x00\xdf\xbf\xc3\x5a\xc8\x82\xeb\xfb\xfe\xe0\x2e\x38\xfc\xfa\x03\xe8\x7f\x38\xdd\x79\x32\xb3\x5b\xe7\xb1\xe9\x87\x3b\x0b\xfb\x29\xb4\xff\x60\xff\x12\xf6\x5f\x31\x0e\x26\xf6\x97\x4f\x18\x79\x24\x31\x85\x3b\x1f\x62\xca\xa0\xe3\x11\x7c\x83\x3b\xf3\x65\xa3\xf5\xda\xa4\xc2\x8a\x2b\xbc\x7f\x18\x1c\xa2\xfa\x35\xf4\x11\xa0\xce\x21\x1a\x4e\xbc\x5e\x6f\xe4\xfd\x3f\x00\xd1\xcd\xf7\xbb\x60\x11\x00\x00"),
 		},
 		"/preinstall/003-tables.sql": &vfsgen۰CompressedFileInfo{
 			name:             "003-tables.sql",
 			modTime:          time.Time{},
-			uncompressedSize: 3282,
+			uncompressedSize: 5654,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x56\xdd\x6e\xdb\x38\x13\xbd\xf7\x53\xcc\x9d\xec\x42\x32\x9c\xab\xef\x6b\x83\x2e\xa0\x38\x6a\x2a\xd4\x96\x53\x5b\xee\xb6\xbb\x58\x08\xb4\x34\xb6\x88\x50\xa4\x96\xa4\xea\xfa\xed\x17\xa4\x64\xfd\x38\x71\xb2\xc0\x1a\xb9\x89\x78\x66\x38\x73\xe6\xcc\x0c\xbd\x97\x7f\x23\xcf\x83\x98\xec\x18\x42\x86\x7b\xca\xa9\xa6\x82\x2b\xb0\xdf\x5f\xc6\x1b\x03\x02\xaa\xc4\x94\x12\x06\xfa\x54\x22\x1c\x11\x2a\x85\x40\x39\x88\x4a\x82\x36\xde\x14\x28\x01\x45\xa5\x34\xec\x10\x52\x89\x44\x63\x06\x39\x4a\x1c\xcd\xd7\x81\x1f\x07\x70\xbf\x5a\xfa\x61\x04\x9b\xf9\xe7\x60\xe9\x27\x8f\xeb\xd5\x72\xca\xc8\x0e\x59\x42\xa4\x24\x27\xf0\x37\x40\xb9\xfe\xf3\x2f\x88\x56\x31\x44\xdb\xc5\xe2\x76\x74\xb6\x8c\xfd\xbb\x45\x00\x65\xb5\x63\x34\x9d\x96\x52\x14\x09\xe5\x4a\x13\xc6\x88\x89\x3d\xa1\x7c\x2f\x60\x3c\x02\x00\x78\xc2\x13\xc4\xc1\xf7\x18\x1e\xd7\xe1\xd2\x5f\xff\x80\x2f\xc1\x0f\xd7\x9e\xfc\x24\xac\x42\x7b\x36\x9a\xdc\x8e\x46\x61\xb4\x09\xd6\x31\x84\x51\xbc\x7a\xdd\xf1\xf8\x09\x4f\x6e\x6d\x3d\x81\x6f\xfe\x62\x1b\x6c\xac\xbf\xb1\x93\x12\x4d\x98\x38\x80\x4a\x73\x2c\x88\xe3\x42\xf3\x73\x9a\x0c\xe7\x7e\xec\x2f\x56\x0f\xce\xc4\x6d\x0c\xcc\x05\xa8\x73\xac\x14\xf8\x8f\x61\x67\xe7\xf4\x28\xe9\xd0\xf8\x4b\x23\x57\x54\xf0\x8b\x0b\xce\xe8\xe0\x7b\xdc\x81\x15\x4a\x6a\x2a\x70\x11\x4a\x0b\xde\x04\xeb\x30\xd8\x74\xf8\x02\xb5\xa4\xe9\x75\xfc\x32\x88\xd7\xe1\xbc\xc3\x67\x44\x93\xe7\xe8\x0e\x7f\xef\xc7\x7e\x87\x36\xbc\xc9\xc2\x72\x38\x30\x3a\xa3\xc3\xe8\xd3\xca\x31\x55\x18\x16\x78\xc8\xdb\xb4\xc9\xa9\x2e\x2c\xcd\x60\x47\x0f\x94\xeb\x56\x1e\xf5\x65\x75\x22\x09\xcd\xe0\xf9\x99\x55\x97\xba\x2a\xb8\x16\x0c\x9e\xd7\x40\x89\x44\x38\x30\xb1\x23\x8c\x9d\xa0\xe2\xf4\xef\x0a\x61\x87\x29\x31\x5a\x17\x7b\xc8\xc5\x11\x4a\x22\x75\xd3\x32\x06\x6d\x5b\x08\x33\x7b\x5f\x86\x0c\x35\x26\x58\x8a\x34\x6f\xa3\xdd\x2e\x16\x70\x1f\x7c\xf2\xb7\x8b\xfa\x36\xf0\x3c\xa8\x11\x64\xaf\x51\xc2\x31\xa7\x69\x0e\x3a\xa7\x0a\xa4\x38\x42\x4a\xb8\xe9\x9f\xda\x55\x36\x9a\xc0\xa3\xbf\x8e\xc3\x38\x5c\x45\x70\xf7\x03\x16\xe1\x26\x1e\xb7\x29\x4f\xba\x0e\x09\xa3\xfb\xe0\x3b\xd4\x8c\x25\x75\x32\x86\x93\x55\x74\x85\xd4\xed\x26\x8c\x1e\xe0\x21\x8c\x60\x5c\xa3\xaf\xf9\x3a\x07\x62\xf2\xbb\xe6\x6d\xdc\x4f\xdc\x05\x9a\x4d\x2c\xfc\xf7\xcf\xc1\x3a\x18\x92\x12\x6e\x7a\xdd\xdd\x5c\xb7\x09\xbe\x6e\x83\x68\x7e\xa5\xfc\x09\xcd\xde\xd2\x89\x4d\xa0\x93\x89\xb1\x23\x0c\xe6\x9f\x83\xf9\x17\x18\xd3\x0c\x7e\x83\x59\xa3\xcc\xf3\x74\xe8\x4f\x04\x8d\xbf\x74\xfd\x7f\x6f\x64\x18\xbb\x09\x84\xd1\x7c\xb1\xbd\x0f\xa0\x3f\x02\x6a\xe8\x36\x0a\xbf\x6e\x87\x07\x1d\xda\xe4\x3f\xb9\x7d\x3d\x66\x9a\xa9\x9a\x92\x3a\xec\xb4\x92\x12\xb9\x6e\x58\xba\x0b\x1f\xc2\x28\x7e\x26\x66\xa5\x93\xaa\xcc\x88\xc6\x44\xd3\x02\x21\x0e\x97\xc1\x26\xf6\x97\x8f\xf1\x1f\x17\x50\xcf\x83\xbd\x90\x29\x82\x36\xe3\x17\xb4\x00\xc1\xd9\xc9\xc8\x8a\x80\xa2\xfc\xc0\xd0\x48\xad\xe6\x4b\x25\x8d\xcc\xef\x56\xab\x45\xe0\x47\xad\xab\x56\xb4\x5a\x56\xd8\xb2\xd9\xc2\x3f\xda\xef\x17\x74\xb4\xc7\x35\x01\x9e\x67\x96\x84\x02\xc2\x81\xc8\x1d\xd5\x92\xc8\x13\x28\x4d\xa4\x06\x9b\x81\x12\x50\x4a\xaa\x34\xe5\x08\x84\x67\x50\xd0\x83\xb4\x5b\xe3\xfe\x4e\x41\x4e\x7e\xda\x04\x40\x91\x02\x6b\x8e\xd5\x60\x6a\x5f\x63\xb4\x19\xd2\x30\x9e\xb9\xe0\xdc\xbc\xff\xdf\xcc\x9b\xdd\x78\xb3\x1b\x98\xcd\x3e\xd8\x3f\xd8\xc6\x73\xc7\xad\xc3\xb7\x41\xc6\xa6\xf7\xec\x0a\x6b\xd6\x96\x02\x72\x6e\xfe\x82\x94\x25\xe5\x87\x91\xe7\xed\x50\x1f\x11\x79\x3d\x54\x8c\x92\x94\x8d\x59\xe7\x48\x25\xa4\x82\x55\x05\x07\x4e\x0a\x63\x9c\x4a\xa1\x54\x33\x99\xd4\xf4\x7c\x03\x55\x90\x09\x8e\xa6\x34\x50\x29\xb2\xa3\x8c\xea\x93\x99\x2a\x3d\x63\x17\xb0\x59\xb3\xec\x64\x80\x86\x42\x26\xf8\xa1\xbe\x4f\xe7\x44\xc3\x01\x35\xa4\x95\x06\xb1\xdf\x4f\xdf\x6e\x8b\xe4\x09\x4f\x6d\x67\x98\x25\xe0\x2f\xae\xb6\x42\x52\x07\x92\x98\x40\x20\xf2\x97\x81\xdb\x18\x5e\x39\xb8\xec\x97\xbe\x16\x4c\x67\xbc\xdd\x05\x6d\x88\x49\x29\x94\x1d\xaa\x4d\x1b\x37\x23\xce\x5e\x68\x1b\x14\x3c\x4f\xe2\x1e\x25\xf2\x14\xcf\xd4\x4e\xfb\x28\x23\xdb\xe6\x33\xcd\x2c\xc7\x25\x4a\xbb\x85\x78\x8a\x20\x91\x28\xc1\xd5\x30\x73\xf0\x3c\x63\xd5\x06\xf1\x8a\xe1\xd4\x5a\x96\x42\x99\x2d\x33\xd4\x7c\x2f\x08\xd7\xf8\xee\x0d\x82\x52\xa8\xb7\x39\x68\x36\xf1\x45\x91\x9e\xbf\x5f\x2e\x29\xb9\xe8\x79\xab\xdf\xfa\xb4\xe5\xa3\x3b\xb5\xba\x36\x2f\x9a\x54\x14\xa5\x1d\xe8\xd7\xfb\x7d\x4f\x98\x42\xb7\x59\x68\x7b\x52\x31\x9d\xa4\x79\xc5\x9f\x12\xca\x35\xca\x9f\x84\xbd\x3e\x2a\x6a\x4b\x89\x1a\xb9\xbd\xb1\x44\x49\x45\x66\x5a\x36\x58\x7f\xf3\x87\xbb\xd0\x96\xc0\x38\xd0\xc2\x3e\x27\x4d\xbb\x37\x77\x3e\xf3\x30\x0c\x48\x14\xa5\x44\x65\x5f\x47\xff\x22\x9a\x0c\x19\x39\xf5\x8d\x92\x8a\x6b\xca\x06\x23\x74\x10\xd7\xb5\x0a\xf7\x8a\xdb\x11\x3e\x14\x7e\xef\xfb\x9b\xb5\x3f\xe7\xfa\x1f\xde\xae\x2f\x7b\xb4\x8b\x69\xf8\x66\x1d\x3b\xc3\x2a\x3a\x2e\x8c\xdb\xa2\x38\xff\x87\x5c\x54\x52\x39\x93\x0f\x1f\x8c\xb8\x26\xee\x68\xec\x5c\x56\xc0\x58\xbc\x9f\xc1\xbb\xae\x96\xce\x0d\x64\xe4\x34\x30\x6a\xc8\xea\x71\x6d\xcc\xf0\x17\x55\x5a\x8d\x15\x32\x4c\x35\xbc\x83\xbd\x14\x05\x94\x87\xa4\x94\x22\x85\xa3\xdd\x52\xa5\x14\x56\xb8\x1f\xc1\x39\x1b\xd7\xba\x6b\xdd\x4f\x6e\x47\xff\x04\x00\x00\xff\xff\x1c\x51\xce\x94\xd2\x0c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x58\x5d\x6f\xdb\x3a\xd2\xbe\xf7\xaf\x98\x3b\xdb\x07\x52\x90\x5e\xbd\xef\x69\xd1\x05\x14\x5b\x4d\x85\xe3\xc8\x39\xb6\x7c\xda\xec\x62\x21\xd0\xd2\xd8\xe6\x46\x22\x55\x92\x8a\x2b\x2c\xf6\xbf\x2f\xf8\x61\x7d\x38\x71\x1d\x60\x8b\x5e\xc4\xe4\xcc\x70\xf8\xcc\x33\x1f\x94\xff\xf6\xbf\x91\xef\x43\x42\xb6\x05\x42\x8e\x3b\xca\xa8\xa2\x9c\x49\x30\xeb\x6f\xcb\x6b\x05\x02\xb2\xc2\x8c\x92\x02\x54\x53\x21\x1c\x11\x6a\x89\x40\x19\xf0\x5a\x80\xd2\xd6\x24\x48\x0e\x65\x2d\x15\x6c\x11\x32\x81\x44\x61\x0e\x07\x14\x38\x9a\xad\xc2\x20\x09\x61\xbe\x7c\x08\xa2\x18\xd6\xb3\xaf\xe1\x43\x90\x3e\xae\x96\x0f\x37\x05\xd9\x62\x91\x12\x21\x48\x03\xc1\x1a\x28\x53\xff\xf8\x27\xc4\xcb\x04\xe2\xcd\x62\xf1\x69\x74\xd2\x4c\x82\xbb\x45\x08\x55\xbd\x2d\x68\x76\x53\x09\x5e\xa6\x94\x49\x45\x8a\x82\x68\xdf\x53\xca\x76\x1c\x26\x23\x00\x80\x67\x6c\x20\x09\xbf\x27\xf0\xb8\x8a\x1e\x82\xd5\x13\xfc\x11\x3e\x79\x66\xe7\x85\x14\x35\x9a\xbd\xd1\xf4\xd3\x68\x14\xc5\xeb\x70\x95\x40\x14\x27\xcb\x5f\x1b\x9e\x3c\x63\xe3\x59\xed\x29\xfc\x15\x2c\x36\xe1\xda\xd8\x9b\x8c\x33\xa2\x48\xc1\xf7\x20\xb3\x03\x96\x64\xec\x81\xfb\x37\x76\x37\x9c\x05\x49\xb0\x58\xde\x8f\xa7\x9e\x53\xd0\x07\xa0\x3a\x60\x2d\x21\x78\x8c\x3a\xbd\x71\x0f\x92\x4e\x1a\x7f\x2a\x64\x92\x72\x76\x76\xc0\x49\x3a\xfc\x9e\x74\xc2\x12\x05\x45\x79\x26\xd9\x13\x5e\x87\xab\x28\x5c\x77\xf2\x25\x2a\x41\xb3\xcb\xf2\x0f\x61\xb2\x8a\x66\x9d\x7c\x4e\x14\x79\x2d\xdd\xc9\xcf\x83\x24\xe8\xa4\x35\x6e\xa2\x34\x18\x0e\x94\x4e\xd2\x51\xfc\x65\x39\xd6\x51\x18\x06\x78\x88\xdb\x8d\xbb\x93\x0d\x2c\xcd\x61\x4b\xf7\x94\xa9\x96\x1e\xf6\x30\x7b\x91\x94\xe6\xf0\x7a\xcf\xb0\x4b\x5e\x24\x5c\x2b\x0c\xbe\xef\x44\x89\x40\xd8\x17\x7c\x4b\x8a\xa2\x81\x9a\xd1\x1f\x35\xc2\x16\x33\xa2\xb9\xce\x77\x70\xe0\x47\xa8\x88\x50\x2e\x65\x88\x70\x29\x84\xb9\x39\x2f\xc7\x02\x15\xa6\x58\xf1\xec\xd0\x7a\xbb\x59\x2c\x60\x1e\x7e\x09\x36\x0b\x7b\x1a\xf8\x3e\x58\x09\xb2\x53\x28\xe0\x78\xa0\xd9\x01\xd4\x81\x4a\x10\xfc\x08\x19\x61\x3a\x7f\xac\xa9\x7c\x34\x85\xc7\x60\x95\x44\x49\xb4\x8c\xe1\xee\x09\x16\xd1\x3a\x99\xb4\x57\x9e\x76\x19\x12\xc5\xf3\xf0\x3b\x58\xc4\x52\x7b\x19\x8d\xc9\x32\xbe\x00\xea\x66\x1d\xc5\xf7\x70\x1f\xc5\x30\xb1\xd2\x97\x6c\x9d\x1c\x01\x80\x8b\xd6\x26\xfd\x8b\x7b\x40\xf3\xa9\x11\xff\xf6\x35\x5c\x85\x43\x50\xa2\x75\x2f\xbb\xdd\x71\xeb\xf0\xcf\x4d\x18\xcf\x2e\x84\x3f\xa5\xf9\x35\x9e\x98\x0b\x74\x34\xd1\x7a\xa4\x80\xd9\xd7\x70\xf6\x07\x4c\x68\x0e\x7f\x83\xdb\xa9\x37\xa8\x0e\xfd\x8a\xa0\xf0\xa7\xb2\xbf\x7b\x25\x43\xeb\x4d\x21\x8a\x67\x8b\xcd\x3c\x84\x7e\x09\xb0\xa2\x9b\x38\xfa\x73\x33\xdc\xe8\xa4\xf5\xfd\xa7\x9f\x7e\xed\x33\xcd\xa5\x85\xc4\xba\x9d\xd5\x42\x20\x53\x76\x09\xee\xa2\xfb\x28\x4e\x5e\x91\x59\xaa\xb4\xae\x72\xa2\x30\x55\xb4\x44\x48\xa2\x87\x70\x9d\x04\x0f\x8f\xc9\xdf\xcf\x44\x7d\x1f\x76\x5c\x64\x08\x4a\x97\x5f\x50\x1c\x38\x2b\x1a\x4d\x2b\x02\x92\xb2\x7d\x81\x9a\x6a\x16\x2f\x99\x3a\x9a\xdf\x2d\x97\x8b\x30\x88\x5b\x53\x2d\x69\x95\xa8\xb1\x45\xb3\x15\xff\x6c\xd6\xcf\xe0\x68\xb7\x2d\x00\xbe\xaf\x9b\x84\x04\xc2\x80\x88\x2d\x55\x82\x88\x06\xa4\x22\x42\x81\xb9\x81\xe4\x50\x09\x2a\x15\x65\x08\x84\xe5\x50\xd2\xbd\x30\x5d\x63\x7e\x27\xe1\x40\x5e\xcc\x05\x40\x92\x12\x2d\xc6\x72\x50\xb5\x2f\x21\xea\x8a\x34\x4c\x6e\x3d\x18\x7f\xf8\xfd\xff\x6e\xfd\xdb\x0f\xfe\xed\x07\xb8\xbd\xfd\x68\xfe\xc3\x26\x99\x8d\x3d\xeb\xbe\x71\x32\xd1\xb9\x67\x5a\x98\x6b\x5b\x12\xc8\x29\xf9\x4b\x52\x55\x94\xed\x47\xbe\xbf\x45\x75\x44\x64\xb6\xa8\x68\x26\x49\xe3\xb3\x3a\x20\x15\x90\xf1\xa2\x2e\x19\x30\x52\x6a\xe5\x4c\x70\x29\x5d\x65\x92\x37\xa7\x13\xa8\x84\x9c\x33\xd4\xa1\x81\x5a\x92\x2d\x2d\xa8\x6a\x74\x55\xe9\x29\x7b\x80\xae\xcd\x16\x8d\x16\xd4\x10\x16\x9c\xed\xed\x79\xea\x40\x14\xec\x51\x41\x56\x2b\xe0\xbb\xdd\xcd\xf5\xb4\x48\x9f\xb1\x69\x33\x43\x37\x81\x60\x71\x31\x15\x52\xeb\x48\xaa\x1d\x81\x38\x78\x08\x3d\xa7\x78\x61\xe3\x3c\x5f\xfa\x5c\xd0\x99\x71\x3d\x0b\x5a\x17\xd3\x8a\x4b\x53\x54\x5d\x1a\xbb\x12\x67\x0e\x34\x09\x0a\xbe\x2f\x70\x87\x02\x59\x86\x27\x68\x6f\xfa\x52\x9a\xb6\x6e\x99\xe6\x06\xe3\x0a\x85\xe9\x42\x2c\x43\x10\x48\x24\x67\x72\x78\x73\xf0\x7d\xad\xd5\x3a\xf1\x0b\xc5\x1b\xa3\x59\x71\xa9\xbb\xcc\x90\xf3\x3d\x27\x3c\x6d\xbb\x57\x08\x2a\x2e\xaf\x63\x60\xf5\xe1\x2c\x48\xaf\xe7\x97\x73\x48\xce\x72\xde\xf0\xd7\xee\xb6\x78\x74\xbb\x86\xd7\x7a\xa2\xc9\x78\x59\x99\x82\x7e\x39\xdf\x77\xa4\x90\xe8\xb9\x86\xb6\x23\x75\xa1\xd2\xec\x50\xb3\xe7\x94\x32\x85\xe2\x85\x14\xbf\x2e\x15\x56\x53\xa0\x42\x66\x4e\xac\x50\x50\x9e\xeb\x94\x0d\x57\x7f\x05\xc3\x5e\x68\x42\xa0\x0d\x28\x6e\xc6\x49\x9d\xee\xee\xcc\x57\x16\x86\x0e\xf1\xb2\x12\x28\xcd\x74\xf4\x0e\x6f\x72\x2c\x48\xd3\x57\x4a\x6b\xa6\x68\x31\x28\xa1\x03\xbf\x2e\x45\xb8\x17\xdc\x0e\xf0\x21\xf1\x7b\xeb\x57\x63\x7f\xba\xeb\xff\x30\xbb\xbe\x6d\xd1\x34\xa6\xe1\xcc\x3a\x19\x0f\xa3\x38\xf6\x60\xd2\x06\x65\xfc\xff\x70\xe0\xb5\x90\xe3\xe9\xc7\x8f\x9a\x5c\x53\x6f\x34\x19\x9f\x47\x40\x6b\xfc\x7e\x0b\xbf\x75\xb1\x1c\x7f\x80\x9c\x34\x03\x25\x07\x56\x0f\x6b\xad\x86\x3f\xa9\x54\x72\x22\xb1\xc0\x4c\xc1\x6f\xb0\x13\xbc\x84\x6a\x9f\x56\x82\x67\x70\x34\x5d\xaa\x12\xdc\x10\xf7\x33\x8c\x4f\xca\x96\x77\xad\x79\xd7\x52\x4a\x54\xc4\x8c\xa3\x93\xe4\xe9\x31\xf4\x34\xee\x89\x29\xc5\x5f\xc3\xc5\xe3\x54\x17\x54\x97\x52\x3b\x52\xd2\x82\xea\x82\x2c\x41\x22\x53\xb0\x6d\xe0\xb1\x1d\xc1\xb5\x29\xca\x40\x60\xc9\x15\xfa\x47\x41\x95\xce\xf6\x1f\x35\x4a\x25\x6f\xe0\x1b\xda\x9e\xf9\x8c\x58\x19\x5a\x96\x5c\x6a\x4e\x66\xc8\x54\xd1\x80\x44\x64\xad\x23\x23\xdb\x6f\x01\x5f\x50\x34\x83\xc3\x9b\x9b\x6b\xa9\xef\x6e\xd2\xcf\x70\xab\x69\x99\x70\x96\xe3\xfa\xe5\x65\xd6\x5f\x93\xb5\x66\x54\x5d\xda\x3b\x60\x51\x5d\xda\x33\x63\x85\xb9\xce\xe5\x79\x62\x50\xe8\x07\x5e\xf6\xf3\xa1\xa9\xd0\x33\x6e\x78\xe6\xc0\x76\x04\x70\x13\xa7\x99\x03\x75\xbe\x9e\x40\x06\xfc\x89\x59\xad\x4b\x11\x91\x0d\xcb\x0e\x82\x33\x5e\x4b\x3d\xa7\x34\x06\xf1\x8c\x33\x86\x99\xe2\xc2\xb4\x50\x48\x0e\x86\x23\x7b\x4d\x0c\x1d\x64\x02\xff\xe2\x5b\xa0\x12\xec\x4c\x94\xbb\x71\x7a\x10\x04\xaa\x40\xf1\x3a\x3b\xa0\xbc\xb9\x92\x87\x66\x46\xd5\x06\xdb\x3a\x7c\x17\xdd\x5f\x2c\xc5\x44\x65\x07\x14\xd2\x60\xda\x7b\xb0\xda\x5d\x33\xe0\x5c\x1b\xd1\x90\xe5\xd7\x44\xa4\x22\xaa\x96\x43\x1e\xb4\x01\x1c\x57\xc8\x72\xca\xf6\xe3\xd3\x64\xe6\xa4\xf5\x40\xdf\xee\x79\x30\x16\x35\x63\xee\x4f\x59\x67\x19\x62\x8e\x3a\x8f\xc7\x3b\x42\x0b\xfb\x57\xa6\xfb\x5c\xa1\x7f\x4c\x5d\x21\xb3\x2b\xa9\x8b\xd3\x3b\x5b\x85\x9b\x76\x52\x8b\x77\x7e\x0e\x4d\xe7\xf8\xbf\xff\x33\x76\xf7\x1b\x3c\x30\xce\x87\xde\x56\xe1\xd6\x4a\x0b\x7e\x7c\xb7\x2c\x0a\xc1\xc5\x25\xc2\xbb\x2f\x13\x29\x51\x6f\x62\xdf\x6a\x30\x7e\x9c\x4c\x7b\x11\x7d\xad\xf2\xda\xb6\xfe\x9a\x22\x0f\xef\x91\xf4\x7d\xc3\x71\x9d\x7d\x76\x16\xd6\xbf\x8e\x5c\x3c\xa3\x00\x17\x33\xb3\xa4\x29\x29\xb0\xe2\xfa\x7c\x4d\xe7\x23\x91\x40\x0a\xfa\x82\x2e\xb1\x89\x50\x5b\x24\xea\xdd\x07\x2a\x64\x84\x29\xf7\xe8\xec\x22\x6c\x1b\xaf\x4d\x50\xcf\x68\xc1\x91\xaa\x03\xaf\x15\x94\x75\xa1\xa8\x6f\xf4\xb2\xc6\x96\x21\x6b\xe3\x15\xbe\x3a\xe1\x07\x4f\xc8\x2e\xad\x52\x47\xc9\x37\x9e\x90\x9d\x90\x79\x73\xd9\x47\xa3\x63\xf3\xe7\x8e\xe7\x9f\x46\xf7\xab\x20\x4e\x60\xb3\x0e\xee\x43\x0f\xd6\xe1\x22\x9c\x25\xc6\xdc\x85\xe7\x63\xef\x70\x9a\xa7\x12\x7f\x80\xfe\xd2\xa3\x3f\xf1\x98\x5a\x2f\x6c\x71\x32\x17\xe7\x47\x26\x49\x59\x15\xda\x41\x81\x92\x17\xb5\x7d\xdf\xf3\x9d\x2d\xfd\x6e\x90\x87\xd0\xd4\x96\x4e\x02\xa8\x04\x53\xfd\x33\xce\x14\x65\x35\xaf\x25\x90\xfd\x5e\xe0\x9e\x28\x1c\x6a\xbb\xf7\x05\x65\xd0\xfb\x60\x92\xce\x97\xdf\xe2\x75\xf0\xf0\xb8\x08\xaf\xd4\xa7\x93\x87\x38\x6c\x15\xd4\xcc\x55\x1d\x77\x57\xe1\x97\x70\xa5\xc1\x58\xbf\x3d\x65\x1a\x84\x97\x31\xcc\xc3\x45\x98\x84\x30\x0b\xd6\xb3\x60\x1e\x9e\x26\xb6\xf6\x5a\x6d\x7f\x1f\x16\xa4\x17\x8a\xc7\xee\x0d\xd0\x9d\x6a\x07\x9f\xcb\xad\x82\xe6\x5e\xcf\x7a\xdb\x17\x34\x38\x02\x65\x5d\x28\x69\x5f\x41\xa7\xc9\xf4\x34\xa1\x98\x65\x41\xd8\x1e\xe1\x47\x6d\x8a\x85\x07\x19\x31\xd5\xc5\xb6\x08\x07\xfd\xa9\x4b\x40\xa4\x4c\x44\xac\x90\x07\x92\xeb\x8c\xa1\x12\x18\x57\xf0\x2d\x58\xf8\x05\xdf\xef\x31\x6f\xa1\xde\xc4\x8b\xe5\xfd\x7d\x38\x7f\x1b\x73\x7d\x64\x93\x5a\x07\x53\x63\xf1\xea\x98\x66\x85\xe1\xee\x29\x09\x83\xb7\x06\xf0\xf7\x56\x9d\xd1\xf4\xc4\x76\x4b\x73\x0f\xec\xc4\xe7\xc1\xe6\x71\x1e\x24\xa1\x77\x0a\xe0\x32\x7e\x87\xd7\x27\xda\x0b\x24\xb9\xa6\xfd\x7f\x07\x00\x45\x00\x53\x14\x16\x16\x00\x00"),
 		},
 		"/preinstall/004-matcher_operators.sql": &vfsgen۰CompressedFileInfo{
 			name:             "004-matcher_operators.sql",
 			modTime:          time.Time{},
 			uncompressedSize: 4975,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x56\x5f\x4f\xdb\xc8\x17\x7d\xf7\xa7\x38\x95\xaa\x62\x57\x38\x82\x57\x90\x29\x6e\x6a\x20\x3f\x39\x31\x3f\xdb\x51\x77\x85\x90\x35\x38\x97\xc4\xd4\xcc\x64\xc7\x13\x28\x2f\x7c\xf6\x95\xc7\x7f\x36\x14\x13\x12\xb6\x2c\x5b\x69\xfd\x82\x33\xcc\xbd\xbe\xf7\x9c\x33\xf7\x8c\xfd\xec\x63\xd8\x36\x7c\x76\x41\x39\x0a\xca\x29\x55\x42\x16\x60\x7c\x82\x6b\xa6\xd2\x19\xc9\x02\x7a\xcb\x3a\x59\xe2\x59\x56\xa0\xa0\x54\x65\x82\x23\x15\x5c\xb1\x8c\x17\x60\xb8\xa4\x5b\x5c\x2e\xb8\x5e\x2f\xa0\x66\x4c\xe1\x96\xb6\x24\x61\x2a\x32\x3e\x85\x12\x90\x34\xcf\x59\x4a\xc8\x38\xd4\x8c\x90\x4d\xe8\x7a\x2e\x14\x71\xd5\xa4\xeb\x55\xf9\xe9\x0e\x4c\x12\x52\x49\x4c\xd1\x04\x33\x92\x84\xab\x45\xa1\xca\x1c\x2c\xcf\xc5\x2d\x16\x45\xf9\x5e\x6d\xd0\xb9\x38\xa5\x54\x14\x4c\xde\x41\xcc\x49\xb2\xb2\xbd\x9e\x61\xf4\x43\xcf\x8d\x3d\x7c\x09\x86\xee\x60\x84\xa8\x7f\xe2\x0d\xdd\xe4\x34\x0c\x86\xbd\xba\xed\x64\x2e\x8a\x4c\x65\x37\x04\x37\x42\xc6\xd5\xd9\x39\x46\x41\x8c\xd1\xd8\xf7\xf7\xd7\x88\xe6\x34\x65\x9b\x47\xe7\x25\x0d\xc9\x37\xba\x2b\xc3\x62\xef\xb7\x78\xad\xa8\x39\x53\x8a\x24\xef\x88\x31\x6c\xfb\x56\xb2\xf9\x9c\x24\x98\x14\x0b\x3e\xc1\x55\x21\xf8\x45\x42\x2c\x9d\x25\x8a\xbe\x6b\xdc\xa6\x65\x99\x0c\x17\x54\x66\x81\x14\xb7\x09\x15\x2a\xbb\x66\x8a\x0c\xdb\xbe\x14\x12\xba\xaa\x02\xe6\xee\x0e\xb8\x50\xd8\xdd\xd9\xb1\x9a\x6a\x82\x10\xa1\x77\xea\xbb\x7d\x0f\x47\xe3\x51\x3f\x1e\x04\x6d\x69\x7d\x37\x76\xfd\xe0\xb8\xee\xe9\x87\xef\x9a\x57\x45\x55\xca\x36\x82\x71\x8c\xb2\xe3\x72\xb9\xfa\x75\xc3\xf2\x05\xe9\xdf\x96\x81\xd0\x8b\xc7\xe1\x28\x42\xe4\xc5\xc1\x11\x24\xa5\x42\x4e\x0c\xf8\xee\xe8\x78\xec\x1e\x7b\x88\xfe\xef\x1b\x18\x0c\x87\xe3\xd8\xfd\xec\x7b\x38\x75\x43\xd7\xf7\x3d\x1f\x91\x7b\xe4\x21\x8a\xc3\x41\x3f\x46\x18\x7c\x8d\xb0\xbb\x63\xb8\x11\xde\x37\x32\x7c\x8f\xc8\xf3\xbd\x7e\x0c\xf3\x71\x65\x96\xd5\xfb\xb8\xb4\x73\xdf\xd8\xa0\xdb\x54\x2c\xb8\xaa\xbb\xfd\x46\x77\x85\x79\x55\xf5\x69\x19\x4d\x23\x83\x51\xdc\x56\xf2\xde\x00\xd0\x54\xa2\x43\xcd\x8f\xd6\xde\x5e\xc6\x15\x2e\xa5\xb8\x86\x59\xff\xab\xca\x27\x2e\xae\x28\x55\x75\x5a\xcb\xc2\xcd\xbe\x51\x67\x59\x86\xe3\x29\x34\xd6\x6a\x63\x59\xc3\x66\xcd\xfb\x0f\xf5\xaf\x3a\x2b\xdd\x8d\xb9\x61\xe8\xfe\x6e\xea\x95\xfa\x69\x3b\x66\x39\x15\x29\x99\x79\x2f\x9b\x6c\xc3\xde\xb5\x60\xdb\xb0\x77\x91\xf1\x49\x96\x32\x45\x05\xb8\x40\xb1\x48\x67\x95\x06\x97\x53\x1c\x85\xc1\x10\xdd\xda\xaa\xea\xb6\xb7\x92\x84\xb3\x6b\x4a\x92\x2d\x0b\xb4\x1c\xea\x7b\x47\x31\xfe\x17\x0c\xba\xa5\x8a\x07\x9f\x29\x9f\x60\x04\x33\xef\x95\x1a\x75\x40\xfa\xaf\x3b\xfa\x82\xbc\x57\xe9\xb4\x5c\xd3\x6f\x56\x1b\x67\xed\xed\xad\x44\xa9\x8b\xb5\xa8\x9b\xb2\xce\x59\x0b\x31\xc7\xe1\x01\x9e\x1c\xc5\x1b\x9f\xce\x66\x56\x37\x94\x3f\x9e\x49\x4c\x4a\x76\xb7\xad\xb5\x90\x74\xeb\xe2\x73\x10\xf8\x9e\x3b\xea\x96\x40\x1d\x72\x78\xd0\x29\xb4\xa5\xac\xd6\x26\xe0\x34\x6d\x9e\x7a\xa1\x1b\x07\xe1\x83\xdc\x87\x07\xa8\x24\x57\xb2\xed\x86\xc7\x70\x9e\xec\x4a\x6f\x0b\x07\xc7\x27\xf5\xbe\x6a\x30\xe9\xd5\x16\x36\x67\x35\x70\x86\xb5\x8a\xab\x4f\x3f\x91\x2a\x8d\xd9\xb3\x3c\xb5\xde\xbd\x4a\x87\x9b\x71\xf7\xe1\x43\x9b\xf5\x85\x63\xa7\x8b\xa6\x4f\x2f\x66\x69\x55\x67\xeb\x91\xa7\xa3\x34\x73\x6f\x40\x42\x73\x41\x58\x93\x84\xd2\xd3\xcd\xc7\x4c\x58\xff\x5a\x2a\x9a\xfe\x36\xa5\xe2\xc9\x43\xe4\x38\x78\xe7\x38\x70\x9c\x7b\xbc\x73\xee\x7f\xe2\x89\xba\xcc\xf8\xa4\x34\xd5\x84\xfe\x58\xb0\xdc\x2c\xdf\x94\xa8\x2a\xea\xbe\x9c\x6d\x63\xce\x54\xd7\x0d\xec\xef\x98\x64\x3f\x70\x7d\x2f\xea\x7b\xa6\x86\x38\x61\xd3\xa9\x76\x45\x6b\x1b\x7a\xe1\xec\x5c\x5f\x0b\xce\xce\x9f\xf3\x96\xd6\x1b\x57\x78\xdb\xd7\x13\x2f\xf4\xd0\x58\xda\x83\x86\xcb\x8b\xff\x5f\xce\x36\x67\xea\x25\xf3\x78\x63\xe4\xb9\x50\xaf\x8d\x7e\xa3\xc7\x57\x41\xbf\x4d\xfe\x4b\xa2\x2f\x69\x4a\xdf\xff\xd3\x7d\x8b\xfc\xfd\x3f\xaa\xfb\x57\x46\xff\x97\xd3\xfd\x8b\xd1\xef\xf2\x34\xc7\x59\xc3\xd4\x4a\x70\x57\x59\x5a\x8d\xf3\x7a\x4e\xf6\xd0\x4d\x1e\xdc\x2e\xba\x0a\x7c\xf7\x86\x15\xb6\x53\xf7\xd9\x2a\x4b\xd3\x7d\xab\x2a\xf5\xf9\x58\x03\xc7\xfb\x37\xc5\xb1\xa9\xf2\xcf\x00\x00\x00\xff\xff\x05\xa6\xf0\xd1\x6f\x13\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x56\x5d\x4f\xdb\x48\x14\x7d\xf7\xaf\x38\x95\xaa\xd6\xae\x70\x04\xaf\x20\x53\xdc\x74\x80\xac\x9c\x98\xb5\x1d\x75\x57\x08\x59\x83\x73\x49\x4c\xcd\x4c\x76\x3c\x81\xf2\xc2\x6f\x5f\xf9\x73\x43\x31\x21\x61\x4b\xd9\x4a\xeb\x17\x9c\x61\xee\xf5\xb9\xe7\xdc\xb9\x67\xec\x27\x1f\xc3\xb6\xe1\xf1\x73\xca\x90\x53\x46\x89\x96\x2a\x07\x17\x13\x5c\x71\x9d\xcc\x48\xe5\x28\xb7\xac\x93\x25\x9a\xa5\x39\x72\x4a\x74\x2a\x05\x12\x29\x34\x4f\x45\x0e\x8e\x0b\xba\xc1\xc5\x42\x94\xeb\x39\xf4\x8c\x6b\xdc\xd0\x7b\x45\x98\xca\x54\x4c\xa1\x25\x14\xcd\x33\x9e\x10\x52\x01\x3d\x23\xa4\x13\xba\x9a\x4b\x4d\x42\x37\xe9\x7a\x55\x7e\xba\x05\x57\x84\x44\x11\xd7\x34\xc1\x8c\x14\xe1\x72\x91\xeb\x22\x07\xcf\x32\x79\x83\x45\x5e\xbc\x57\x1b\xca\x5c\x82\x12\xca\x73\xae\x6e\x21\xe7\xa4\x78\x51\x5e\xcf\x30\xfa\x01\x73\x23\x86\xcf\xfe\xd0\x1d\x8c\x10\xf6\x8f\xd9\xd0\x8d\x4f\x02\x7f\xd8\xab\xcb\x8e\xe7\x32\x4f\x75\x7a\x4d\x70\x43\xa4\x42\x9f\x9e\x61\xe4\x47\x18\x8d\x3d\x6f\x6f\x8d\x68\x41\x53\xbe\x79\x74\x56\xc8\x10\x7f\xa5\xdb\x22\x2c\x62\x7f\x44\x6b\x45\xcd\xb9\xd6\xa4\x44\x47\x8c\x61\xdb\x37\x8a\xcf\xe7\xa4\xc0\x95\x5c\x88\x09\x2e\x73\x29\xce\x63\xe2\xc9\x2c\xd6\xf4\xad\xe4\x6d\x5a\xc0\xe4\x38\xa7\x22\x0b\x94\xbc\x89\x29\xd7\xe9\x15\xd7\x64\xd8\xf6\x85\x54\x28\x51\xe5\x30\x77\xb6\x21\xa4\xc6\xce\xf6\xb6\xd5\xa0\xf1\x03\x04\xec\xc4\x73\xfb\x0c\x87\xe3\x51\x3f\x1a\xf8\x2d\xb4\xbe\x1b\xb9\x9e\x7f\x54\xd7\xf4\xdd\x77\xcd\xcb\xbc\x82\xb2\x05\x7f\x1c\xa1\xa8\xb8\x58\xae\x7e\x5d\xf3\x6c\x41\xe5\x6f\xcb\x40\xc0\xa2\x71\x30\x0a\x11\xb2\xc8\x3f\x84\xa2\x44\xaa\x89\x01\xcf\x1d\x1d\x8d\xdd\x23\x86\xf0\x77\xcf\xc0\x60\x38\x1c\x47\xee\x27\x8f\xe1\xc4\x0d\x5c\xcf\x63\x1e\x42\xf7\x90\x21\x8c\x82\x41\x3f\x42\xe0\x7f\x09\xb1\xb3\x6d\xb8\x21\xde\x36\x6d\xf8\x16\x21\xf3\x58\x3f\x82\xf9\x10\x99\x65\xf5\x3e\x2c\xed\xdc\x33\x36\xa8\x36\x91\x0b\xa1\xeb\x6a\xbf\xd2\x6d\x6e\x5e\x56\x75\x5a\x46\x53\xc8\x60\x14\xb5\x48\xde\x1a\x00\x1a\x24\x65\xa8\xf9\xc1\xda\xdd\x4d\x85\xc6\x85\x92\x57\x30\xeb\x7f\x55\xf9\xe4\xf9\x25\x25\xba\x4e\x6b\x59\xb8\xde\x33\xea\x2c\xcb\x74\x3c\xc6\xc6\x5a\x65\x2c\xf7\xb0\x59\xeb\xfe\x1d\xfe\x55\x67\xa5\xbb\x30\x37\x08\xdc\x3f\xcd\x72\xa5\x7e\xda\x8a\x79\x46\x79\x42\x66\xd6\x4b\x27\x5b\xb0\x77\x2c\xd8\x36\xec\x1d\xa4\x62\x92\x26\x5c\x53\x0e\x21\x91\x2f\x92\x59\xd5\x83\xcb\x29\x0e\x03\x7f\x88\xee\xde\xaa\x70\xdb\xef\xe3\x58\xf0\x2b\x8a\xe3\xf7\x16\x68\x39\xd4\x63\x87\x11\x7e\xf3\x07\xdd\xad\x8a\x7b\x9f\x29\x1e\x7f\x04\x33\xeb\x15\x3d\xea\x80\xca\xbf\xee\xe8\x33\xb2\x5e\xd5\xa7\xc5\x5a\xf9\x66\xb5\x71\xd6\xee\xee\x4a\x96\xba\x54\x0b\xbb\x25\xeb\x9c\xb5\x90\x73\x1c\xec\xe3\xd1\x51\xbc\xf1\xe9\x6c\x66\x75\x23\xf9\xc3\x99\xc4\x95\xe2\xb7\x5b\x65\x2f\xc4\xdd\x7d\xf1\xc9\xf7\x3d\xe6\x8e\xba\x5b\xa0\x0e\x39\xd8\xef\x6c\xb4\xa5\xac\xd6\x26\xe4\x34\x65\x9e\xb0\xc0\x8d\xfc\xe0\x5e\xee\x83\x7d\x98\x46\xa3\xb6\x1b\x1c\xc1\x79\xb4\xaa\x72\x5b\x30\x38\x3a\xae\xf7\x55\x83\xa9\x5c\x6d\x69\x73\x56\x13\x67\x58\xab\xb4\xfa\xf8\x03\xa5\x2a\x39\x7b\x52\xa7\xd6\xbb\x57\xf5\xe1\x66\xda\xbd\x7b\xd7\x66\x7d\xe6\xd8\xe9\x92\xe9\xe3\xb3\x55\x5a\x55\xd9\x7a\xe2\x95\x51\x86\xb5\x84\xf0\x27\x8a\xd0\x5c\x10\xd6\x14\xa1\xf0\x74\xf3\xa1\x12\xd6\x7f\x56\x8a\xa6\xbe\x4d\xa5\x78\xf4\x10\x39\x0e\xde\x38\x0e\x1c\xe7\x0e\x6f\x9c\xbb\x1f\x78\xa2\x2e\x52\x31\x29\x4c\x35\xa6\xbf\x16\x3c\x33\x8b\x37\x2d\x2b\x44\xdd\x97\xb3\x2d\xcc\xb9\xee\xba\x81\xfd\x1b\x93\xec\xfb\xae\xc7\xc2\x3e\x33\x4b\x8a\x63\x3e\x9d\x96\xae\x68\x6d\xa1\x5c\x38\x3d\x2b\xaf\x05\xa7\x67\x4f\x79\x4b\xeb\x8d\x2b\xbc\xed\xcb\x31\x0b\x18\x1a\x4b\xbb\x57\x70\x71\xf1\xff\xc7\xd9\xe6\x5c\x3f\x67\x1e\x6f\xcc\xbc\x90\xfa\xa5\xd9\x6f\xfa\xf1\x45\xd8\x6f\x93\xff\x92\xec\x2b\x9a\xd2\xb7\xff\xfb\xbe\x65\xfe\xee\xa7\xf6\xfd\x0b\xb3\xff\xcb\xf5\xfd\xb3\xd9\xef\xf2\x34\xc7\x59\xc3\xd4\x0a\x72\x57\x59\x5a\xcd\xf3\x7a\x4e\x76\xdf\x4d\x0c\xeb\x09\x80\x6f\x5e\x11\x61\x3b\x75\x9f\x44\x59\x98\xee\x6b\xa1\x2c\xcf\xc7\x1a\x3c\xde\xbd\x2a\x8f\x0d\xca\xbf\x07\x00\x05\xa6\xf0\xd1\x6f\x13\x00\x00"),
 		},
 		"/preinstall/005-install_uda.sql": &vfsgen۰CompressedFileInfo{
 			name:             "005-install_uda.sql",
 			modTime:          time.Time{},
 			uncompressedSize: 833,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\xd2\x5f\x6b\xa3\x40\x14\x05\xf0\xf7\xf9\x14\xe7\x21\x60\x03\x69\x68\xbb\x6f\x0d\x5d\x98\x9a\x31\x15\x26\x6a\x47\xc3\x3e\xca\xa8\x37\x76\xc4\x8c\xa2\x63\x37\xfd\xf6\x4b\xd2\x6e\xd8\x3f\x2c\xec\xc2\x3e\xeb\xbd\xe7\xdc\x1f\xe3\x2b\xc1\x33\x81\x58\x41\x89\x44\x72\x5f\x20\xd8\x45\x7e\x16\xc6\x11\x52\xff\x49\x6c\x79\xee\xf3\x8c\xcb\x78\xb3\xac\xc9\xe5\xce\x1c\x68\x2c\x75\x4b\xf9\x41\x37\xdd\x90\xbf\xd2\x30\x9a\xce\x5e\xcd\x19\x00\x28\x91\xed\x54\x94\x22\x8c\x32\xc6\x53\xcc\xf6\x93\x2d\x67\xe7\x2f\xa9\x90\xc2\xcf\x30\xf6\xad\x71\x79\xaf\x07\x77\x45\x47\xf7\x31\xbc\x80\xb7\xf4\x16\xb8\x9d\xdf\xdf\x87\x51\x86\x40\xc5\x5b\xf4\x75\x5e\x6a\xa7\xdb\xae\x5e\xf6\x75\x4e\x47\x47\xf6\xf4\x2b\xbe\x3c\x09\x25\x40\x47\x67\xf5\x81\x1e\xbc\x4b\x9d\xaa\xf0\x20\xc3\x6d\x98\xe1\x76\xc5\x3e\x72\x25\x8f\x36\x3b\xbe\x11\x48\x9f\x25\xd2\x8c\x3f\x4a\x81\x84\x2b\x2e\xa5\x90\x48\x79\x20\x56\x8c\x5d\x5f\x37\xd3\xe8\xa0\x31\xba\xa9\xc0\x57\xd3\xb6\x28\x08\x03\xf5\xad\x2e\xa9\x82\xb1\x70\x2f\x04\x53\xd1\xa1\xef\x1c\x59\x87\xb1\x1c\x4c\xef\x46\xf6\x3b\x5b\xa2\x62\x5f\xac\x77\x4a\xfc\xea\x46\x47\x2a\x27\x77\x12\x33\xd6\x91\xd5\xb6\xa4\xbc\xe9\x8a\xab\xa6\x2b\x72\x73\xca\x70\x0b\x94\x9d\xdd\x9b\x1a\xcd\xd8\xd9\x62\x7e\xb6\x9b\xb1\x47\xb1\x09\xa3\x77\x57\x1e\xa6\x02\x5e\xa9\xdb\xd6\xd8\x1a\x7f\x58\x78\xbe\x61\x81\xf1\xa5\x9b\xda\x0a\x2f\xfa\x95\x50\x10\xd9\xcb\x35\xde\x8a\x89\x68\xcd\x66\x33\x5c\x64\x12\x99\x6c\xd2\x67\xb9\x62\x27\x09\x5d\x55\xb8\x43\xd3\x15\xe3\x47\xc2\x39\xeb\x95\x86\x37\x7c\xba\xc1\xc1\x58\x14\x6f\xa8\x68\xaf\xa7\xd6\x61\xdf\x0d\xf8\x41\x1f\x77\xcb\x1b\xb6\x8e\x7f\xae\x1d\x06\xff\xf4\x84\xf0\xf9\x01\x77\xc8\x9e\xc4\xfb\x34\x80\x44\xa8\x20\x56\x5b\xe8\xaa\x3a\x8b\x79\x7f\x27\xeb\x2d\xe0\xbd\x37\xf6\xe6\xab\xff\xbe\x4b\x44\x6b\x84\xc1\x77\xcb\x15\xfb\x16\x00\x00\xff\xff\x8d\x56\xe6\xa0\x41\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\xd2\x5f\x6b\xa3\x40\x14\x05\xf0\xf7\xf9\x14\xe7\x21\x60\x03\x69\x68\xbb\x6f\x0d\x5d\x98\x9a\x31\x15\x26\x6a\x47\xc3\x3e\xca\xa8\x37\x76\xc4\x8c\xa2\x63\x37\xfd\xf6\x4b\xd2\x6e\xd8\x3f\x2c\xec\xc2\x3e\xeb\xbd\xe7\xdc\x1f\xe3\x2b\xc1\x33\x81\x58\x41\x89\x44\x72\x5f\x20\xd8\x45\x7e\x16\xc6\x11\x52\xff\x49\x6c\x79\xee\xf3\x8c\xcb\x78\xb3\xac\xc9\xe5\xce\x1c\x68\x2c\x75\x4b\xf9\x41\x37\xdd\x90\xbf\xd2\x30\x9a\xce\x5e\xcd\x19\x00\x28\x91\xed\x54\x94\x22\x8c\x32\xc6\x53\xcc\xf6\x93\x2d\x67\xe7\x2f\xa9\x90\xc2\xcf\x30\xf6\xad\x71\x79\xaf\x07\x77\x45\x47\xf7\x31\xbc\x80\xb7\xf4\x16\xb8\x9d\xdf\xdf\x87\x51\x86\x40\xc5\x5b\xf4\x75\x5e\x6a\xa7\xdb\xae\x5e\xf6\x75\x4e\x47\x47\xf6\xf4\x2b\xbe\x3c\x09\x25\x40\x47\x67\xf5\x81\x1e\xbc\x4b\x9d\xaa\xf0\x20\xc3\x6d\x98\xe1\x76\xc5\x3e\x72\x25\x8f\x36\x3b\xbe\x11\x48\x9f\x25\xd2\x8c\x3f\x4a\x81\x84\x2b\x2e\xa5\x90\x48\x79\x20\x56\x8c\x5d\x5f\x37\xd3\xe8\xa0\x31\xba\xa9\xc0\x57\xd3\xb6\x28\x08\x03\xf5\xad\x2e\xa9\x82\xb1\x70\x2f\x04\x53\xd1\xa1\xef\x1c\x59\x87\xb1\x1c\x4c\xef\x46\xf6\x3b\x5b\xa2\x62\x5f\xac\x77\x4a\xfc\xea\x46\x47\x2a\x27\x77\x12\x33\xd6\x91\xd5\xb6\xa4\xbc\xe9\x8a\xab\xa6\x2b\x72\x73\xca\x70\x0b\x94\x9d\xdd\x9b\x1a\xcd\xd8\xd9\x62\x7e\xb6\x9b\xb1\x47\xb1\x09\xa3\x77\x57\x1e\xa6\x02\x5e\xa9\xdb\xd6\xd8\x1a\x7f\x58\x78\xbe\x61\x81\xf1\xa5\x9b\xda\x0a\x2f\xfa\x95\x50\x10\xd9\xcb\x35\xde\x8a\x89\x68\xcd\x66\x33\x5c\x64\x12\x99\x6c\xd2\x67\xb9\x62\x27\x09\x5d\x55\xb8\x43\xd3\x15\xe3\x47\xc2\x39\xeb\x95\x86\x37\x7c\xba\xc1\xc1\x58\x14\x6f\xa8\x68\xaf\xa7\xd6\x61\xdf\x0d\xf8\x41\x1f\x77\xcb\x1b\xb6\x8e\x7f\xae\x1d\x06\xff\xf4\x84\xf0\xf9\x01\x77\xc8\x9e\xc4\xfb\x34\x80\x44\xa8\x20\x56\x5b\xe8\xaa\x3a\x8b\x79\x7f\x27\xeb\x2d\xe0\xbd\x37\xf6\xe6\xab\xff\xbe\x4b\x44\x6b\x84\xc1\x77\xcb\x15\xfb\x36\x00\x8d\x56\xe6\xa0\x41\x03\x00\x00"),
 		},
 		"/versions": &vfsgen۰DirInfo{
 			name:    "versions",
@@ -125,14 +132,14 @@ var MigrationFiles = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 246,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x5c\xcd\xd1\x4e\x83\x30\x14\x87\xf1\x7b\x9e\xe2\x7f\x07\x98\xc5\x07\xd8\xe2\xc5\x19\x54\x5d\x72\xd6\x26\xa3\x78\x4b\x48\x3d\x73\x64\x40\x49\x5b\x74\xbe\xbd\x17\x6a\x62\x7c\x80\xef\xfb\x11\x5b\x75\x82\xa5\x3d\x2b\x34\xd5\xb3\x3a\x52\x57\x91\x25\x36\x4f\xf7\x93\xa4\x30\xb8\x8c\xea\x1a\x95\xe1\xf6\xa8\xf1\x2a\xe7\x7e\x1d\x53\xe7\xfc\xb4\x04\x89\x71\xf0\x33\xf6\xc6\xb0\x22\x0d\x6d\x2c\x74\xcb\x8c\x5a\x3d\x52\xcb\x16\x29\xac\xb2\xcb\xb2\x83\x6e\xd4\xc9\xe2\xa0\xad\xf9\x0f\xfc\xec\x8a\xab\x7c\x6e\xde\xfb\x71\x95\x12\x2f\xc4\xad\x6a\xb2\x22\xff\xc6\xff\x4a\xf9\x06\x85\xdc\x86\x98\x62\x11\x65\x14\x97\x70\x87\x73\xf0\x13\x96\xb7\x6e\x09\xde\xe1\xe3\x22\x41\xb0\x04\x3f\xf7\x93\xe0\x01\xf9\x6f\xdc\xb9\xcb\x3a\x5f\xf3\x72\xbb\x4d\x72\x4b\x65\xb9\xcb\xbe\x02\x00\x00\xff\xff\xae\xdc\x15\xea\xf6\x00\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x5c\xcd\xd1\x4e\x83\x30\x14\x87\xf1\x7b\x9e\xe2\x7f\x07\x98\xc5\x07\xd8\xe2\xc5\x19\x54\x5d\x72\xd6\x26\xa3\x78\x4b\x48\x3d\x73\x64\x40\x49\x5b\x74\xbe\xbd\x17\x6a\x62\x7c\x80\xef\xfb\x11\x5b\x75\x82\xa5\x3d\x2b\x34\xd5\xb3\x3a\x52\x57\x91\x25\x36\x4f\xf7\x93\xa4\x30\xb8\x8c\xea\x1a\x95\xe1\xf6\xa8\xf1\x2a\xe7\x7e\x1d\x53\xe7\xfc\xb4\x04\x89\x71\xf0\x33\xf6\xc6\xb0\x22\x0d\x6d\x2c\x74\xcb\x8c\x5a\x3d\x52\xcb\x16\x29\xac\xb2\xcb\xb2\x83\x6e\xd4\xc9\xe2\xa0\xad\xf9\x0f\xfc\xec\x8a\xab\x7c\x6e\xde\xfb\x71\x95\x12\x2f\xc4\xad\x6a\xb2\x22\xff\xc6\xff\x4a\xf9\x06\x85\xdc\x86\x98\x62\x11\x65\x14\x97\x70\x87\x73\xf0\x13\x96\xb7\x6e\x09\xde\xe1\xe3\x22\x41\xb0\x04\x3f\xf7\x93\xe0\x01\xf9\x6f\xdc\xb9\xcb\x3a\x5f\xf3\x72\xbb\x4d\x72\x4b\x65\xb9\xcb\xbe\x06\x00\xae\xdc\x15\xea\xf6\x00\x00\x00"),
 		},
 		"/versions/dev/0.1.1-dev/2-add_id_deletion_epochs.sql": &vfsgen۰CompressedFileInfo{
 			name:             "2-add_id_deletion_epochs.sql",
 			modTime:          time.Time{},
 			uncompressedSize: 2418,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x56\xdf\x6f\x9b\x48\x10\x7e\xe7\xaf\x98\x93\x1c\xd9\x96\x88\x95\xdc\xcb\xe9\x82\xfa\xb0\xc1\x1b\x07\x1d\x06\x17\x96\xfe\xb8\xaa\x42\x14\x26\xce\x2a\x18\xb8\xdd\x25\x6e\xa4\xfe\xf1\xa7\x5d\x28\xc6\x76\x73\xbd\x5a\x51\x1e\x76\x66\x67\xbe\x6f\xe6\xe3\x03\xe2\x33\x1a\x01\x23\xb7\x3e\x85\xd8\xbd\xa7\x6b\x92\xba\x84\x11\x3f\x5c\x2d\x24\x0a\x8e\xd2\x02\x00\x20\xcb\x25\xb8\xa1\x9f\xac\x03\x28\xb0\x44\x85\x29\x36\x75\xfe\x08\xb7\xde\xca\x0b\x98\x63\x59\x6e\x44\x09\xa3\xe0\x05\x4b\xfa\x01\xba\x8b\x69\x97\x59\x98\x02\x61\xf0\xe3\xea\xb3\x71\x39\x1b\x78\x31\x37\xe9\xef\xef\x69\x44\x8f\x5b\x79\x31\x04\x21\x83\x20\xf1\x7d\xc7\xb2\xac\xcb\x4b\xe8\xce\x1f\x6a\xd1\x25\xf2\x6a\xdb\x77\x86\xac\x2a\xa0\xcc\xbe\x60\x99\x3e\xe1\x0b\xf0\x42\x7e\xc7\xf7\x43\x9e\xbc\x90\x5d\x8f\x99\xe9\x9d\xb7\x42\x60\xa5\x8e\x18\x0e\xad\x6d\x93\x52\x66\x52\xa5\x6d\x53\x64\x0a\x53\xc5\x77\x08\xcc\x5b\xd3\x98\x91\xf5\x86\xfd\x7d\x92\x7a\x79\xa9\x11\xe6\x08\xea\x11\x05\x82\xaa\xa1\xae\xca\x17\xf8\x82\x90\x81\xe4\xd5\xb6\x44\x10\xf5\xde\xa4\x72\x99\xb6\x15\xff\xa7\x45\xb8\x0d\x43\x9f\x92\x60\x28\x05\x4b\x7a\x47\x12\x9f\x81\x12\x2d\x82\x7b\x4f\xdd\xbf\x60\x76\x48\x7f\x63\xce\xe7\x5d\xc3\x24\xf0\xde\x26\x74\x14\x9e\x5b\x73\xc7\xb2\xbc\x20\xa6\x11\x03\x2f\x60\xe1\xab\xfc\xe1\x1d\xf1\x13\x1a\xc3\xec\xca\x86\xe9\xf5\x9f\x7f\x5c\x5d\x5e\x5d\x5f\x5e\x5d\xc3\xd5\xd5\x8d\xf9\x83\x84\xb9\x53\xbb\x6b\xe6\x98\x15\x08\xcc\x05\x66\x4a\xb3\xe3\x12\x1e\xda\x2a\x57\xbc\xae\xa0\xaa\xf7\x9a\x69\x56\x14\x9a\xf6\x6b\xdb\x4c\x7c\x5f\xd7\xd0\x19\xbc\xc0\x5d\x53\x2b\xac\x14\xc8\x5c\xf0\x46\x49\xc8\x04\x82\x68\x2b\x50\x75\x0d\xa5\x6e\xa1\x17\xdd\x4a\x5d\x77\x8b\xca\xdc\xca\x6b\x21\x30\x57\xba\xc8\x33\x0a\xa9\x3b\xeb\xcd\x77\x8b\x31\x19\x75\x59\xc0\x33\xc7\xfd\x20\x80\x30\x82\x88\x6e\x7c\xe2\x52\xb8\x4b\x02\x97\x79\xe7\xc2\xec\x18\xa5\xbd\x88\xf5\xed\x4e\x17\xfa\xb7\x43\x25\x78\x9e\x56\xd9\x0e\x41\xe1\x57\xd5\xa9\x35\xa2\x2c\x89\x82\xf8\xfb\xda\x2c\x12\xc3\x44\xcf\x62\x62\x2d\xa9\xeb\x93\x88\x5a\x46\x33\x5a\x8f\xcf\x59\xd9\x62\x9a\xd7\xa5\x34\xf7\x1d\x1d\xd1\x2d\x0e\x25\xcd\x51\xdf\x87\x17\xc0\x2b\xe5\x58\xb7\x74\xe5\x05\xa6\x55\x4c\x7d\xea\xb2\x01\xcf\xd4\x9e\xc2\xb7\x6f\x20\x95\xe0\xd5\x36\xcd\xb6\xdb\x03\x54\xfd\x7b\xa8\xc5\x2e\x53\x30\x9b\xf6\x14\x37\x51\xb8\x5e\x3c\x67\xe5\xac\x23\xb7\x30\x98\xe4\xa7\x0b\xf9\x79\x0e\x24\x86\x0b\x6f\x6a\x37\xb5\xbc\xb9\xe1\x95\xb2\x4f\xc7\xb2\x45\x95\x0e\xcf\x94\x66\xd0\xee\x2a\x83\x3a\x7d\xa8\x45\x37\xa6\x27\x7c\xb1\xe1\x21\x2b\x25\xce\xe7\x03\x0e\x1b\xa6\x36\x4c\x21\x8c\x96\x34\x82\xdb\x8f\xd0\xd4\xb2\x0b\x76\x52\x64\x91\xe7\xb2\xb3\xe1\x98\x84\xbb\x28\x5c\x9f\xa2\x38\x20\x68\x6a\xc9\x8d\xd8\xca\xa7\x66\xe4\x19\xe5\x53\xb3\x18\x6f\xe9\x0d\x9c\xef\xf3\x28\x41\x2b\x46\xbb\xc4\x6f\x6f\x60\x9a\x76\x84\xd2\xa9\x63\x8d\xa6\x0d\xbb\x85\xca\xbe\x94\x68\x82\x36\xec\x16\xbc\x38\x23\x30\xec\xd0\x3e\xec\xee\x55\x12\x5d\x06\xec\x46\xb0\x77\xbf\x04\xba\x87\x47\x3f\x50\x37\x61\x14\xee\xc2\x68\x4d\xd8\x6c\x32\x19\x86\x7e\xae\xf6\x77\x1e\x7d\xff\x1d\x47\x4c\x23\x8f\xc6\x8b\x8b\xeb\x89\x07\x24\x1e\x2e\x9d\x68\xcb\x58\x52\xa1\x75\xd1\xa3\xe0\x85\x7d\x14\xec\xd4\x73\x74\x74\xf1\xfb\xe4\x70\xa0\xa9\x1f\x45\xfb\xf6\x4b\xc2\x4e\x31\xc0\xe8\x5d\xf3\x1f\xfe\xaf\x1d\x43\x87\x27\x13\x7b\x3c\xf1\x53\xf5\xcc\x9d\xd1\x63\x69\xec\xca\xb1\x68\xb0\xb4\xfa\x87\xd2\x27\xc1\x2a\x21\x2b\x0a\x1b\x7f\xb3\x8a\xdf\xfa\xf0\x2e\xf4\x09\xf3\x7c\xea\x58\xab\x88\x04\x6c\x98\x6b\x18\xfc\x8a\x49\x18\x3f\x00\x16\x42\x23\xea\x5d\xba\x17\x5c\xa1\xe8\x5c\xb2\x37\x24\x6d\x46\xfd\x0b\xca\x78\x92\xb6\xb2\xc7\xec\x19\x7f\xf2\xb6\xb3\x96\x21\x4c\x8e\xac\x64\x6c\x43\x12\x18\xfd\xc0\x3e\x7d\x76\x4e\x03\x60\x02\xe7\xde\x01\x79\x9d\x95\x28\x73\x9c\x65\x42\x64\x2f\xc6\x34\x8e\xd4\x37\xb7\xc1\x44\x3e\x7d\xbe\xb9\xe9\x6a\xcf\x7f\xae\xe2\xe1\x79\x18\x43\xeb\x65\x7a\x17\x46\x94\xb8\xf7\x47\xe0\xbc\x00\x48\x14\x91\x8f\x47\xf9\x26\xdb\x0f\xc3\xcd\x50\x72\x43\x23\x2d\xee\xff\x31\xfd\x31\x81\x6e\x16\x34\x58\x9a\x62\x66\xf7\x93\x49\xff\xd1\xb0\xc7\xa9\x7e\xb1\x60\x53\x66\xb9\xfe\x62\xd8\x73\xf5\x08\x99\xde\x59\x8e\x45\x2b\xd0\x06\x59\xc3\x1e\xa1\x42\x2c\x20\xab\x00\xbf\x36\x25\xcf\xb9\x82\x65\x14\x6e\x2c\xfd\xef\x55\x4d\x14\xa2\x6e\xd2\x1e\x47\xfe\xd8\x56\x4f\x72\xa6\xe7\x67\x8f\xbf\x0e\xe6\x8e\xf5\x6f\x00\x00\x00\xff\xff\xa5\x82\xb7\x8e\x72\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x56\x5d\x6f\xa3\x48\x10\x7c\xe7\x57\xd4\x49\x8e\x6c\x4b\xc4\x4a\xee\xe5\x74\x41\xfb\x30\xc1\x13\x07\x1d\x06\x2f\x8c\xf7\xe3\x56\x2b\x44\xa0\xe3\x8c\x82\x81\x1b\xc6\xf1\x46\xda\x1f\x7f\x1a\x70\x6c\xec\x6c\x6e\x6f\x51\x94\x87\xe9\x9e\xee\xaa\xee\xa2\x0c\xf3\x05\x8f\x20\xd8\xb5\xcf\x11\xbb\xb7\x7c\xce\x12\x97\x09\xe6\x87\xb3\x49\x43\x4a\x52\x63\x01\x00\x9b\x4e\xe1\x86\xfe\x72\x1e\x20\xa7\x82\x34\x25\x54\x57\xd9\x03\xae\xbd\x99\x17\x08\xc7\xb2\xdc\x88\x33\xc1\xe1\x05\x53\xfe\x09\xdd\xc5\xa4\xcb\xcc\xdb\x02\x61\xf0\xe3\xea\xa3\x7e\x39\x1b\x32\x1f\xb7\xe9\x1f\x6f\x79\xc4\x8f\x5b\x79\x31\x82\x50\x20\x58\xfa\xbe\x63\x59\xd6\xf9\x39\xba\xf3\xfb\x4a\x75\x89\xb2\x5c\xed\x3a\x23\x2d\x73\x14\xe9\x1d\x15\xc9\x23\x3d\x43\xe6\xcd\x0b\xbe\x1f\xf2\x94\x79\xd3\xf5\x18\xb5\xbd\xb3\x8d\x52\x54\xea\x23\x86\xfb\xd6\x76\x9b\x52\xa4\x8d\x4e\x36\x75\x9e\x6a\x4a\xb4\x5c\x13\x84\x37\xe7\xb1\x60\xf3\x85\xf8\xfb\x24\xf5\xfc\xdc\x20\xcc\x08\xfa\x81\x14\x41\x57\xa8\xca\xe2\x19\x77\x84\x14\x8d\x2c\x57\x05\x41\x55\xdb\x36\x55\x36\xc9\xa6\x94\xff\x6c\x08\xd7\x61\xe8\x73\x16\xec\x4b\x61\xca\x6f\xd8\xd2\x17\xd0\x6a\x43\x70\x6f\xb9\xfb\x17\x46\x87\xf4\x77\xed\xf9\xb8\x6b\xb8\x0c\xbc\xf7\x4b\xde\x0b\x8f\xad\xb1\x63\x59\x5e\x10\xf3\x48\xc0\x0b\x44\xf8\x26\x7f\x7c\x60\xfe\x92\xc7\x18\x5d\xd8\x18\x5e\xfe\xf9\xc7\xc5\xf9\xc5\xe5\xf9\xc5\x25\x2e\x2e\xae\xda\x3f\x2c\x85\x3b\xb4\xbb\x66\x4e\xbb\x02\x45\x99\xa2\x54\x1b\x76\xb2\xc1\xfd\xa6\xcc\xb4\xac\x4a\x94\xd5\xd6\x30\x4d\xf3\xdc\xd0\x7e\x6b\x9b\x4b\xdf\x37\x35\x4c\x86\xcc\x69\x5d\x57\x9a\x4a\x8d\x26\x53\xb2\xd6\x0d\x52\x45\x50\x9b\x12\xba\xaa\x50\x98\x16\x66\xd1\x9b\xc6\xd4\x5d\x91\x6e\x6f\x65\x95\x52\x94\x69\x53\xe4\x89\x54\x63\x3a\x9b\xcd\x77\x8b\x69\x33\xaa\x22\xc7\x93\xa4\xed\x5e\x00\x61\x84\x88\x2f\x7c\xe6\x72\xdc\x2c\x03\x57\x78\xaf\x85\xd9\x31\x4a\x76\x22\x36\xb7\x3b\x5d\x98\x67\x4d\x5a\xc9\x2c\x29\xd3\x35\x41\xd3\x37\xdd\xa9\x35\xe2\x62\x19\x05\xf1\xcb\xda\x2c\x16\x63\x60\x66\x31\xb0\xa6\xdc\xf5\x59\xc4\x2d\x60\xa7\xc7\xa7\xb4\xd8\x50\x92\x55\x45\xd3\xde\x77\x4c\xc4\xb4\x38\x94\x74\xac\x43\x1f\x99\x43\x96\xda\xb1\xae\xf9\xcc\x0b\xcc\x39\x62\xee\x73\x57\xec\xf1\x0c\xed\x21\xbe\x7f\x47\xa3\x95\x2c\x57\x49\xba\x5a\x1d\xa0\x9a\xe7\xbe\x52\xeb\x54\x63\x34\xdc\x51\x5c\x44\xe1\x7c\xf2\x94\x16\xa3\x8e\xdc\xa4\xc5\xd4\x7c\x39\x6b\xbe\x8e\xc1\x62\x9c\x79\x43\xbb\xae\x9a\xab\x2b\x59\x6a\xfb\x74\x2c\x2b\xd2\xc9\xfe\x9d\x32\x0c\x36\xeb\xb2\x45\x9d\xdc\x57\xaa\x1b\xd3\x23\x3d\xdb\xb8\x4f\x8b\x86\xc6\xe3\x3d\x0e\x1b\x43\x1b\x43\x84\xd1\x94\x47\xb8\xfe\x8c\xba\x6a\xba\x60\x27\x45\x11\x79\xae\x78\x35\x9c\x36\xe1\x26\x0a\xe7\xa7\x28\x0e\x08\xea\xaa\x91\xad\xd8\x8a\xc7\xba\xe7\x19\xc5\x63\x3d\xe9\x6f\xe9\x1d\x5e\xef\xf3\x28\xc1\x28\xc6\xb8\xc4\x6f\xef\x30\x4c\x3a\x42\xc9\xd0\xb1\x7a\xd3\xc6\x7a\xa2\xd3\xbb\x82\xda\xa0\x8d\xf5\x44\xe6\xaf\x08\xec\x77\x68\x1f\x76\xf7\x26\x89\x2e\x03\xeb\x1e\xec\xf5\x2f\x81\xde\xc1\xe3\x9f\xb8\xbb\x14\x1c\x37\x61\x34\x67\x62\x34\x18\xec\x87\xfe\x5a\xed\x1f\x3c\xfe\xf1\x05\x47\xcc\x23\x8f\xc7\x93\xb3\xcb\x81\x07\x16\xef\x2f\x9d\x68\xcb\x3c\x32\x07\x8b\x5f\xfc\x5c\xe6\xf6\x51\xb0\x53\xcf\xd1\xd1\xd9\xef\x83\xc3\x81\xa1\x7e\x14\xdd\xb5\x9f\x32\x71\x8a\x01\xbd\xdf\x9a\xff\xf0\x7f\xe3\x18\x00\x30\x18\xd8\xfd\x89\x9f\xaa\x67\xec\xf4\x5e\xcb\xd6\xae\x1c\x8b\x07\x53\x6b\xf7\x52\xfa\x2c\x98\x2d\xd9\x8c\x63\xe1\x2f\x66\xf1\x7b\x1f\x1f\x42\x9f\x09\xcf\xe7\x8e\x35\x8b\x58\x20\xf6\x73\x0d\x83\x5f\x31\x89\xd6\x0f\x20\x42\xd4\xaa\x5a\x27\x5b\x25\x35\xa9\xce\x25\x77\x86\x64\xcc\xa8\xbb\xd0\x79\x12\x74\x85\x87\xf4\x89\x7e\xf2\x6b\x67\x4d\x43\x0c\x8e\xac\xa4\x6f\x43\x0d\x04\xff\x24\xbe\x7c\x75\x4e\x03\x68\x03\xaf\xbd\x03\x59\x95\x16\xd4\x64\x34\x4a\x95\x4a\x9f\x5b\xd3\x38\x52\xdf\xd8\x46\x1b\xf9\xf2\xf5\xea\xaa\xab\x3d\xfe\xb9\x8a\xf7\xef\x43\x1f\xda\x4e\xa6\x37\x61\xc4\x99\x7b\xdb\x0f\xc1\x0b\xc0\xa2\x88\x7d\x3e\xca\x6f\xb3\xfd\x30\x5c\xec\x4b\x2e\x78\x64\xc4\xfd\x3f\xa6\xdf\x27\xd0\xcd\x82\x07\xd3\xb6\x58\xbb\xfb\xc1\x60\xf7\xd1\xb0\xa5\xa1\x22\x28\xaa\x8b\x34\x33\x5f\x0c\x5b\xa9\x1f\x90\x9a\x9d\x65\x94\x6f\x14\xd9\x68\x2a\x6c\x09\x25\x51\x8e\xb4\x04\x7d\xab\x0b\x99\x49\x8d\x69\x14\x2e\x2c\xf3\xef\x4d\x4d\xe4\xaa\xaa\x93\x1d\x8e\xec\x61\x53\x3e\x36\x23\x33\x3f\xbb\xff\x75\x30\x76\xac\x7f\x07\x00\xa5\x82\xb7\x8e\x72\x09\x00\x00"),
 		},
 		"/versions/dev/0.1.3-dev": &vfsgen۰DirInfo{
 			name:    "0.1.3-dev",
@@ -143,7 +150,7 @@ var MigrationFiles = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 450,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x90\x41\x6b\xe3\x30\x14\x84\xef\xfe\x15\x73\x08\x64\x03\x09\x98\xbd\x9a\x3d\x28\xce\xc3\x09\x08\xdb\x91\x65\x36\xb7\xe0\x95\x5f\x88\x97\x44\x06\x59\x6a\xda\x7f\x5f\xd4\xa6\x84\x9c\xda\x77\x94\x66\xe6\x1b\x26\x57\x24\x34\xa1\x52\x50\x54\x4b\x91\x13\x6a\x55\xe5\xb4\x69\x15\x81\x5f\xd9\x04\xcf\x47\x7e\x61\xf7\x76\x3b\xb3\xe3\x5f\x66\xbc\x5e\x3b\xdb\x43\xd3\x41\x2f\xe1\x5d\x67\xa7\xce\xf8\x61\xb4\xdd\x05\xeb\xaa\x92\x24\x4a\xfc\x81\x77\x81\x17\x89\x68\x30\x3b\x05\x6b\x66\xc9\x9a\x8a\x5d\x99\x00\x00\x1d\x28\x6f\x35\xe1\x9e\x93\x25\x1f\xaf\x8f\xff\x78\xb9\x90\x12\xfd\x30\x79\x37\xfc\x0b\x9e\xfb\x63\xec\xf1\x45\x5e\x64\xf7\x9c\x9c\x6a\xbd\xab\x1e\xae\xbf\x5b\x2a\x11\x6c\xcf\xa7\xc1\x72\x7f\x8c\xe0\xd8\x0b\x7a\x4b\x0f\x51\xbc\xd5\x0a\x37\x9e\x3b\x86\x1d\x3d\xa2\x60\xb8\xf2\x64\xba\x0b\xe3\xf7\x12\xff\xc3\xe4\xe1\xd8\x07\x67\x9f\x4c\x8a\x74\xab\xca\xec\x99\xd6\xec\x65\xa3\xe3\x7a\xf3\x54\xa4\x69\x3a\xff\x86\xe5\xcf\x8c\xce\x18\x9e\x26\xd8\xb1\xe7\x9f\xc1\xa8\xdc\x64\x09\x95\x9b\xe4\x73\x4a\x48\x51\x16\xad\x28\x08\xb5\xac\x8b\x66\x2f\xb3\xe4\x3d\x00\x00\xff\xff\x04\x62\xea\x84\xc2\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x90\x41\x6b\xe3\x30\x14\x84\xef\xfe\x15\x73\x08\x64\x03\x09\x98\xbd\x9a\x3d\x28\xce\xc3\x09\x08\xdb\x91\x65\x36\xb7\xe0\x95\x5f\x88\x97\x44\x06\x59\x6a\xda\x7f\x5f\xd4\xa6\x84\x9c\xda\x77\x94\x66\xe6\x1b\x26\x57\x24\x34\xa1\x52\x50\x54\x4b\x91\x13\x6a\x55\xe5\xb4\x69\x15\x81\x5f\xd9\x04\xcf\x47\x7e\x61\xf7\x76\x3b\xb3\xe3\x5f\x66\xbc\x5e\x3b\xdb\x43\xd3\x41\x2f\xe1\x5d\x67\xa7\xce\xf8\x61\xb4\xdd\x05\xeb\xaa\x92\x24\x4a\xfc\x81\x77\x81\x17\x89\x68\x30\x3b\x05\x6b\x66\xc9\x9a\x8a\x5d\x99\x00\x00\x1d\x28\x6f\x35\xe1\x9e\x93\x25\x1f\xaf\x8f\xff\x78\xb9\x90\x12\xfd\x30\x79\x37\xfc\x0b\x9e\xfb\x63\xec\xf1\x45\x5e\x64\xf7\x9c\x9c\x6a\xbd\xab\x1e\xae\xbf\x5b\x2a\x11\x6c\xcf\xa7\xc1\x72\x7f\x8c\xe0\xd8\x0b\x7a\x4b\x0f\x51\xbc\xd5\x0a\x37\x9e\x3b\x86\x1d\x3d\xa2\x60\xb8\xf2\x64\xba\x0b\xe3\xf7\x12\xff\xc3\xe4\xe1\xd8\x07\x67\x9f\x4c\x8a\x74\xab\xca\xec\x99\xd6\xec\x65\xa3\xe3\x7a\xf3\x54\xa4\x69\x3a\xff\x86\xe5\xcf\x8c\xce\x18\x9e\x26\xd8\xb1\xe7\x9f\xc1\xa8\xdc\x64\x09\x95\x9b\xe4\x73\x4a\x48\x51\x16\xad\x28\x08\xb5\xac\x8b\x66\x2f\xb3\xe4\x7d\x00\x04\x62\xea\x84\xc2\x01\x00\x00"),
 		},
 		"/versions/dev/0.1.4-dev": &vfsgen۰DirInfo{
 			name:    "0.1.4-dev",
@@ -154,7 +161,7 @@ var MigrationFiles = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 352,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\xd0\xcb\x4a\xc4\x30\x14\x80\xe1\x7d\x9f\xe2\x2c\x67\x60\xf0\x05\xba\x3a\x93\x9c\xd1\x48\x2e\x25\x39\x01\x71\x13\xc6\x36\x62\xb5\x6d\xa4\x97\x85\x6f\x2f\x08\x42\x71\xe7\x62\x5e\xe0\xfb\xe1\x47\xcd\xe4\x81\xf1\xac\x09\x82\x78\x20\x83\x49\x20\xa3\x76\xf7\x77\x63\x5e\xe7\xbe\xad\x00\x00\x50\x4a\x10\x4e\x47\x63\xa1\xcb\xc3\xf5\x2b\xb5\x65\xfc\x9c\xf3\xb2\xf4\x65\x4a\xdb\xb4\xf6\x03\xb0\x32\x14\x18\x4d\xc3\xcf\x20\xe9\x82\x51\x33\xd8\xa8\x75\x5d\xfd\x08\xd2\xbb\x06\x1a\xef\x04\xc9\xe8\x09\xd4\x05\xe8\x49\x05\x0e\x7f\xa3\x5d\xfe\xa5\x53\xfb\xb6\x4d\x1f\x4b\xba\xbe\xae\x79\x3e\x58\x34\x74\xda\x47\x8e\xf5\xff\xdc\x92\x6e\x45\xef\x5f\xbc\x97\x97\x83\xb2\x7c\x82\xc7\xe0\xec\xf9\x58\x57\xdf\x01\x00\x00\xff\xff\xae\x60\x74\x46\x60\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\xd0\xcb\x4a\xc4\x30\x14\x80\xe1\x7d\x9f\xe2\x2c\x67\x60\xf0\x05\xba\x3a\x93\x9c\xd1\x48\x2e\x25\x39\x01\x71\x13\xc6\x36\x62\xb5\x6d\xa4\x97\x85\x6f\x2f\x08\x42\x71\xe7\x62\x5e\xe0\xfb\xe1\x47\xcd\xe4\x81\xf1\xac\x09\x82\x78\x20\x83\x49\x20\xa3\x76\xf7\x77\x63\x5e\xe7\xbe\xad\x00\x00\x50\x4a\x10\x4e\x47\x63\xa1\xcb\xc3\xf5\x2b\xb5\x65\xfc\x9c\xf3\xb2\xf4\x65\x4a\xdb\xb4\xf6\x03\xb0\x32\x14\x18\x4d\xc3\xcf\x20\xe9\x82\x51\x33\xd8\xa8\x75\x5d\xfd\x08\xd2\xbb\x06\x1a\xef\x04\xc9\xe8\x09\xd4\x05\xe8\x49\x05\x0e\x7f\xa3\x5d\xfe\xa5\x53\xfb\xb6\x4d\x1f\x4b\xba\xbe\xae\x79\x3e\x58\x34\x74\xda\x47\x8e\xf5\xff\xdc\x92\x6e\x45\xef\x5f\xbc\x97\x97\x83\xb2\x7c\x82\xc7\xe0\xec\xf9\x58\x57\xdf\x03\x00\xae\x60\x74\x46\x60\x01\x00\x00"),
 		},
 		"/versions/dev/0.1.5-dev": &vfsgen۰DirInfo{
 			name:    "0.1.5-dev",
@@ -165,20 +172,76 @@ var MigrationFiles = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 4918,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x58\x4d\x73\x9b\x48\x10\xbd\xf3\x2b\xfa\xa0\x2a\xc5\xb5\x22\xe5\xda\xab\x2a\x87\x09\xb4\x65\x6a\x31\x28\x30\xca\x3a\x27\x15\x82\xb6\x4d\x8c\x40\x3b\x33\x58\xd6\xfe\xfa\xad\x01\xa4\x05\x7f\x48\xc8\xb2\x13\x9d\x70\x31\xf3\x5e\xbf\x37\xdd\xed\x69\x4c\x53\x45\x8b\x8c\x40\x15\x20\xa3\x07\x82\xb8\x58\x2e\xa3\x3c\x91\x20\x0b\x50\x77\xb4\x81\x38\xca\x61\x41\x20\xca\x1c\xd6\x77\x94\x43\x94\x24\x69\x7e\x0b\x39\xad\x21\x2f\x12\x92\x06\x58\x01\x32\x8e\xc0\xd9\x57\x17\x21\xb4\x2e\xf1\x8a\xcd\x2d\xc6\x99\xeb\x4f\x3e\x0b\x5a\x16\x8a\xe6\x5b\xd4\x4f\x06\x00\xc0\x3d\x6d\x80\xe3\x35\x87\x69\xe0\x5c\xb1\xe0\x07\xfc\x85\x3f\x46\xd5\x1b\x49\xff\x40\x88\x81\xc3\xdc\xfa\x6f\x25\xa2\x5c\x46\xb1\x4a\x8b\x3c\xca\xe0\xab\xef\xbb\xc8\xbc\xfa\x55\x03\x59\x01\x19\x67\x63\xc3\xb0\x03\x7f\x0a\xd3\xc0\xb7\xd0\x9e\x05\x08\xf4\x48\x71\xa9\x68\x4e\x0f\x24\x36\xeb\x3b\x12\xf4\x49\xd1\xa3\x1a\x6d\x51\xf4\x16\xc3\x34\xa5\x2a\x17\x5a\x7c\x94\x65\xc5\x1a\x4a\xa9\x9f\x6f\xd2\x2c\x83\x34\x87\xda\x99\xc5\x06\xe2\x62\xb5\x31\x57\x91\x54\x64\x34\x62\xfd\x00\x02\x9c\xba\xcc\xc2\xfd\x94\x4d\x94\x73\x2d\xb9\xa6\x6f\xc7\x3d\x7a\x59\x20\x7c\x01\x25\x4a\x3a\x33\x58\x08\x83\x9b\x32\x8f\x07\xc6\x57\x9c\x38\x5e\xa5\x5b\xff\x1c\x2f\xc4\x80\x83\xe3\x71\xff\x90\xe1\xf7\xb4\xd9\x71\x3e\xa1\x3b\x83\xef\xcc\x9d\x61\xd8\x0e\xf2\xd5\xb5\x3b\x6e\xdf\x03\xcb\xf7\x2e\x5c\xc7\xe2\xa0\xd1\xcf\xc0\xf6\x61\x36\xb5\xb5\x2b\x21\xf2\x9d\xbe\x2f\x40\x8f\x71\x56\x26\x94\x7c\x7e\x19\xb2\xbd\xa2\xf3\x62\x6c\xa0\x67\x1b\xb5\x70\x70\x99\x37\x99\xb1\x09\xc2\xd4\x9d\x4e\xc2\x6f\xae\x3e\x35\x8b\xb9\xee\x4b\x66\x0f\x63\x41\x91\xa2\xf9\x4a\x14\xcb\xb9\xa0\x28\x21\x31\x1c\xc1\x80\x68\x50\x45\x6f\xfb\x30\x18\xec\x74\x74\x1d\xd5\xbf\xe6\x68\x03\xdf\x45\x68\x41\x8c\x77\x8b\xf0\xda\xc2\x29\x77\x7c\x0f\xfe\xbe\x44\x0f\x92\x72\x95\xa5\xb1\x26\x2c\x16\x3f\x29\x56\xc0\x2f\xb1\x8b\x18\x30\x27\x44\xf0\x7c\xee\x58\x08\x43\x51\x64\xd4\x06\x86\x28\xd3\x0f\x1b\xa0\xc7\x54\x2a\x39\x02\x79\x9f\xae\x56\xba\xb8\x6a\x1d\xc3\x71\x17\x0c\xf9\x2c\xf0\x5a\xd1\x78\x76\xf5\x3c\x18\x8c\x0d\xad\x51\x27\x74\x2f\x67\xd6\x22\x55\x27\x3a\x53\x43\x7c\x80\x33\x35\xf0\xaf\x74\xe6\x56\x44\xb9\x6a\xa7\x4c\xd7\xa4\x9d\x47\x93\x80\x79\xbc\x73\x7c\xdc\xef\x9a\xd1\xf7\x10\x64\x7c\x47\xcb\x48\x36\x07\x50\x9b\xdf\x32\xbd\x31\xbb\xae\x6b\x70\x2e\xb4\x4b\x80\xd7\x4e\xc8\xc3\x27\xc5\x3e\x06\xd3\x84\x38\x52\x51\x56\xdc\xd6\xcd\x4a\xc2\x1f\x90\xe6\x8a\x84\xae\x2f\x5d\x40\xba\xa2\x64\x2b\xfe\x59\xa8\x6b\xc9\xf7\xb6\xf0\x5d\xc0\x9d\xa4\x76\xe6\xd7\x1b\x43\x74\xd1\xe2\x7a\xa7\x56\x57\xf5\xfa\x10\x9c\x63\x70\x98\xcb\x31\x00\x1b\x2f\xd8\xcc\xad\x5a\xff\x77\xc7\xc5\xc9\x3e\x94\xa7\xcc\x0d\xeb\xeb\x41\xf6\x54\xd7\xce\xde\x36\xc7\xa8\x69\xac\xa3\xa6\x9f\x8d\xc0\x46\x17\x39\x1e\xab\xba\x8d\x7f\x9a\xea\x7d\x11\x3d\x71\x63\x4b\xda\x37\x87\xa6\x81\x7f\x55\x25\xd0\xaa\x5c\x64\x69\x7c\x4c\xb6\xe8\xad\xcf\x4e\xa1\x2f\x2f\x5e\xf3\x8a\xb6\x58\xa9\x74\x99\xfe\x4b\x09\x3c\x90\x90\x9a\x18\x8a\x9b\xff\xa3\x68\x2a\x3d\xd1\xff\x7e\xd5\x1d\x01\x3d\x2a\xca\xf5\xb2\xc3\xe1\xe9\x9b\xc5\x5b\xa3\xd3\x97\x0f\x0c\xab\x00\x25\x89\x94\x24\x3c\xa4\xb4\xee\xe1\x49\xbd\xf1\xe4\x02\xda\x03\xd3\x3f\x93\x1a\x90\x9e\xe5\xd3\xd7\x9a\x2b\xe4\x81\x63\x55\xd6\x2c\x49\x89\x34\xee\x6b\x4d\xbd\xf1\x64\x6b\xf6\xc0\xf4\xb7\xa6\x01\x79\x67\x6b\x6c\xc6\x59\x8f\x3e\xa4\x97\x9d\x6c\xc3\xab\x20\xfd\x4d\xa8\x20\xde\xab\xb9\x76\xe2\x79\xef\xce\xfa\x2a\xf8\x09\x62\x3f\xb0\xa7\x6a\xae\x6d\x0b\xe9\xe7\xdc\x7b\xf5\x8d\x43\x58\xc7\xf9\x75\x64\x07\x79\x93\xd2\x8f\x48\x95\x7d\x1c\xa7\x3b\xf0\x96\xc4\xe9\x9b\x39\x8e\x77\xe1\xf7\x30\x52\x2f\x3b\x39\x57\x5e\x05\xe9\x6f\x51\x05\xd1\x33\x3b\xd0\xb3\xf5\x05\x77\x7b\x3b\x36\x4d\x41\xad\xcb\xe9\xef\x1f\xa8\xf1\x1a\xad\x19\xc7\x2d\x4e\x53\xed\xbf\x6b\xc4\xfe\x15\xe3\xb5\xf1\x7c\xca\xab\x66\x96\x24\x95\x4a\xa4\x8b\x52\x51\x32\xd7\xd6\x6f\xa3\x3e\x1b\x1b\x9d\x21\x6f\xb7\xab\x1a\xf6\xca\x3c\xa1\x9b\x34\xa7\x64\xbe\x3d\xd3\xe7\xe3\x9e\x69\xc2\x9a\x86\x82\x20\x2f\x14\xe8\x05\xe9\x92\x64\x1c\x65\x04\x7f\x8e\xe0\x67\x29\x15\x08\x52\xa5\xc8\xf7\x8e\x75\x15\x5b\xf8\xcd\x0d\xb9\x36\x63\x78\xce\xce\xcf\xcf\x87\x07\xb8\xf4\xd5\x31\x8a\x63\x92\xb2\xfa\x5a\xd5\x8f\x0c\x3d\xfb\xc0\x37\x88\xff\x02\x00\x00\xff\xff\x56\x04\x46\xf5\x36\x13\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x98\xcd\x72\x9b\x48\x10\xc7\xef\x3c\x45\x1f\x54\xa5\xa8\x56\xa4\x5c\x7b\x55\xe5\x30\x86\xb6\x4c\x2d\x06\x05\x46\x59\xe7\xa4\x42\xd0\xb6\x89\x11\x68\x67\x06\xcb\xda\xa7\x4f\x0d\x20\x05\xfc\x21\x63\xcb\x4e\x74\x92\xcd\xcc\xbf\xbb\x7f\xd3\xdd\x9a\xc6\x34\x55\xb4\xcc\x08\x54\x01\x32\xba\x23\x88\x8b\xd5\x2a\xca\x13\x09\xb2\x00\x75\x43\x5b\x88\xa3\x1c\x96\x04\xa2\xcc\x61\x73\x43\x39\x44\x49\x92\xe6\xd7\x90\xd3\x06\xf2\x22\x21\x69\x80\x15\x20\xe3\x08\x9c\x9d\xba\x08\xa1\x75\x8e\x17\x6c\x61\x31\xce\x5c\x7f\xfa\x59\xd0\xaa\x50\xb4\xd8\xa9\x7e\x32\x00\x00\x6e\x69\x0b\x1c\x2f\x39\xcc\x02\xe7\x82\x05\xdf\xe1\x1f\xfc\x3e\xae\x9e\x48\xfa\x0f\x42\x0c\x1c\xe6\xd6\x7f\x2b\x11\xe5\x32\x8a\x55\x5a\xe4\x51\x06\xa7\xbe\xef\x22\xf3\xea\x47\x8d\x64\x25\x64\x8c\x26\x86\x61\x07\xfe\x0c\x66\x81\x6f\xa1\x3d\x0f\x10\xe8\x9e\xe2\x52\xd1\x82\xee\x48\x6c\x37\x37\x24\xe8\x93\xa2\x7b\x35\xde\xa9\xe8\x2d\x86\x69\x4a\x55\x2e\x75\xf0\x51\x96\x15\x1b\x28\xa5\xfe\x7e\x95\x66\x19\xa4\x39\xd4\x64\x96\x5b\x88\x8b\xf5\xd6\x5c\x47\x52\x91\xd1\x04\xeb\x07\x10\xe0\xcc\x65\x16\x1e\x36\xd9\x78\xb9\xd0\x21\xd7\xe6\xdb\x7e\x8f\x9f\x0e\x10\xbe\x80\x12\x25\x8d\x0c\x16\xc2\xe0\xaa\xcc\xe3\x81\x71\x8a\x53\xc7\x33\xa0\xf9\x38\x5e\x88\x01\x07\xc7\xe3\xfe\x4b\xc0\x6f\x69\xbb\xb7\xf9\xc0\xdc\x08\xbe\x31\x77\x8e\x61\xdb\xc9\x67\xd7\xee\x6d\xfb\x1e\x58\xbe\x77\xe6\x3a\x16\x07\xad\x3e\x02\xdb\x87\xf9\xcc\xd6\x54\x42\xe4\xfb\xf8\xbe\x00\xdd\xc7\x59\x99\x50\xf2\xf9\x69\xc9\xf6\x8a\xce\x83\x89\x81\x9e\x6d\xd4\x81\x83\xcb\xbc\xe9\x9c\x4d\x11\x66\xee\x6c\x1a\x7e\x75\xf5\xa9\x59\xcc\x75\x9f\x82\x3d\x8c\x05\x45\x8a\x16\x6b\x51\xac\x16\x82\xa2\x84\xc4\x70\x0c\x03\xa2\x81\x01\x00\xda\xcf\xc1\x60\x1f\x47\x97\xa8\xfe\x34\x47\x1b\xf8\x2e\x42\x4b\x62\xb2\x5f\x84\x97\x16\xce\xb8\xe3\x7b\xf0\xef\x39\x7a\x90\x94\xeb\x2c\x8d\xb5\xc1\x62\xf9\x83\x62\x05\xfc\x1c\xbb\x8a\x01\x73\x42\x04\xcf\xe7\x8e\x85\x30\x14\x45\x46\x6d\x61\x88\x32\xfd\x65\x0b\x74\x9f\x4a\x25\xc7\x20\x6f\xd3\xf5\x5a\x17\x57\x1d\xc7\x70\xd2\x15\x43\x3e\x0f\xbc\x5f\xff\xd3\x90\x00\x00\x06\x83\x89\xa1\x63\x1c\x4d\x7a\x92\xd9\x88\x54\x1d\x49\xa6\x96\xf8\x00\x32\xb5\xf0\xef\x24\x73\x2d\xa2\x5c\xb5\x53\xa6\x0b\x69\xcf\x68\x1a\x30\x8f\x77\x8e\x8f\xfb\x5d\x18\x7d\x0f\x41\xc6\x37\xb4\x8a\x64\x73\x00\x35\xfc\x16\xf4\x06\x76\x5d\xd7\xe0\x9c\x69\x4a\x80\x97\x4e\xc8\xc3\x07\xc5\x3e\x01\xd3\x84\x38\x52\x51\x56\x5c\xd7\xcd\x4a\xc2\x5f\x90\xe6\x8a\x84\xae\x2f\x5d\x40\xba\xa2\x64\xcb\xff\x79\xa8\x6b\xc9\xf7\x76\xf2\x5d\xc1\x7d\x48\xed\xcc\xaf\x37\x86\xe8\xa2\xc5\xf5\x4e\x1d\x5d\xd5\xeb\x43\x70\x5e\xa3\xc3\x5c\x8e\x01\xd8\x78\xc6\xe6\x6e\xd5\xfa\xbf\x39\x2e\x4e\x0f\xa9\x3c\xb4\xdc\x58\x7d\xde\xc9\x9e\xd1\xb5\xb3\xb7\x6d\x63\xdc\x34\xd6\x71\xd3\xcf\xc6\x60\xa3\x8b\x1c\x5f\x1b\x75\x5b\xff\xb8\xa8\x0f\x79\xf4\x80\xc6\xce\x68\xdf\x1c\x9a\x05\xfe\x45\x95\x40\xeb\x72\x99\xa5\xf1\x6b\xb2\x45\x6f\x7d\x74\x0a\x7d\xed\xe2\x25\xaf\xcc\x16\x6b\x95\xae\xd2\xff\x29\x81\x3b\x12\x52\x1b\x86\xe2\xea\x97\x17\x4d\xa5\x27\xfa\xe7\x57\xdd\x10\xd0\xbd\xa2\x5c\x2f\x7b\xd9\x3d\x7d\xb3\x78\xab\x77\xfa\xf2\x81\x61\xe5\xa0\x24\x91\x92\x84\xbb\x94\x36\x3d\x98\xd4\x1b\x8f\x2e\xa0\x03\x32\xfd\x33\xa9\x11\xe9\x59\x3e\x7d\xd1\x5c\x20\x0f\x1c\xab\x42\xb3\x22\x25\xd2\xb8\x2f\x9a\x7a\xe3\xd1\x68\x0e\xc8\xf4\x47\xd3\x88\xbc\x33\x1a\x9b\x71\xd6\xa3\x0f\xe9\x65\x47\x63\x78\x56\xa4\x3f\x84\x4a\xe2\xbd\x9a\x6b\xc7\x9f\xf7\xee\xac\xcf\x8a\x1f\x11\xec\x07\xf6\x54\x6d\x6b\xd7\x42\xfa\x91\x7b\xaf\xbe\xf1\x92\xd6\xeb\x78\xbd\xb2\x83\xbc\x29\xd2\x8f\x48\x95\x43\x36\x8e\x27\xf0\x96\xc4\xe9\x9b\x39\x8e\x77\xe6\xf7\x00\xa9\x97\x1d\x9d\x2b\xcf\x8a\xf4\x47\x54\x49\xf4\xcc\x0e\xf4\x6c\x7d\xc1\xdd\xdd\x8e\x4d\x53\x50\xeb\x72\xfa\xe7\x07\x6a\xbc\x44\x6b\xce\x71\xa7\xd3\x54\xfb\x9f\x1a\xb1\x7f\xc7\x78\x6d\x3c\x9e\xf2\xaa\x99\x25\x49\xa5\x12\xe9\xb2\x54\x94\x2c\x34\xfa\x9d\xd7\xa3\x89\xd1\x19\xf2\xf6\xbb\xaa\x61\xaf\xcc\x13\xba\x4a\x73\x4a\x16\xbb\x33\x7d\x3c\xee\x99\x26\x6c\x68\x28\x08\xf2\x42\x81\x5e\x90\xae\x48\xc6\x51\x46\xf0\xf7\x18\x7e\x94\x52\x81\x20\x55\x8a\xfc\xe0\x58\x57\x59\x0b\xbf\xba\x21\xd7\x30\x86\x27\xec\xe4\xe4\x64\xf8\x82\x2d\x7d\x75\x8c\xe2\x98\xa4\xac\xde\x56\xf5\x33\x86\x9e\xfd\xc2\x3b\x88\x9f\x03\x00\x56\x04\x46\xf5\x36\x13\x00\x00"),
 		},
 		"/versions/dev/0.1.5-dev/2-update_autovac_settings.sql": &vfsgen۰CompressedFileInfo{
 			name:             "2-update_autovac_settings.sql",
 			modTime:          time.Time{},
 			uncompressedSize: 522,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x91\x4d\x4b\xc4\x30\x10\x86\xef\xf9\x15\x73\x88\xb0\x2b\xcb\xd2\x3d\x78\x2a\x1e\xb2\xc9\xec\x07\x64\x37\x92\x46\xf4\x16\x62\x1b\x69\xb1\x1f\x10\xd3\x05\xfd\xf5\xd2\xba\x16\xc1\x4b\xc1\xb9\x84\xbc\x79\xe0\x7d\xc8\x08\x05\xb4\xe8\xaa\x48\x89\x40\x2e\x99\x46\x02\x00\x10\x40\x23\x57\x5a\xa4\x64\x8b\xfb\xe3\x79\xcc\x76\x4a\x43\x80\xeb\x65\x98\x0c\x25\x72\x03\xb7\x53\xb0\xd3\xea\x04\x19\x3f\xe0\x89\x59\xce\x0c\x93\x6a\xbf\x6e\x7c\x0c\x55\x3e\x21\x4f\x07\xd4\x08\x85\x7f\x75\x7d\x1d\x6d\x5e\xf6\xed\x9b\xad\xda\xe8\xc3\xc5\xd5\x23\x24\x95\x7a\x98\x68\x7c\x46\xfe\x68\x70\xa8\x3e\x31\xb3\xa0\x74\x7a\x19\x86\x49\x83\x1a\x0c\xdb\x4a\xfc\x69\x15\xcc\xb0\xf5\xcd\x11\x32\x34\xb0\x70\x7d\xec\x2e\x2e\xef\xfb\xc6\x5e\x8f\x58\x06\xff\x5e\x76\x75\x01\xf7\x70\x97\x24\x49\xb2\x82\x5f\x90\x6b\x5d\xfd\xf1\xe9\xff\x52\xcb\xa9\x96\xd2\x15\x84\x75\x74\x2f\xb5\xb7\xad\x6b\xfc\x32\xfd\x9f\xab\xcd\x50\x1f\x31\x9b\xa9\xbc\x99\x21\xbc\x99\xa1\x8b\x67\x31\xfe\x73\x4a\xf0\x2c\xc8\xf7\xfa\x53\xf2\x15\x00\x00\xff\xff\xb6\x7d\x90\x8e\x0a\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x91\x4d\x4b\xc4\x30\x10\x86\xef\xf9\x15\x73\x88\xb0\x2b\xcb\xd2\x3d\x78\x2a\x1e\xb2\xc9\xec\x07\x64\x37\x92\x46\xf4\x16\x62\x1b\x69\xb1\x1f\x10\xd3\x05\xfd\xf5\xd2\xba\x16\xc1\x4b\xc1\xb9\x84\xbc\x79\xe0\x7d\xc8\x08\x05\xb4\xe8\xaa\x48\x89\x40\x2e\x99\x46\x02\x00\x10\x40\x23\x57\x5a\xa4\x64\x8b\xfb\xe3\x79\xcc\x76\x4a\x43\x80\xeb\x65\x98\x0c\x25\x72\x03\xb7\x53\xb0\xd3\xea\x04\x19\x3f\xe0\x89\x59\xce\x0c\x93\x6a\xbf\x6e\x7c\x0c\x55\x3e\x21\x4f\x07\xd4\x08\x85\x7f\x75\x7d\x1d\x6d\x5e\xf6\xed\x9b\xad\xda\xe8\xc3\xc5\xd5\x23\x24\x95\x7a\x98\x68\x7c\x46\xfe\x68\x70\xa8\x3e\x31\xb3\xa0\x74\x7a\x19\x86\x49\x83\x1a\x0c\xdb\x4a\xfc\x69\x15\xcc\xb0\xf5\xcd\x11\x32\x34\xb0\x70\x7d\xec\x2e\x2e\xef\xfb\xc6\x5e\x8f\x58\x06\xff\x5e\x76\x75\x01\xf7\x70\x97\x24\x49\xb2\x82\x5f\x90\x6b\x5d\xfd\xf1\xe9\xff\x52\xcb\xa9\x96\xd2\x15\x84\x75\x74\x2f\xb5\xb7\xad\x6b\xfc\x32\xfd\x9f\xab\xcd\x50\x1f\x31\x9b\xa9\xbc\x99\x21\xbc\x99\xa1\x8b\x67\x31\xfe\x73\x4a\xf0\x2c\xc8\xf7\xfa\x53\xf2\x35\x00\xb6\x7d\x90\x8e\x0a\x02\x00\x00"),
 		},
 		"/versions/dev/0.1.5-dev/3-drop_function_metric_view.sql": &vfsgen۰FileInfo{
 			name:    "3-drop_function_metric_view.sql",
 			modTime: time.Time{},
 			content: []byte("\x44\x52\x4f\x50\x20\x46\x55\x4e\x43\x54\x49\x4f\x4e\x20\x49\x46\x20\x45\x58\x49\x53\x54\x53\x20\x53\x43\x48\x45\x4d\x41\x5f\x43\x41\x54\x41\x4c\x4f\x47\x2e\x6d\x65\x74\x72\x69\x63\x5f\x76\x69\x65\x77\x28\x29\x20\x43\x41\x53\x43\x41\x44\x45\x3b"),
 		},
+		"/versions/dev/0.2.1-dev": &vfsgen۰DirInfo{
+			name:    "0.2.1-dev",
+			modTime: time.Time{},
+		},
+		"/versions/dev/0.2.1-dev/1-add_metric_metadata.sql": &vfsgen۰CompressedFileInfo{
+			name:             "1-add_metric_metadata.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 254,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x8e\xb1\x8a\x83\x40\x14\x45\x7b\xbf\xe2\x96\x0a\xb2\x3f\xb0\xd5\xdb\xf1\xed\xae\x64\x46\x45\x9f\x10\xd3\xc8\x90\x4c\x88\xa0\x22\x71\x52\xf8\xf7\x21\xa6\x49\x02\xb6\xf7\x5c\x38\x47\x95\x4c\xc2\x10\xfa\xd1\x8c\x4a\xfd\xb3\xa1\x56\x91\x90\xce\xff\xbe\x06\xe7\xed\xc9\x7a\x8b\x30\x00\x80\xc1\xf9\x6b\x77\x6c\xcf\x76\xe8\xfa\x05\xc2\x7b\x41\x96\x0b\xb2\x5a\xeb\x78\x3d\xf8\x65\x72\xcf\x3d\xe1\x5f\xaa\xf5\x2b\xbb\x8d\x9d\xdf\x62\x17\xd7\x4f\x5b\xac\xb7\xb3\x6f\x67\xe7\x46\x48\x6a\xb8\x12\x32\x85\x1c\x3e\xbc\x45\x99\x1a\x2a\x1b\xec\xb8\x41\xf8\x56\x19\x21\xcd\x94\xae\x13\x46\xf8\x88\x8b\xd7\x8c\x78\x15\x46\x41\xf4\x1d\xdc\x07\x00\x3d\x08\x5d\x89\xfe\x00\x00\x00"),
+		},
+		"/versions/dev/0.2.1-dev/2-add_delete_jobs.sql": &vfsgen۰CompressedFileInfo{
+			name:             "2-add_delete_jobs.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 824,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x52\xcb\x6e\xdb\x30\x10\xbc\xeb\x2b\xf6\x66\x09\x30\x8a\xde\x8d\x1e\x68\x65\x6b\x0b\x91\xa9\x54\xa2\xd1\xa4\x45\x41\xb0\xe4\xba\x66\x61\x53\x09\x49\xc1\x87\xa2\xff\x5e\xc4\x54\xec\x22\x4e\xd3\xdc\xb8\x9c\xd9\xd7\xec\x94\x2d\x32\x81\x20\xd8\xbc\x46\xe8\xca\x25\xae\x98\x2c\x99\x60\x75\xb3\x78\x67\x68\x47\x91\xe4\xcf\xfe\x3b\xe4\x19\x00\x80\x35\x30\xaf\x16\x1d\xb6\x15\xab\xe1\xa6\xad\x56\xac\xbd\x83\x6b\xbc\x9b\x1e\xd1\xbd\x8a\x7a\x4b\x3e\x80\xc0\x5b\xf1\xf5\x1b\xf0\x46\x00\x5f\xd7\x75\x42\x43\x54\x3e\xca\x68\xf7\x04\xa2\x5a\x61\x27\xd8\xea\x46\x7c\x79\x46\x22\x67\xfe\x47\x09\x51\xc5\x21\xf5\x38\x21\x70\x85\x1f\xd9\xba\x16\x30\xb9\x27\x67\xac\xfb\x31\x81\x72\x89\xe5\x35\xe4\x23\xbb\xe2\x90\x9f\xb0\x29\x4c\xfc\xe0\xdc\xf8\x0c\x83\xd6\x44\x86\xcc\x63\xb0\x51\x76\x97\x5e\x5a\x39\x4d\xbb\xc7\xa0\x28\x52\xe3\xf4\x23\x3d\x3d\x0c\x14\x22\x19\x98\x37\x4d\x8d\x8c\x5f\x4e\xb1\x51\xbb\x40\xa3\x26\x14\xbd\xd5\x41\xc6\x7e\xd0\x5b\x32\xcf\xa5\x39\x0f\xfe\xeb\xf7\x64\xdc\x8f\xbc\xa5\x20\x93\xf6\x47\xbd\x2b\xfe\xc2\xa6\xef\x13\xdb\xf7\x87\x37\x73\xc9\xfb\xde\x27\xe1\x9e\x90\xb3\xac\xda\x93\x8a\x64\xa4\x8a\x2f\x6a\x7f\xca\x70\xfd\x21\x2f\xfe\xba\xe8\x65\xca\x65\xed\x8d\x75\x36\x6c\x5f\x67\x66\xc5\x2c\x1b\xbd\x58\xf1\x2b\xbc\x85\xb3\xf9\xe4\x78\x38\x68\xf8\xbf\x1d\x9a\x5b\x53\xc0\xe7\x25\xb6\xf8\xe4\x90\x0f\x67\x37\xcc\xb2\x45\xcb\xb8\x80\x75\xc7\x16\x38\x85\x0e\x6b\x2c\xc5\xb1\x1c\x7e\x5a\x23\x2f\x5f\x71\xbe\xb4\x46\x06\x7a\x00\xd1\xc0\xbd\xef\xf7\xf2\xe0\x6d\x24\x3f\xcb\xfe\x0c\x00\x4e\xa5\xeb\x38\x38\x03\x00\x00"),
+		},
+		"/versions/dev/0.2.1-dev/3-add_exemplars.sql": &vfsgen۰CompressedFileInfo{
+			name:             "3-add_exemplars.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 1989,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\x55\x5b\x6f\xdb\x38\x13\x7d\xd7\xaf\x98\x87\x16\xb6\x01\xd9\x7f\x20\x48\x00\xc6\xa6\x5d\x7e\xa0\x29\x57\xa2\x82\xe0\x7b\x11\x18\x69\x1c\x13\xd5\xc5\x20\xe9\x5c\xb0\xd8\xff\xbe\xa0\x6e\x4d\xb5\xd9\xb6\x59\xac\x5f\x0c\x8f\xce\x5c\xce\xd1\x99\xf1\x72\x09\xf8\x82\xd5\xb9\x54\xc6\x82\x32\x08\x4d\x5d\xbe\x82\x75\x8d\xc1\x02\x9a\x1a\xdc\x09\x41\xe5\x39\x5a\x0b\x75\x53\x60\x08\xb6\xf1\x31\x6d\xc0\xe6\x27\xac\x14\x68\xff\xc0\x41\x6e\x50\x39\x2c\x00\x9f\xd0\xbc\x3e\x9f\xd0\x60\xb0\x8e\x29\x91\x14\x92\xf5\x17\xba\x27\xc0\xb6\x20\x22\x09\xf4\x9e\x25\x32\xe9\x83\xd9\x86\x48\x92\xd1\x7b\xba\x3f\x70\x12\x5f\x05\xbb\x98\x08\x09\x69\x42\x76\x14\x22\x31\x64\xbe\x87\x05\x19\xc1\xd9\x34\x55\x66\x50\x15\x68\x86\xd4\x84\x72\xba\x96\x3e\x97\x70\x0e\x92\xdc\x72\x9a\x00\xfb\x58\x25\xc2\x25\x8d\x61\x43\xb7\x24\xe5\x12\x0e\x31\xbb\x63\x9c\xee\x7e\x5d\x67\x3a\x41\xdf\xfd\xfd\x41\x3f\xc4\xf1\xd9\x68\x37\xe5\x18\x02\x13\x09\x8d\x65\x08\xe9\x61\x43\x24\x0d\x61\x43\x39\x95\xf4\xdf\x71\x1f\x3a\xfc\x17\xdc\x7f\x36\xd9\x44\x93\xa1\x6d\xb0\x5c\x7a\x9f\xe9\x02\xab\x73\xe3\xb0\x76\x60\x73\xa3\xcf\xce\x82\xb9\xd4\xa0\x8e\x0e\x0d\xb8\x93\xb6\x50\xe9\x47\xa3\x9c\x6e\xea\xc1\x87\x70\xbc\xd4\xb9\x0f\x78\x1f\x16\x78\xd4\x35\x16\xe0\xdd\x07\xca\xc2\x33\x96\xe5\xe0\xc2\x28\x86\x98\x1e\x38\x59\x53\xd8\xa6\x62\x2d\xd9\xa8\x7d\xb6\x26\x92\xf0\x68\xb7\xea\x2c\x9c\x0d\xeb\x90\x39\xf5\x50\xe2\xbc\x42\x67\x74\xde\xfd\x00\x41\xf6\x34\x84\x3e\xa4\x0b\x60\x42\x2e\x02\x00\x80\x98\xca\x34\x16\x09\xdc\x45\x6c\x13\x90\x04\x3e\xf9\xb9\x3e\x05\xb7\x74\xc7\x44\x0b\xa0\xf7\x74\x9d\x4a\x0a\xc7\xc6\x54\xca\xcd\x67\xfd\x58\xad\x20\xbf\xb1\x1b\xab\xcf\x6c\xee\x74\x85\x20\xd9\x9e\x26\x92\xec\x0f\xf2\xff\x6d\x8e\x48\x39\x0f\xe1\x49\x95\x17\x84\x4d\x94\xfa\x6a\x87\x98\xae\x59\xe2\x09\x7e\x07\x58\x34\x1a\xad\x1f\xf9\x96\xed\x98\x90\x6f\x1e\x95\xea\x01\x4b\x0b\xff\x4b\x22\x71\x3b\x86\x47\x0f\xcc\x66\x7f\xfc\x39\x9b\x2d\x66\x61\xcb\x62\xfa\x79\x2b\xce\xe2\xea\x67\x44\x53\xc1\xbe\xa6\x14\x98\xd8\xd0\xfb\x09\xdf\x51\xf0\x71\xc8\xcc\x53\xcd\x3e\xdb\xef\x0b\xf2\x37\x35\x60\x3e\xa2\x43\xf0\xf0\x5f\x8c\xe8\x61\x93\x69\x5b\x38\xdb\x4e\x6d\xa0\x6d\xdb\xde\xe6\xaa\xc4\xe2\x21\xd3\xb5\x75\xaa\x2c\xb1\x98\x2f\x40\x7e\xa1\x62\x6c\xb2\x5c\xfe\x78\x38\x8f\xf8\x0c\x79\x53\x9d\x95\xbf\x9c\xae\x73\xa7\x55\xd5\xb9\x44\x3b\xb8\xf5\xb5\x05\x7e\xc3\xb3\x7b\xe7\xb4\xbe\x2d\xfc\x84\x35\xe8\x1a\xaa\x4b\xe9\xb4\x7f\x06\x16\xdd\xe5\x6c\x57\x23\xe6\x40\xe3\x6d\x14\xef\xfb\xb3\x9b\x9d\x5e\xcf\x68\x3a\xbf\x0e\xba\xff\x83\x6e\xb3\x89\x0c\x21\xcc\x3c\xdb\x89\x78\xf9\xe9\x52\x7f\xeb\xde\x82\xae\x1d\x9a\x27\x55\x5e\xdf\x4c\x74\x7a\x44\x97\x15\x78\x54\x97\xd2\x65\x1d\x7e\x80\xce\x17\x93\x6a\xdd\x90\x03\x58\xd7\x05\xbe\xa0\xbd\xbe\x39\xaa\xd2\xe2\x8f\x50\x7d\xcc\xea\xc6\x65\xf8\xa2\xad\xb3\xd7\x37\xce\x5c\x46\x5f\x89\x0d\xb0\xed\x55\x40\xc5\x26\xe8\xb7\x8b\x13\xb1\x4b\xfd\x1d\x3d\xf0\xc3\x2e\xf9\xca\xe1\x2e\xe2\x44\x32\x4e\x87\x53\x39\x78\x31\x12\x1f\x5c\xfa\x6e\xcf\xfd\x76\xbf\x77\xab\xba\x9c\xf6\xfd\x0d\x79\xd0\xe6\x59\x68\x8e\x7d\x58\x5b\xa7\xeb\xc7\x5e\x6b\x1b\xf4\x7f\x0b\xbf\x77\x71\x56\xed\x77\x56\xab\x0a\x43\xa8\x56\xba\x58\x04\xdb\x38\xda\x4f\xb3\xbb\xda\x50\x5d\x05\x7f\x0d\x00\x1b\x07\xee\xcf\xc5\x07\x00\x00"),
+		},
+		"/versions/dev/0.2.1-dev/4-add_downsampling.sql": &vfsgen۰CompressedFileInfo{
+			name:             "4-add_downsampling.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 790,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x91\xc1\x8e\xda\x30\x18\x84\xef\x79\x8a\x39\x82\x04\xbc\xc0\x9e\xdc\xe4\x87\x46\x35\x0e\x4d\x9c\x6d\xf7\x14\x79\x89\x0b\x96\xc0\x8e\x6c\x67\x57\xbc\x7d\x95\x2c\xb0\x14\x15\xa9\x3d\x26\x99\xf9\xfe\xc9\xcc\x7c\x8e\xad\xb3\xd1\xd8\xde\xf5\x01\x6a\xb7\xf3\x7a\xa7\xa2\x0e\x50\x5e\xc3\xba\x88\xd0\x77\x9d\xf3\x51\xb7\x70\x16\xad\x09\xd1\x9b\xd7\x7e\x78\xdc\x9f\x3a\xed\xa3\x7a\x3d\xe8\x30\x43\x70\x88\x7b\x9d\xcc\xe7\x68\xdd\xbb\x0d\xea\xd8\x1d\x74\x8b\x56\x45\x05\x13\xe0\xec\xe1\x34\xd8\xe3\x5e\x43\x6d\xb7\x3a\x04\x58\xd7\xea\x24\x2d\x89\x49\x42\x95\x7e\xa5\x35\x43\xbe\x84\x28\x24\xe8\x67\x5e\xc9\xea\xfc\xb2\xc9\x98\x64\x4d\x56\xfc\x10\x15\x5b\x6f\x38\x3d\x25\xab\x92\x09\x89\xba\x62\x2b\x42\x21\x2e\xde\xbf\xab\x21\x0b\x74\xde\x1d\x1b\xaf\x55\xab\xfd\xc5\x5c\x11\xa7\x54\x0e\x6e\xc6\x39\x24\xfb\xc2\xa9\x42\xfe\xbf\x2c\xc6\x25\x95\xc8\x68\xc9\x6a\x2e\xb1\x29\xf3\xe7\x9c\xd3\xea\x5f\x48\xf7\x29\xce\x09\xee\x0f\x0c\x6d\x0e\x8d\x5d\x1b\x35\x76\x07\xaf\x83\x3b\xf4\xd1\x38\x1b\xe0\x7e\x8d\xdf\x8f\x3a\x7a\xb3\x0d\x0b\xd0\x9b\xf6\xa7\x1b\xc5\x50\xbd\x4a\x1e\x2c\xfc\xa7\x1b\xe3\x90\x30\xf6\x41\xe6\xc5\x65\xab\x31\xeb\x45\x94\x32\xc9\x78\xb1\x5a\x7c\x6e\x8e\x49\x02\xe0\x0c\x6d\x4c\x8b\x5c\xc8\x71\x55\x51\x73\x8e\x92\x96\x54\x92\x48\xa9\xba\x27\x7c\x18\x26\xa6\x9d\x0e\x85\x64\xc4\x49\x12\x52\x56\xa5\x2c\xa3\xd9\x88\xbc\xf9\xad\x5c\x48\x2a\x9f\x19\xbf\x82\x3f\x14\x6f\x46\xbf\x37\x56\x1d\x35\x04\x5b\xd3\xe7\xd5\x5a\xe4\xdf\xeb\x33\x65\x53\xe6\x6b\x56\xbe\xe0\x1b\xbd\x60\x72\x4d\x39\xbb\xa1\x4f\x93\xe9\x53\xf2\x7b\x00\xaa\x90\x4d\x90\x16\x03\x00\x00"),
+		},
+		"/versions/dev/0.2.1-dev/5-add_query_result_cache.sql": &vfsgen۰CompressedFileInfo{
+			name:             "5-add_query_result_cache.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 254,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\xce\xcf\x4a\xc3\x40\x14\x85\xf1\x7d\x9e\xe2\x2c\x5b\x08\xbe\x40\x57\xb7\xc9\x35\x06\x27\x33\x61\x72\x02\xc6\xcd\x10\xd2\x0b\x82\x7f\xaa\x63\x8a\xf4\xed\x05\x03\xae\xbb\xfe\x7e\x8b\xaf\x8a\x2a\x54\x8c\xde\x85\xa6\xd1\x1a\x94\xa3\x53\x0c\xd5\x83\x76\x92\x2a\xa1\xb8\xd0\xdc\x7d\x5d\x2c\x5f\x53\xb6\xef\xcb\xdb\x9a\x96\x79\x79\x31\xec\x0a\x00\x78\xb5\x2b\xa8\x4f\x44\x1f\xdb\x4e\xe2\x84\x47\x9d\xca\xbf\xb2\x61\x1c\x27\xaa\xc0\x07\xc2\x8f\xce\x6d\x69\xc9\x36\xaf\x76\x4a\xf3\x0a\xb6\x9d\x0e\x94\xae\xe7\xf3\x3f\x42\xad\xf7\x32\x3a\xe2\xe3\xfc\xb3\xdb\x17\xfb\x43\xd1\x44\xf1\xc4\xa0\x4e\x2b\x96\x68\xfd\xa0\x91\x25\xc6\xbe\x16\x6a\x89\x5a\x9d\x52\x11\xfc\x0d\xd7\x0c\xf8\xcc\xe7\xf7\x94\x6d\x3e\x59\x3e\x14\xbf\x03\x00\xc0\x12\xd2\x5c\xfe\x00\x00\x00"),
+		},
+		"/versions/dev/0.2.1-dev/6-add_delete_job_heartbeat.sql": &vfsgen۰FileInfo{
+			name:    "6-add_delete_job_heartbeat.sql",
+			modTime: time.Time{},
+			content: []byte("\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x53\x43\x48\x45\x4d\x41\x5f\x43\x41\x54\x41\x4c\x4f\x47\x2e\x64\x65\x6c\x65\x74\x65\x5f\x6a\x6f\x62\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x68\x65\x61\x72\x74\x62\x65\x61\x74\x5f\x61\x74\x20\x54\x49\x4d\x45\x53\x54\x41\x4d\x50\x54\x5a\x20\x44\x45\x46\x41\x55\x4c\x54\x20\x4e\x55\x4c\x4c\x3b\x0a"),
+		},
+		"/versions/dev/0.2.1-dev/7-add_delete_job_tenant.sql": &vfsgen۰FileInfo{
+			name:    "7-add_delete_job_tenant.sql",
+			modTime: time.Time{},
+			content: []byte("\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x53\x43\x48\x45\x4d\x41\x5f\x43\x41\x54\x41\x4c\x4f\x47\x2e\x64\x65\x6c\x65\x74\x65\x5f\x6a\x6f\x62\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x74\x65\x6e\x61\x6e\x74\x20\x54\x45\x58\x54\x20\x44\x45\x46\x41\x55\x4c\x54\x20\x4e\x55\x4c\x4c\x3b\x0a"),
+		},
+		"/versions/dev/0.2.1-dev/8-report_series_creation.sql": &vfsgen۰CompressedFileInfo{
+			name:             "8-report_series_creation.sql",
+			modTime:          time.Time{},
+			uncompressedSize: 240,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x8e\x41\x4b\xc3\x40\x10\x46\xef\xf9\x15\xdf\x4d\x85\x6e\xff\x80\xa7\x10\x53\x0d\x68\x23\x66\x85\x82\xc8\x30\xee\x4e\x4d\x30\xec\x96\xd9\x89\xb1\xff\xde\x43\xf1\xee\xe9\x9d\xde\xe3\x39\x87\x4f\x31\xca\x4a\x41\x85\x4d\xa8\x88\x4e\x52\x68\x8a\x74\xcc\x4a\x5f\xdf\xc4\xaa\x7c\x46\xca\x2b\x78\x2e\x19\x2a\xb6\x68\x2a\x58\x47\xb1\x51\x14\x36\x0a\x2e\x4e\xe5\x1c\x56\x2e\xb8\x84\xe2\x06\x9c\x22\x18\xc7\x25\x05\x9b\x72\xba\x2a\x50\x29\xcb\x6c\xb0\xf3\x49\x10\x38\xa5\x6c\xf8\x10\xa8\x9c\x66\x0e\x12\xb7\xd5\xdd\x4b\xff\x8c\xdd\xeb\xbe\xf1\x5d\xbf\x47\xb7\x43\x7b\xe8\x06\x3f\x60\x68\x1e\xda\xa7\x9a\x9a\xda\xd7\x8f\xfd\xfd\xf6\x3f\xc3\xd7\xbe\x3d\xf8\x0d\x4c\x7e\xec\xed\xfd\x8f\x37\xb7\xd5\xef\x00\x15\xcc\x9e\xd8\xf0\x00\x00\x00"),
+		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/idempotent"].(os.FileInfo),
@@ -188,6 +251,7 @@ var MigrationFiles = func() http.FileSystem {
 	fs["/idempotent"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/idempotent/base.sql"].(os.FileInfo),
 		fs["/idempotent/matcher-functions.sql"].(os.FileInfo),
+		fs["/idempotent/promql-functions.sql"].(os.FileInfo),
 	}
 	fs["/preinstall"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/preinstall/000-utils.sql"].(os.FileInfo),
@@ -208,6 +272,7 @@ var MigrationFiles = func() http.FileSystem {
 		fs["/versions/dev/0.1.3-dev"].(os.FileInfo),
 		fs["/versions/dev/0.1.4-dev"].(os.FileInfo),
 		fs["/versions/dev/0.1.5-dev"].(os.FileInfo),
+		fs["/versions/dev/0.2.1-dev"].(os.FileInfo),
 	}
 	fs["/versions/dev/0.1.0-beta.2.dev"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/versions/dev/0.1.0-beta.2.dev/1-drop_procedure_named_drop_chunks.sql"].(os.FileInfo),
@@ -230,6 +295,16 @@ var MigrationFiles = func() http.FileSystem {
 		fs["/versions/dev/0.1.5-dev/2-update_autovac_settings.sql"].(os.FileInfo),
 		fs["/versions/dev/0.1.5-dev/3-drop_function_metric_view.sql"].(os.FileInfo),
 	}
+	fs["/versions/dev/0.2.1-dev"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/versions/dev/0.2.1-dev/1-add_metric_metadata.sql"].(os.FileInfo),
+		fs["/versions/dev/0.2.1-dev/2-add_delete_jobs.sql"].(os.FileInfo),
+		fs["/versions/dev/0.2.1-dev/3-add_exemplars.sql"].(os.FileInfo),
+		fs["/versions/dev/0.2.1-dev/4-add_downsampling.sql"].(os.FileInfo),
+		fs["/versions/dev/0.2.1-dev/5-add_query_result_cache.sql"].(os.FileInfo),
+		fs["/versions/dev/0.2.1-dev/6-add_delete_job_heartbeat.sql"].(os.FileInfo),
+		fs["/versions/dev/0.2.1-dev/7-add_delete_job_tenant.sql"].(os.FileInfo),
+		fs["/versions/dev/0.2.1-dev/8-report_series_creation.sql"].(os.FileInfo),
+	}
 
 	return fs
 }()
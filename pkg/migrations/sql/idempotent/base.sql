@@ -165,6 +165,31 @@ COMMENT ON PROCEDURE SCHEMA_CATALOG.finalize_metric_creation()
 IS 'Finalizes metric creation. This procedure should be run by the connector automatically';
 GRANT EXECUTE ON PROCEDURE SCHEMA_CATALOG.finalize_metric_creation() TO prom_writer;
 
+--Creates the table storing the exemplars of a metric, unless it already exists.
+--Exemplars are keyed by the series they belong to, like the samples, while their
+--own labels (e.g. trace_id) are kept as a JSONB object to not fill the label catalog.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.create_exemplar_table(metric_table NAME, metric_id INT)
+    RETURNS VOID
+AS $func$
+BEGIN
+    EXECUTE format('CREATE TABLE IF NOT EXISTS SCHEMA_DATA_EXEMPLAR.%I(time TIMESTAMPTZ NOT NULL, value DOUBLE PRECISION NOT NULL, series_id BIGINT NOT NULL, labels JSONB NOT NULL DEFAULT ''{}'')',
+                    metric_table);
+    EXECUTE format('CREATE UNIQUE INDEX IF NOT EXISTS exemplar_series_id_time_%s ON SCHEMA_DATA_EXEMPLAR.%I (series_id, time)',
+                    metric_id, metric_table);
+
+    IF SCHEMA_CATALOG.is_timescaledb_installed() THEN
+        --exemplars are few compared to the samples, so they are kept on the access node
+        --even in multinode setups.
+        PERFORM create_hypertable(format('SCHEMA_DATA_EXEMPLAR.%I', metric_table), 'time',
+            chunk_time_interval=>SCHEMA_CATALOG.get_default_chunk_interval(),
+            create_default_indexes=>false,
+            if_not_exists=>true);
+    END IF;
+END
+$func$
+LANGUAGE PLPGSQL VOLATILE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.create_exemplar_table(NAME, INT) TO prom_writer;
+
 --This function is called by a trigger when a new metric is created. It
 --sets up the metric just enough to insert data into it. Metric creation
 --is completed in finalize_metric_creation() above. See the comments
@@ -218,6 +243,8 @@ BEGIN
         ) WITH (autovacuum_vacuum_threshold = 100, autovacuum_analyze_threshold = 100)
     $$, NEW.table_name, label_id, NEW.id);
 
+    PERFORM SCHEMA_CATALOG.create_exemplar_table(NEW.table_name, NEW.id);
+
    RETURN NEW;
 END
 $func$
@@ -517,12 +544,21 @@ BEGIN
 END;
 $$;
 
+--the series table of a metric is named after its table name, which differs from the metric name for
+--metric names longer than the identifier limit, so it is looked up from the metric name.
+DROP FUNCTION IF EXISTS SCHEMA_CATALOG.delete_series_catalog_row(name, bigint[]);
 CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.delete_series_catalog_row(
-    metric_table name,
+    metric_name text,
     series_ids bigint[]
 ) RETURNS VOID AS
 $$
+DECLARE
+    metric_table name;
 BEGIN
+    SELECT table_name INTO STRICT metric_table
+    FROM SCHEMA_CATALOG.metric m
+    WHERE m.metric_name = delete_series_catalog_row.metric_name;
+
     EXECUTE FORMAT(
         'UPDATE SCHEMA_DATA_SERIES.%1$I SET delete_epoch = current_epoch+1 FROM SCHEMA_CATALOG.ids_epoch WHERE delete_epoch IS NULL AND id = ANY($1)',
         metric_table
@@ -547,6 +583,70 @@ $func$
 LANGUAGE SQL STABLE PARALLEL SAFE;
 GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.get_metric_table_name_if_exists(text) to prom_reader;
 
+--Returns the ids of the metrics with samples between start_time and end_time.
+--Only the chunks overlapping the range are read, up to the first sample found.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_metric_ids_in_time_range(
+        start_time TIMESTAMPTZ, end_time TIMESTAMPTZ)
+    RETURNS SETOF INT
+AS $func$
+DECLARE
+    r RECORD;
+    found_sample BOOLEAN;
+BEGIN
+    FOR r IN
+        SELECT m.id, m.table_name
+        FROM SCHEMA_CATALOG.metric m
+    LOOP
+        EXECUTE format('SELECT EXISTS (SELECT 1 FROM SCHEMA_DATA.%I WHERE time >= $1 AND time <= $2)', r.table_name)
+        INTO found_sample
+        USING start_time, end_time;
+        IF found_sample THEN
+            RETURN NEXT r.id;
+        END IF;
+    END LOOP;
+END
+$func$
+LANGUAGE PLPGSQL STABLE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.get_metric_ids_in_time_range(TIMESTAMPTZ, TIMESTAMPTZ) to prom_reader;
+
+--Returns the ids of the supplied series with samples between start_time and
+--end_time, running one query per metric of the series.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_series_ids_in_time_range(
+        series_ids BIGINT[], start_time TIMESTAMPTZ, end_time TIMESTAMPTZ)
+    RETURNS BIGINT[]
+AS $func$
+DECLARE
+    r RECORD;
+    metric_series_ids BIGINT[];
+    result BIGINT[] := array[]::BIGINT[];
+BEGIN
+    FOR r IN
+        SELECT m.table_name, array_agg(s.id) AS ids
+        FROM SCHEMA_CATALOG.series s
+        INNER JOIN SCHEMA_CATALOG.metric m ON (m.id = s.metric_id)
+        WHERE s.id = ANY(series_ids)
+        GROUP BY m.table_name
+    LOOP
+        EXECUTE format($$
+            SELECT array_agg(s.id)
+            FROM unnest($1) AS s(id)
+            WHERE EXISTS (
+                SELECT 1 FROM SCHEMA_DATA.%I m
+                WHERE m.series_id = s.id
+                AND m.time >= $2
+                AND m.time <= $3
+            )
+        $$, r.table_name)
+        INTO metric_series_ids
+        USING r.ids, start_time, end_time;
+        result := result || COALESCE(metric_series_ids, array[]::BIGINT[]);
+    END LOOP;
+    RETURN result;
+END
+$func$
+LANGUAGE PLPGSQL STABLE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.get_series_ids_in_time_range(BIGINT[], TIMESTAMPTZ, TIMESTAMPTZ) to prom_reader;
+
 -- Public function to get the name of the table for a given metric
 -- This will create the metric table if it does not yet exist.
 CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_or_create_metric_table_name(
@@ -613,6 +713,23 @@ $$
 LANGUAGE SQL STABLE PARALLEL SAFE;
 GRANT EXECUTE ON FUNCTION SCHEMA_PROM.label_key_position(text, text) to prom_reader;
 
+CREATE OR REPLACE FUNCTION SCHEMA_PROM.get_metric_metadata(metric_family_name TEXT = NULL)
+    RETURNS TABLE (metric_family TEXT, type TEXT, unit TEXT, help TEXT)
+AS $$
+    SELECT
+        md.metric_family, md.type, md.unit, md.help
+    FROM
+        SCHEMA_CATALOG.metadata md
+    WHERE
+        get_metric_metadata.metric_family_name IS NULL
+        OR md.metric_family = get_metric_metadata.metric_family_name
+    ORDER BY md.metric_family
+$$
+LANGUAGE SQL STABLE PARALLEL SAFE;
+COMMENT ON FUNCTION SCHEMA_PROM.get_metric_metadata(TEXT)
+IS 'returns the last seen type, unit and help of a metric family, or of all metric families if the name is NULL';
+GRANT EXECUTE ON FUNCTION SCHEMA_PROM.get_metric_metadata(TEXT) to prom_reader;
+
 -- drop_metric deletes a metric and related series hypertable from the database along with the related series, views and unreferenced labels.
 CREATE OR REPLACE FUNCTION SCHEMA_PROM.drop_metric(metric_name_to_be_dropped text) RETURNS VOID
 AS
@@ -620,6 +737,7 @@ $$
     DECLARE
         hypertable_name TEXT;
         deletable_metric_id INTEGER;
+        downsample_view NAME;
     BEGIN
         IF (SELECT NOT pg_try_advisory_xact_lock(SCHEMA_LOCK_ID)) THEN
             RAISE NOTICE 'drop_metric can run only when no Promscale connectors are running. Please shutdown the Promscale connectors';
@@ -630,7 +748,13 @@ $$
         EXECUTE FORMAT('DROP VIEW SCHEMA_SERIES.%1$I;', hypertable_name);
         EXECUTE FORMAT('DROP VIEW SCHEMA_METRIC.%1$I;', hypertable_name);
         EXECUTE FORMAT('DROP TABLE SCHEMA_DATA_SERIES.%1$I;', hypertable_name);
+        -- the continuous aggregates depend on the metric table.
+        FOR downsample_view IN SELECT view_name FROM SCHEMA_CATALOG.downsample WHERE metric_id = deletable_metric_id
+        LOOP
+            EXECUTE FORMAT('DROP MATERIALIZED VIEW SCHEMA_DATA_DOWNSAMPLE.%1$I;', downsample_view);
+        END LOOP;
         EXECUTE FORMAT('DROP TABLE SCHEMA_DATA.%1$I;', hypertable_name);
+        EXECUTE FORMAT('DROP TABLE IF EXISTS SCHEMA_DATA_EXEMPLAR.%1$I;', hypertable_name);
         DELETE FROM SCHEMA_CATALOG.metric WHERE id=deletable_metric_id;
         -- clean up unreferenced labels, label_keys and its position.
         DELETE FROM SCHEMA_CATALOG.label_key_position WHERE metric_name=metric_name_to_be_dropped;
@@ -639,6 +763,139 @@ $$
 $$
 LANGUAGE plpgsql;
 
+-- add_downsampling materializes the samples of a metric at a lower resolution,
+-- as a continuous aggregate storing the min, max, sum, count and last value of
+-- every series in every bucket of the resolution. Queries whose step is at least
+-- the resolution read the aggregate instead of the raw samples.
+CREATE OR REPLACE FUNCTION SCHEMA_PROM.add_downsampling(metric_name TEXT, resolution INTERVAL)
+    RETURNS VOID
+AS $func$
+DECLARE
+    metric_table NAME;
+    metric_table_id INT;
+    downsample_view NAME;
+    resolution_seconds BIGINT;
+BEGIN
+    IF NOT SCHEMA_CATALOG.is_timescaledb_installed() OR SCHEMA_CATALOG.get_timescale_major_version() < 2 THEN
+        RAISE EXCEPTION 'downsampling requires TimescaleDB 2.0 or later';
+    END IF;
+    IF SCHEMA_CATALOG.is_multinode() THEN
+        RAISE EXCEPTION 'downsampling is not supported on multinode';
+    END IF;
+    IF resolution <= INTERVAL '0' THEN
+        RAISE EXCEPTION 'invalid downsampling resolution %, must be positive', resolution;
+    END IF;
+
+    SELECT m.table_name, m.id INTO metric_table, metric_table_id
+    FROM SCHEMA_CATALOG.get_metric_table_name_if_exists(add_downsampling.metric_name) m;
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'metric % does not exist', add_downsampling.metric_name;
+    END IF;
+
+    resolution_seconds := extract(epoch FROM resolution)::BIGINT;
+    downsample_view := SCHEMA_CATALOG.pg_name_unique(
+        format('%s_%ss', metric_table, resolution_seconds),
+        format('%s_%ss', metric_table_id, resolution_seconds));
+
+    INSERT INTO SCHEMA_CATALOG.downsample(metric_id, resolution, view_name)
+    VALUES (metric_table_id, resolution, downsample_view);
+
+    -- the time of a bucket is the one of its last sample, so that the bucket
+    -- is seen by the queries like that sample would be.
+    EXECUTE format($$
+        CREATE MATERIALIZED VIEW SCHEMA_DATA_DOWNSAMPLE.%1$I WITH (timescaledb.continuous) AS
+        SELECT time_bucket(%3$L, time) AS time, series_id,
+            max(time) AS last_time,
+            min(value) AS min,
+            max(value) AS max,
+            sum(value) AS sum,
+            count(value) AS count,
+            last(value, time) AS last
+        FROM SCHEMA_DATA.%2$I
+        GROUP BY 1, 2
+        WITH NO DATA$$, downsample_view, metric_table, resolution);
+
+    -- the first refresh materializes the existing samples. Aggregates are
+    -- kept when the raw samples are dropped by the retention.
+    PERFORM add_continuous_aggregate_policy(format('SCHEMA_DATA_DOWNSAMPLE.%I', downsample_view)::regclass,
+        start_offset => NULL,
+        end_offset => resolution,
+        schedule_interval => resolution);
+END
+$func$
+LANGUAGE PLPGSQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_PROM.add_downsampling(TEXT, INTERVAL)
+IS 'downsample a metric to a resolution with a continuous aggregate, which is used by the queries with a step of at least the resolution';
+
+CREATE OR REPLACE FUNCTION SCHEMA_PROM.remove_downsampling(metric_name TEXT, resolution INTERVAL)
+    RETURNS VOID
+AS $func$
+DECLARE
+    downsample_view NAME;
+BEGIN
+    DELETE FROM SCHEMA_CATALOG.downsample d
+    WHERE d.resolution = remove_downsampling.resolution
+    AND d.metric_id = (SELECT id FROM SCHEMA_CATALOG.get_metric_table_name_if_exists(remove_downsampling.metric_name))
+    RETURNING view_name INTO downsample_view;
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'metric % is not downsampled to %', remove_downsampling.metric_name, resolution;
+    END IF;
+    EXECUTE format('DROP MATERIALIZED VIEW SCHEMA_DATA_DOWNSAMPLE.%I', downsample_view);
+END
+$func$
+LANGUAGE PLPGSQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_PROM.remove_downsampling(TEXT, INTERVAL)
+IS 'remove a downsampling resolution of a metric along with its continuous aggregate';
+
+-- returns the downsampling resolutions of a metric, in milliseconds, and the
+-- continuous aggregates storing them.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_metric_downsampling(metric_name TEXT)
+    RETURNS TABLE(resolution_ms BIGINT, view_name NAME)
+AS $func$
+    SELECT (extract(epoch FROM d.resolution) * 1000)::BIGINT, d.view_name
+    FROM SCHEMA_CATALOG.downsample d
+    INNER JOIN SCHEMA_CATALOG.metric m ON (m.id = d.metric_id)
+    WHERE m.metric_name = get_metric_downsampling.metric_name
+    ORDER BY d.resolution
+$func$
+LANGUAGE SQL STABLE PARALLEL SAFE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.get_metric_downsampling(TEXT) TO prom_reader;
+
+-- returns the samples of the given series of a metric within a time range,
+-- aggregated into an array per series. It lets a query selecting series of
+-- several metrics read all of them in a single statement, without knowing the
+-- data tables of the metrics in advance.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_metric_series_samples(
+        metric_name TEXT, series_ids BIGINT[], start_time TIMESTAMPTZ, end_time TIMESTAMPTZ)
+    RETURNS TABLE(labels INT[], time_array TIMESTAMPTZ[], value_array DOUBLE PRECISION[])
+AS $func$
+DECLARE
+    metric_table NAME;
+BEGIN
+    SELECT m.table_name
+    INTO metric_table
+    FROM SCHEMA_CATALOG.get_metric_table_name_if_exists(get_metric_series_samples.metric_name) m;
+    IF NOT FOUND THEN
+        RETURN;
+    END IF;
+
+    RETURN QUERY EXECUTE format(
+        $$
+        SELECT s.labels, array_agg(m.time ORDER BY m.time), array_agg(m.value ORDER BY m.time)
+        FROM SCHEMA_DATA.%1$I m
+        INNER JOIN SCHEMA_DATA_SERIES.%1$I s
+        ON m.series_id = s.id
+        WHERE m.series_id = ANY($1)
+        AND m.time >= $2
+        AND m.time <= $3
+        GROUP BY s.id, s.labels
+        $$, metric_table)
+    USING series_ids, start_time, end_time;
+END
+$func$
+LANGUAGE PLPGSQL STABLE PARALLEL SAFE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.get_metric_series_samples(TEXT, BIGINT[], TIMESTAMPTZ, TIMESTAMPTZ) TO prom_reader;
+
 --Get the label_id for a key, value pair
 -- no need for a get function only as users will not be using ids directly
 CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_or_create_label_id(
@@ -870,7 +1127,7 @@ COMMENT ON FUNCTION SCHEMA_CATALOG.get_or_create_series_id(jsonb)
 IS 'returns the series id that exactly matches a JSONB of labels';
 GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.get_or_create_series_id(jsonb) TO prom_writer;
 
-CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_or_create_series_id_for_kv_array(metric_name TEXT, label_keys text[], label_values text[], OUT table_name NAME, OUT series_id BIGINT)
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.get_or_create_series_id_for_kv_array(metric_name TEXT, label_keys text[], label_values text[], OUT table_name NAME, OUT series_id BIGINT, OUT created BOOLEAN)
 AS $func$
 DECLARE
   metric_id int;
@@ -896,19 +1153,58 @@ BEGIN
         UPDATE SCHEMA_DATA_SERIES.%1$I SET delete_epoch = NULL
         WHERE id IN (SELECT id FROM existing WHERE delete_epoch IS NOT NULL)
     )
-    SELECT id FROM existing
+    SELECT id, false FROM existing
     UNION ALL
-    SELECT SCHEMA_CATALOG.create_series(%2$L, %1$L, (SELECT * FROM cte))
+    SELECT SCHEMA_CATALOG.create_series(%2$L, %1$L, (SELECT * FROM cte)), true
     LIMIT 1
    $query$, table_name, metric_id)
    USING metric_name, label_keys, label_values
-   INTO series_id;
+   INTO series_id, created;
 
    RETURN;
 END
 $func$
 LANGUAGE PLPGSQL VOLATILE;
+COMMENT ON FUNCTION SCHEMA_CATALOG.get_or_create_series_id_for_kv_array(TEXT, text[], text[])
+IS 'returns the series id that exactly matches the labels, and whether the series was created';
 GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.get_or_create_series_id_for_kv_array(TEXT, text[], text[]) TO prom_writer;
+
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.series_exists(metric_name TEXT, label_keys text[], label_values text[])
+RETURNS BOOLEAN AS $$
+    WITH idx_val AS (
+        SELECT lkp.pos idx, l.id val
+        FROM ROWS FROM(unnest(label_keys), UNNEST(label_values)) AS kv(key, value)
+            LEFT JOIN SCHEMA_CATALOG.label l
+               ON (l.key = kv.key AND l.value = kv.value)
+            LEFT JOIN SCHEMA_CATALOG.label_key_position lkp
+               ON
+               (
+                  lkp.metric_name = series_exists.metric_name AND
+                  lkp.key = kv.key
+               )
+    )
+    -- a series whose labels or key positions are missing cannot exist
+    SELECT NOT EXISTS (SELECT 1 FROM idx_val WHERE idx IS NULL OR val IS NULL)
+        AND EXISTS (
+            SELECT 1
+            FROM SCHEMA_CATALOG.series s
+            INNER JOIN SCHEMA_CATALOG.metric m ON (m.id = s.metric_id)
+            WHERE m.metric_name = series_exists.metric_name
+            AND s.labels = ARRAY(
+                SELECT coalesce(idx_val.val, 0)
+                FROM
+                    generate_series(
+                            1,
+                            (SELECT max(idx) FROM idx_val)
+                    ) g
+                    LEFT JOIN idx_val ON (idx_val.idx = g)
+            )::SCHEMA_PROM.label_array
+        )
+$$
+LANGUAGE SQL STABLE;
+COMMENT ON FUNCTION SCHEMA_CATALOG.series_exists(TEXT, text[], text[])
+IS 'returns whether the series exactly matching the labels exists, without creating it or its labels';
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.series_exists(TEXT, text[], text[]) TO prom_writer;
 --
 -- Parameter manipulation functions
 --
@@ -1263,26 +1559,14 @@ $func$
 LANGUAGE PLPGSQL VOLATILE;
 
 
-CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.delete_expired_series(
-    metric_table TEXT, ran_at TIMESTAMPTZ
+--Deletes the series of the metric marked for deletion in deletion_epoch or earlier that have
+--no data left, along with the labels no longer used by any series.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.delete_expired_series_in_epoch(
+    metric_table TEXT, deletion_epoch BIGINT
 ) RETURNS VOID AS $func$
 DECLARE
-    last_epoch_time TIMESTAMPTZ;
-    deletion_epoch BIGINT;
-    next_epoch BIGINT;
     label_array int[];
 BEGIN
-    -- technically we can delete any ID <= current_epoch - 1
-    -- but it's always safe to leave them around for a bit longer
-    SELECT last_update_time, current_epoch-4, current_epoch+1
-        FROM SCHEMA_CATALOG.ids_epoch LIMIT 1
-        INTO last_epoch_time, deletion_epoch, next_epoch;
-
-    -- we don't want to delete too soon
-    IF ran_at < last_epoch_time + '1 hour' THEN
-        RETURN;
-    END IF;
-
     EXECUTE format($query$
         -- recheck that the series IDs we might delete are actually dead
         WITH dead_series AS (
@@ -1327,6 +1611,31 @@ BEGIN
         WHERE id IN (SELECT * FROM confirmed_drop_labels) AND key != '__name__';
     $query$, metric_table) USING label_array;
 
+END
+$func$
+LANGUAGE PLPGSQL VOLATILE;
+
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.delete_expired_series(
+    metric_table TEXT, ran_at TIMESTAMPTZ
+) RETURNS VOID AS $func$
+DECLARE
+    last_epoch_time TIMESTAMPTZ;
+    deletion_epoch BIGINT;
+    next_epoch BIGINT;
+BEGIN
+    -- technically we can delete any ID <= current_epoch - 1
+    -- but it's always safe to leave them around for a bit longer
+    SELECT last_update_time, current_epoch-4, current_epoch+1
+        FROM SCHEMA_CATALOG.ids_epoch LIMIT 1
+        INTO last_epoch_time, deletion_epoch, next_epoch;
+
+    -- we don't want to delete too soon
+    IF ran_at < last_epoch_time + '1 hour' THEN
+        RETURN;
+    END IF;
+
+    PERFORM SCHEMA_CATALOG.delete_expired_series_in_epoch(metric_table, deletion_epoch);
+
     -- wait for current insertions to be done, and ensure that all future
     -- insertions will see the epoch change
     LOCK TABLE SCHEMA_CATALOG.ids_epoch IN ACCESS EXCLUSIVE MODE;
@@ -1340,6 +1649,76 @@ $func$
 LANGUAGE PLPGSQL VOLATILE;
 
 
+--Deletes the expired series of all metrics right away instead of waiting for the maintenance
+--jobs to get to them. Metrics under maintenance are skipped. Like delete_expired_series, it does
+--nothing if the epoch was advanced less than an hour ago.
+CREATE OR REPLACE PROCEDURE SCHEMA_CATALOG.delete_expired_series_of_all_metrics(ran_at TIMESTAMPTZ DEFAULT now())
+AS $func$
+DECLARE
+    last_epoch_time TIMESTAMPTZ;
+    deletion_epoch BIGINT;
+    next_epoch BIGINT;
+    r RECORD;
+BEGIN
+    SELECT last_update_time, current_epoch-4, current_epoch+1
+        FROM SCHEMA_CATALOG.ids_epoch LIMIT 1
+        INTO last_epoch_time, deletion_epoch, next_epoch;
+
+    IF ran_at < last_epoch_time + '1 hour' THEN
+        RETURN;
+    END IF;
+
+    FOR r IN
+        SELECT id, table_name
+        FROM SCHEMA_CATALOG.metric
+        ORDER BY id
+    LOOP
+        IF SCHEMA_CATALOG.lock_metric_for_maintenance(r.id, wait=>false) THEN
+            PERFORM SCHEMA_CATALOG.delete_expired_series_in_epoch(r.table_name, deletion_epoch);
+        END IF;
+        COMMIT;
+    END LOOP;
+
+    -- wait for current insertions to be done, and ensure that all future
+    -- insertions will see the epoch change
+    LOCK TABLE SCHEMA_CATALOG.ids_epoch IN ACCESS EXCLUSIVE MODE;
+
+    UPDATE SCHEMA_CATALOG.ids_epoch
+        SET (current_epoch, last_update_time) = (next_epoch, now())
+        WHERE current_epoch < next_epoch;
+END
+$func$
+LANGUAGE PLPGSQL;
+
+--Drops the exemplars of a metric older than older_than, which match the samples dropped by retention.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.drop_exemplar_chunks(metric_table NAME, older_than TIMESTAMPTZ)
+    RETURNS VOID
+AS $func$
+BEGIN
+    IF SCHEMA_CATALOG.is_timescaledb_installed() AND EXISTS (
+        SELECT 1 FROM _timescaledb_catalog.hypertable h
+        WHERE h.schema_name = 'SCHEMA_DATA_EXEMPLAR' AND h.table_name = metric_table
+    ) THEN
+        IF SCHEMA_CATALOG.get_timescale_major_version() >= 2 THEN
+            PERFORM drop_chunks(
+                relation=>format('%I.%I', 'SCHEMA_DATA_EXEMPLAR', metric_table),
+                older_than=>older_than
+            );
+        ELSE
+            PERFORM drop_chunks(
+                table_name=>metric_table,
+                schema_name=> 'SCHEMA_DATA_EXEMPLAR',
+                older_than=>older_than,
+                cascade_to_materializations=>FALSE
+            );
+        END IF;
+    ELSE
+        EXECUTE format($$ DELETE FROM SCHEMA_DATA_EXEMPLAR.%I WHERE time < %L $$, metric_table, older_than);
+    END IF;
+END
+$func$
+LANGUAGE PLPGSQL VOLATILE;
+
 --drop chunks from metrics tables and delete the appropriate series.
 CREATE OR REPLACE PROCEDURE SCHEMA_CATALOG.drop_metric_chunks(
     metric_name TEXT, older_than TIMESTAMPTZ, ran_at TIMESTAMPTZ DEFAULT now()
@@ -1414,6 +1793,7 @@ BEGIN
         ELSE
             EXECUTE format($$ DELETE FROM SCHEMA_DATA.%I WHERE time < %L $$, metric_table, older_than);
         END IF;
+        PERFORM SCHEMA_CATALOG.drop_exemplar_chunks(metric_table, older_than);
     COMMIT;
     PERFORM SCHEMA_CATALOG.lock_metric_for_maintenance(metric_id);
 
@@ -1712,11 +2092,105 @@ BEGIN
         GET DIAGNOSTICS rows_affected = ROW_COUNT;
         num_rows_deleted = num_rows_deleted + rows_affected;
     END IF;
+    EXECUTE FORMAT('DELETE FROM SCHEMA_DATA_EXEMPLAR.%1$I WHERE series_id = ANY($1)', metric_table) USING series_ids;
     PERFORM SCHEMA_CATALOG.delete_series_catalog_row(name, series_ids);
     RETURN num_rows_deleted;
 END;
 $$;
 
+--Deletes the rows of the series within [start_time, end_time] from the metric. Compressed chunks
+--overlapping the range are decompressed first, they will be compressed again by the compression job.
+--Series without any rows left are marked for deletion and returned in deleted_series_ids.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.delete_series_from_metric_in_range(
+    name text, series_ids bigint[], start_time timestamptz, end_time timestamptz,
+    OUT rows_deleted bigint, OUT deleted_series_ids bigint[])
+LANGUAGE PLPGSQL
+AS
+$$
+DECLARE
+    metric_table name;
+    chunk_row record;
+    time_dimension_id int;
+    start_internal bigint;
+    end_internal bigint;
+BEGIN
+    SELECT table_name INTO metric_table FROM SCHEMA_CATALOG.metric m WHERE m.metric_name=name;
+    IF SCHEMA_CATALOG.is_timescaledb_installed() THEN
+        SELECT d.id INTO STRICT time_dimension_id
+        FROM _timescaledb_catalog.hypertable h
+        INNER JOIN _timescaledb_catalog.dimension d ON (d.hypertable_id = h.id)
+        WHERE h.schema_name = 'SCHEMA_DATA' AND h.table_name = metric_table
+        ORDER BY d.id
+        LIMIT 1;
+
+        IF start_time = timestamptz '-Infinity' THEN
+            start_internal := -9223372036854775808;
+        ELSE
+            SELECT _timescaledb_internal.time_to_internal(start_time) INTO STRICT start_internal;
+        END IF;
+        IF end_time = timestamptz 'Infinity' THEN
+            end_internal := 9223372036854775807;
+        ELSE
+            SELECT _timescaledb_internal.time_to_internal(end_time) INTO STRICT end_internal;
+        END IF;
+
+        FOR chunk_row IN
+            SELECT c.*
+            FROM _timescaledb_catalog.dimension_slice ds
+            INNER JOIN _timescaledb_catalog.chunk_constraint cc ON cc.dimension_slice_id = ds.id
+            INNER JOIN _timescaledb_catalog.chunk c ON cc.chunk_id = c.id
+            WHERE ds.dimension_id = time_dimension_id
+            -- the range_ends are non-inclusive
+            AND start_internal < ds.range_end
+            AND end_internal >= ds.range_start
+            AND c.compressed_chunk_id IS NOT NULL
+        LOOP
+            RAISE NOTICE 'Promscale is decompressing chunk: %.%', chunk_row.schema_name, chunk_row.table_name;
+            PERFORM decompress_chunk(format('%I.%I', chunk_row.schema_name, chunk_row.table_name)::regclass, if_compressed=>true);
+        END LOOP;
+    END IF;
+
+    EXECUTE FORMAT('DELETE FROM SCHEMA_DATA.%1$I WHERE series_id = ANY($1) AND time >= $2 AND time <= $3', metric_table)
+    USING series_ids, start_time, end_time;
+    GET DIAGNOSTICS rows_deleted = ROW_COUNT;
+
+    EXECUTE FORMAT('DELETE FROM SCHEMA_DATA_EXEMPLAR.%1$I WHERE series_id = ANY($1) AND time >= $2 AND time <= $3', metric_table)
+    USING series_ids, start_time, end_time;
+
+    EXECUTE FORMAT(
+        'SELECT COALESCE(array_agg(s.id), array[]::bigint[]) FROM unnest($1) AS s(id)
+         WHERE NOT EXISTS (SELECT 1 FROM SCHEMA_DATA.%1$I m WHERE m.series_id = s.id)',
+        metric_table
+    ) USING series_ids INTO deleted_series_ids;
+
+    PERFORM SCHEMA_CATALOG.delete_series_catalog_row(name, deleted_series_ids);
+END;
+$$;
+
+--Returns the planner's estimate of the number of rows of the series within [start_time, end_time]
+--in the metric's table. It is much cheaper than counting the rows but can be inaccurate.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.estimate_series_rows(
+    name text, series_ids bigint[], start_time timestamptz, end_time timestamptz)
+RETURNS BIGINT
+LANGUAGE PLPGSQL STABLE
+AS
+$$
+DECLARE
+    metric_table name;
+    query_plan json;
+BEGIN
+    SELECT table_name INTO metric_table FROM SCHEMA_CATALOG.metric m WHERE m.metric_name=name;
+    IF NOT FOUND THEN
+        RETURN 0;
+    END IF;
+    EXECUTE FORMAT(
+        'EXPLAIN (FORMAT JSON) SELECT 1 FROM SCHEMA_DATA.%1$I WHERE series_id = ANY(%2$L::bigint[]) AND time >= %3$L::timestamptz AND time <= %4$L::timestamptz',
+        metric_table, series_ids, start_time, end_time
+    ) INTO query_plan;
+    RETURN (query_plan->0->'Plan'->>'Plan Rows')::numeric::bigint;
+END;
+$$;
+
 --------------------------------- Views --------------------------------
 
 CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.metric_view()
@@ -1899,6 +2373,12 @@ CREATE OR REPLACE VIEW SCHEMA_INFO.system_stats AS
         SELECT count(*) FROM SCHEMA_CATALOG.label
     ) AS num_labels;
 
+CREATE OR REPLACE VIEW SCHEMA_INFO.downsampling AS
+    SELECT m.metric_name, d.resolution, format('SCHEMA_DATA_DOWNSAMPLE.%I', d.view_name) AS view_name
+    FROM SCHEMA_CATALOG.downsample d
+    INNER JOIN SCHEMA_CATALOG.metric m ON (m.id = d.metric_id)
+    ORDER BY m.metric_name, d.resolution;
+
 CREATE OR REPLACE VIEW SCHEMA_INFO.metric_stats AS
     SELECT metric_name,
     SCHEMA_CATALOG.safe_approximate_row_count(format('prom_series.%I', table_name)::regclass) AS num_series_approx,
@@ -0,0 +1,477 @@
+-- The functions in this file evaluate PromQL functions over the samples of a
+-- series, for all the steps of a query at once, so that the querier can push
+-- them down into the database. The samples are passed as arrays ordered by
+-- time, and the result has a value, or NULL, for every step from lowest_time
+-- to greatest_time. The window of a step ending at t is [t - range, t], and the
+-- stale markers are ignored, as in the PromQL engine.
+
+-- prom_extrapolated_rate evaluates rate, increase or delta, as the
+-- extrapolatedRate function of Prometheus.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.prom_extrapolated_rate(
+        lowest_time TIMESTAMPTZ, greatest_time TIMESTAMPTZ, step_ms BIGINT, range_ms BIGINT,
+        sample_times TIMESTAMPTZ[], sample_values DOUBLE PRECISION[], is_counter BOOLEAN, is_rate BOOLEAN)
+    RETURNS DOUBLE PRECISION[]
+AS $func$
+DECLARE
+    times BIGINT[];
+    vals DOUBLE PRECISION[];
+    -- the sum of the counter resets up to every sample.
+    corrections DOUBLE PRECISION[];
+    num_samples INT;
+    result DOUBLE PRECISION[] := '{}';
+    step_end BIGINT := (extract(epoch FROM lowest_time) * 1000)::BIGINT;
+    last_step BIGINT := (extract(epoch FROM greatest_time) * 1000)::BIGINT;
+    range_start BIGINT;
+    first_idx INT := 1;
+    last_idx INT := 0;
+    result_value DOUBLE PRECISION;
+    duration_to_start DOUBLE PRECISION;
+    duration_to_end DOUBLE PRECISION;
+    duration_to_zero DOUBLE PRECISION;
+    sampled_interval DOUBLE PRECISION;
+    average_duration DOUBLE PRECISION;
+    extrapolate_to_interval DOUBLE PRECISION;
+BEGIN
+    SELECT array_agg((extract(epoch FROM t) * 1000)::BIGINT ORDER BY ord), array_agg(v ORDER BY ord)
+    INTO times, vals
+    FROM unnest(sample_times, sample_values) WITH ORDINALITY AS s(t, v, ord)
+    WHERE NOT SCHEMA_PROM.is_stale_marker(v);
+    num_samples := coalesce(array_length(times, 1), 0);
+
+    corrections := array_fill(0::DOUBLE PRECISION, ARRAY[greatest(num_samples, 1)]);
+    FOR i IN 2..num_samples LOOP
+        corrections[i] := corrections[i-1];
+        IF is_counter AND vals[i] < vals[i-1] THEN
+            corrections[i] := corrections[i] + vals[i-1];
+        END IF;
+    END LOOP;
+
+    WHILE step_end <= last_step LOOP
+        range_start := step_end - range_ms;
+        WHILE first_idx <= num_samples AND times[first_idx] < range_start LOOP
+            first_idx := first_idx + 1;
+        END LOOP;
+        WHILE last_idx < num_samples AND times[last_idx+1] <= step_end LOOP
+            last_idx := last_idx + 1;
+        END LOOP;
+
+        -- a rate needs at least two samples.
+        IF last_idx - first_idx < 1 OR times[last_idx] = times[first_idx] THEN
+            result := result || NULL::DOUBLE PRECISION;
+        ELSE
+            result_value := vals[last_idx] - vals[first_idx] + corrections[last_idx] - corrections[first_idx];
+
+            duration_to_start := (times[first_idx] - range_start) / 1000.0;
+            duration_to_end := (step_end - times[last_idx]) / 1000.0;
+            sampled_interval := (times[last_idx] - times[first_idx]) / 1000.0;
+            average_duration := sampled_interval / (last_idx - first_idx);
+
+            -- counters cannot be negative, so do not extrapolate below zero.
+            IF is_counter AND result_value > 0 AND vals[first_idx] >= 0 THEN
+                duration_to_zero := sampled_interval * (vals[first_idx] / result_value);
+                IF duration_to_zero < duration_to_start THEN
+                    duration_to_start := duration_to_zero;
+                END IF;
+            END IF;
+
+            extrapolate_to_interval := sampled_interval;
+            IF duration_to_start < average_duration * 1.1 THEN
+                extrapolate_to_interval := extrapolate_to_interval + duration_to_start;
+            ELSE
+                extrapolate_to_interval := extrapolate_to_interval + average_duration / 2;
+            END IF;
+            IF duration_to_end < average_duration * 1.1 THEN
+                extrapolate_to_interval := extrapolate_to_interval + duration_to_end;
+            ELSE
+                extrapolate_to_interval := extrapolate_to_interval + average_duration / 2;
+            END IF;
+
+            result_value := result_value * (extrapolate_to_interval / sampled_interval);
+            IF is_rate THEN
+                result_value := result_value / (range_ms / 1000.0);
+            END IF;
+            result := result || result_value;
+        END IF;
+
+        step_end := step_end + step_ms;
+    END LOOP;
+    RETURN result;
+END
+$func$
+LANGUAGE PLPGSQL IMMUTABLE PARALLEL SAFE;
+COMMENT ON FUNCTION SCHEMA_CATALOG.prom_extrapolated_rate(TIMESTAMPTZ, TIMESTAMPTZ, BIGINT, BIGINT, TIMESTAMPTZ[], DOUBLE PRECISION[], BOOLEAN, BOOLEAN)
+IS 'evaluates the PromQL rate (is_counter and is_rate), increase (is_counter) or delta function for every step of a query';
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.prom_extrapolated_rate(TIMESTAMPTZ, TIMESTAMPTZ, BIGINT, BIGINT, TIMESTAMPTZ[], DOUBLE PRECISION[], BOOLEAN, BOOLEAN) TO prom_reader;
+
+-- prom_instant_rate evaluates irate or idelta from the last two samples of
+-- every window, as the instantValue function of Prometheus.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.prom_instant_rate(
+        lowest_time TIMESTAMPTZ, greatest_time TIMESTAMPTZ, step_ms BIGINT, range_ms BIGINT,
+        sample_times TIMESTAMPTZ[], sample_values DOUBLE PRECISION[], is_rate BOOLEAN)
+    RETURNS DOUBLE PRECISION[]
+AS $func$
+DECLARE
+    times BIGINT[];
+    vals DOUBLE PRECISION[];
+    num_samples INT;
+    result DOUBLE PRECISION[] := '{}';
+    step_end BIGINT := (extract(epoch FROM lowest_time) * 1000)::BIGINT;
+    last_step BIGINT := (extract(epoch FROM greatest_time) * 1000)::BIGINT;
+    first_idx INT := 1;
+    last_idx INT := 0;
+    result_value DOUBLE PRECISION;
+BEGIN
+    SELECT array_agg((extract(epoch FROM t) * 1000)::BIGINT ORDER BY ord), array_agg(v ORDER BY ord)
+    INTO times, vals
+    FROM unnest(sample_times, sample_values) WITH ORDINALITY AS s(t, v, ord)
+    WHERE NOT SCHEMA_PROM.is_stale_marker(v);
+    num_samples := coalesce(array_length(times, 1), 0);
+
+    WHILE step_end <= last_step LOOP
+        WHILE first_idx <= num_samples AND times[first_idx] < step_end - range_ms LOOP
+            first_idx := first_idx + 1;
+        END LOOP;
+        WHILE last_idx < num_samples AND times[last_idx+1] <= step_end LOOP
+            last_idx := last_idx + 1;
+        END LOOP;
+
+        IF last_idx - first_idx < 1 OR times[last_idx] = times[last_idx-1] THEN
+            result := result || NULL::DOUBLE PRECISION;
+        ELSE
+            IF is_rate AND vals[last_idx] < vals[last_idx-1] THEN
+                -- counter reset.
+                result_value := vals[last_idx];
+            ELSE
+                result_value := vals[last_idx] - vals[last_idx-1];
+            END IF;
+            IF is_rate THEN
+                result_value := result_value / ((times[last_idx] - times[last_idx-1]) / 1000.0);
+            END IF;
+            result := result || result_value;
+        END IF;
+
+        step_end := step_end + step_ms;
+    END LOOP;
+    RETURN result;
+END
+$func$
+LANGUAGE PLPGSQL IMMUTABLE PARALLEL SAFE;
+COMMENT ON FUNCTION SCHEMA_CATALOG.prom_instant_rate(TIMESTAMPTZ, TIMESTAMPTZ, BIGINT, BIGINT, TIMESTAMPTZ[], DOUBLE PRECISION[], BOOLEAN)
+IS 'evaluates the PromQL irate (is_rate) or idelta function for every step of a query';
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.prom_instant_rate(TIMESTAMPTZ, TIMESTAMPTZ, BIGINT, BIGINT, TIMESTAMPTZ[], DOUBLE PRECISION[], BOOLEAN) TO prom_reader;
+
+-- prom_over_time evaluates the avg_over_time, min_over_time, max_over_time,
+-- sum_over_time, count_over_time or last_over_time function, named by fn, as
+-- the PromQL engine does.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.prom_over_time(
+        lowest_time TIMESTAMPTZ, greatest_time TIMESTAMPTZ, step_ms BIGINT, range_ms BIGINT,
+        sample_times TIMESTAMPTZ[], sample_values DOUBLE PRECISION[], fn TEXT)
+    RETURNS DOUBLE PRECISION[]
+AS $func$
+DECLARE
+    times BIGINT[];
+    vals DOUBLE PRECISION[];
+    num_samples INT;
+    result DOUBLE PRECISION[] := '{}';
+    step_end BIGINT := (extract(epoch FROM lowest_time) * 1000)::BIGINT;
+    last_step BIGINT := (extract(epoch FROM greatest_time) * 1000)::BIGINT;
+    first_idx INT := 1;
+    last_idx INT := 0;
+    result_value DOUBLE PRECISION;
+    cnt DOUBLE PRECISION;
+    v DOUBLE PRECISION;
+BEGIN
+    IF fn NOT IN ('avg_over_time', 'min_over_time', 'max_over_time', 'sum_over_time', 'count_over_time', 'last_over_time') THEN
+        RAISE EXCEPTION 'unsupported function %', fn;
+    END IF;
+
+    SELECT array_agg((extract(epoch FROM t) * 1000)::BIGINT ORDER BY ord), array_agg(s.v ORDER BY ord)
+    INTO times, vals
+    FROM unnest(sample_times, sample_values) WITH ORDINALITY AS s(t, v, ord)
+    WHERE NOT SCHEMA_PROM.is_stale_marker(s.v);
+    num_samples := coalesce(array_length(times, 1), 0);
+
+    WHILE step_end <= last_step LOOP
+        WHILE first_idx <= num_samples AND times[first_idx] < step_end - range_ms LOOP
+            first_idx := first_idx + 1;
+        END LOOP;
+        WHILE last_idx < num_samples AND times[last_idx+1] <= step_end LOOP
+            last_idx := last_idx + 1;
+        END LOOP;
+
+        IF last_idx < first_idx THEN
+            result_value := NULL;
+        ELSIF fn = 'count_over_time' THEN
+            result_value := last_idx - first_idx + 1;
+        ELSIF fn = 'last_over_time' THEN
+            result_value := vals[last_idx];
+        ELSIF fn = 'sum_over_time' THEN
+            result_value := 0;
+            FOR i IN first_idx..last_idx LOOP
+                result_value := result_value + vals[i];
+            END LOOP;
+        ELSIF fn = 'min_over_time' THEN
+            -- NaN is greater than any number in PostgreSQL, but is never
+            -- selected over a number in PromQL.
+            result_value := vals[first_idx];
+            FOR i IN first_idx..last_idx LOOP
+                IF result_value = 'NaN' OR (vals[i] <> 'NaN' AND vals[i] < result_value) THEN
+                    result_value := vals[i];
+                END IF;
+            END LOOP;
+        ELSIF fn = 'max_over_time' THEN
+            result_value := vals[first_idx];
+            FOR i IN first_idx..last_idx LOOP
+                IF result_value = 'NaN' OR (vals[i] <> 'NaN' AND vals[i] > result_value) THEN
+                    result_value := vals[i];
+                END IF;
+            END LOOP;
+        ELSE
+            -- avg_over_time, as an incremental mean which does not overflow.
+            result_value := 0;
+            cnt := 0;
+            FOR i IN first_idx..last_idx LOOP
+                v := vals[i];
+                cnt := cnt + 1;
+                IF result_value IN ('Infinity', '-Infinity') THEN
+                    CONTINUE WHEN v IN ('Infinity', '-Infinity') AND (result_value > 0) = (v > 0);
+                    CONTINUE WHEN v NOT IN ('Infinity', '-Infinity', 'NaN');
+                END IF;
+                result_value := result_value + v / cnt - result_value / cnt;
+            END LOOP;
+        END IF;
+        result := result || result_value;
+
+        step_end := step_end + step_ms;
+    END LOOP;
+    RETURN result;
+END
+$func$
+LANGUAGE PLPGSQL IMMUTABLE PARALLEL SAFE;
+COMMENT ON FUNCTION SCHEMA_CATALOG.prom_over_time(TIMESTAMPTZ, TIMESTAMPTZ, BIGINT, BIGINT, TIMESTAMPTZ[], DOUBLE PRECISION[], TEXT)
+IS 'evaluates the PromQL avg_over_time, min_over_time, max_over_time, sum_over_time, count_over_time or last_over_time function for every step of a query';
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.prom_over_time(TIMESTAMPTZ, TIMESTAMPTZ, BIGINT, BIGINT, TIMESTAMPTZ[], DOUBLE PRECISION[], TEXT) TO prom_reader;
+
+-- prom_vector_selector evaluates an instant vector selector: the value of
+-- every step is the one of the last sample up to lookback_ms before it,
+-- unless that sample is a stale marker.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.prom_vector_selector(
+        lowest_time TIMESTAMPTZ, greatest_time TIMESTAMPTZ, step_ms BIGINT, lookback_ms BIGINT,
+        sample_times TIMESTAMPTZ[], sample_values DOUBLE PRECISION[])
+    RETURNS DOUBLE PRECISION[]
+AS $func$
+DECLARE
+    times BIGINT[];
+    num_samples INT := coalesce(array_length(sample_times, 1), 0);
+    result DOUBLE PRECISION[] := '{}';
+    step_end BIGINT := (extract(epoch FROM lowest_time) * 1000)::BIGINT;
+    last_step BIGINT := (extract(epoch FROM greatest_time) * 1000)::BIGINT;
+    last_idx INT := 0;
+BEGIN
+    SELECT array_agg((extract(epoch FROM t) * 1000)::BIGINT ORDER BY ord)
+    INTO times
+    FROM unnest(sample_times) WITH ORDINALITY AS s(t, ord);
+
+    WHILE step_end <= last_step LOOP
+        WHILE last_idx < num_samples AND times[last_idx+1] <= step_end LOOP
+            last_idx := last_idx + 1;
+        END LOOP;
+
+        IF last_idx = 0 OR times[last_idx] < step_end - lookback_ms OR SCHEMA_PROM.is_stale_marker(sample_values[last_idx]) THEN
+            result := result || NULL::DOUBLE PRECISION;
+        ELSE
+            result := result || sample_values[last_idx];
+        END IF;
+
+        step_end := step_end + step_ms;
+    END LOOP;
+    RETURN result;
+END
+$func$
+LANGUAGE PLPGSQL IMMUTABLE PARALLEL SAFE;
+COMMENT ON FUNCTION SCHEMA_CATALOG.prom_vector_selector(TIMESTAMPTZ, TIMESTAMPTZ, BIGINT, BIGINT, TIMESTAMPTZ[], DOUBLE PRECISION[])
+IS 'evaluates a PromQL instant vector selector for every step of a query';
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.prom_vector_selector(TIMESTAMPTZ, TIMESTAMPTZ, BIGINT, BIGINT, TIMESTAMPTZ[], DOUBLE PRECISION[]) TO prom_reader;
+
+-- prom_quantile_over_time evaluates quantile_over_time, interpolating between
+-- the two samples around the rank of the quantile as the PromQL engine does.
+-- Like the engine, it sorts the NaN samples first, whereas PostgreSQL orders
+-- NaN above every number.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.prom_quantile_over_time(
+        lowest_time TIMESTAMPTZ, greatest_time TIMESTAMPTZ, step_ms BIGINT, range_ms BIGINT,
+        sample_times TIMESTAMPTZ[], sample_values DOUBLE PRECISION[], quantile DOUBLE PRECISION)
+    RETURNS DOUBLE PRECISION[]
+AS $func$
+DECLARE
+    times BIGINT[];
+    vals DOUBLE PRECISION[];
+    num_samples INT;
+    result DOUBLE PRECISION[] := '{}';
+    step_end BIGINT := (extract(epoch FROM lowest_time) * 1000)::BIGINT;
+    last_step BIGINT := (extract(epoch FROM greatest_time) * 1000)::BIGINT;
+    first_idx INT := 1;
+    last_idx INT := 0;
+    window_vals DOUBLE PRECISION[];
+    num_window INT;
+    rank DOUBLE PRECISION;
+    lower_idx INT;
+    upper_idx INT;
+    weight DOUBLE PRECISION;
+BEGIN
+    SELECT array_agg((extract(epoch FROM t) * 1000)::BIGINT ORDER BY ord), array_agg(v ORDER BY ord)
+    INTO times, vals
+    FROM unnest(sample_times, sample_values) WITH ORDINALITY AS s(t, v, ord)
+    WHERE NOT SCHEMA_PROM.is_stale_marker(v);
+    num_samples := coalesce(array_length(times, 1), 0);
+
+    WHILE step_end <= last_step LOOP
+        WHILE first_idx <= num_samples AND times[first_idx] < step_end - range_ms LOOP
+            first_idx := first_idx + 1;
+        END LOOP;
+        WHILE last_idx < num_samples AND times[last_idx+1] <= step_end LOOP
+            last_idx := last_idx + 1;
+        END LOOP;
+
+        IF last_idx < first_idx THEN
+            result := result || NULL::DOUBLE PRECISION;
+        -- NaN is greater than any number in PostgreSQL.
+        ELSIF quantile = 'NaN' THEN
+            result := result || 'NaN'::DOUBLE PRECISION;
+        ELSIF quantile < 0 THEN
+            result := result || '-Infinity'::DOUBLE PRECISION;
+        ELSIF quantile > 1 THEN
+            result := result || 'Infinity'::DOUBLE PRECISION;
+        ELSE
+            window_vals := ARRAY(
+                SELECT v FROM unnest(vals[first_idx:last_idx]) AS v
+                ORDER BY v <> 'NaN', v);
+            num_window := last_idx - first_idx + 1;
+            rank := quantile * (num_window - 1);
+            lower_idx := floor(rank)::INT;
+            upper_idx := least(num_window - 1, lower_idx + 1);
+            weight := rank - floor(rank);
+            result := result || (window_vals[lower_idx+1] * (1 - weight) + window_vals[upper_idx+1] * weight);
+        END IF;
+
+        step_end := step_end + step_ms;
+    END LOOP;
+    RETURN result;
+END
+$func$
+LANGUAGE PLPGSQL IMMUTABLE PARALLEL SAFE;
+COMMENT ON FUNCTION SCHEMA_CATALOG.prom_quantile_over_time(TIMESTAMPTZ, TIMESTAMPTZ, BIGINT, BIGINT, TIMESTAMPTZ[], DOUBLE PRECISION[], DOUBLE PRECISION)
+IS 'evaluates the PromQL quantile_over_time function for every step of a query';
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.prom_quantile_over_time(TIMESTAMPTZ, TIMESTAMPTZ, BIGINT, BIGINT, TIMESTAMPTZ[], DOUBLE PRECISION[], DOUBLE PRECISION) TO prom_reader;
+
+-- prom_parse_float parses a float as Prometheus does, e.g. the upper bound in
+-- the le label of a histogram bucket, returning NULL if it is not a float.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.prom_parse_float(value TEXT)
+    RETURNS DOUBLE PRECISION
+AS $func$
+BEGIN
+    RETURN CASE lower(value)
+        WHEN '+inf' THEN 'Infinity'
+        WHEN 'inf' THEN 'Infinity'
+        WHEN '-inf' THEN '-Infinity'
+        ELSE value::DOUBLE PRECISION
+    END;
+EXCEPTION WHEN invalid_text_representation THEN
+    RETURN NULL;
+END
+$func$
+LANGUAGE PLPGSQL IMMUTABLE PARALLEL SAFE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.prom_parse_float(TEXT) TO prom_reader;
+
+-- prom_bucket_quantile evaluates histogram_quantile over the buckets of a
+-- histogram at a step, given as their upper bounds and counts. A NULL count
+-- is a bucket without a value at the step.
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.prom_bucket_quantile(
+        quantile DOUBLE PRECISION, upper_bounds DOUBLE PRECISION[], counts DOUBLE PRECISION[])
+    RETURNS DOUBLE PRECISION
+AS $func$
+DECLARE
+    bounds DOUBLE PRECISION[];
+    cnts DOUBLE PRECISION[];
+    num_buckets INT;
+    observations DOUBLE PRECISION;
+    rank DOUBLE PRECISION;
+    b INT;
+    bucket_start DOUBLE PRECISION := 0;
+    bucket_count DOUBLE PRECISION;
+    max_count DOUBLE PRECISION;
+BEGIN
+    -- the buckets with the same upper bound are merged.
+    SELECT array_agg(ub ORDER BY ub), array_agg(c ORDER BY ub)
+    INTO bounds, cnts
+    FROM (
+        SELECT ub, sum(c) AS c
+        FROM unnest(upper_bounds, counts) AS u(ub, c)
+        WHERE c IS NOT NULL AND ub IS NOT NULL
+        GROUP BY ub
+    ) AS buckets;
+    num_buckets := coalesce(array_length(bounds, 1), 0);
+
+    IF num_buckets = 0 THEN
+        RETURN NULL;
+    END IF;
+    -- NaN is greater than any number in PostgreSQL.
+    IF quantile = 'NaN' THEN
+        RETURN 'NaN';
+    END IF;
+    IF quantile < 0 THEN
+        RETURN '-Infinity';
+    END IF;
+    IF quantile > 1 THEN
+        RETURN 'Infinity';
+    END IF;
+    IF bounds[num_buckets] <> 'Infinity' OR num_buckets < 2 THEN
+        RETURN 'NaN';
+    END IF;
+
+    -- the counts may not be monotonic, e.g. when the buckets are not all
+    -- scraped at once; use their envelope. As in the engine, a NaN count is
+    -- left as is and never raises the envelope.
+    max_count := '-Infinity';
+    FOR i IN 1..num_buckets LOOP
+        IF cnts[i] = 'NaN' THEN
+            CONTINUE;
+        ELSIF cnts[i] > max_count THEN
+            max_count := cnts[i];
+        ELSIF cnts[i] < max_count THEN
+            cnts[i] := max_count;
+        END IF;
+    END LOOP;
+
+    observations := cnts[num_buckets];
+    IF observations = 0 THEN
+        RETURN 'NaN';
+    END IF;
+    rank := quantile * observations;
+
+    b := 1;
+    WHILE b < num_buckets AND cnts[b] < rank LOOP
+        b := b + 1;
+    END LOOP;
+
+    IF b = num_buckets THEN
+        RETURN bounds[num_buckets-1];
+    END IF;
+    IF b = 1 AND bounds[1] <= 0 THEN
+        RETURN bounds[1];
+    END IF;
+    bucket_count := cnts[b];
+    IF b > 1 THEN
+        bucket_start := bounds[b-1];
+        bucket_count := bucket_count - cnts[b-1];
+        rank := rank - cnts[b-1];
+    END IF;
+    IF bucket_count = 0 THEN
+        RETURN 'NaN';
+    END IF;
+    RETURN bucket_start + (bounds[b] - bucket_start) * (rank / bucket_count);
+END
+$func$
+LANGUAGE PLPGSQL IMMUTABLE PARALLEL SAFE;
+COMMENT ON FUNCTION SCHEMA_CATALOG.prom_bucket_quantile(DOUBLE PRECISION, DOUBLE PRECISION[], DOUBLE PRECISION[])
+IS 'evaluates the PromQL histogram_quantile function over the buckets of a histogram at a step';
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.prom_bucket_quantile(DOUBLE PRECISION, DOUBLE PRECISION[], DOUBLE PRECISION[]) TO prom_reader;
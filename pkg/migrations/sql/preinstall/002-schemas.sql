@@ -46,6 +46,22 @@ CALL execute_everywhere('create_schemas', $ee$ DO $$ BEGIN
     ALTER DEFAULT PRIVILEGES IN SCHEMA SCHEMA_INFO GRANT SELECT ON TABLES TO prom_reader;
 END $$ $ee$);
 
+-- exemplars are only stored on the access node, so their schema is not created everywhere
+CREATE SCHEMA IF NOT EXISTS SCHEMA_DATA_EXEMPLAR;
+GRANT USAGE ON SCHEMA SCHEMA_DATA_EXEMPLAR TO prom_reader;
+GRANT SELECT ON ALL TABLES IN SCHEMA SCHEMA_DATA_EXEMPLAR TO prom_reader;
+ALTER DEFAULT PRIVILEGES IN SCHEMA SCHEMA_DATA_EXEMPLAR GRANT SELECT ON TABLES TO prom_reader;
+GRANT USAGE ON SCHEMA SCHEMA_DATA_EXEMPLAR TO prom_writer;
+GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA SCHEMA_DATA_EXEMPLAR TO prom_writer;
+ALTER DEFAULT PRIVILEGES IN SCHEMA SCHEMA_DATA_EXEMPLAR GRANT SELECT, INSERT, UPDATE, DELETE ON TABLES TO prom_writer;
+
+-- continuous aggregates are not supported on distributed hypertables, so the
+-- downsampled data is only on the access node
+CREATE SCHEMA IF NOT EXISTS SCHEMA_DATA_DOWNSAMPLE;
+GRANT USAGE ON SCHEMA SCHEMA_DATA_DOWNSAMPLE TO prom_reader;
+GRANT SELECT ON ALL TABLES IN SCHEMA SCHEMA_DATA_DOWNSAMPLE TO prom_reader;
+ALTER DEFAULT PRIVILEGES IN SCHEMA SCHEMA_DATA_DOWNSAMPLE GRANT SELECT ON TABLES TO prom_reader;
+
 -- the promscale extension contains optimized version of some
 -- of our functions and operators. To ensure the correct version of the are
 -- used, SCHEMA_EXT must be before all of our other schemas in the search path
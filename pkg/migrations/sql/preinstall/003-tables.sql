@@ -96,3 +96,57 @@ INSERT INTO SCHEMA_CATALOG.default(key,value) VALUES
 ('chunk_interval', (INTERVAL '8 hours')::text),
 ('retention_period', (90 * INTERVAL '1 day')::text),
 ('metric_compression', (exists(select * from pg_proc where proname = 'compress_chunk')::text));
+
+-- metadata (TYPE, UNIT and HELP) of metric families as sent by Prometheus
+-- in remote-write requests. We only keep the most recently seen metadata
+-- for every metric family.
+CREATE TABLE SCHEMA_CATALOG.metadata (
+    metric_family TEXT NOT NULL,
+    type TEXT DEFAULT NULL,
+    unit TEXT DEFAULT NULL,
+    help TEXT DEFAULT NULL,
+    last_seen TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (metric_family) INCLUDE (type, unit, help)
+);
+
+-- series deletion requests executed asynchronously by the connectors.
+-- The progress of a job is updated after every metric it touches.
+CREATE TABLE SCHEMA_CATALOG.delete_job (
+    id BIGSERIAL PRIMARY KEY,
+    matchers TEXT[] NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'succeeded', 'failed', 'cancelled')),
+    cancel_requested BOOLEAN NOT NULL DEFAULT false,
+    metrics_touched TEXT[] NOT NULL DEFAULT '{}',
+    series_deleted BIGINT NOT NULL DEFAULT 0,
+    rows_deleted BIGINT NOT NULL DEFAULT 0,
+    error TEXT DEFAULT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    started_at TIMESTAMPTZ DEFAULT NULL,
+    finished_at TIMESTAMPTZ DEFAULT NULL,
+    -- the last time the worker running the job reported it was alive
+    heartbeat_at TIMESTAMPTZ DEFAULT NULL,
+    -- the tenant which requested the deletion, NULL without multi-tenancy
+    tenant TEXT DEFAULT NULL
+);
+CREATE INDEX delete_job_pending ON SCHEMA_CATALOG.delete_job(id) WHERE status = 'pending';
+GRANT USAGE, SELECT ON SEQUENCE SCHEMA_CATALOG.delete_job_id_seq TO prom_writer;
+
+-- the downsampling resolutions of the metrics. Every resolution is a
+-- continuous aggregate of the metric table in SCHEMA_DATA_DOWNSAMPLE.
+CREATE TABLE SCHEMA_CATALOG.downsample (
+    metric_id INT NOT NULL REFERENCES SCHEMA_CATALOG.metric(id) ON DELETE CASCADE,
+    resolution INTERVAL NOT NULL,
+    view_name NAME NOT NULL UNIQUE,
+    PRIMARY KEY (metric_id, resolution)
+);
+
+-- the results of completed intervals of range queries, cached by the
+-- connectors. It is a cache, so it is not WAL-logged.
+CREATE UNLOGGED TABLE SCHEMA_CATALOG.query_result_cache (
+    key TEXT PRIMARY KEY,
+    result BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+GRANT SELECT, INSERT, UPDATE, DELETE ON SCHEMA_CATALOG.query_result_cache TO prom_reader;
@@ -0,0 +1,8 @@
+CREATE TABLE SCHEMA_CATALOG.metadata (
+    metric_family TEXT NOT NULL,
+    type TEXT DEFAULT NULL,
+    unit TEXT DEFAULT NULL,
+    help TEXT DEFAULT NULL,
+    last_seen TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (metric_family) INCLUDE (type, unit, help)
+);
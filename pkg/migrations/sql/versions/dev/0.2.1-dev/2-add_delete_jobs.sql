@@ -0,0 +1,17 @@
+CREATE TABLE SCHEMA_CATALOG.delete_job (
+    id BIGSERIAL PRIMARY KEY,
+    matchers TEXT[] NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'succeeded', 'failed', 'cancelled')),
+    cancel_requested BOOLEAN NOT NULL DEFAULT false,
+    metrics_touched TEXT[] NOT NULL DEFAULT '{}',
+    series_deleted BIGINT NOT NULL DEFAULT 0,
+    rows_deleted BIGINT NOT NULL DEFAULT 0,
+    error TEXT DEFAULT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    started_at TIMESTAMPTZ DEFAULT NULL,
+    finished_at TIMESTAMPTZ DEFAULT NULL
+);
+CREATE INDEX delete_job_pending ON SCHEMA_CATALOG.delete_job(id) WHERE status = 'pending';
+GRANT USAGE, SELECT ON SEQUENCE SCHEMA_CATALOG.delete_job_id_seq TO prom_writer;
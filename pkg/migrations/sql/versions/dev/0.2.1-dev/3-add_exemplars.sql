@@ -0,0 +1,35 @@
+-- exemplars are only stored on the access node, so their schema is not created everywhere
+CREATE SCHEMA IF NOT EXISTS SCHEMA_DATA_EXEMPLAR;
+GRANT USAGE ON SCHEMA SCHEMA_DATA_EXEMPLAR TO prom_reader;
+GRANT SELECT ON ALL TABLES IN SCHEMA SCHEMA_DATA_EXEMPLAR TO prom_reader;
+ALTER DEFAULT PRIVILEGES IN SCHEMA SCHEMA_DATA_EXEMPLAR GRANT SELECT ON TABLES TO prom_reader;
+GRANT USAGE ON SCHEMA SCHEMA_DATA_EXEMPLAR TO prom_writer;
+GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA SCHEMA_DATA_EXEMPLAR TO prom_writer;
+ALTER DEFAULT PRIVILEGES IN SCHEMA SCHEMA_DATA_EXEMPLAR GRANT SELECT, INSERT, UPDATE, DELETE ON TABLES TO prom_writer;
+
+--the idempotent scripts run after this migration, so the function is defined here as well
+CREATE OR REPLACE FUNCTION SCHEMA_CATALOG.create_exemplar_table(metric_table NAME, metric_id INT)
+    RETURNS VOID
+AS $func$
+BEGIN
+    EXECUTE format('CREATE TABLE IF NOT EXISTS SCHEMA_DATA_EXEMPLAR.%I(time TIMESTAMPTZ NOT NULL, value DOUBLE PRECISION NOT NULL, series_id BIGINT NOT NULL, labels JSONB NOT NULL DEFAULT ''{}'')',
+                    metric_table);
+    EXECUTE format('CREATE UNIQUE INDEX IF NOT EXISTS exemplar_series_id_time_%s ON SCHEMA_DATA_EXEMPLAR.%I (series_id, time)',
+                    metric_id, metric_table);
+
+    IF SCHEMA_CATALOG.is_timescaledb_installed() THEN
+        --exemplars are few compared to the samples, so they are kept on the access node
+        --even in multinode setups.
+        PERFORM create_hypertable(format('SCHEMA_DATA_EXEMPLAR.%I', metric_table), 'time',
+            chunk_time_interval=>SCHEMA_CATALOG.get_default_chunk_interval(),
+            create_default_indexes=>false,
+            if_not_exists=>true);
+    END IF;
+END
+$func$
+LANGUAGE PLPGSQL VOLATILE;
+GRANT EXECUTE ON FUNCTION SCHEMA_CATALOG.create_exemplar_table(NAME, INT) TO prom_writer;
+
+--create the exemplar tables of the existing metrics
+SELECT SCHEMA_CATALOG.create_exemplar_table(m.table_name, m.id)
+FROM SCHEMA_CATALOG.metric m;
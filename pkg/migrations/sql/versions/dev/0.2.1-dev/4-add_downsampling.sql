@@ -0,0 +1,15 @@
+-- continuous aggregates are not supported on distributed hypertables, so the
+-- downsampled data is only on the access node
+CREATE SCHEMA IF NOT EXISTS SCHEMA_DATA_DOWNSAMPLE;
+GRANT USAGE ON SCHEMA SCHEMA_DATA_DOWNSAMPLE TO prom_reader;
+GRANT SELECT ON ALL TABLES IN SCHEMA SCHEMA_DATA_DOWNSAMPLE TO prom_reader;
+ALTER DEFAULT PRIVILEGES IN SCHEMA SCHEMA_DATA_DOWNSAMPLE GRANT SELECT ON TABLES TO prom_reader;
+
+-- the downsampling resolutions of the metrics. Every resolution is a
+-- continuous aggregate of the metric table in SCHEMA_DATA_DOWNSAMPLE.
+CREATE TABLE SCHEMA_CATALOG.downsample (
+    metric_id INT NOT NULL REFERENCES SCHEMA_CATALOG.metric(id) ON DELETE CASCADE,
+    resolution INTERVAL NOT NULL,
+    view_name NAME NOT NULL UNIQUE,
+    PRIMARY KEY (metric_id, resolution)
+);
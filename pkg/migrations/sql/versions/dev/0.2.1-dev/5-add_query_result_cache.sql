@@ -0,0 +1,6 @@
+CREATE UNLOGGED TABLE SCHEMA_CATALOG.query_result_cache (
+    key TEXT PRIMARY KEY,
+    result BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+GRANT SELECT, INSERT, UPDATE, DELETE ON SCHEMA_CATALOG.query_result_cache TO prom_reader;
@@ -0,0 +1 @@
+ALTER TABLE SCHEMA_CATALOG.delete_job ADD COLUMN heartbeat_at TIMESTAMPTZ DEFAULT NULL;
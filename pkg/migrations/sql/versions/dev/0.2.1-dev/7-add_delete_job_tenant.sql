@@ -0,0 +1 @@
+ALTER TABLE SCHEMA_CATALOG.delete_job ADD COLUMN tenant TEXT DEFAULT NULL;
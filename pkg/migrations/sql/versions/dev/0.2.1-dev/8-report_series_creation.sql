@@ -0,0 +1,3 @@
+-- get_or_create_series_id_for_kv_array now also returns whether the series
+-- was created, and a function's result type cannot be replaced.
+DROP FUNCTION IF EXISTS SCHEMA_CATALOG.get_or_create_series_id_for_kv_array(TEXT, text[], text[]);
@@ -7,15 +7,21 @@ package pgclient
 import (
 	"context"
 	"fmt"
+	"time"
 
 	pgx "github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/timescale/promscale/pkg/clockcache"
 	"github.com/timescale/promscale/pkg/log"
 	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	deletePkg "github.com/timescale/promscale/pkg/pgmodel/delete"
+	"github.com/timescale/promscale/pkg/pgmodel/exemplar"
 	"github.com/timescale/promscale/pkg/pgmodel/health"
 	"github.com/timescale/promscale/pkg/pgmodel/ingestor"
 	"github.com/timescale/promscale/pkg/pgmodel/lreader"
+	"github.com/timescale/promscale/pkg/pgmodel/metadata"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
 	"github.com/timescale/promscale/pkg/pgmodel/querier"
 	"github.com/timescale/promscale/pkg/pgxconn"
 	"github.com/timescale/promscale/pkg/prompb"
@@ -29,12 +35,16 @@ type Client struct {
 	Connection    pgxconn.PgxConn
 	ingestor      *ingestor.DBIngestor
 	querier       querier.Querier
+	labelsReader  lreader.LabelsReader
 	healthCheck   health.HealthCheckerFn
 	queryable     promql.Queryable
 	ConnectionStr string
 	metricCache   cache.MetricCache
 	labelsCache   cache.LabelsCache
 	seriesCache   cache.SeriesCache
+	deleteJobs    *deletePkg.Jobs
+	deleteWorker  *deletePkg.Worker
+	queryLimits   querier.QueryLimits
 	closePool     bool
 }
 
@@ -99,10 +109,15 @@ func NewClientWithPool(cfg *Config, numCopiers int, dbConn pgxconn.PgxConn) (*Cl
 	labelsCache := clockcache.WithMax(cfg.LabelsCacheSize)
 	seriesCache := cache.NewSeriesCache(cfg.SeriesCacheSize)
 	c := ingestor.Cfg{
-		AsyncAcks:       cfg.AsyncAcks,
-		ReportInterval:  cfg.ReportInterval,
-		SeriesCacheSize: cfg.SeriesCacheSize,
-		NumCopiers:      numCopiers,
+		AsyncAcks:          cfg.AsyncAcks,
+		WALDir:             cfg.AsyncAcksWALDir,
+		ReportInterval:     cfg.ReportInterval,
+		SeriesCacheSize:    cfg.SeriesCacheSize,
+		NumCopiers:         numCopiers,
+		MaxInflightSamples: cfg.MaxInflightSamples,
+		MaxInflightBytes:   cfg.MaxInflightBytes,
+		Limits:             cfg.IngestLimits,
+		RelabelConfigs:     cfg.RelabelConfigs,
 	}
 	ingestor, err := ingestor.NewPgxIngestorWithMetricCache(dbConn, metricsCache, seriesCache, &c)
 	if err != nil {
@@ -111,18 +126,21 @@ func NewClientWithPool(cfg *Config, numCopiers int, dbConn pgxconn.PgxConn) (*Cl
 	}
 	labelsReader := lreader.NewLabelsReader(dbConn, labelsCache)
 	querier := querier.NewQuerier(dbConn, metricsCache, labelsReader)
-	queryable := query.NewQueryable(querier, labelsReader)
+	queryable := query.NewQueryable(querier, labelsReader, cfg.QueryLimits)
 
 	healthChecker := health.NewHealthChecker(dbConn)
 	client := &Client{
-		Connection:  dbConn,
-		ingestor:    ingestor,
-		querier:     querier,
-		healthCheck: healthChecker,
-		queryable:   queryable,
-		metricCache: metricsCache,
-		labelsCache: labelsCache,
-		seriesCache: seriesCache,
+		Connection:   dbConn,
+		ingestor:     ingestor,
+		querier:      querier,
+		labelsReader: labelsReader,
+		healthCheck:  healthChecker,
+		queryable:    queryable,
+		metricCache:  metricsCache,
+		labelsCache:  labelsCache,
+		seriesCache:  seriesCache,
+		deleteJobs:   &deletePkg.Jobs{Conn: dbConn},
+		queryLimits:  cfg.QueryLimits,
 	}
 
 	InitClientMetrics(client)
@@ -133,6 +151,9 @@ func NewClientWithPool(cfg *Config, numCopiers int, dbConn pgxconn.PgxConn) (*Cl
 // Close closes the client and performs cleanup
 func (c *Client) Close() {
 	log.Info("msg", "Shutting down Client")
+	if c.deleteWorker != nil {
+		c.deleteWorker.Close()
+	}
 	c.ingestor.Close()
 	if c.closePool {
 		c.Connection.Close()
@@ -154,8 +175,11 @@ func (c *Client) Read(req *prompb.ReadRequest) (*prompb.ReadResponse, error) {
 		Results: make([]*prompb.QueryResult, len(req.Queries)),
 	}
 
+	// The whole response is built in memory, so all of its queries share
+	// a single budget.
+	budget := querier.NewQueryBudget(c.queryLimits)
 	for i, q := range req.Queries {
-		tts, err := c.querier.Query(q)
+		tts, err := c.querier.Query(q, budget)
 		if err != nil {
 			return nil, err
 		}
@@ -167,6 +191,88 @@ func (c *Client) Read(req *prompb.ReadRequest) (*prompb.ReadResponse, error) {
 	return &resp, nil
 }
 
+// ReadChunked streams the promQL query results as XOR-encoded chunked series
+func (c *Client) ReadChunked(req *prompb.ReadRequest, handle func(queryIndex int64, series *prompb.ChunkedSeries) error) error {
+	if req == nil {
+		return nil
+	}
+
+	budget := querier.NewQueryBudget(c.queryLimits)
+	for i, q := range req.Queries {
+		queryIndex := int64(i)
+		err := c.querier.QueryChunked(q, budget, func(series *prompb.ChunkedSeries) error {
+			return handle(queryIndex, series)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MetricMetadata returns the metadata of the given metric, or of all metrics
+// if metric is empty, as stored in the catalog.
+func (c *Client) MetricMetadata(metric string, limit int) (map[string][]model.Metadata, error) {
+	return metadata.MetricQuery(c.Connection, metric, limit)
+}
+
+// QueryExemplars returns the exemplars between start and end of the series
+// matching any of the matcher sets.
+func (c *Client) QueryExemplars(start, end time.Time, matcherSets [][]*labels.Matcher) ([]exemplar.QueryResult, error) {
+	return exemplar.Query(c.Connection, c.metricCache, c.labelsReader, start, end, matcherSets)
+}
+
+// StartDeleteJobs starts executing the pending series delete jobs in the
+// background until the client is closed. New jobs are looked for every
+// pollInterval.
+func (c *Client) StartDeleteJobs(pollInterval time.Duration) {
+	c.deleteWorker = deletePkg.NewWorker(c.Connection, pollInterval)
+	c.deleteWorker.Start()
+}
+
+// EnqueueDeleteJob persists a job of the tenant deleting the series matching
+// any of the matchers between start and end.
+func (c *Client) EnqueueDeleteJob(tenant string, matchers []string, start, end time.Time) (*deletePkg.Job, error) {
+	return c.deleteJobs.Enqueue(tenant, matchers, start, end)
+}
+
+// GetDeleteJob returns the series delete job of the tenant with the given id.
+func (c *Client) GetDeleteJob(tenant string, id int64) (*deletePkg.Job, error) {
+	return c.deleteJobs.Get(tenant, id)
+}
+
+// CancelDeleteJob cancels the series delete job of the tenant with the given
+// id.
+func (c *Client) CancelDeleteJob(tenant string, id int64) (*deletePkg.Job, error) {
+	return c.deleteJobs.Cancel(tenant, id)
+}
+
+// EstimateDeleteSeries estimates what deleting the series matching any of the
+// matcher sets between start and end would affect.
+func (c *Client) EstimateDeleteSeries(matcherSets [][]*labels.Matcher, start, end time.Time) ([]deletePkg.MetricEstimate, error) {
+	pgDelete := &deletePkg.PgDelete{Conn: c.Connection}
+	return pgDelete.EstimateDeleteSeries(matcherSets, start, end)
+}
+
+// DeleteSeries deletes the series matching any of the matcher sets between
+// start and end right away, without going through a delete job.
+func (c *Client) DeleteSeries(matcherSets [][]*labels.Matcher, start, end time.Time) error {
+	pgDelete := &deletePkg.PgDelete{Conn: c.Connection}
+	for _, matchers := range matcherSets {
+		if _, _, _, err := pgDelete.DeleteSeries(matchers, start, end); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CleanTombstones deletes the series with no data left in all metrics.
+func (c *Client) CleanTombstones() error {
+	pgDelete := &deletePkg.PgDelete{Conn: c.Connection}
+	return pgDelete.CleanTombstones()
+}
+
 func (c *Client) NumCachedMetricNames() int {
 	return c.metricCache.Len()
 }
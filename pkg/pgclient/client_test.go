@@ -18,6 +18,7 @@ import (
 
 type mockQuerier struct {
 	tts           []*prompb.TimeSeries
+	chunked       []*prompb.ChunkedSeries
 	err           error
 	labelNames    []string
 	labelNamesErr error
@@ -25,14 +26,26 @@ type mockQuerier struct {
 
 var _ querier.Querier = (*mockQuerier)(nil)
 
-func (q *mockQuerier) Select(mint int64, maxt int64, sortSeries bool, hints *storage.SelectHints, path []parser.Node, ms ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+func (q *mockQuerier) Select(mint int64, maxt int64, sortSeries bool, hints *storage.SelectHints, path []parser.Node, budget *querier.QueryBudget, ms ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
 	return nil, nil
 }
 
-func (q *mockQuerier) Query(*prompb.Query) ([]*prompb.TimeSeries, error) {
+func (q *mockQuerier) Query(*prompb.Query, *querier.QueryBudget) ([]*prompb.TimeSeries, error) {
 	return q.tts, q.err
 }
 
+func (q *mockQuerier) QueryChunked(_ *prompb.Query, _ *querier.QueryBudget, handle func(*prompb.ChunkedSeries) error) error {
+	if q.err != nil {
+		return q.err
+	}
+	for _, s := range q.chunked {
+		if err := handle(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (q *mockQuerier) LabelNames() ([]string, error) {
 	return q.labelNames, q.labelNamesErr
 }
@@ -163,6 +176,61 @@ func TestDBReaderRead(t *testing.T) {
 
 }
 
+func TestDBReaderReadChunked(t *testing.T) {
+	series := []*prompb.ChunkedSeries{
+		{
+			Labels: []prompb.Label{{Name: "__name__", Value: "foo"}},
+			Chunks: []prompb.Chunk{{MinTimeMs: 1, MaxTimeMs: 2, Type: prompb.Chunk_XOR}},
+		},
+	}
+	testCases := []struct {
+		name       string
+		req        *prompb.ReadRequest
+		err        error
+		expIndexes []int64
+	}{
+		{
+			name: "No request",
+		},
+		{
+			name: "Query error",
+			req: &prompb.ReadRequest{
+				Queries: []*prompb.Query{{StartTimestampMs: 1}},
+			},
+			err: fmt.Errorf("some error"),
+		},
+		{
+			name: "Multiple queries",
+			req: &prompb.ReadRequest{
+				Queries: []*prompb.Query{{StartTimestampMs: 1}, {StartTimestampMs: 1}},
+			},
+			expIndexes: []int64{0, 1},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			r := Client{querier: &mockQuerier{chunked: series, err: c.err}}
+
+			var indexes []int64
+			err := r.ReadChunked(c.req, func(queryIndex int64, s *prompb.ChunkedSeries) error {
+				if !reflect.DeepEqual(s, series[0]) {
+					t.Errorf("unexpected series:\ngot\n%v\nwanted\n%v", s, series[0])
+				}
+				indexes = append(indexes, queryIndex)
+				return nil
+			})
+
+			if err != c.err {
+				t.Errorf("unexpected error:\ngot\n%s\nwanted\n%s\n", err, c.err)
+			}
+			if !reflect.DeepEqual(indexes, c.expIndexes) {
+				t.Errorf("unexpected query indexes:\ngot\n%v\nwanted\n%v", indexes, c.expIndexes)
+			}
+		})
+	}
+}
+
 func TestHealthCheck(t *testing.T) {
 	healthCheckCalled := false
 
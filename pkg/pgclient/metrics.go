@@ -16,6 +16,10 @@ var (
 	labelsCacheCap      prometheus.GaugeFunc
 	seriesCacheCap      prometheus.GaugeFunc
 	seriesCacheLen      prometheus.GaugeFunc
+	inflightSamples     prometheus.GaugeFunc
+	inflightBytes       prometheus.GaugeFunc
+	metricQueueDepth    prometheus.GaugeFunc
+	copierQueueDepth    prometheus.GaugeFunc
 )
 
 func InitClientMetrics(client *Client) {
@@ -72,6 +76,42 @@ func InitClientMetrics(client *Client) {
 		return float64(client.seriesCache.Cap())
 	})
 
+	inflightSamples = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: util.PromNamespace,
+		Name:      "ingest_inflight_samples",
+		Help:      "Number of samples received but not yet inserted, only tracked with in-flight limits.",
+	}, func() float64 {
+		samples, _ := client.ingestor.Inflight()
+		return float64(samples)
+	})
+
+	inflightBytes = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: util.PromNamespace,
+		Name:      "ingest_inflight_bytes",
+		Help:      "Size of the time series received but not yet inserted, only tracked with in-flight limits.",
+	}, func() float64 {
+		_, bytes := client.ingestor.Inflight()
+		return float64(bytes)
+	})
+
+	metricQueueDepth = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: util.PromNamespace,
+		Name:      "ingest_metric_queue_depth",
+		Help:      "Number of insert requests waiting in the per-metric queues.",
+	}, func() float64 {
+		depth, _ := client.ingestor.QueueDepth()
+		return float64(depth)
+	})
+
+	copierQueueDepth = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: util.PromNamespace,
+		Name:      "ingest_copier_queue_depth",
+		Help:      "Number of insert batches waiting for a database copier.",
+	}, func() float64 {
+		_, depth := client.ingestor.QueueDepth()
+		return float64(depth)
+	})
+
 	prometheus.MustRegister(
 		cachedMetricNames,
 		metricNamesCacheCap,
@@ -79,5 +119,9 @@ func InitClientMetrics(client *Client) {
 		labelsCacheCap,
 		seriesCacheLen,
 		seriesCacheCap,
+		inflightSamples,
+		inflightBytes,
+		metricQueueDepth,
+		copierQueueDepth,
 	)
 }
@@ -23,6 +23,9 @@ const DefaultSeriesCacheSize = 500000
 type SeriesCache interface {
 	Reset()
 	GetSeriesFromProtos(labelPairs []prompb.Label) (series *model.Series, metricName string, err error)
+	// Contains returns whether the series of the labels is cached, without
+	// caching it.
+	Contains(labelPairs []prompb.Label) (bool, error)
 	Len() int
 	Cap() int
 }
@@ -148,6 +151,15 @@ func (t *SeriesCacheImpl) GetSeriesFromLabels(ls labels.Labels) (*model.Series,
 	return l, err
 }
 
+// Contains returns whether the series of the labels is cached
+func (t *SeriesCacheImpl) Contains(labelPairs []prompb.Label) (bool, error) {
+	key, _, err := generateKey(labelPairs)
+	if err != nil {
+		return false, err
+	}
+	return t.loadSeries(key) != nil, nil
+}
+
 // GetSeriesFromProtos converts a prompb.Label to a canonical Labels object
 func (t *SeriesCacheImpl) GetSeriesFromProtos(labelPairs []prompb.Label) (*model.Series, string, error) {
 	key, metricName, err := generateKey(labelPairs)
@@ -0,0 +1,227 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+// Package clauses builds the SQL clauses used to find the series matching a
+// set of PromQL label matchers.
+package clauses
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	pgmodel "github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+const (
+	subQueryEQ            = "labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value = $%d)"
+	subQueryEQMatchEmpty  = "NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value != $%d)"
+	subQueryNEQ           = "labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value != $%d)"
+	subQueryNEQMatchEmpty = "NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value = $%d)"
+	subQueryRE            = "labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value ~ $%d)"
+	subQueryREMatchEmpty  = "NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value !~ $%d)"
+	subQueryNRE           = "labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value !~ $%d)"
+	subQueryNREMatchEmpty = "NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value ~ $%d)"
+
+	/* MULTIPLE METRIC PATH (less common case) */
+	/* The following two sql statements are for queries where the metric name is unknown in the query. The first query gets the
+	* metric name and series_id array and the second queries individual metrics while passing down the array */
+	metricNameSeriesIDSQLFormat = `SELECT m.metric_name, array_agg(s.id)
+	FROM _prom_catalog.series s
+	INNER JOIN _prom_catalog.metric m
+	ON (m.id = s.metric_id)
+	WHERE %s
+	GROUP BY m.metric_name
+	ORDER BY m.metric_name`
+)
+
+// BuildSubQueries returns a clause builder holding a SQL clause per label
+// matcher, and the metric name if one of the matchers selects it.
+func BuildSubQueries(matchers []*labels.Matcher) (*clauseBuilder, error) {
+	var err error
+	cb := &clauseBuilder{}
+
+	for _, m := range matchers {
+		// From the PromQL docs: "Label matchers that match
+		// empty label values also select all time series that
+		// do not have the specific label set at all."
+		matchesEmpty := m.Matches("")
+
+		switch m.Type {
+		case labels.MatchEqual:
+			if m.Name == pgmodel.MetricNameLabelName {
+				cb.SetMetricName(m.Value)
+				continue
+			}
+			sq := subQueryEQ
+			if matchesEmpty {
+				sq = subQueryEQMatchEmpty
+			}
+			err = cb.addClause(sq, m.Name, m.Value)
+		case labels.MatchNotEqual:
+			sq := subQueryNEQ
+			if matchesEmpty {
+				sq = subQueryNEQMatchEmpty
+			}
+			err = cb.addClause(sq, m.Name, m.Value)
+		case labels.MatchRegexp:
+			sq := subQueryRE
+			if matchesEmpty {
+				sq = subQueryREMatchEmpty
+			}
+			err = cb.addClause(sq, m.Name, anchorValue(m.Value))
+		case labels.MatchNotRegexp:
+			sq := subQueryNRE
+			if matchesEmpty {
+				sq = subQueryNREMatchEmpty
+			}
+			err = cb.addClause(sq, m.Name, anchorValue(m.Value))
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cb, err
+}
+
+/* Given a clause with %d placeholder for parameter numbers, and the existing and new parameters, return a clause with the parameters set to the appropriate $index and the full set of parameter values */
+func SetParameterNumbers(clause string, existingArgs []interface{}, newArgs ...interface{}) (string, []interface{}, error) {
+	argIndex := len(existingArgs) + 1
+	argCountInClause := strings.Count(clause, "%d")
+
+	if argCountInClause != len(newArgs) {
+		return "", nil, fmt.Errorf("invalid number of args: in sql %d vs args %d", argCountInClause, len(newArgs))
+	}
+
+	argIndexes := make([]interface{}, 0, argCountInClause)
+
+	for argCountInClause > 0 {
+		argIndexes = append(argIndexes, argIndex)
+		argIndex++
+		argCountInClause--
+	}
+
+	newSQL := fmt.Sprintf(clause, argIndexes...)
+	resArgs := append(existingArgs, newArgs...)
+	return newSQL, resArgs, nil
+}
+
+type clauseBuilder struct {
+	metricName    string
+	contradiction bool
+	clauses       []string
+	args          []interface{}
+}
+
+func (c *clauseBuilder) SetMetricName(name string) {
+	if c.metricName == "" {
+		c.metricName = name
+		return
+	}
+
+	/* Impossible to have 2 different metric names at same time */
+	if c.metricName != name {
+		c.contradiction = true
+	}
+}
+
+func (c *clauseBuilder) GetMetricName() string {
+	return c.metricName
+}
+
+func (c *clauseBuilder) addClause(clause string, args ...interface{}) error {
+	clauseWithParameters, newArgs, err := SetParameterNumbers(clause, c.args, args...)
+	if err != nil {
+		return err
+	}
+
+	c.clauses = append(c.clauses, clauseWithParameters)
+	c.args = newArgs
+	return nil
+}
+
+func (c *clauseBuilder) Build(includeMetricName bool) ([]string, []interface{}, error) {
+	if c.contradiction {
+		return []string{"FALSE"}, nil, nil
+	}
+
+	/* no support for queries across all data */
+	if len(c.clauses) == 0 && c.metricName == "" {
+		return nil, nil, errors.ErrNoClausesGen
+	}
+
+	if includeMetricName && c.metricName != "" {
+		nameClause, newArgs, err := SetParameterNumbers(subQueryEQ, c.args, pgmodel.MetricNameLabelName, c.metricName)
+		if err != nil {
+			return nil, nil, err
+		}
+		return append(c.clauses, nameClause), newArgs, err
+	}
+
+	if len(c.clauses) == 0 {
+		return []string{"TRUE"}, nil, nil
+	}
+	return c.clauses, c.args, nil
+}
+
+// BuildMetricNameSeriesIDQuery returns the query fetching the matching series
+// IDs grouped by metric name.
+func BuildMetricNameSeriesIDQuery(cases []string) string {
+	return fmt.Sprintf(metricNameSeriesIDSQLFormat, strings.Join(cases, " AND "))
+}
+
+// GetSeriesPerMetric scans the results of BuildMetricNameSeriesIDQuery.
+func GetSeriesPerMetric(rows pgx.Rows) ([]string, [][]pgmodel.SeriesID, error) {
+	metrics := make([]string, 0)
+	series := make([][]pgmodel.SeriesID, 0)
+
+	for rows.Next() {
+		var (
+			metricName string
+			seriesIDs  []int64
+		)
+		if err := rows.Scan(&metricName, &seriesIDs); err != nil {
+			return nil, nil, err
+		}
+
+		sIDs := make([]pgmodel.SeriesID, 0, len(seriesIDs))
+
+		for _, v := range seriesIDs {
+			sIDs = append(sIDs, pgmodel.SeriesID(v))
+		}
+
+		metrics = append(metrics, metricName)
+		series = append(series, sIDs)
+	}
+
+	return metrics, series, nil
+}
+
+// anchorValue adds anchors to values in regexps since PromQL docs
+// states that "Regex-matches are fully anchored."
+func anchorValue(str string) string {
+	l := len(str)
+
+	if l == 0 {
+		return "^$"
+	}
+
+	if str[0] == '^' && str[l-1] == '$' {
+		return str
+	}
+
+	if str[0] == '^' {
+		return fmt.Sprintf("%s$", str)
+	}
+
+	if str[l-1] == '$' {
+		return fmt.Sprintf("^%s", str)
+	}
+
+	return fmt.Sprintf("^%s$", str)
+}
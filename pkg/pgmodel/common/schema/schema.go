@@ -13,7 +13,9 @@ const (
 
 	LockID = 0x4D829C732AAFCEDE // Chosen randomly.
 
-	SeriesView = "prom_series"
-	MetricView = "prom_metric"
-	DataSeries = "prom_data_series"
+	SeriesView     = "prom_series"
+	MetricView     = "prom_metric"
+	DataSeries     = "prom_data_series"
+	DataExemplar   = "prom_data_exemplar"
+	DataDownsample = "prom_data_downsample"
 )
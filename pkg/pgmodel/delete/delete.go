@@ -6,16 +6,52 @@ package delete
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/pgmodel/common/clauses"
 	"github.com/timescale/promscale/pkg/pgmodel/model"
-	"github.com/timescale/promscale/pkg/pgmodel/querier"
 	"github.com/timescale/promscale/pkg/pgxconn"
 )
 
-const queryDeleteSeries = "SELECT _prom_catalog.delete_series_from_metric($1, $2)"
+const (
+	queryDeleteSeries        = "SELECT _prom_catalog.delete_series_from_metric($1, $2)"
+	queryDeleteSeriesInRange = "SELECT rows_deleted, deleted_series_ids FROM _prom_catalog.delete_series_from_metric_in_range($1, $2, $3, $4)"
+	queryEstimateSeriesRows  = "SELECT _prom_catalog.estimate_series_rows($1, $2, $3, $4)"
+	queryCleanTombstones     = "CALL _prom_catalog.delete_expired_series_of_all_metrics()"
+	queryNextCleanTombstones = "SELECT last_update_time + interval '1 hour', now() FROM _prom_catalog.ids_epoch LIMIT 1"
+)
+
+// ErrCleanedRecently is returned when the tombstones are cleaned less than an
+// hour after the series were last cleaned up, in which case nothing is done.
+var ErrCleanedRecently = errors.New("series were cleaned up less than an hour ago")
+
+// MetricEstimate describes what a series deletion would affect in a metric.
+type MetricEstimate struct {
+	MetricName    string `json:"metricName"`
+	SeriesCount   int    `json:"seriesCount"`
+	EstimatedRows int64  `json:"estimatedRows"`
+}
+
+// Estimator estimates the effect of a series deletion without deleting anything.
+type Estimator interface {
+	// EstimateDeleteSeries returns the estimates for the series matching any
+	// of the matcher sets between start and end, sorted by metric name.
+	EstimateDeleteSeries(matcherSets [][]*labels.Matcher, start, end time.Time) ([]MetricEstimate, error)
+}
+
+// TSDBAdmin performs the series deletions of the Prometheus TSDB admin API.
+type TSDBAdmin interface {
+	// DeleteSeries deletes the samples of the series matching any of the
+	// matcher sets between start and end, returning once they are deleted.
+	DeleteSeries(matcherSets [][]*labels.Matcher, start, end time.Time) error
+	// CleanTombstones removes the series that have no samples left. It
+	// returns ErrCleanedRecently if nothing was done.
+	CleanTombstones() error
+}
 
 // PgDelete deletes the series based on matchers.
 type PgDelete struct {
@@ -23,54 +59,183 @@ type PgDelete struct {
 }
 
 // DeleteSeries deletes the series that matches the provided label_matchers.
-func (pgDel *PgDelete) DeleteSeries(matchers []*labels.Matcher, _, _ time.Time) ([]string, []model.SeriesID, int, error) {
+// If the time range between start and end does not cover all time, only the
+// samples within the range are deleted and the returned series IDs contain
+// just the series that have no samples left.
+func (pgDel *PgDelete) DeleteSeries(matchers []*labels.Matcher, start, end time.Time) ([]string, []model.SeriesID, int, error) {
 	var (
 		deletedSeriesIDs []model.SeriesID
 		totalRowsDeleted int
-		err              error
 		metricsTouched   = make(map[string]struct{})
 	)
-	metricNames, seriesIDMatrix, err := pgDel.getMetricNameSeriesIDFromMatchers(matchers)
+	err := pgDel.deleteSeries(context.Background(), matchers, start, end, func(metricName string, removedSeriesIDs []model.SeriesID, rowsDeleted int) error {
+		if _, ok := metricsTouched[metricName]; !ok {
+			metricsTouched[metricName] = struct{}{}
+		}
+		deletedSeriesIDs = append(deletedSeriesIDs, removedSeriesIDs...)
+		totalRowsDeleted += rowsDeleted
+		return nil
+	})
+	return getKeys(metricsTouched), deletedSeriesIDs, totalRowsDeleted, err
+}
+
+// CleanTombstones deletes the series marked for deletion that have no data
+// left in all metrics, as the maintenance jobs do eventually. Like them, it
+// does nothing if the series were cleaned up less than an hour ago, in which
+// case it returns ErrCleanedRecently.
+func (pgDel *PgDelete) CleanTombstones() error {
+	var next, now time.Time
+	if err := pgDel.Conn.QueryRow(context.Background(), queryNextCleanTombstones).Scan(&next, &now); err != nil {
+		return fmt.Errorf("clean tombstones: %w", err)
+	}
+	if now.Before(next) {
+		return fmt.Errorf("%w, retry after %s", ErrCleanedRecently, next.UTC().Format(time.RFC3339))
+	}
+	if _, err := pgDel.Conn.Exec(context.Background(), queryCleanTombstones); err != nil {
+		return fmt.Errorf("clean tombstones: %w", err)
+	}
+	return nil
+}
+
+// EstimateDeleteSeries returns, per metric, the number of series matching any
+// of the matcher sets and the estimated number of their rows between start and
+// end, i.e. what DeleteSeries would delete. Nothing is deleted.
+func (pgDel *PgDelete) EstimateDeleteSeries(matcherSets [][]*labels.Matcher, start, end time.Time) ([]MetricEstimate, error) {
+	seriesPerMetric := make(map[string]map[model.SeriesID]struct{})
+	for _, matchers := range matcherSets {
+		metricNames, seriesIDMatrix, err := pgDel.getMetricNameSeriesIDFromMatchers(context.Background(), matchers)
+		if err != nil {
+			return nil, fmt.Errorf("estimate delete-series: %w", err)
+		}
+		for metricIndex, metricName := range metricNames {
+			seriesIDs, ok := seriesPerMetric[metricName]
+			if !ok {
+				seriesIDs = make(map[model.SeriesID]struct{})
+				seriesPerMetric[metricName] = seriesIDs
+			}
+			for _, id := range seriesIDMatrix[metricIndex] {
+				seriesIDs[id] = struct{}{}
+			}
+		}
+	}
+
+	metricNames := make([]string, 0, len(seriesPerMetric))
+	for metricName := range seriesPerMetric {
+		metricNames = append(metricNames, metricName)
+	}
+	sort.Strings(metricNames)
+
+	estimates := make([]MetricEstimate, 0, len(metricNames))
+	for _, metricName := range metricNames {
+		seriesIDs := make([]int64, 0, len(seriesPerMetric[metricName]))
+		for id := range seriesPerMetric[metricName] {
+			seriesIDs = append(seriesIDs, int64(id))
+		}
+		sort.Slice(seriesIDs, func(i, j int) bool { return seriesIDs[i] < seriesIDs[j] })
+
+		var estimatedRows int64
+		if err := pgDel.Conn.QueryRow(
+			context.Background(),
+			queryEstimateSeriesRows,
+			metricName,
+			seriesIDs,
+			model.TimeToTimestamptz(start),
+			model.TimeToTimestamptz(end),
+		).Scan(&estimatedRows); err != nil {
+			return nil, fmt.Errorf("estimating rows of metric_name=%s: %w", metricName, err)
+		}
+		estimates = append(estimates, MetricEstimate{
+			MetricName:    metricName,
+			SeriesCount:   len(seriesIDs),
+			EstimatedRows: estimatedRows,
+		})
+	}
+	return estimates, nil
+}
+
+// progressFn is called after the series of a metric have been deleted. An
+// error returned by it stops the deletion of the remaining metrics.
+type progressFn func(metricName string, removedSeriesIDs []model.SeriesID, rowsDeleted int) error
+
+// deleteSeries deletes the series that match the label_matchers metric by
+// metric, reporting the progress after each metric to the progress function.
+func (pgDel *PgDelete) deleteSeries(ctx context.Context, matchers []*labels.Matcher, start, end time.Time, progress progressFn) error {
+	metricNames, seriesIDMatrix, err := pgDel.getMetricNameSeriesIDFromMatchers(ctx, matchers)
 	if err != nil {
-		return nil, nil, -1, fmt.Errorf("delete-series: %w", err)
+		return fmt.Errorf("delete-series: %w", err)
 	}
 	for metricIndex, metricName := range metricNames {
 		seriesIDs := seriesIDMatrix[metricIndex]
+		rowsDeleted, removedSeriesIDs, err := pgDel.deleteFromMetric(ctx, metricName, seriesIDs, start, end)
+		if err != nil {
+			return fmt.Errorf("deleting series with metric_name=%s and series_ids=%v : %w", metricName, seriesIDs, err)
+		}
+		if err = progress(metricName, removedSeriesIDs, rowsDeleted); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteFromMetric deletes the samples of the given series of a metric within
+// [start, end] and returns the number of rows deleted along with the series
+// that were removed entirely.
+func (pgDel *PgDelete) deleteFromMetric(ctx context.Context, metricName string, seriesIDs []model.SeriesID, start, end time.Time) (int, []model.SeriesID, error) {
+	if isUnboundedRange(start, end) {
 		var rowsDeleted int
-		if err = pgDel.Conn.QueryRow(
-			context.Background(),
+		if err := pgDel.Conn.QueryRow(
+			ctx,
 			queryDeleteSeries,
 			metricName,
 			convertSeriesIDsToInt64s(seriesIDs),
 		).Scan(&rowsDeleted); err != nil {
-			return getKeys(metricsTouched), deletedSeriesIDs, totalRowsDeleted, fmt.Errorf("deleting series with metric_name=%s and series_ids=%v : %w", metricName, seriesIDs, err)
-		}
-		if _, ok := metricsTouched[metricName]; !ok {
-			metricsTouched[metricName] = struct{}{}
+			return 0, nil, err
 		}
-		deletedSeriesIDs = append(deletedSeriesIDs, seriesIDs...)
-		totalRowsDeleted += rowsDeleted
+		return rowsDeleted, seriesIDs, nil
 	}
-	return getKeys(metricsTouched), deletedSeriesIDs, totalRowsDeleted, nil
+	var (
+		rowsDeleted int
+		removedIDs  []int64
+	)
+	if err := pgDel.Conn.QueryRow(
+		ctx,
+		queryDeleteSeriesInRange,
+		metricName,
+		convertSeriesIDsToInt64s(seriesIDs),
+		model.TimeToTimestamptz(start),
+		model.TimeToTimestamptz(end),
+	).Scan(&rowsDeleted, &removedIDs); err != nil {
+		return 0, nil, err
+	}
+	removedSeriesIDs := make([]model.SeriesID, len(removedIDs))
+	for i := range removedIDs {
+		removedSeriesIDs[i] = model.SeriesID(removedIDs[i])
+	}
+	return rowsDeleted, removedSeriesIDs, nil
+}
+
+// isUnboundedRange returns true if the range between start and end covers all time.
+func isUnboundedRange(start, end time.Time) bool {
+	return !start.After(model.MinTime) && !end.Before(model.MaxTime.Truncate(time.Millisecond))
 }
 
 // getMetricNameSeriesIDFromMatchers returns the metric name list and the corresponding series ID array
 // as a matrix.
-func (pgDel *PgDelete) getMetricNameSeriesIDFromMatchers(matchers []*labels.Matcher) ([]string, [][]model.SeriesID, error) {
-	cb, err := querier.BuildSubQueries(matchers)
+func (pgDel *PgDelete) getMetricNameSeriesIDFromMatchers(ctx context.Context, matchers []*labels.Matcher) ([]string, [][]model.SeriesID, error) {
+	cb, err := clauses.BuildSubQueries(matchers)
 	if err != nil {
 		return nil, nil, fmt.Errorf("delete series build subqueries: %w", err)
 	}
-	clauses, values, err := cb.Build(true)
+	cases, values, err := cb.Build(true)
 	if err != nil {
 		return nil, nil, fmt.Errorf("delete series build clauses: %w", err)
 	}
-	query := querier.BuildMetricNameSeriesIDQuery(clauses)
-	rows, err := pgDel.Conn.Query(context.Background(), query, values...)
+	query := clauses.BuildMetricNameSeriesIDQuery(cases)
+	rows, err := pgDel.Conn.Query(ctx, query, values...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("build metric name series: %w", err)
 	}
-	metricNames, correspondingSeriesIDs, err := querier.GetSeriesPerMetric(rows)
+	metricNames, correspondingSeriesIDs, err := clauses.GetSeriesPerMetric(rows)
 	if err != nil {
 		return nil, nil, fmt.Errorf("series per metric: %w", err)
 	}
@@ -0,0 +1,102 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package delete
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+func TestEstimateDeleteSeries(t *testing.T) {
+	matcherSets := [][]*labels.Matcher{
+		{labels.MustNewMatcher(labels.MatchEqual, "job", "prometheus")},
+		{labels.MustNewMatcher(labels.MatchEqual, "__name__", "up")},
+	}
+	seriesQueries := []model.SqlQuery{
+		{
+			Sql:     seriesByLabelSQL,
+			Args:    []interface{}{"job", "prometheus"},
+			Results: model.RowResults{{"up", []int64{2, 3}}, {"go_goroutines", []int64{4}}},
+		},
+		{
+			Sql:     seriesByLabelSQL,
+			Args:    []interface{}{"__name__", "up"},
+			Results: model.RowResults{{"up", []int64{1, 2}}},
+		},
+	}
+	start := timestamp.Time(1000)
+	startArg := pgtype.Timestamptz{Time: start, Status: pgtype.Present}
+	endArg := pgtype.Timestamptz{Status: pgtype.Present, InfinityModifier: pgtype.Infinity}
+
+	testCases := []struct {
+		name       string
+		sqlQueries []model.SqlQuery
+		expected   []MetricEstimate
+		expectErr  bool
+	}{
+		{
+			name: "Estimates per metric",
+			sqlQueries: append(seriesQueries,
+				model.SqlQuery{
+					Sql:     queryEstimateSeriesRows,
+					Args:    []interface{}{"go_goroutines", []int64{4}, startArg, endArg},
+					Results: model.RowResults{{int64(20)}},
+				},
+				model.SqlQuery{
+					Sql:     queryEstimateSeriesRows,
+					Args:    []interface{}{"up", []int64{1, 2, 3}, startArg, endArg},
+					Results: model.RowResults{{int64(60)}},
+				},
+			),
+			expected: []MetricEstimate{
+				{MetricName: "go_goroutines", SeriesCount: 1, EstimatedRows: 20},
+				{MetricName: "up", SeriesCount: 3, EstimatedRows: 60},
+			},
+		},
+		{
+			name: "Error on estimate",
+			sqlQueries: append(seriesQueries,
+				model.SqlQuery{
+					Sql:  queryEstimateSeriesRows,
+					Args: []interface{}{"go_goroutines", []int64{4}, startArg, endArg},
+					Err:  fmt.Errorf("some error"),
+				},
+			),
+			expectErr: true,
+		},
+		{
+			name: "Error on series",
+			sqlQueries: []model.SqlQuery{
+				{
+					Sql:  seriesByLabelSQL,
+					Args: []interface{}{"job", "prometheus"},
+					Err:  fmt.Errorf("some error"),
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			mock := model.NewSqlRecorder(c.sqlQueries, t)
+			pgDelete := &PgDelete{Conn: mock}
+
+			estimates, err := pgDelete.EstimateDeleteSeries(matcherSets, start, model.MaxTime)
+			if c.expectErr != (err != nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(estimates, c.expected) {
+				t.Errorf("unexpected estimates:\ngot\n%v\nwanted\n%v", estimates, c.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package delete
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgxconn"
+)
+
+// JobStatus is the state of a delete job.
+type JobStatus string
+
+const (
+	JobPending   JobStatus = "pending"
+	JobRunning   JobStatus = "running"
+	JobSucceeded JobStatus = "succeeded"
+	JobFailed    JobStatus = "failed"
+	JobCancelled JobStatus = "cancelled"
+)
+
+const (
+	jobColumns = "id, matchers, start_time, end_time, status, metrics_touched, series_deleted, rows_deleted, coalesce(error, ''), created_at, started_at, finished_at"
+
+	// jobOfTenant restricts a statement to the jobs of the tenant in $2, or
+	// to any job if $2 is empty.
+	jobOfTenant = "($2 = '' OR tenant = $2)"
+
+	enqueueJobSQL = "INSERT INTO " + schema.Catalog + ".delete_job (matchers, start_time, end_time, tenant) VALUES ($1, $2, $3, NULLIF($4, '')) RETURNING " + jobColumns
+	getJobSQL     = "SELECT " + jobColumns + " FROM " + schema.Catalog + ".delete_job WHERE id = $1 AND " + jobOfTenant
+	// cancelJobSQL cancels pending and abandoned jobs right away, running
+	// jobs are stopped by their worker.
+	cancelJobSQL = `UPDATE ` + schema.Catalog + `.delete_job
+	SET cancel_requested = status IN ('pending', 'running'),
+		status = CASE WHEN status = 'pending' OR (` + abandonedJob + `) THEN 'cancelled' ELSE status END,
+		finished_at = CASE WHEN status = 'pending' OR (` + abandonedJob + `) THEN now() ELSE finished_at END
+	WHERE id = $1 AND ` + jobOfTenant + `
+	RETURNING ` + jobColumns
+)
+
+// ErrJobNotFound is returned when the requested delete job does not exist.
+var ErrJobNotFound = fmt.Errorf("delete job not found")
+
+// Job is a series deletion request executed in the background.
+type Job struct {
+	ID       int64    `json:"id"`
+	Matchers []string `json:"matchers"`
+	// Start and End are nil if the deletion is unbounded on that side.
+	Start          *time.Time `json:"start,omitempty"`
+	End            *time.Time `json:"end,omitempty"`
+	Status         JobStatus  `json:"status"`
+	MetricsTouched []string   `json:"metricsTouched"`
+	SeriesDeleted  int64      `json:"seriesDeleted"`
+	RowsDeleted    int64      `json:"rowsDeleted"`
+	Error          string     `json:"error,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	StartedAt      *time.Time `json:"startedAt,omitempty"`
+	FinishedAt     *time.Time `json:"finishedAt,omitempty"`
+}
+
+// JobQueue enqueues delete jobs and reports on their progress. Every job
+// belongs to the tenant which enqueued it, and is only visible to that
+// tenant. An empty tenant disables multi-tenancy, giving access to every job.
+type JobQueue interface {
+	// EnqueueDeleteJob persists a job deleting the series matching any of
+	// the matchers between start and end.
+	EnqueueDeleteJob(tenant string, matchers []string, start, end time.Time) (*Job, error)
+	// GetDeleteJob returns the job with the given id.
+	GetDeleteJob(tenant string, id int64) (*Job, error)
+	// CancelDeleteJob cancels the job with the given id. A running job stops
+	// once the deletion of the metric in progress is done.
+	CancelDeleteJob(tenant string, id int64) (*Job, error)
+}
+
+// Jobs stores the delete jobs in the catalog.
+type Jobs struct {
+	Conn pgxconn.PgxConn
+}
+
+// Enqueue persists a new pending delete job of the tenant, which is empty
+// without multi-tenancy.
+func (j *Jobs) Enqueue(tenant string, matchers []string, start, end time.Time) (*Job, error) {
+	job, err := scanJob(j.Conn.QueryRow(
+		context.Background(),
+		enqueueJobSQL,
+		matchers,
+		model.TimeToTimestamptz(start),
+		model.TimeToTimestamptz(end),
+		tenant,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("enqueue delete job: %w", err)
+	}
+	return job, nil
+}
+
+// Get returns the delete job with the given id, if it belongs to the tenant.
+// An empty tenant gives access to every job.
+func (j *Jobs) Get(tenant string, id int64) (*Job, error) {
+	job, err := scanJob(j.Conn.QueryRow(context.Background(), getJobSQL, id, tenant))
+	if err != nil {
+		return nil, fmt.Errorf("get delete job: %w", err)
+	}
+	return job, nil
+}
+
+// Cancel marks the delete job with the given id as cancelled. Pending and
+// abandoned jobs are cancelled right away, running jobs are stopped by the
+// worker executing them. Finished jobs are returned unchanged. Like Get, it
+// only gives access to the jobs of the tenant.
+func (j *Jobs) Cancel(tenant string, id int64) (*Job, error) {
+	job, err := scanJob(j.Conn.QueryRow(context.Background(), cancelJobSQL, id, tenant))
+	if err != nil {
+		return nil, fmt.Errorf("cancel delete job: %w", err)
+	}
+	return job, nil
+}
+
+func scanJob(row pgx.Row) (*Job, error) {
+	var (
+		job                   Job
+		status                string
+		start, end            pgtype.Timestamptz
+		startedAt, finishedAt pgtype.Timestamptz
+	)
+	err := row.Scan(
+		&job.ID,
+		&job.Matchers,
+		&start,
+		&end,
+		&status,
+		&job.MetricsTouched,
+		&job.SeriesDeleted,
+		&job.RowsDeleted,
+		&job.Error,
+		&job.CreatedAt,
+		&startedAt,
+		&finishedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, ErrJobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	job.Status = JobStatus(status)
+	job.Start = timestamptzToTime(start)
+	job.End = timestamptzToTime(end)
+	job.StartedAt = timestamptzToTime(startedAt)
+	job.FinishedAt = timestamptzToTime(finishedAt)
+	return &job, nil
+}
+
+// timestamptzToTime returns nil for NULL and infinite timestamps.
+func timestamptzToTime(t pgtype.Timestamptz) *time.Time {
+	if t.Status != pgtype.Present || t.InfinityModifier != pgtype.None {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,235 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgxconn"
+)
+
+const (
+	// heartbeatInterval is how often a worker records that the job it runs
+	// is still alive.
+	heartbeatInterval = time.Minute
+	// abandonedJob matches the running jobs whose worker stopped sending
+	// heartbeats, most likely because its connector crashed. It allows for
+	// a few missed heartbeats.
+	abandonedJob = "status = 'running' AND COALESCE(heartbeat_at, started_at) < now() - interval '5 minutes'"
+
+	// cancelAbandonedJobsSQL finishes the abandoned jobs that were
+	// cancelled, as no worker is left to stop them.
+	cancelAbandonedJobsSQL = `UPDATE ` + schema.Catalog + `.delete_job
+	SET status = 'cancelled', finished_at = now()
+	WHERE cancel_requested AND ` + abandonedJob
+	// claimJobSQL picks the oldest pending or abandoned job. Jobs locked by
+	// other connectors are skipped so that every job runs only once. An
+	// abandoned job runs again from its first metric, so its progress is
+	// reset rather than counted twice.
+	claimJobSQL = `UPDATE ` + schema.Catalog + `.delete_job
+	SET status = 'running', started_at = now(), heartbeat_at = now(),
+		metrics_touched = '{}', series_deleted = 0, rows_deleted = 0
+	WHERE id = (
+		SELECT id FROM ` + schema.Catalog + `.delete_job
+		WHERE status = 'pending' OR (` + abandonedJob + `)
+		ORDER BY id
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING id, matchers, start_time, end_time`
+	updateJobProgressSQL = `UPDATE ` + schema.Catalog + `.delete_job
+	SET metrics_touched = CASE WHEN $2 = ANY(metrics_touched) THEN metrics_touched ELSE array_append(metrics_touched, $2) END,
+		series_deleted = series_deleted + $3,
+		rows_deleted = rows_deleted + $4,
+		heartbeat_at = now()
+	WHERE id = $1
+	RETURNING cancel_requested`
+	heartbeatJobSQL = "UPDATE " + schema.Catalog + ".delete_job SET heartbeat_at = now() WHERE id = $1 AND status = 'running'"
+	finishJobSQL    = "UPDATE " + schema.Catalog + ".delete_job SET status = $2, error = $3, finished_at = now() WHERE id = $1"
+	requeueJobSQL   = "UPDATE " + schema.Catalog + ".delete_job SET status = 'pending', started_at = NULL, heartbeat_at = NULL WHERE id = $1"
+)
+
+var errJobCancelled = fmt.Errorf("delete job cancelled")
+
+// Worker executes the pending delete jobs in the background. A job is leased
+// to the worker running it through heartbeats, so that the jobs of a
+// connector which crashed are picked up again by another one.
+type Worker struct {
+	conn              pgxconn.PgxConn
+	interval          time.Duration
+	heartbeatInterval time.Duration
+	ctx               context.Context
+	cancel            context.CancelFunc
+	done              chan struct{}
+}
+
+// NewWorker returns a worker that looks for pending delete jobs every interval.
+func NewWorker(conn pgxconn.PgxConn, interval time.Duration) *Worker {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Worker{
+		conn:              conn,
+		interval:          interval,
+		heartbeatInterval: heartbeatInterval,
+		ctx:               ctx,
+		cancel:            cancel,
+		done:              make(chan struct{}),
+	}
+}
+
+// Start starts executing delete jobs.
+func (w *Worker) Start() {
+	go w.run()
+}
+
+// Close stops the worker. A job interrupted by it is returned to the queue
+// and resumed later on by any connector.
+func (w *Worker) Close() {
+	w.cancel()
+	<-w.done
+}
+
+func (w *Worker) run() {
+	defer close(w.done)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		for {
+			ran, err := w.RunNext(w.ctx)
+			if err != nil {
+				if w.ctx.Err() == nil {
+					log.Error("msg", "error running delete job", "err", err)
+				}
+				break
+			}
+			if !ran {
+				break
+			}
+		}
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunNext executes the oldest pending or abandoned delete job. It returns
+// false if there was no job to run.
+func (w *Worker) RunNext(ctx context.Context) (bool, error) {
+	if _, err := w.conn.Exec(ctx, cancelAbandonedJobsSQL); err != nil {
+		return false, fmt.Errorf("cancel abandoned delete jobs: %w", err)
+	}
+
+	var (
+		id         int64
+		matchers   []string
+		start, end pgtype.Timestamptz
+	)
+	err := w.conn.QueryRow(ctx, claimJobSQL).Scan(&id, &matchers, &start, &end)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("claim delete job: %w", err)
+	}
+
+	log.Info("msg", "running delete job", "id", id, "matchers", fmt.Sprintf("%v", matchers))
+	stopHeartbeat := w.heartbeat(ctx, id)
+	jobErr := w.execute(ctx, id, matchers, timeOrDefault(start, model.MinTime), timeOrDefault(end, model.MaxTime))
+	stopHeartbeat()
+
+	status, errMsg := JobSucceeded, ""
+	switch {
+	case jobErr == nil:
+	case errors.Is(jobErr, errJobCancelled):
+		status = JobCancelled
+	case ctx.Err() != nil:
+		// The worker is shutting down, let the job be picked up again.
+		if _, err = w.conn.Exec(context.Background(), requeueJobSQL, id); err != nil {
+			return true, fmt.Errorf("requeue delete job %d: %w", id, err)
+		}
+		return true, ctx.Err()
+	default:
+		status, errMsg = JobFailed, jobErr.Error()
+		log.Error("msg", "delete job failed", "id", id, "err", jobErr)
+	}
+	if _, err = w.conn.Exec(context.Background(), finishJobSQL, id, string(status), errMsg); err != nil {
+		return true, fmt.Errorf("finish delete job %d: %w", id, err)
+	}
+	log.Info("msg", "delete job finished", "id", id, "status", status)
+	return true, nil
+}
+
+// heartbeat keeps the lease of the job until the returned function is called.
+func (w *Worker) heartbeat(ctx context.Context, id int64) (stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(w.heartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if _, err := w.conn.Exec(ctx, heartbeatJobSQL, id); err != nil && ctx.Err() == nil {
+				log.Warn("msg", "error sending delete job heartbeat", "id", id, "err", err)
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func (w *Worker) execute(ctx context.Context, id int64, matchers []string, start, end time.Time) error {
+	pgDelete := &PgDelete{Conn: w.conn}
+	progress := func(metricName string, removedSeriesIDs []model.SeriesID, rowsDeleted int) error {
+		var cancelRequested bool
+		if err := w.conn.QueryRow(
+			ctx,
+			updateJobProgressSQL,
+			id,
+			metricName,
+			int64(len(removedSeriesIDs)),
+			int64(rowsDeleted),
+		).Scan(&cancelRequested); err != nil {
+			return fmt.Errorf("update delete job progress: %w", err)
+		}
+		if cancelRequested {
+			return errJobCancelled
+		}
+		return nil
+	}
+	for _, s := range matchers {
+		parsed, err := parser.ParseMetricSelector(s)
+		if err != nil {
+			return fmt.Errorf("parsing matcher %s: %w", s, err)
+		}
+		if err = pgDelete.deleteSeries(ctx, parsed, start, end, progress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func timeOrDefault(t pgtype.Timestamptz, def time.Time) time.Time {
+	if ts := timestamptzToTime(t); ts != nil {
+		return *ts
+	}
+	return def
+}
@@ -0,0 +1,121 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package delete
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+const seriesByLabelSQL = `SELECT m.metric_name, array_agg(s.id)
+	FROM _prom_catalog.series s
+	INNER JOIN _prom_catalog.metric m
+	ON (m.id = s.metric_id)
+	WHERE labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)
+	GROUP BY m.metric_name
+	ORDER BY m.metric_name`
+
+func TestWorkerRunNext(t *testing.T) {
+	cancelAbandonedQuery := model.SqlQuery{Sql: cancelAbandonedJobsSQL}
+	claimQuery := model.SqlQuery{
+		Sql:     claimJobSQL,
+		Results: model.RowResults{{int64(1), []string{`{__name__="up"}`}, pgtype.Timestamptz{}, pgtype.Timestamptz{}}},
+	}
+	seriesQuery := model.SqlQuery{
+		Sql:     seriesByLabelSQL,
+		Args:    []interface{}{"__name__", "up"},
+		Results: model.RowResults{{"up", []int64{1, 2}}},
+	}
+	testCases := []struct {
+		name       string
+		sqlQueries []model.SqlQuery
+		ran        bool
+		expectErr  bool
+	}{
+		{
+			name: "No pending job",
+			sqlQueries: []model.SqlQuery{
+				cancelAbandonedQuery,
+				{Sql: claimJobSQL, Err: pgx.ErrNoRows},
+			},
+		},
+		{
+			name: "Error cancelling abandoned jobs",
+			sqlQueries: []model.SqlQuery{
+				{Sql: cancelAbandonedJobsSQL, Err: fmt.Errorf("some error")},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Error claiming job",
+			sqlQueries: []model.SqlQuery{
+				cancelAbandonedQuery,
+				{Sql: claimJobSQL, Err: fmt.Errorf("some error")},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Job succeeded",
+			sqlQueries: []model.SqlQuery{
+				cancelAbandonedQuery,
+				claimQuery,
+				seriesQuery,
+				{
+					Sql:     queryDeleteSeries,
+					Args:    []interface{}{"up", []int64{1, 2}},
+					Results: model.RowResults{{10}},
+				},
+				{
+					Sql:     updateJobProgressSQL,
+					Args:    []interface{}{int64(1), "up", int64(2), int64(10)},
+					Results: model.RowResults{{false}},
+				},
+				{
+					Sql:  finishJobSQL,
+					Args: []interface{}{int64(1), "succeeded", ""},
+				},
+			},
+			ran: true,
+		},
+		{
+			name: "Job failed",
+			sqlQueries: []model.SqlQuery{
+				cancelAbandonedQuery,
+				claimQuery,
+				seriesQuery,
+				{
+					Sql:  queryDeleteSeries,
+					Args: []interface{}{"up", []int64{1, 2}},
+					Err:  fmt.Errorf("some error"),
+				},
+				{
+					Sql:  finishJobSQL,
+					Args: []interface{}{int64(1), "failed", "deleting series with metric_name=up and series_ids=[1 2] : some error"},
+				},
+			},
+			ran: true,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			mock := model.NewSqlRecorder(c.sqlQueries, t)
+			worker := NewWorker(mock, 0)
+
+			ran, err := worker.RunNext(context.Background())
+			if c.expectErr != (err != nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ran != c.ran {
+				t.Errorf("unexpected ran: got %v wanted %v", ran, c.ran)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package exemplar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	"github.com/timescale/promscale/pkg/pgmodel/common/clauses"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
+	"github.com/timescale/promscale/pkg/pgmodel/lreader"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgxconn"
+)
+
+const (
+	getMetricTableSQL = "SELECT table_name FROM " + schema.Catalog + ".get_metric_table_name_if_exists($1)"
+
+	exemplarsBySeriesSQLFormat = `SELECT s.labels, array_agg(e.time ORDER BY e.time), array_agg(e.value ORDER BY e.time), array_agg(e.labels::TEXT ORDER BY e.time)
+	FROM %s e
+	INNER JOIN ` + schema.Catalog + `.series s
+	ON (s.id = e.series_id)
+	WHERE e.series_id = ANY($1) AND e.time >= $2 AND e.time <= $3
+	GROUP BY s.id
+	ORDER BY s.id`
+)
+
+// Reader reads the exemplars stored alongside the samples.
+type Reader interface {
+	// QueryExemplars returns the exemplars between start and end of the
+	// series matching any of the matcher sets, grouped by series.
+	QueryExemplars(start, end time.Time, matcherSets [][]*labels.Matcher) ([]QueryResult, error)
+}
+
+// QueryResult holds the exemplars of a single series.
+type QueryResult struct {
+	SeriesLabels labels.Labels `json:"seriesLabels"`
+	Exemplars    []Exemplar    `json:"exemplars"`
+}
+
+// Exemplar is a sample with labels, like a trace ID, pointing to more details
+// about the event it was recorded for.
+type Exemplar struct {
+	Labels    labels.Labels
+	Value     float64
+	Timestamp int64
+}
+
+// MarshalJSON encodes the exemplar the way the Prometheus HTTP API does: the
+// value as a string and the timestamp in seconds.
+func (e Exemplar) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Labels    labels.Labels `json:"labels"`
+		Value     string        `json:"value"`
+		Timestamp float64       `json:"timestamp"`
+	}{
+		Labels:    e.Labels,
+		Value:     strconv.FormatFloat(e.Value, 'f', -1, 64),
+		Timestamp: float64(e.Timestamp) / 1e3,
+	})
+}
+
+// Query returns the exemplars between start and end of the series matching
+// any of the matcher sets. Series matched by several sets are only returned
+// once.
+func Query(conn pgxconn.PgxConn, metricCache cache.MetricCache, labelsReader lreader.LabelsReader, start, end time.Time, matcherSets [][]*labels.Matcher) ([]QueryResult, error) {
+	result := make([]QueryResult, 0)
+	seen := make(map[int64]struct{})
+	for _, matchers := range matcherSets {
+		builder, err := clauses.BuildSubQueries(matchers)
+		if err != nil {
+			return nil, err
+		}
+		cases, values, err := builder.Build(true)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := conn.Query(context.Background(), clauses.BuildMetricNameSeriesIDQuery(cases), values...)
+		if err != nil {
+			return nil, fmt.Errorf("querying series: %w", err)
+		}
+		metrics, series, err := clauses.GetSeriesPerMetric(rows)
+		rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("scanning series: %w", err)
+		}
+
+		for i, metric := range metrics {
+			ids := make([]int64, 0, len(series[i]))
+			for _, id := range series[i] {
+				if _, ok := seen[int64(id)]; ok {
+					continue
+				}
+				seen[int64(id)] = struct{}{}
+				ids = append(ids, int64(id))
+			}
+			if len(ids) == 0 {
+				continue
+			}
+
+			tableName, err := getMetricTableName(conn, metricCache, metric)
+			if err != nil {
+				// If the metric table is missing, there are no exemplars for it.
+				if err == errors.ErrMissingTableName {
+					continue
+				}
+				return nil, err
+			}
+
+			metricResult, err := queryMetricExemplars(conn, labelsReader, tableName, ids, start, end)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, metricResult...)
+		}
+	}
+	return result, nil
+}
+
+// queryMetricExemplars returns the exemplars of the given series of a metric.
+func queryMetricExemplars(conn pgxconn.PgxConn, labelsReader lreader.LabelsReader, tableName string, ids []int64, start, end time.Time) ([]QueryResult, error) {
+	sqlQuery := fmt.Sprintf(exemplarsBySeriesSQLFormat, pgx.Identifier{schema.DataExemplar, tableName}.Sanitize())
+	rows, err := conn.Query(context.Background(), sqlQuery, ids, model.TimeToTimestamptz(start), model.TimeToTimestamptz(end))
+	if err != nil {
+		// The exemplar table might have been dropped along with the metric.
+		if e, ok := err.(*pgconn.PgError); ok && e.Code == pgerrcode.UndefinedTable {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("querying exemplars: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]QueryResult, 0)
+	for rows.Next() {
+		var (
+			labelIDs       []int64
+			times          []time.Time
+			values         []float64
+			exemplarLabels []string
+		)
+		if err := rows.Scan(&labelIDs, &times, &values, &exemplarLabels); err != nil {
+			return nil, fmt.Errorf("scanning exemplars: %w", err)
+		}
+		seriesLabels, err := labelsReader.LabelsForIds(labelIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		exemplars := make([]Exemplar, 0, len(times))
+		for i := range times {
+			var lbls map[string]string
+			if err := json.Unmarshal([]byte(exemplarLabels[i]), &lbls); err != nil {
+				return nil, fmt.Errorf("decoding exemplar labels: %w", err)
+			}
+			exemplars = append(exemplars, Exemplar{
+				Labels:    labels.FromMap(lbls),
+				Value:     values[i],
+				Timestamp: timestamp.FromTime(times[i]),
+			})
+		}
+		result = append(result, QueryResult{SeriesLabels: seriesLabels, Exemplars: exemplars})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading exemplars: %w", err)
+	}
+	return result, nil
+}
+
+// getMetricTableName gets the table name for a specific metric from the
+// cache. If not found, fetches it from the database and updates the cache.
+func getMetricTableName(conn pgxconn.PgxConn, metricCache cache.MetricCache, metric string) (string, error) {
+	tableName, err := metricCache.Get(metric)
+	if err == nil {
+		return tableName, nil
+	}
+	if err != errors.ErrEntryNotFound {
+		return "", err
+	}
+
+	rows, err := conn.Query(context.Background(), getMetricTableSQL, metric)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return "", errors.ErrMissingTableName
+	}
+	if err := rows.Scan(&tableName); err != nil {
+		return "", err
+	}
+
+	return tableName, metricCache.Set(metric, tableName)
+}
@@ -0,0 +1,139 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package exemplar
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/clockcache"
+	"github.com/timescale/promscale/pkg/pgmodel/lreader"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+func TestQuery(t *testing.T) {
+	const (
+		seriesSQL = "SELECT m.metric_name, array_agg(s.id)\n\t" +
+			"FROM _prom_catalog.series s\n\t" +
+			"INNER JOIN _prom_catalog.metric m\n\t" +
+			"ON (m.id = s.metric_id)\n\t" +
+			"WHERE labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)\n\t" +
+			"GROUP BY m.metric_name\n\t" +
+			"ORDER BY m.metric_name"
+		tableSQL     = "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)"
+		exemplarsSQL = "SELECT s.labels, array_agg(e.time ORDER BY e.time), array_agg(e.value ORDER BY e.time), array_agg(e.labels::TEXT ORDER BY e.time)\n\t" +
+			"FROM \"prom_data_exemplar\".\"foo\" e\n\t" +
+			"INNER JOIN _prom_catalog.series s\n\t" +
+			"ON (s.id = e.series_id)\n\t" +
+			"WHERE e.series_id = ANY($1) AND e.time >= $2 AND e.time <= $3\n\t" +
+			"GROUP BY s.id\n\t" +
+			"ORDER BY s.id"
+		labelsSQL = "SELECT (labels_info($1::int[])).*"
+	)
+	start, end := time.Unix(10, 0), time.Unix(20, 0)
+	matchers := []*labels.Matcher{labels.MustNewMatcher(labels.MatchEqual, "job", "api")}
+
+	testCases := []struct {
+		name        string
+		matcherSets [][]*labels.Matcher
+		sqlQueries  []model.SqlQuery
+		expectedRes []QueryResult
+		expectErr   bool
+	}{
+		{
+			name:        "Error on series query",
+			matcherSets: [][]*labels.Matcher{matchers},
+			sqlQueries: []model.SqlQuery{
+				{
+					Sql:  seriesSQL,
+					Args: []interface{}{"job", "api"},
+					Err:  fmt.Errorf("some error"),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name:        "Missing metric table",
+			matcherSets: [][]*labels.Matcher{matchers},
+			sqlQueries: []model.SqlQuery{
+				{
+					Sql:     seriesSQL,
+					Args:    []interface{}{"job", "api"},
+					Results: model.RowResults{{"foo", []int64{1}}},
+				},
+				{
+					Sql:     tableSQL,
+					Args:    []interface{}{"foo"},
+					Results: model.RowResults{},
+				},
+			},
+			expectedRes: []QueryResult{},
+		},
+		{
+			name:        "Exemplars of a series matched twice",
+			matcherSets: [][]*labels.Matcher{matchers, matchers},
+			sqlQueries: []model.SqlQuery{
+				{
+					Sql:     seriesSQL,
+					Args:    []interface{}{"job", "api"},
+					Results: model.RowResults{{"foo", []int64{1}}},
+				},
+				{
+					Sql:     tableSQL,
+					Args:    []interface{}{"foo"},
+					Results: model.RowResults{{"foo"}},
+				},
+				{
+					Sql:     exemplarsSQL,
+					Args:    []interface{}{[]int64{1}, model.TimeToTimestamptz(start), model.TimeToTimestamptz(end)},
+					Results: model.RowResults{{[]int64{3}, []time.Time{time.Unix(15, 0)}, []float64{6}, []string{`{"trace_id": "abc"}`}}},
+				},
+				{
+					Sql:     labelsSQL,
+					Args:    []interface{}{[]int64{3}},
+					Results: model.RowResults{{[]int64{3}, []string{"job"}, []string{"api"}}},
+				},
+				{
+					Sql:     seriesSQL,
+					Args:    []interface{}{"job", "api"},
+					Results: model.RowResults{{"foo", []int64{1}}},
+				},
+			},
+			expectedRes: []QueryResult{
+				{
+					SeriesLabels: labels.FromStrings("job", "api"),
+					Exemplars: []Exemplar{
+						{Labels: labels.FromStrings("trace_id", "abc"), Value: 6, Timestamp: 15000},
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			mock := model.NewSqlRecorder(c.sqlQueries, t)
+			metricCache := &model.MockMetricCache{MetricCache: map[string]string{}}
+			labelsReader := lreader.NewLabelsReader(mock, clockcache.WithMax(0))
+
+			res, err := Query(mock, metricCache, labelsReader, start, end, c.matcherSets)
+			if c.expectErr {
+				if err == nil {
+					t.Fatal("expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(res, c.expectedRes) {
+				t.Errorf("unexpected result:\ngot\n%+v\nwanted\n%+v", res, c.expectedRes)
+			}
+		})
+	}
+}
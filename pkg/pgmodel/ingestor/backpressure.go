@@ -0,0 +1,68 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package ingestor
+
+import (
+	"sync/atomic"
+
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+// inflightLimiter bounds the samples and bytes accepted by the ingestor that
+// have not reached the database yet, so that requests are rejected instead of
+// queueing without bound when the database falls behind.
+type inflightLimiter struct {
+	// Using the first words in struct to ensure proper alignment in 32-bit systems.
+	// Reference: https://golang.org/pkg/sync/atomic/#pkg-note-BUG
+	samples    int64
+	bytes      int64
+	maxSamples int64
+	maxBytes   int64
+}
+
+// newInflightLimiter returns a limiter for the given limits, or nil if both
+// are disabled. A limit of 0 or less is disabled.
+func newInflightLimiter(maxSamples, maxBytes int64) *inflightLimiter {
+	if maxSamples <= 0 && maxBytes <= 0 {
+		return nil
+	}
+	return &inflightLimiter{maxSamples: maxSamples, maxBytes: maxBytes}
+}
+
+// acquire reserves room for a request, returning false if it would exceed
+// the limits. A request is always accepted when nothing else is in flight,
+// so that requests larger than the limits can still make progress.
+func (l *inflightLimiter) acquire(samples, bytes int64) bool {
+	newSamples := atomic.AddInt64(&l.samples, samples)
+	newBytes := atomic.AddInt64(&l.bytes, bytes)
+	exceeded := (l.maxSamples > 0 && newSamples > l.maxSamples) ||
+		(l.maxBytes > 0 && newBytes > l.maxBytes)
+	if exceeded && (newSamples > samples || newBytes > bytes) {
+		l.release(samples, bytes)
+		return false
+	}
+	return true
+}
+
+// release frees the room reserved for a request once it was inserted.
+func (l *inflightLimiter) release(samples, bytes int64) {
+	atomic.AddInt64(&l.samples, -samples)
+	atomic.AddInt64(&l.bytes, -bytes)
+}
+
+// inflight returns the number of samples and bytes currently in flight.
+func (l *inflightLimiter) inflight() (samples, bytes int64) {
+	return atomic.LoadInt64(&l.samples), atomic.LoadInt64(&l.bytes)
+}
+
+// requestSize returns the number of samples and the encoded size in bytes of
+// the time series of a request.
+func requestSize(tts []prompb.TimeSeries) (samples, bytes int64) {
+	for i := range tts {
+		samples += int64(len(tts[i].Samples))
+		bytes += int64(tts[i].Size())
+	}
+	return samples, bytes
+}
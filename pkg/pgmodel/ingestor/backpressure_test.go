@@ -0,0 +1,83 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package ingestor
+
+import (
+	"testing"
+
+	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+func TestInflightLimiter(t *testing.T) {
+	if newInflightLimiter(0, 0) != nil {
+		t.Fatal("a limiter without limits must be disabled")
+	}
+
+	l := newInflightLimiter(10, 100)
+	if !l.acquire(8, 50) {
+		t.Fatal("request within the limits was rejected")
+	}
+	if l.acquire(3, 10) {
+		t.Fatal("request exceeding the sample limit was accepted")
+	}
+	if l.acquire(1, 60) {
+		t.Fatal("request exceeding the byte limit was accepted")
+	}
+	if samples, bytes := l.inflight(); samples != 8 || bytes != 50 {
+		t.Fatalf("rejected requests must not be in flight, got %d samples and %d bytes", samples, bytes)
+	}
+
+	l.release(8, 50)
+	// oversized requests are accepted when nothing else is in flight.
+	if !l.acquire(20, 200) {
+		t.Fatal("oversized request was rejected while nothing was in flight")
+	}
+	if l.acquire(1, 1) {
+		t.Fatal("request was accepted while the limits are exceeded")
+	}
+	l.release(20, 200)
+	if samples, bytes := l.inflight(); samples != 0 || bytes != 0 {
+		t.Fatalf("unexpected in-flight data: %d samples and %d bytes", samples, bytes)
+	}
+}
+
+func TestDBIngestorIngestLimits(t *testing.T) {
+	inserter := &mockAckingInserter{
+		MockInserter: model.MockInserter{InsertedSeries: make(map[string]model.SeriesID)},
+	}
+	i := &DBIngestor{
+		db:          inserter,
+		scache:      cache.NewSeriesCache(100),
+		limiter:     newInflightLimiter(1, 0),
+		ackInserter: inserter,
+	}
+
+	// another request is still being inserted.
+	i.limiter.acquire(1, 0)
+	req := NewWriteRequest()
+	req.Timeseries = walTestSeries(1)
+	if _, err := i.Ingest(req.Timeseries, req); err != pgmodelErrs.ErrIngestLimitExceeded {
+		t.Fatalf("expected the ingest limit to be exceeded, got %v", err)
+	}
+	if len(req.Timeseries) != 0 {
+		t.Fatalf("rejected request was not recycled")
+	}
+	if len(inserter.InsertedData) != 0 {
+		t.Fatalf("rejected request was inserted: %v", inserter.InsertedData)
+	}
+
+	i.limiter.release(1, 0)
+	if _, err := i.Ingest(walTestSeries(1), NewWriteRequest()); err != nil {
+		t.Fatal(err)
+	}
+	if len(inserter.InsertedData) != 1 {
+		t.Fatalf("unexpected inserted data: %v", inserter.InsertedData)
+	}
+	if samples, bytes := i.Inflight(); samples != 0 || bytes != 0 {
+		t.Fatalf("inserted requests must be released, got %d samples and %d bytes in flight", samples, bytes)
+	}
+}
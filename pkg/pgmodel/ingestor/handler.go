@@ -13,12 +13,17 @@ import (
 	"github.com/timescale/promscale/pkg/pgxconn"
 )
 
+// seriesCreatedFunc is called with the labels of a series created in the
+// catalog.
+type seriesCreatedFunc func(names, values []string)
+
 type insertHandler struct {
 	conn            pgxconn.PgxConn
 	input           chan *insertDataRequest
 	pending         *pendingBuffer
 	metricTableName string
 	toCopiers       chan copyRequest
+	seriesCreated   seriesCreatedFunc
 }
 
 func (h *insertHandler) blockingHandleReq() bool {
@@ -104,6 +109,8 @@ func (h *insertHandler) setSeriesIds(seriesSamples []model.Samples) error {
 	})
 
 	batchSeries := make([][]model.Samples, 0, len(seriesToInsert))
+	// the labels of every series in batchSeries.
+	batchLabels := make([][2][]string, 0, len(seriesToInsert))
 	// group the seriesToInsert by labels, one slice array per unique labels
 	for _, curr := range seriesToInsert {
 		names, values, ok := curr.GetSeries().NameValues()
@@ -121,6 +128,7 @@ func (h *insertHandler) setSeriesIds(seriesSamples []model.Samples) error {
 		batch.Queue("COMMIT;")
 		numSQLFunctionCalls++
 		batchSeries = append(batchSeries, []model.Samples{curr})
+		batchLabels = append(batchLabels, [2][]string{names, values})
 
 		lastSeenLabel = curr.GetSeries()
 	}
@@ -162,12 +170,18 @@ func (h *insertHandler) setSeriesIds(seriesSamples []model.Samples) error {
 			return fmt.Errorf("Error setting series ids: %w", err)
 		}
 
-		var id model.SeriesID
+		var (
+			id      model.SeriesID
+			created bool
+		)
 		row = br.QueryRow()
-		err = row.Scan(&tableName, &id)
+		err = row.Scan(&tableName, &id, &created)
 		if err != nil {
 			return fmt.Errorf("Error setting series ids: %w", err)
 		}
+		if created && h.seriesCreated != nil {
+			h.seriesCreated(batchLabels[i][0], batchLabels[i][1])
+		}
 
 		for _, si := range batchSeries[i] {
 			si.GetSeries().SetSeriesID(id, dbEpoch)
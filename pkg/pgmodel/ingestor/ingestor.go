@@ -7,7 +7,9 @@ package ingestor
 import (
 	"fmt"
 
+	"github.com/prometheus/prometheus/pkg/relabel"
 	"github.com/timescale/promscale/pkg/clockcache"
+	"github.com/timescale/promscale/pkg/log"
 	"github.com/timescale/promscale/pkg/pgmodel/cache"
 	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	"github.com/timescale/promscale/pkg/pgmodel/model"
@@ -21,12 +23,40 @@ type Cfg struct {
 	SeriesCacheSize  uint64
 	NumCopiers       int
 	DisableEpochSync bool
+	// WALDir is the directory of the write-ahead log keeping the requests
+	// acked asynchronously, with their samples, exemplars and metadata,
+	// until they are inserted. Empty disables the WAL.
+	WALDir         string
+	WALSegmentSize int64
+	// MaxInflightSamples and MaxInflightBytes bound the data accepted but not
+	// yet inserted, requests exceeding them are rejected with
+	// ErrIngestLimitExceeded. 0 disables the limit.
+	MaxInflightSamples int64
+	MaxInflightBytes   int64
+	Limits             Limits
+	// RelabelConfigs are the relabeling rules applied to the labels of the
+	// ingested series before they are canonicalized.
+	RelabelConfigs []*relabel.Config
 }
 
 // DBIngestor ingest the TimeSeries data into Timescale database.
 type DBIngestor struct {
-	db     model.Inserter
-	scache cache.SeriesCache
+	db      model.Inserter
+	scache  cache.SeriesCache
+	relabel []*relabel.Config
+	limits  *ingestLimiter
+	// wal is only set when asynchronous acks are enabled with a write-ahead
+	// log, and limiter when in-flight limits are set. Both need to know when
+	// the data has reached the database, which ackInserter reports.
+	wal         *wal
+	limiter     *inflightLimiter
+	ackInserter ackingInserter
+}
+
+// ackingInserter is an inserter reporting when the data it inserts
+// asynchronously has reached the database.
+type ackingInserter interface {
+	insertDataWithAck(rows map[string][]model.Samples, ack func(error)) (uint64, error)
 }
 
 // NewPgxIngestorWithMetricCache returns a new Ingestor that uses connection pool and a metrics cache
@@ -36,8 +66,33 @@ func NewPgxIngestorWithMetricCache(conn pgxconn.PgxConn, cache cache.MetricCache
 	if err != nil {
 		return nil, err
 	}
+	limits := newIngestLimiter(cfg.Limits, scache, conn)
+	if limits != nil {
+		pi.seriesCreated = limits.seriesCreated
+	}
 
-	return &DBIngestor{db: pi, scache: scache}, nil
+	ingestor := &DBIngestor{
+		db:          pi,
+		scache:      scache,
+		relabel:     cfg.RelabelConfigs,
+		limits:      limits,
+		limiter:     newInflightLimiter(cfg.MaxInflightSamples, cfg.MaxInflightBytes),
+		ackInserter: pi,
+	}
+	if cfg.WALDir != "" {
+		if !cfg.AsyncAcks {
+			log.Warn("msg", "the write-ahead log is only used with asynchronous acks, ignoring it")
+			return ingestor, nil
+		}
+		w, err := openWAL(cfg.WALDir, cfg.WALSegmentSize)
+		if err != nil {
+			pi.Close()
+			return nil, err
+		}
+		ingestor.wal = w
+		ingestor.replayWAL()
+	}
+	return ingestor, nil
 }
 
 // NewPgxIngestor returns a new Ingestor that write to PostgreSQL using PGX
@@ -53,17 +108,134 @@ func NewPgxIngestor(conn pgxconn.PgxConn) (*DBIngestor, error) {
 //     req the WriteRequest backing tts. It will be added to our WriteRequest
 //         pool when it is no longer needed.
 func (i *DBIngestor) Ingest(tts []prompb.TimeSeries, req *prompb.WriteRequest) (uint64, error) {
+	var reservation inflightReservation
+	if i.limiter != nil {
+		reservation.samples, reservation.bytes = requestSize(tts)
+		if !i.limiter.acquire(reservation.samples, reservation.bytes) {
+			FinishWriteRequest(req)
+			return 0, errors.ErrIngestLimitExceeded
+		}
+	}
+	if len(i.relabel) > 0 {
+		// the limits and the WAL need the labels the series are stored
+		// with, so the series are relabeled first.
+		relabelSeries(tts, i.relabel)
+	}
+	// the series exceeding the label limits are dropped, and reported once
+	// the rest of the request is ingested.
+	var dropped error
+	if i.limits != nil {
+		dropped = i.limits.dropInvalid(tts)
+		if err := i.limits.check(tts); err != nil {
+			i.release(reservation)
+			FinishWriteRequest(req)
+			return 0, err
+		}
+	}
+
+	var record walRecord
+	if i.wal != nil {
+		// the request has to be durable before it is acked.
+		var err error
+		if record, err = i.wal.log(tts, req.GetMetadata()); err != nil {
+			i.release(reservation)
+			FinishWriteRequest(req)
+			return 0, err
+		}
+	}
+
+	// metadata has to be extracted before parseData recycles req.
+	metadata := parseMetadata(req)
 	data, totalRows, err := i.parseData(tts, req)
 
 	if err != nil {
+		if i.wal != nil {
+			// the request is rejected, so there is nothing to replay.
+			i.wal.confirm(record)
+		}
+		i.release(reservation)
 		return 0, err
 	}
 
-	rowsInserted, err := i.db.InsertNewData(data)
+	rowsInserted, err := i.insertData(data, record, reservation)
 	if err == nil && int(rowsInserted) != totalRows {
 		return rowsInserted, fmt.Errorf("failed to insert all the data! Expected: %d, Got: %d", totalRows, rowsInserted)
 	}
-	return rowsInserted, err
+	if err != nil {
+		return rowsInserted, err
+	}
+
+	if len(metadata) > 0 {
+		if _, err = i.db.InsertMetadata(metadata); err != nil {
+			return rowsInserted, err
+		}
+	}
+	return rowsInserted, dropped
+}
+
+// inflightReservation is the room reserved for a request in the limiter.
+type inflightReservation struct {
+	samples, bytes int64
+}
+
+func (i *DBIngestor) release(r inflightReservation) {
+	if i.limiter != nil {
+		i.limiter.release(r.samples, r.bytes)
+	}
+}
+
+// insertData inserts the parsed data. Once the data is done with, the WAL
+// record of the request is confirmed and its in-flight reservation released.
+// Records of failed inserts are kept to be replayed on the next start.
+func (i *DBIngestor) insertData(data map[string][]model.Samples, record walRecord, reservation inflightReservation) (uint64, error) {
+	if i.wal == nil && i.limiter == nil {
+		return i.db.InsertNewData(data)
+	}
+	return i.ackInserter.insertDataWithAck(data, func(err error) {
+		if i.wal != nil && err == nil {
+			i.wal.confirm(record)
+		}
+		i.release(reservation)
+	})
+}
+
+// Inflight returns the number of samples and bytes accepted by the ingestor
+// that were not inserted yet. Only tracked when in-flight limits are set.
+func (i *DBIngestor) Inflight() (samples, bytes int64) {
+	if i.limiter == nil {
+		return 0, 0
+	}
+	return i.limiter.inflight()
+}
+
+// QueueDepth returns the number of requests waiting in the per-metric insert
+// queues, and the number of batches waiting for a copier.
+func (i *DBIngestor) QueueDepth() (metricQueues, copierQueue int) {
+	if pi, ok := i.db.(*pgxInserter); ok {
+		return pi.queueDepth()
+	}
+	return 0, 0
+}
+
+// replayWAL inserts the requests left in the WAL by a previous run, along
+// with their metadata, waiting for each of them to reach the database.
+func (i *DBIngestor) replayWAL() {
+	i.wal.replay(func(req *prompb.WriteRequest) error {
+		metadata := parseMetadata(req)
+		data, _, err := i.parseData(req.Timeseries, req)
+		if err != nil {
+			return err
+		}
+		result := make(chan error, 1)
+		_, _ = i.ackInserter.insertDataWithAck(data, func(err error) { result <- err })
+		if err = <-result; err != nil {
+			return err
+		}
+		if len(metadata) > 0 {
+			_, err = i.db.InsertMetadata(metadata)
+		}
+		return err
+	})
 }
 
 // Parts of metric creation not needed to insert data
@@ -81,7 +253,7 @@ func (ingestor *DBIngestor) parseData(tts []prompb.TimeSeries, req *prompb.Write
 
 	for i := range tts {
 		t := &tts[i]
-		if len(t.Samples) == 0 {
+		if len(t.Samples) == 0 && len(t.Exemplars) == 0 {
 			continue
 		}
 
@@ -94,13 +266,14 @@ func (ingestor *DBIngestor) parseData(tts []prompb.TimeSeries, req *prompb.Write
 		if metricName == "" {
 			return nil, rows, errors.ErrNoMetricName
 		}
-		sample := model.NewPromSample(seriesLabels, t.Samples)
+		sample := model.NewPromSampleWithExemplars(seriesLabels, t.Samples, t.Exemplars)
 		rows += len(t.Samples)
 
 		dataSamples[metricName] = append(dataSamples[metricName], sample)
-		// we're going to free req after this, but we still need the samples,
-		// so nil the field
+		// we're going to free req after this, but we still need the samples
+		// and exemplars, so nil the fields
 		t.Samples = nil
+		t.Exemplars = nil
 	}
 
 	// WriteRequests can contain pointers into the original buffer we deserialized
@@ -114,7 +287,25 @@ func (ingestor *DBIngestor) parseData(tts []prompb.TimeSeries, req *prompb.Write
 	return dataSamples, rows, nil
 }
 
+// parseMetadata converts the metric metadata of a WriteRequest.
+func parseMetadata(req *prompb.WriteRequest) []model.Metadata {
+	if req == nil || len(req.Metadata) == 0 {
+		return nil
+	}
+	metadata := make([]model.Metadata, 0, len(req.Metadata))
+	for _, md := range req.Metadata {
+		if md.MetricFamilyName == "" {
+			continue
+		}
+		metadata = append(metadata, model.NewMetadataFromProto(md))
+	}
+	return metadata
+}
+
 // Close closes the ingestor
 func (i *DBIngestor) Close() {
 	i.db.Close()
+	if i.wal != nil {
+		i.wal.close()
+	}
 }
@@ -24,12 +24,15 @@ func TestPGXInserterInsertSeries(t *testing.T) {
 		name       string
 		series     []labels.Labels
 		sqlQueries []model.SqlQuery
+		// metrics of the series created in the catalog.
+		created []string
 	}{
 		{
 			name: "Zero series",
 		},
 		{
-			name: "One series",
+			name:    "One series",
+			created: []string{"metric_1"},
 			series: []labels.Labels{
 				{
 					{Name: "name_1", Value: "value_1"},
@@ -54,14 +57,15 @@ func TestPGXInserterInsertSeries(t *testing.T) {
 						[]string{"__name__", "name_1"},
 						[]string{"metric_1", "value_1"},
 					},
-					Results: model.RowResults{{"table", int64(1)}},
+					Results: model.RowResults{{"table", int64(1), true}},
 					Err:     error(nil),
 				},
 				{Sql: "COMMIT;"},
 			},
 		},
 		{
-			name: "Two series",
+			name:    "Two series",
+			created: []string{"metric_1"},
 			series: []labels.Labels{
 				{
 					{Name: "name_1", Value: "value_1"},
@@ -89,7 +93,7 @@ func TestPGXInserterInsertSeries(t *testing.T) {
 						[]string{"__name__", "name_1"},
 						[]string{"metric_1", "value_1"},
 					},
-					Results: model.RowResults{{"table", int64(1)}},
+					Results: model.RowResults{{"table", int64(1), true}},
 					Err:     error(nil),
 				},
 				{Sql: "COMMIT;"},
@@ -101,14 +105,15 @@ func TestPGXInserterInsertSeries(t *testing.T) {
 						[]string{"__name__", "name_2"},
 						[]string{"metric_2", "value_2"},
 					},
-					Results: model.RowResults{{"table", int64(2)}},
+					Results: model.RowResults{{"table", int64(2), false}},
 					Err:     error(nil),
 				},
 				{Sql: "COMMIT;"},
 			},
 		},
 		{
-			name: "Double series",
+			name:    "Double series",
+			created: []string{"metric_1", "metric_2"},
 			series: []labels.Labels{
 				{
 					{Name: "name_1", Value: "value_1"},
@@ -138,7 +143,7 @@ func TestPGXInserterInsertSeries(t *testing.T) {
 						[]string{"__name__", "name_1"},
 						[]string{"metric_1", "value_1"},
 					},
-					Results: model.RowResults{{"table", int64(1)}},
+					Results: model.RowResults{{"table", int64(1), true}},
 					Err:     error(nil),
 				},
 				{Sql: "COMMIT;"},
@@ -149,7 +154,7 @@ func TestPGXInserterInsertSeries(t *testing.T) {
 						"metric_2", []string{"__name__", "name_2"},
 						[]string{"metric_2", "value_2"},
 					},
-					Results: model.RowResults{{"table", int64(2)}},
+					Results: model.RowResults{{"table", int64(2), true}},
 					Err:     error(nil),
 				},
 				{Sql: "COMMIT;"},
@@ -183,7 +188,7 @@ func TestPGXInserterInsertSeries(t *testing.T) {
 						[]string{"__name__", "name_1"},
 						[]string{"metric_1", "value_1"},
 					},
-					Results: model.RowResults{{"table", int64(1)}},
+					Results: model.RowResults{{"table", int64(1), true}},
 					Err:     fmt.Errorf("some query error"),
 				},
 				{Sql: "COMMIT;"},
@@ -195,7 +200,7 @@ func TestPGXInserterInsertSeries(t *testing.T) {
 						[]string{"__name__", "name_2"},
 						[]string{"metric_2", "value_2"},
 					},
-					Results: model.RowResults{{"table", int64(2)}},
+					Results: model.RowResults{{"table", int64(2), true}},
 					Err:     error(nil),
 				},
 				{Sql: "COMMIT;"},
@@ -209,8 +214,12 @@ func TestPGXInserterInsertSeries(t *testing.T) {
 			scache := cache.NewSeriesCache(100)
 			scache.Reset()
 
+			var created []string
 			inserter := insertHandler{
 				conn: mock,
+				seriesCreated: func(names, values []string) {
+					created = append(created, values[0])
+				},
 			}
 
 			lsi := make([]model.Samples, 0)
@@ -245,6 +254,7 @@ func TestPGXInserterInsertSeries(t *testing.T) {
 					require.True(t, si > 0, "series id not set")
 					require.True(t, se > 0, "epoch not set")
 				}
+				require.Equal(t, c.created, created)
 			}
 		})
 	}
@@ -281,7 +291,7 @@ func TestPGXInserterCacheReset(t *testing.T) {
 				[]string{"__name__", "name_1"},
 				[]string{"metric_1", "value_1"},
 			},
-			Results: model.RowResults{{"table", int64(1)}},
+			Results: model.RowResults{{"table", int64(1), true}},
 			Err:     error(nil),
 		},
 		{Sql: "COMMIT;"},
@@ -293,7 +303,7 @@ func TestPGXInserterCacheReset(t *testing.T) {
 				[]string{"__name__", "name_1"},
 				[]string{"metric_1", "value_2"},
 			},
-			Results: model.RowResults{{"table", int64(2)}},
+			Results: model.RowResults{{"table", int64(2), true}},
 			Err:     error(nil),
 		},
 		{Sql: "COMMIT;"},
@@ -331,7 +341,7 @@ func TestPGXInserterCacheReset(t *testing.T) {
 				[]string{"__name__", "name_1"},
 				[]string{"metric_1", "value_1"},
 			},
-			Results: model.RowResults{{"table", int64(3)}},
+			Results: model.RowResults{{"table", int64(3), true}},
 			Err:     error(nil),
 		},
 		{Sql: "COMMIT;"},
@@ -343,7 +353,7 @@ func TestPGXInserterCacheReset(t *testing.T) {
 				[]string{"__name__", "name_1"},
 				[]string{"metric_1", "value_2"},
 			},
-			Results: model.RowResults{{"table", int64(4)}},
+			Results: model.RowResults{{"table", int64(4), true}},
 			Err:     error(nil),
 		},
 		{Sql: "COMMIT;"},
@@ -472,7 +482,7 @@ func TestPGXInserterInsertData(t *testing.T) {
 				{
 					Sql:     "SELECT table_name, possibly_new FROM _prom_catalog.get_or_create_metric_table_name($1)",
 					Args:    []interface{}{"metric_0"},
-					Results: model.RowResults{{"metric_0", true}},
+					Results: model.RowResults{{"metric_0", false}},
 					Err:     error(nil),
 				},
 				{
@@ -493,6 +503,50 @@ func TestPGXInserterInsertData(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "One data with exemplars",
+			rows: map[string][]model.Samples{
+				"metric_0": {model.NewPromSampleWithExemplars(makeLabel(), make([]prompb.Sample, 1), []prompb.Exemplar{
+					{Labels: []prompb.Label{{Name: "trace_id", Value: "abc"}}, Value: 1, Timestamp: 1000},
+				})},
+			},
+			sqlQueries: []model.SqlQuery{
+				{Sql: "CALL _prom_catalog.finalize_metric_creation()"},
+				{
+					Sql:     "SELECT table_name, possibly_new FROM _prom_catalog.get_or_create_metric_table_name($1)",
+					Args:    []interface{}{"metric_0"},
+					Results: model.RowResults{{"metric_0", false}},
+					Err:     error(nil),
+				},
+				{
+					Sql: `INSERT INTO "prom_data"."metric_0"(time, value, series_id) SELECT * FROM unnest($1::TIMESTAMPTZ[], $2::DOUBLE PRECISION[], $3::BIGINT[]) a(t,v,s) ORDER BY s,t ON CONFLICT DO NOTHING`,
+					Args: []interface{}{
+						[]time.Time{time.Unix(0, 0)},
+						[]float64{0},
+						[]int64{1},
+					},
+					Results: model.RowResults{{pgconn.CommandTag{'1'}}},
+					Err:     error(nil),
+				},
+				{
+					Sql: `INSERT INTO "prom_data_exemplar"."metric_0"(time, value, series_id, labels) SELECT t, v, s, l::JSONB FROM unnest($1::TIMESTAMPTZ[], $2::DOUBLE PRECISION[], $3::BIGINT[], $4::TEXT[]) a(t,v,s,l) ORDER BY s,t ON CONFLICT DO NOTHING`,
+					Args: []interface{}{
+						[]time.Time{time.Unix(1, 0)},
+						[]float64{1},
+						[]int64{1},
+						[]string{`{"trace_id":"abc"}`},
+					},
+					Results: model.RowResults{{pgconn.CommandTag{'1'}}},
+					Err:     error(nil),
+				},
+				{
+					Sql:     "SELECT CASE current_epoch > $1::BIGINT + 1 WHEN true THEN _prom_catalog.epoch_abort($1) END FROM _prom_catalog.ids_epoch LIMIT 1",
+					Args:    []interface{}{int64(1)},
+					Results: model.RowResults{{[]byte{}}},
+					Err:     error(nil),
+				},
+			},
+		},
 		{
 			name: "Two data",
 			rows: map[string][]model.Samples{
@@ -506,7 +560,7 @@ func TestPGXInserterInsertData(t *testing.T) {
 				{
 					Sql:     "SELECT table_name, possibly_new FROM _prom_catalog.get_or_create_metric_table_name($1)",
 					Args:    []interface{}{"metric_0"},
-					Results: model.RowResults{{"metric_0", true}},
+					Results: model.RowResults{{"metric_0", false}},
 					Err:     error(nil),
 				},
 
@@ -561,7 +615,7 @@ func TestPGXInserterInsertData(t *testing.T) {
 				{
 					Sql:     "SELECT table_name, possibly_new FROM _prom_catalog.get_or_create_metric_table_name($1)",
 					Args:    []interface{}{"metric_0"},
-					Results: model.RowResults{{"metric_0", true}},
+					Results: model.RowResults{{"metric_0", false}},
 					Err:     error(nil),
 				},
 
@@ -619,7 +673,7 @@ func TestPGXInserterInsertData(t *testing.T) {
 				{
 					Sql:     "SELECT table_name, possibly_new FROM _prom_catalog.get_or_create_metric_table_name($1)",
 					Args:    []interface{}{"metric_0"},
-					Results: model.RowResults{{"metric_0", true}},
+					Results: model.RowResults{{"metric_0", false}},
 					Err:     error(nil),
 				},
 
@@ -748,3 +802,87 @@ func TestPGXInserterInsertData(t *testing.T) {
 		})
 	}
 }
+
+func TestPGXInserterInsertMetadata(t *testing.T) {
+	testCases := []struct {
+		name       string
+		metadata   []model.Metadata
+		sqlQueries []model.SqlQuery
+		expCount   uint64
+		expErr     bool
+	}{
+		{
+			name: "Zero metadata",
+			sqlQueries: []model.SqlQuery{
+				{Sql: "CALL _prom_catalog.finalize_metric_creation()"},
+			},
+		},
+		{
+			name: "Duplicate metric families",
+			metadata: []model.Metadata{
+				{MetricFamily: "metric_1", Type: "counter", Help: "old help"},
+				{MetricFamily: "metric_2", Type: "gauge", Unit: "bytes"},
+				{MetricFamily: "metric_1", Type: "counter", Help: "new help"},
+			},
+			sqlQueries: []model.SqlQuery{
+				{Sql: "CALL _prom_catalog.finalize_metric_creation()"},
+				{
+					Sql: `INSERT INTO _prom_catalog.metadata (last_seen, metric_family, type, unit, help)
+	SELECT now(), * FROM unnest($1::TEXT[], $2::TEXT[], $3::TEXT[], $4::TEXT[]) a(f, t, u, h)
+	ON CONFLICT (metric_family) DO UPDATE SET type = EXCLUDED.type, unit = EXCLUDED.unit, help = EXCLUDED.help, last_seen = EXCLUDED.last_seen`,
+					Args: []interface{}{
+						[]string{"metric_1", "metric_2"},
+						[]string{"counter", "gauge"},
+						[]string{"", "bytes"},
+						[]string{"new help", ""},
+					},
+					Results: model.RowResults{{pgconn.CommandTag("INSERT 0 2")}},
+				},
+			},
+			expCount: 2,
+		},
+		{
+			name: "Insert error",
+			metadata: []model.Metadata{
+				{MetricFamily: "metric_1", Type: "counter"},
+			},
+			sqlQueries: []model.SqlQuery{
+				{Sql: "CALL _prom_catalog.finalize_metric_creation()"},
+				{
+					Sql: `INSERT INTO _prom_catalog.metadata (last_seen, metric_family, type, unit, help)
+	SELECT now(), * FROM unnest($1::TEXT[], $2::TEXT[], $3::TEXT[], $4::TEXT[]) a(f, t, u, h)
+	ON CONFLICT (metric_family) DO UPDATE SET type = EXCLUDED.type, unit = EXCLUDED.unit, help = EXCLUDED.help, last_seen = EXCLUDED.last_seen`,
+					Args: []interface{}{
+						[]string{"metric_1"},
+						[]string{"counter"},
+						[]string{""},
+						[]string{""},
+					},
+					Err: fmt.Errorf("some error"),
+				},
+			},
+			expErr: true,
+		},
+	}
+
+	for _, co := range testCases {
+		c := co
+		t.Run(c.name, func(t *testing.T) {
+			mock := model.NewSqlRecorder(c.sqlQueries, t)
+			mockMetrics := &model.MockMetricCache{MetricCache: map[string]string{}}
+			inserter, err := newPgxInserter(mock, mockMetrics, cache.NewSeriesCache(100), &Cfg{DisableEpochSync: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer inserter.Close()
+
+			count, err := inserter.InsertMetadata(c.metadata)
+			if c.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.expCount, count)
+		})
+	}
+}
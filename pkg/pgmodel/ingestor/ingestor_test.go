@@ -6,6 +6,7 @@ package ingestor
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/timescale/promscale/pkg/pgmodel/cache"
@@ -55,6 +56,22 @@ func TestDBIngestorIngest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "One metric, only exemplars",
+			metrics: []prompb.TimeSeries{
+				{
+					Labels: []prompb.Label{
+						{Name: model.MetricNameLabelName, Value: "test"},
+						{Name: "test", Value: "test"},
+					},
+					Exemplars: []prompb.Exemplar{
+						{Labels: []prompb.Label{{Name: "trace_id", Value: "abc"}}, Timestamp: 1, Value: 0.1},
+					},
+				},
+			},
+			count:       0,
+			countSeries: 1,
+		},
 		{
 			name: "Two metrics",
 			metrics: []prompb.TimeSeries{
@@ -224,3 +241,47 @@ func TestDBIngestorIngest(t *testing.T) {
 		})
 	}
 }
+
+func TestDBIngestorIngestMetadata(t *testing.T) {
+	inserter := model.MockInserter{
+		InsertedSeries: make(map[string]model.SeriesID),
+	}
+	i := DBIngestor{
+		db:     &inserter,
+		scache: cache.NewSeriesCache(100),
+	}
+
+	req := NewWriteRequest()
+	req.Metadata = []prompb.MetricMetadata{
+		{
+			Type:             prompb.MetricMetadata_COUNTER,
+			MetricFamilyName: "http_requests_total",
+			Help:             "Total number of HTTP requests.",
+		},
+		{
+			Type:             prompb.MetricMetadata_GAUGEHISTOGRAM,
+			MetricFamilyName: "request_size",
+			Unit:             "bytes",
+		},
+		{
+			// metadata without a metric family is ignored.
+			Type: prompb.MetricMetadata_GAUGE,
+		},
+	}
+
+	count, err := i.Ingest(nil, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if count != 0 {
+		t.Errorf("invalid number of samples inserted: got %d, want 0", count)
+	}
+
+	expected := []model.Metadata{
+		{MetricFamily: "http_requests_total", Type: "counter", Help: "Total number of HTTP requests."},
+		{MetricFamily: "request_size", Type: "gaugehistogram", Unit: "bytes"},
+	}
+	if !reflect.DeepEqual(inserter.InsertedMetadata, expected) {
+		t.Errorf("unexpected metadata inserted:\ngot\n%+v\nwanted\n%+v", inserter.InsertedMetadata, expected)
+	}
+}
@@ -60,7 +60,7 @@ func initializeInserterRoutine(conn pgxconn.PgxConn, metricName string, complete
 	return tableName, err
 }
 
-func runInserterRoutine(conn pgxconn.PgxConn, input chan *insertDataRequest, metricName string, completeMetricCreationSignal chan struct{}, metricTableNames cache.MetricCache, toCopiers chan copyRequest) {
+func runInserterRoutine(conn pgxconn.PgxConn, input chan *insertDataRequest, metricName string, completeMetricCreationSignal chan struct{}, metricTableNames cache.MetricCache, toCopiers chan copyRequest, seriesCreated seriesCreatedFunc) {
 	var tableName string
 	var firstReq *insertDataRequest
 	firstReqSet := false
@@ -86,6 +86,7 @@ func runInserterRoutine(conn pgxconn.PgxConn, input chan *insertDataRequest, met
 		pending:         NewPendingBuffer(),
 		metricTableName: tableName,
 		toCopiers:       toCopiers,
+		seriesCreated:   seriesCreated,
 	}
 
 	handler.handleReq(firstReq)
@@ -317,6 +318,7 @@ func doInsert(conn pgxconn.PgxConn, reqs ...copyRequest) (err error) {
 
 	numRowsPerInsert := make([]int, 0, len(reqs))
 	numRowsTotal := 0
+	numExemplarInserts := 0
 	lowestEpoch := pgmodel.SeriesEpoch(math.MaxInt64)
 	for r := range reqs {
 		req := &reqs[r]
@@ -358,6 +360,22 @@ func doInsert(conn pgxconn.PgxConn, reqs ...copyRequest) (err error) {
 		batch.Queue(queryString, times, vals, series)
 	}
 
+	// the exemplars are queued after all the samples so that the row counts of
+	// the sample inserts can be checked against numRowsPerInsert.
+	for r := range reqs {
+		req := &reqs[r]
+		if req.data.batch.CountExemplars() == 0 {
+			continue
+		}
+		times, vals, series, lbls, err := req.data.batch.Exemplars()
+		if err != nil {
+			return err
+		}
+		queryString := fmt.Sprintf("INSERT INTO %s(time, value, series_id, labels) SELECT t, v, s, l::JSONB FROM unnest($1::TIMESTAMPTZ[], $2::DOUBLE PRECISION[], $3::BIGINT[], $4::TEXT[]) a(t,v,s,l) ORDER BY s,t ON CONFLICT DO NOTHING", pgx.Identifier{schema.DataExemplar, req.table}.Sanitize())
+		batch.Queue(queryString, times, vals, series, lbls)
+		numExemplarInserts++
+	}
+
 	//note the epoch increment takes an access exclusive on the table before incrementing.
 	//thus we don't need row locking here. Note by doing this check at the end we can
 	//have some wasted work for the inserts before this fails but this is rare.
@@ -386,6 +404,12 @@ func doInsert(conn pgxconn.PgxConn, reqs ...copyRequest) (err error) {
 		}
 	}
 
+	for i := 0; i < numExemplarInserts; i++ {
+		if _, err := results.Exec(); err != nil {
+			return err
+		}
+	}
+
 	var val []byte
 	row := results.QueryRow()
 	err = row.Scan(&val)
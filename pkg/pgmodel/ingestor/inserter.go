@@ -30,6 +30,9 @@ type pgxInserter struct {
 	seriesEpochRefresh     *time.Ticker
 	doneChannel            chan bool
 	doneWG                 sync.WaitGroup
+	// seriesCreated is called for every series created in the catalog, if
+	// set. It must be set before the first insert.
+	seriesCreated seriesCreatedFunc
 }
 
 func newPgxInserter(conn pgxconn.PgxConn, cache cache.MetricCache, scache cache.SeriesCache, cfg *Cfg) (*pgxInserter, error) {
@@ -172,6 +175,13 @@ func (p *pgxInserter) InsertNewData(rows map[string][]model.Samples) (uint64, er
 // Though we may insert data to multiple tables concurrently, if asyncAcks is
 // unset this function will wait until _all_ the insert attempts have completed.
 func (p *pgxInserter) InsertData(rows map[string][]model.Samples) (uint64, error) {
+	return p.insertDataWithAck(rows, nil)
+}
+
+// insertDataWithAck inserts the data like InsertData, and then calls ack, if
+// not nil, with the result of the insert. When asyncAcks is set, ack is
+// called after returning, once the data reached the database.
+func (p *pgxInserter) insertDataWithAck(rows map[string][]model.Samples, ack func(error)) (uint64, error) {
 	var numRows uint64
 	workFinished := &sync.WaitGroup{}
 	workFinished.Add(len(rows))
@@ -195,6 +205,9 @@ func (p *pgxInserter) InsertData(rows map[string][]model.Samples) (uint64, error
 		default:
 		}
 		close(errChan)
+		if ack != nil {
+			ack(err)
+		}
 	} else {
 		go func() {
 			workFinished.Wait()
@@ -208,12 +221,25 @@ func (p *pgxInserter) InsertData(rows map[string][]model.Samples) (uint64, error
 			} else if p.insertedDatapoints != nil {
 				atomic.AddInt64(p.insertedDatapoints, int64(numRows))
 			}
+			if ack != nil {
+				ack(err)
+			}
 		}()
 	}
 
 	return numRows, err
 }
 
+// queueDepth returns the number of requests waiting in the per-metric insert
+// queues, and the number of batches waiting for a copier.
+func (p *pgxInserter) queueDepth() (metricQueues, copierQueue int) {
+	p.inserters.Range(func(_, value interface{}) bool {
+		metricQueues += len(value.(chan *insertDataRequest))
+		return true
+	})
+	return metricQueues, len(p.toCopiers)
+}
+
 // Get the handler for a given metric name, creating a new one if none exists
 func (p *pgxInserter) getMetricInserter(metric string) chan *insertDataRequest {
 	inserter, ok := p.inserters.Load(metric)
@@ -227,7 +253,7 @@ func (p *pgxInserter) getMetricInserter(metric string) chan *insertDataRequest {
 		actual, old := p.inserters.LoadOrStore(metric, c)
 		inserter = actual
 		if !old {
-			go runInserterRoutine(p.conn, c, metric, p.completeMetricCreation, p.metricTableNames, p.toCopiers)
+			go runInserterRoutine(p.conn, c, metric, p.completeMetricCreation, p.metricTableNames, p.toCopiers, p.seriesCreated)
 		}
 	}
 	return inserter.(chan *insertDataRequest)
@@ -0,0 +1,383 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package ingestor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
+	"github.com/timescale/promscale/pkg/pgmodel/metrics"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgxconn"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+const (
+	newSeriesWindow = time.Hour
+
+	seriesExistsSQL = "SELECT " + schema.Catalog + ".series_exists($1, $2, $3)"
+
+	limitLabels     = "labels"
+	limitNewSeries  = "new_series"
+	limitSampleRate = "sample_rate"
+)
+
+// Limits are the ingest limits protecting the catalog from cardinality
+// explosions. The rate limits apply separately to every value of Label.
+// A limit of 0 is disabled.
+type Limits struct {
+	// Label is the label whose values the rate limits apply to, like
+	// __name__ for per-metric limits or __tenant__ for per-tenant ones.
+	Label string
+	// MaxNewSeriesPerHour limits the series created in the catalog per hour.
+	// Once it is reached, the requests with series which are not in the
+	// catalog are rejected until the hour is over. The series created by the
+	// requests in flight can exceed it.
+	MaxNewSeriesPerHour int64
+	MaxSamplesPerSecond float64
+	// The series exceeding the label limits are dropped from the requests,
+	// while the rest of their series are ingested.
+	MaxLabelsPerSeries  int
+	MaxLabelNameLength  int
+	MaxLabelValueLength int
+}
+
+func (l Limits) enabled() bool {
+	return l.MaxNewSeriesPerHour > 0 || l.MaxSamplesPerSecond > 0 || l.MaxLabelsPerSeries > 0 ||
+		l.MaxLabelNameLength > 0 || l.MaxLabelValueLength > 0
+}
+
+// ingestLimiter enforces the ingest limits on write requests. A request
+// exceeding any of the rate limits is rejected as a whole.
+type ingestLimiter struct {
+	limits Limits
+	scache cache.SeriesCache
+	now    func() time.Time
+	// seriesExist looks up which of the series exist in the catalog.
+	seriesExist seriesExistFunc
+
+	mtx       sync.Mutex
+	keys      map[string]*keyLimits
+	lastPrune time.Time
+}
+
+// keyLimits is the state of the rate limits of a label value.
+type keyLimits struct {
+	windowStart time.Time
+	// newSeries is the number of series created in the catalog since the
+	// start of the window.
+	newSeries int64
+	// the samples are limited by a token bucket holding up to a second of
+	// samples.
+	tokens     float64
+	lastRefill time.Time
+}
+
+// keyUsage is what a request uses of the rate limits of a label value.
+type keyUsage struct {
+	samples int64
+	// uncached are the labels of the series which are not in the series
+	// cache, which get_or_create_series_id_for_kv_array may create.
+	uncached [][]prompb.Label
+}
+
+// seriesExistFunc returns whether each of the series exists in the catalog.
+type seriesExistFunc func(series [][]prompb.Label) ([]bool, error)
+
+// newIngestLimiter returns a limiter for the limits, or nil if they are all
+// disabled.
+func newIngestLimiter(limits Limits, scache cache.SeriesCache, conn pgxconn.PgxConn) *ingestLimiter {
+	if !limits.enabled() {
+		return nil
+	}
+	if limits.Label == "" {
+		limits.Label = model.MetricNameLabelName
+	}
+	return &ingestLimiter{
+		limits:      limits,
+		scache:      scache,
+		now:         time.Now,
+		seriesExist: catalogSeriesExist(conn),
+		keys:        make(map[string]*keyLimits),
+	}
+}
+
+// catalogSeriesExist returns a function looking up the series in the catalog
+// with a single batch.
+func catalogSeriesExist(conn pgxconn.PgxConn) seriesExistFunc {
+	return func(series [][]prompb.Label) ([]bool, error) {
+		batch := conn.NewBatch()
+		for _, lbls := range series {
+			metricName := ""
+			names := make([]string, len(lbls))
+			values := make([]string, len(lbls))
+			for i, lbl := range lbls {
+				names[i], values[i] = lbl.Name, lbl.Value
+				if lbl.Name == model.MetricNameLabelName {
+					metricName = lbl.Value
+				}
+			}
+			batch.Queue(seriesExistsSQL, metricName, names, values)
+		}
+
+		br, err := conn.SendBatch(context.Background(), batch)
+		if err != nil {
+			return nil, fmt.Errorf("looking up series: %w", err)
+		}
+		defer br.Close()
+
+		exist := make([]bool, len(series))
+		for i := range series {
+			if err := br.QueryRow().Scan(&exist[i]); err != nil {
+				return nil, fmt.Errorf("looking up series: %w", err)
+			}
+		}
+		return exist, nil
+	}
+}
+
+// dropInvalid drops the series exceeding the label limits from the time
+// series. Their data is removed, so that they are skipped by the rest of the
+// ingest like the series dropped by the relabeling rules. Returns an error
+// reporting the dropped series, if any.
+func (l *ingestLimiter) dropInvalid(tts []prompb.TimeSeries) error {
+	var (
+		firstErr       error
+		droppedSeries  int
+		droppedSamples int
+	)
+	for i := range tts {
+		t := &tts[i]
+		if len(t.Samples) == 0 && len(t.Exemplars) == 0 {
+			continue
+		}
+		if err := l.checkLabels(t.Labels); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			droppedSeries++
+			droppedSamples += len(t.Samples)
+			t.Samples, t.Exemplars = nil, nil
+		}
+	}
+	if firstErr == nil {
+		return nil
+	}
+
+	reject(limitLabels, droppedSamples)
+	if droppedSeries > 1 {
+		return fmt.Errorf("%w, along with %d other series", firstErr, droppedSeries-1)
+	}
+	return firstErr
+}
+
+// check returns an error if the time series exceed the rate limits, or else
+// accounts for them.
+func (l *ingestLimiter) check(tts []prompb.TimeSeries) error {
+	usage := make(map[string]*keyUsage)
+	numSamples := 0
+	for i := range tts {
+		numSamples += len(tts[i].Samples)
+	}
+
+	for i := range tts {
+		t := &tts[i]
+		if len(t.Samples) == 0 && len(t.Exemplars) == 0 {
+			// nothing is inserted for the series, e.g. it was dropped by
+			// the relabeling rules.
+			continue
+		}
+		key := ""
+		for _, lbl := range t.Labels {
+			if lbl.Name == l.limits.Label {
+				key = lbl.Value
+				break
+			}
+		}
+		u, ok := usage[key]
+		if !ok {
+			u = &keyUsage{}
+			usage[key] = u
+		}
+		u.samples += int64(len(t.Samples))
+		if l.limits.MaxNewSeriesPerHour > 0 {
+			cached, err := l.scache.Contains(t.Labels)
+			if err != nil {
+				return err
+			}
+			if !cached {
+				u.uncached = append(u.uncached, t.Labels)
+			}
+		}
+	}
+
+	if err := l.resolveUncached(usage); err != nil {
+		return err
+	}
+	limit, err := l.admit(usage)
+	if err != nil {
+		reject(limit, numSamples)
+	}
+	return err
+}
+
+// resolveUncached looks up in the catalog the uncached series of the label
+// values which reached the new series limit, so that the series evicted from
+// the series cache, or not cached yet since a restart, do not count as new.
+// The uncached series of the other label values are admitted anyway, and so
+// are not looked up.
+func (l *ingestLimiter) resolveUncached(usage map[string]*keyUsage) error {
+	if l.limits.MaxNewSeriesPerHour <= 0 {
+		return nil
+	}
+
+	l.mtx.Lock()
+	now := l.now()
+	resolve := make([]*keyUsage, 0)
+	numSeries := 0
+	for key, u := range usage {
+		if len(u.uncached) > 0 && l.load(key, now).newSeries >= l.limits.MaxNewSeriesPerHour {
+			resolve = append(resolve, u)
+			numSeries += len(u.uncached)
+		}
+	}
+	l.mtx.Unlock()
+	if numSeries == 0 {
+		return nil
+	}
+
+	series := make([][]prompb.Label, 0, numSeries)
+	for _, u := range resolve {
+		series = append(series, u.uncached...)
+	}
+	exist, err := l.seriesExist(series)
+	if err != nil {
+		return err
+	}
+	for _, u := range resolve {
+		missing := u.uncached[:0]
+		for _, lbls := range u.uncached {
+			if !exist[0] {
+				missing = append(missing, lbls)
+			}
+			exist = exist[1:]
+		}
+		u.uncached = missing
+	}
+	return nil
+}
+
+func reject(limit string, numSamples int) {
+	metrics.IngestLimitRejectedRequests.WithLabelValues(limit).Inc()
+	metrics.IngestLimitRejectedSamples.WithLabelValues(limit).Add(float64(numSamples))
+}
+
+// checkLabels checks the number and the length of the labels of a series.
+func (l *ingestLimiter) checkLabels(lbls []prompb.Label) error {
+	if l.limits.MaxLabelsPerSeries > 0 && len(lbls) > l.limits.MaxLabelsPerSeries {
+		return fmt.Errorf("%w: a series %s has %d labels, the limit is %d", errors.ErrSeriesLimitExceeded, seriesName(lbls), len(lbls), l.limits.MaxLabelsPerSeries)
+	}
+	for _, lbl := range lbls {
+		if l.limits.MaxLabelNameLength > 0 && len(lbl.Name) > l.limits.MaxLabelNameLength {
+			return fmt.Errorf("%w: a series %s has a label name longer than %d", errors.ErrSeriesLimitExceeded, seriesName(lbls), l.limits.MaxLabelNameLength)
+		}
+		if l.limits.MaxLabelValueLength > 0 && len(lbl.Value) > l.limits.MaxLabelValueLength {
+			return fmt.Errorf("%w: a series %s has a label value longer than %d", errors.ErrSeriesLimitExceeded, seriesName(lbls), l.limits.MaxLabelValueLength)
+		}
+	}
+	return nil
+}
+
+// admit checks the usage of the rate limits of a request, and accounts for it
+// if all of them are within the limits. Returns the exceeded limit otherwise.
+func (l *ingestLimiter) admit(usage map[string]*keyUsage) (string, error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := l.now()
+	l.prune(now)
+	for key, u := range usage {
+		kl := l.load(key, now)
+		if l.limits.MaxNewSeriesPerHour > 0 && len(u.uncached) > 0 && kl.newSeries >= l.limits.MaxNewSeriesPerHour {
+			return limitNewSeries, fmt.Errorf("%w: %s=%q reached the limit of %d new series per hour", errors.ErrSeriesLimitExceeded, l.limits.Label, key, l.limits.MaxNewSeriesPerHour)
+		}
+		// a request larger than the bucket is accepted when the bucket is
+		// full, so that it can still make progress.
+		if l.limits.MaxSamplesPerSecond > 0 && float64(u.samples) > kl.tokens && kl.tokens < l.limits.MaxSamplesPerSecond {
+			return limitSampleRate, fmt.Errorf("%w: %s=%q would exceed the limit of %g samples per second", errors.ErrSampleRateExceeded, l.limits.Label, key, l.limits.MaxSamplesPerSecond)
+		}
+	}
+	for key, u := range usage {
+		l.keys[key].tokens -= float64(u.samples)
+	}
+	return "", nil
+}
+
+// seriesCreated accounts for a series created in the catalog in the new
+// series limit.
+func (l *ingestLimiter) seriesCreated(names, values []string) {
+	key := ""
+	for i, name := range names {
+		if name == l.limits.Label {
+			key = values[i]
+			break
+		}
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.load(key, l.now()).newSeries++
+}
+
+// load returns the up to date rate limits state of a label value. Must be
+// called with the lock held.
+func (l *ingestLimiter) load(key string, now time.Time) *keyLimits {
+	kl, ok := l.keys[key]
+	if !ok {
+		kl = &keyLimits{windowStart: now, tokens: l.limits.MaxSamplesPerSecond, lastRefill: now}
+		l.keys[key] = kl
+		return kl
+	}
+	if now.Sub(kl.windowStart) >= newSeriesWindow {
+		kl.windowStart, kl.newSeries = now, 0
+	}
+	kl.tokens += now.Sub(kl.lastRefill).Seconds() * l.limits.MaxSamplesPerSecond
+	if kl.tokens > l.limits.MaxSamplesPerSecond {
+		kl.tokens = l.limits.MaxSamplesPerSecond
+	}
+	kl.lastRefill = now
+	return kl
+}
+
+// prune forgets the label values which were not written to for a whole
+// window, their state being the same as the one of a new value. Must be
+// called with the lock held.
+func (l *ingestLimiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < newSeriesWindow {
+		return
+	}
+	for key, kl := range l.keys {
+		if now.Sub(kl.windowStart) >= newSeriesWindow && now.Sub(kl.lastRefill) >= time.Second {
+			delete(l.keys, key)
+		}
+	}
+	l.lastPrune = now
+}
+
+// seriesName describes a series in errors by its metric name, as its other
+// labels can be exceeding the limits.
+func seriesName(lbls []prompb.Label) string {
+	for _, lbl := range lbls {
+		if lbl.Name == model.MetricNameLabelName {
+			return fmt.Sprintf("of metric %q", lbl.Value)
+		}
+	}
+	return "without metric name"
+}
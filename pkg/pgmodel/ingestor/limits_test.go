@@ -0,0 +1,247 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package ingestor
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	pgmodelErrs "github.com/timescale/promscale/pkg/pgmodel/common/errors"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+func limitsTestSeries(metric string, numSeries, numSamples int, extraLabels ...prompb.Label) []prompb.TimeSeries {
+	tts := make([]prompb.TimeSeries, 0, numSeries)
+	for i := 0; i < numSeries; i++ {
+		lbls := []prompb.Label{
+			{Name: model.MetricNameLabelName, Value: metric},
+			{Name: "instance", Value: fmt.Sprint(i)},
+		}
+		tts = append(tts, prompb.TimeSeries{
+			Labels:  append(lbls, extraLabels...),
+			Samples: make([]prompb.Sample, numSamples),
+		})
+	}
+	return tts
+}
+
+func TestIngestLimiterLabels(t *testing.T) {
+	if newIngestLimiter(Limits{Label: "job"}, nil, nil) != nil {
+		t.Fatal("a limiter without limits must be disabled")
+	}
+
+	l := newIngestLimiter(Limits{MaxLabelsPerSeries: 3, MaxLabelNameLength: 10, MaxLabelValueLength: 10}, cache.NewSeriesCache(100), nil)
+	testCases := []struct {
+		name      string
+		tts       []prompb.TimeSeries
+		expectErr bool
+	}{
+		{
+			name: "within the limits",
+			tts:  limitsTestSeries("foo", 1, 1, prompb.Label{Name: "job", Value: "api"}),
+		},
+		{
+			name:      "too many labels",
+			tts:       limitsTestSeries("foo", 1, 1, prompb.Label{Name: "job", Value: "api"}, prompb.Label{Name: "env", Value: "prod"}),
+			expectErr: true,
+		},
+		{
+			name:      "label name too long",
+			tts:       limitsTestSeries("foo", 1, 1, prompb.Label{Name: "a_very_long_name", Value: "api"}),
+			expectErr: true,
+		},
+		{
+			name:      "label value too long",
+			tts:       limitsTestSeries("foo", 1, 1, prompb.Label{Name: "job", Value: strings.Repeat("a", 11)}),
+			expectErr: true,
+		},
+	}
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			// only the series exceeding the limits are dropped.
+			tts := append(limitsTestSeries("bar", 1, 1), c.tts...)
+			err := l.dropInvalid(tts)
+			if c.expectErr != errors.Is(err, pgmodelErrs.ErrSeriesLimitExceeded) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(tts[0].Samples) != 1 {
+				t.Fatal("a series within the limits was dropped")
+			}
+			if c.expectErr != (len(tts[1].Samples) == 0) {
+				t.Fatalf("unexpected samples of the checked series: %v", tts[1].Samples)
+			}
+		})
+	}
+}
+
+func TestIngestLimiterNewSeries(t *testing.T) {
+	scache := cache.NewSeriesCache(100)
+	l := newIngestLimiter(Limits{MaxNewSeriesPerHour: 3}, scache, nil)
+	now := time.Unix(1000, 0)
+	l.now = func() time.Time { return now }
+	// the series of the catalog, by metric and instance.
+	catalog := make(map[string]bool)
+	lookups := 0
+	l.seriesExist = func(series [][]prompb.Label) ([]bool, error) {
+		lookups++
+		exist := make([]bool, len(series))
+		for i, lbls := range series {
+			exist[i] = catalog[lbls[0].Value+"/"+lbls[1].Value]
+		}
+		return exist, nil
+	}
+	// create creates the next numSeries instances of the metric.
+	created := make(map[string]int)
+	create := func(metric string, numSeries int) {
+		for i := 0; i < numSeries; i++ {
+			instance := fmt.Sprint(created[metric])
+			created[metric]++
+			catalog[metric+"/"+instance] = true
+			l.seriesCreated([]string{model.MetricNameLabelName, "instance"}, []string{metric, instance})
+		}
+	}
+
+	// series missing from the cache only count once they are created.
+	if err := l.check(limitsTestSeries("foo", 5, 1)); err != nil {
+		t.Fatal(err)
+	}
+	create("foo", 2)
+	if err := l.check(limitsTestSeries("foo", 5, 1)); err != nil {
+		t.Fatal(err)
+	}
+	if lookups != 0 {
+		t.Fatalf("series were looked up below the limit: %d lookups", lookups)
+	}
+	create("foo", 1)
+	if err := l.check(limitsTestSeries("foo", 5, 1)); !errors.Is(err, pgmodelErrs.ErrSeriesLimitExceeded) {
+		t.Fatalf("expected the new series limit to be exceeded, got %v", err)
+	}
+	// the limits apply separately to every metric.
+	if err := l.check(limitsTestSeries("bar", 5, 1)); err != nil {
+		t.Fatal(err)
+	}
+
+	// cached series are not new.
+	for _, ts := range limitsTestSeries("foo", 2, 1) {
+		if _, _, err := scache.GetSeriesFromProtos(ts.Labels); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lookups = 0
+	if err := l.check(limitsTestSeries("foo", 2, 1)); err != nil {
+		t.Fatal(err)
+	}
+	if lookups != 0 {
+		t.Fatalf("cached series were looked up: %d lookups", lookups)
+	}
+
+	// neither are the series of the catalog missing from the cache, e.g.
+	// after they were evicted from it.
+	if err := l.check(limitsTestSeries("foo", 3, 1)); err != nil {
+		t.Fatal(err)
+	}
+	if lookups != 1 {
+		t.Fatalf("expected the uncached series to be looked up at once, got %d lookups", lookups)
+	}
+
+	now = now.Add(time.Hour)
+	if err := l.check(limitsTestSeries("foo", 5, 1)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIngestLimiterSampleRate(t *testing.T) {
+	l := newIngestLimiter(Limits{Label: "job", MaxSamplesPerSecond: 10}, cache.NewSeriesCache(100), nil)
+	now := time.Unix(1000, 0)
+	l.now = func() time.Time { return now }
+	job := prompb.Label{Name: "job", Value: "api"}
+
+	if err := l.check(limitsTestSeries("foo", 2, 3, job)); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.check(limitsTestSeries("bar", 2, 3, job)); !errors.Is(err, pgmodelErrs.ErrSampleRateExceeded) {
+		t.Fatalf("expected the sample rate to be exceeded, got %v", err)
+	}
+	// other values of the label are limited separately.
+	if err := l.check(limitsTestSeries("bar", 2, 3, prompb.Label{Name: "job", Value: "db"})); err != nil {
+		t.Fatal(err)
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	if err := l.check(limitsTestSeries("bar", 3, 3, job)); err != nil {
+		t.Fatal(err)
+	}
+
+	// requests larger than a second of samples are accepted when the
+	// bucket is full, and throttle the following ones.
+	now = now.Add(time.Minute)
+	if err := l.check(limitsTestSeries("foo", 10, 10, job)); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(time.Second)
+	if err := l.check(limitsTestSeries("foo", 1, 1, job)); err == nil {
+		t.Fatal("expected the sample rate to be exceeded")
+	}
+}
+
+func TestDBIngestorIngestLimitsRejected(t *testing.T) {
+	inserter := model.MockInserter{InsertedSeries: make(map[string]model.SeriesID)}
+	scache := cache.NewSeriesCache(100)
+	i := &DBIngestor{
+		db:     &inserter,
+		scache: scache,
+		limits: newIngestLimiter(Limits{MaxNewSeriesPerHour: 1}, scache, nil),
+	}
+	i.limits.seriesExist = func(series [][]prompb.Label) ([]bool, error) {
+		return make([]bool, len(series)), nil
+	}
+	i.limits.seriesCreated([]string{model.MetricNameLabelName}, []string{"foo"})
+
+	req := NewWriteRequest()
+	req.Timeseries = limitsTestSeries("foo", 2, 1)
+	if _, err := i.Ingest(req.Timeseries, req); !errors.Is(err, pgmodelErrs.ErrSeriesLimitExceeded) {
+		t.Fatalf("expected the new series limit to be exceeded, got %v", err)
+	}
+	if len(req.Timeseries) != 0 {
+		t.Fatalf("rejected request was not recycled")
+	}
+	if len(inserter.InsertedData) != 0 || scache.Len() != 0 {
+		t.Fatalf("rejected series were inserted: %v", inserter.InsertedData)
+	}
+	if _, err := i.Ingest(limitsTestSeries("bar", 1, 1), NewWriteRequest()); err != nil {
+		t.Fatal(err)
+	}
+	if len(inserter.InsertedData) != 1 {
+		t.Fatalf("unexpected inserted data: %v", inserter.InsertedData)
+	}
+}
+
+func TestDBIngestorIngestDropsInvalidSeries(t *testing.T) {
+	inserter := model.MockInserter{InsertedSeries: make(map[string]model.SeriesID)}
+	scache := cache.NewSeriesCache(100)
+	i := &DBIngestor{
+		db:     &inserter,
+		scache: scache,
+		limits: newIngestLimiter(Limits{MaxLabelsPerSeries: 2}, scache, nil),
+	}
+
+	tts := append(limitsTestSeries("foo", 2, 1), limitsTestSeries("bar", 1, 1, prompb.Label{Name: "job", Value: "api"})...)
+	rows, err := i.Ingest(tts, NewWriteRequest())
+	if !errors.Is(err, pgmodelErrs.ErrSeriesLimitExceeded) {
+		t.Fatalf("expected the series limit to be exceeded, got %v", err)
+	}
+	// the series within the limits are ingested nonetheless.
+	if rows != 2 {
+		t.Fatalf("unexpected number of rows inserted: got %d wanted 2", rows)
+	}
+	if len(inserter.InsertedData) != 1 || len(inserter.InsertedData[0]["foo"]) != 2 || len(inserter.InsertedData[0]["bar"]) != 0 {
+		t.Fatalf("unexpected inserted data: %v", inserter.InsertedData)
+	}
+}
@@ -0,0 +1,51 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package ingestor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+const insertMetadataSQL = `INSERT INTO ` + schema.Catalog + `.metadata (last_seen, metric_family, type, unit, help)
+	SELECT now(), * FROM unnest($1::TEXT[], $2::TEXT[], $3::TEXT[], $4::TEXT[]) a(f, t, u, h)
+	ON CONFLICT (metric_family) DO UPDATE SET type = EXCLUDED.type, unit = EXCLUDED.unit, help = EXCLUDED.help, last_seen = EXCLUDED.last_seen`
+
+// InsertMetadata upserts the metric metadata into the catalog. Only the last
+// metadata received for a metric family is kept.
+// returns the number of metric families inserted or updated.
+func (p *pgxInserter) InsertMetadata(metadata []model.Metadata) (uint64, error) {
+	if len(metadata) == 0 {
+		return 0, nil
+	}
+
+	// The same metric family can be scraped from multiple targets, but
+	// a single upsert statement cannot update the same row twice.
+	index := make(map[string]int, len(metadata))
+	families := make([]string, 0, len(metadata))
+	types := make([]string, 0, len(metadata))
+	units := make([]string, 0, len(metadata))
+	helps := make([]string, 0, len(metadata))
+	for _, md := range metadata {
+		if i, ok := index[md.MetricFamily]; ok {
+			types[i], units[i], helps[i] = md.Type, md.Unit, md.Help
+			continue
+		}
+		index[md.MetricFamily] = len(families)
+		families = append(families, md.MetricFamily)
+		types = append(types, md.Type)
+		units = append(units, md.Unit)
+		helps = append(helps, md.Help)
+	}
+
+	ct, err := p.conn.Exec(context.Background(), insertMetadataSQL, families, types, units, helps)
+	if err != nil {
+		return 0, fmt.Errorf("inserting metric metadata: %w", err)
+	}
+	return uint64(ct.RowsAffected()), nil
+}
@@ -0,0 +1,49 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package ingestor
+
+import (
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/relabel"
+	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/tenancy"
+)
+
+// relabelSeries applies the relabeling rules to the labels of the time series
+// in place. The data of the series dropped by the rules is removed, so that
+// they are skipped by the rest of the ingest. The tenant label is out of reach
+// of the rules, which cannot move a series out of its tenant.
+func relabelSeries(tts []prompb.TimeSeries, cfgs []*relabel.Config) {
+	lbls := make(labels.Labels, 0)
+	for i := range tts {
+		t := &tts[i]
+		lbls = lbls[:0]
+		tenant, hasTenant := "", false
+		for _, l := range t.Labels {
+			if l.Name == tenancy.LabelName {
+				tenant, hasTenant = l.Value, true
+				continue
+			}
+			lbls = append(lbls, labels.Label{Name: l.Name, Value: l.Value})
+		}
+
+		relabeled := relabel.Process(lbls, cfgs...)
+		if relabeled == nil {
+			t.Labels, t.Samples, t.Exemplars = nil, nil, nil
+			continue
+		}
+
+		t.Labels = t.Labels[:0]
+		for _, l := range relabeled {
+			if hasTenant && l.Name == tenancy.LabelName {
+				continue
+			}
+			t.Labels = append(t.Labels, prompb.Label{Name: l.Name, Value: l.Value})
+		}
+		if hasTenant {
+			t.Labels = append(t.Labels, prompb.Label{Name: tenancy.LabelName, Value: tenant})
+		}
+	}
+}
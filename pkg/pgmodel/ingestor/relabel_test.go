@@ -0,0 +1,150 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package ingestor
+
+import (
+	"reflect"
+	"testing"
+
+	promModel "github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/pkg/relabel"
+	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+func relabelTestConfig(cfg relabel.Config) *relabel.Config {
+	c := relabel.DefaultRelabelConfig
+	if cfg.Regex.Regexp != nil {
+		c.Regex = cfg.Regex
+	}
+	if cfg.Replacement != "" {
+		c.Replacement = cfg.Replacement
+	}
+	c.SourceLabels = cfg.SourceLabels
+	c.TargetLabel = cfg.TargetLabel
+	c.Modulus = cfg.Modulus
+	c.Action = cfg.Action
+	return &c
+}
+
+func TestRelabelSeries(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cfgs     []*relabel.Config
+		labels   []prompb.Label
+		expected []prompb.Label
+	}{
+		{
+			name: "drop",
+			cfgs: []*relabel.Config{relabelTestConfig(relabel.Config{
+				SourceLabels: promModel.LabelNames{"job"},
+				Regex:        relabel.MustNewRegexp("debug"),
+				Action:       relabel.Drop,
+			})},
+			labels: []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "job", Value: "debug"}},
+		},
+		{
+			name: "keep",
+			cfgs: []*relabel.Config{relabelTestConfig(relabel.Config{
+				SourceLabels: promModel.LabelNames{"job"},
+				Regex:        relabel.MustNewRegexp("api"),
+				Action:       relabel.Keep,
+			})},
+			labels:   []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "job", Value: "api"}},
+			expected: []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "job", Value: "api"}},
+		},
+		{
+			name: "replace and labeldrop",
+			cfgs: []*relabel.Config{
+				relabelTestConfig(relabel.Config{
+					SourceLabels: promModel.LabelNames{"pod"},
+					Regex:        relabel.MustNewRegexp("(.*)-[0-9]+"),
+					TargetLabel:  "deployment",
+					Action:       relabel.Replace,
+				}),
+				relabelTestConfig(relabel.Config{
+					Regex:  relabel.MustNewRegexp("pod"),
+					Action: relabel.LabelDrop,
+				}),
+			},
+			labels:   []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "pod", Value: "web-1"}},
+			expected: []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "deployment", Value: "web"}},
+		},
+		{
+			name: "hashmod",
+			cfgs: []*relabel.Config{relabelTestConfig(relabel.Config{
+				SourceLabels: promModel.LabelNames{"instance"},
+				TargetLabel:  "shard",
+				Modulus:      1,
+				Action:       relabel.HashMod,
+			})},
+			labels:   []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "instance", Value: "a"}},
+			expected: []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "instance", Value: "a"}, {Name: "shard", Value: "0"}},
+		},
+		{
+			name: "tenant label kept",
+			cfgs: []*relabel.Config{
+				relabelTestConfig(relabel.Config{
+					Regex:  relabel.MustNewRegexp("__tenant__|job"),
+					Action: relabel.LabelDrop,
+				}),
+				relabelTestConfig(relabel.Config{
+					Regex:  relabel.MustNewRegexp("__name__"),
+					Action: relabel.LabelKeep,
+				}),
+			},
+			labels:   []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "job", Value: "api"}, {Name: "__tenant__", Value: "a"}},
+			expected: []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "__tenant__", Value: "a"}},
+		},
+		{
+			name: "tenant label not replaced",
+			cfgs: []*relabel.Config{relabelTestConfig(relabel.Config{
+				SourceLabels: promModel.LabelNames{"job"},
+				TargetLabel:  "__tenant__",
+				Action:       relabel.Replace,
+			})},
+			labels:   []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "__tenant__", Value: "a"}, {Name: "job", Value: "b"}},
+			expected: []prompb.Label{{Name: "__name__", Value: "up"}, {Name: "job", Value: "b"}, {Name: "__tenant__", Value: "a"}},
+		},
+	}
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			tts := []prompb.TimeSeries{{Labels: c.labels, Samples: make([]prompb.Sample, 1)}}
+			relabelSeries(tts, c.cfgs)
+
+			if !reflect.DeepEqual(tts[0].Labels, c.expected) {
+				t.Errorf("unexpected labels: got %v wanted %v", tts[0].Labels, c.expected)
+			}
+			if dropped := len(tts[0].Samples) == 0; dropped != (c.expected == nil) {
+				t.Errorf("unexpected samples after relabeling: %v", tts[0].Samples)
+			}
+		})
+	}
+}
+
+func TestDBIngestorIngestRelabeled(t *testing.T) {
+	inserter := model.MockInserter{InsertedSeries: make(map[string]model.SeriesID)}
+	scache := cache.NewSeriesCache(100)
+	i := &DBIngestor{
+		db:     &inserter,
+		scache: scache,
+		relabel: []*relabel.Config{relabelTestConfig(relabel.Config{
+			SourceLabels: promModel.LabelNames{"instance"},
+			Regex:        relabel.MustNewRegexp("0"),
+			Action:       relabel.Drop,
+		})},
+		// the dropped series must not count as new series.
+		limits: newIngestLimiter(Limits{MaxNewSeriesPerHour: 1}, scache, nil),
+	}
+
+	rows, err := i.Ingest(limitsTestSeries("foo", 2, 1), NewWriteRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows != 1 || len(inserter.InsertedData) != 1 || len(inserter.InsertedData[0]["foo"]) != 1 {
+		t.Fatalf("unexpected inserted data: %v", inserter.InsertedData)
+	}
+}
@@ -0,0 +1,402 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package ingestor
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+const (
+	// DefaultWALSegmentSize is the size after which a new WAL segment is started.
+	DefaultWALSegmentSize = 64 * 1024 * 1024
+
+	// every record starts with the length and the checksum of its payload.
+	walRecordHeaderSize = 8
+	// directory of the WAL where the segments with corrupted records are
+	// moved to.
+	walQuarantineDir = "corrupted"
+)
+
+var walCastagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// wal is the write-ahead log of the requests accepted while asynchronous acks
+// are enabled. Requests are appended to numbered segment files before being
+// acked, and a segment is removed once all of its requests were inserted.
+// Segments left over from a previous run are replayed on startup; replaying
+// a request twice is harmless as inserts ignore duplicate samples.
+//
+// The records are synced to disk by group commit: the records appended while
+// a sync is in progress are synced together by the next one, so that
+// concurrent requests share the cost of a sync instead of waiting for each
+// other's.
+type wal struct {
+	dir         string
+	segmentSize int64
+
+	mtx        sync.Mutex
+	active     *os.File
+	activeSeg  int
+	activeSize int64
+	// syncing is set while the active segment is synced without the lock,
+	// during which it must not be closed.
+	syncing  bool
+	syncDone *sync.Cond
+	// pending is the group of the records appended since the last sync.
+	pending *walSyncGroup
+	// number of records per segment that were not inserted yet.
+	unconfirmed map[int]int
+	// segments found when opening the WAL, which need to be replayed.
+	leftover []int
+}
+
+// walRecord references a record appended to the WAL.
+type walRecord struct {
+	segment int
+}
+
+// walSyncGroup is a group of records synced to disk together.
+type walSyncGroup struct {
+	synced bool
+	err    error
+}
+
+// openWAL opens the WAL in dir, creating the directory if needed. New records
+// are appended to a new segment, after the ones left over by a previous run.
+func openWAL(dir string, segmentSize int64) (*wal, error) {
+	if segmentSize <= 0 {
+		segmentSize = DefaultWALSegmentSize
+	}
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, fmt.Errorf("creating WAL directory: %w", err)
+	}
+	leftover, err := listWALSegments(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &wal{
+		dir:         dir,
+		segmentSize: segmentSize,
+		unconfirmed: make(map[int]int),
+		leftover:    leftover,
+	}
+	w.syncDone = sync.NewCond(&w.mtx)
+	next := 0
+	if len(leftover) > 0 {
+		next = leftover[len(leftover)-1] + 1
+	}
+	if err = w.openSegment(next); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// listWALSegments returns the indexes of the segments in dir, in order.
+func listWALSegments(dir string) ([]int, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("listing WAL segments: %w", err)
+	}
+	segments := make([]int, 0, len(files))
+	for _, f := range files {
+		seg, err := strconv.Atoi(f.Name())
+		if err != nil || f.IsDir() {
+			continue
+		}
+		segments = append(segments, seg)
+	}
+	sort.Ints(segments)
+	return segments, nil
+}
+
+func (w *wal) segmentPath(seg int) string {
+	return filepath.Join(w.dir, fmt.Sprintf("%08d", seg))
+}
+
+func (w *wal) openSegment(seg int) error {
+	f, err := os.OpenFile(w.segmentPath(seg), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0640)
+	if err != nil {
+		return fmt.Errorf("creating WAL segment: %w", err)
+	}
+	w.active, w.activeSeg, w.activeSize = f, seg, 0
+	return nil
+}
+
+// log durably appends the time series and the metadata of a request to the
+// WAL. The returned
+// record must be confirmed once the time series were inserted. The record is
+// written under the lock, but synced to disk without it, along with the
+// records of the concurrent requests.
+func (w *wal) log(tts []prompb.TimeSeries, metadata []prompb.MetricMetadata) (walRecord, error) {
+	wr := prompb.WriteRequest{Timeseries: tts, Metadata: metadata}
+	data, err := wr.Marshal()
+	if err != nil {
+		return walRecord{}, fmt.Errorf("encoding WAL record: %w", err)
+	}
+	payload := snappy.Encode(nil, data)
+	buf := make([]byte, walRecordHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(buf[4:8], crc32.Checksum(payload, walCastagnoli))
+	copy(buf[walRecordHeaderSize:], payload)
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	for w.activeSize > 0 && w.activeSize+int64(len(buf)) > w.segmentSize {
+		if w.syncing {
+			w.syncDone.Wait()
+			continue
+		}
+		if err = w.rotate(); err != nil {
+			return walRecord{}, err
+		}
+	}
+	if _, err = w.active.Write(buf); err != nil {
+		return walRecord{}, fmt.Errorf("writing WAL record: %w", err)
+	}
+	w.activeSize += int64(len(buf))
+	// the record is counted before it is synced, so that its segment is not
+	// removed in the meantime.
+	record := walRecord{segment: w.activeSeg}
+	w.unconfirmed[record.segment]++
+
+	if w.pending == nil {
+		w.pending = &walSyncGroup{}
+	}
+	group := w.pending
+	for !group.synced {
+		if w.syncing {
+			w.syncDone.Wait()
+			continue
+		}
+		w.syncPending()
+	}
+	if group.err != nil {
+		w.unconfirm(record)
+		return walRecord{}, group.err
+	}
+	return record, nil
+}
+
+// syncPending syncs the pending group of records to disk, releasing the lock
+// while the active segment is synced. Must be called with the lock held and
+// no sync in progress.
+func (w *wal) syncPending() {
+	group, f := w.pending, w.active
+	w.pending, w.syncing = nil, true
+	w.mtx.Unlock()
+	err := f.Sync()
+	w.mtx.Lock()
+	w.syncing = false
+	w.finishSync(group, err)
+}
+
+// finishSync wakes the requests waiting for a group of records to be synced.
+func (w *wal) finishSync(group *walSyncGroup, err error) {
+	if group == nil {
+		return
+	}
+	group.synced = true
+	if err != nil {
+		group.err = fmt.Errorf("syncing WAL segment: %w", err)
+	}
+	w.syncDone.Broadcast()
+}
+
+// rotate closes the active segment and starts the next one, syncing the
+// pending records of the active segment first. Must be called with the lock
+// held and no sync in progress.
+func (w *wal) rotate() error {
+	group := w.pending
+	w.pending = nil
+	err := w.active.Sync()
+	w.finishSync(group, err)
+	if err != nil {
+		return fmt.Errorf("syncing WAL segment: %w", err)
+	}
+	if err = w.active.Close(); err != nil {
+		return fmt.Errorf("closing WAL segment: %w", err)
+	}
+	closed := w.activeSeg
+	if err := w.openSegment(closed + 1); err != nil {
+		return err
+	}
+	if w.unconfirmed[closed] == 0 {
+		w.removeSegment(closed)
+	}
+	return nil
+}
+
+// confirm marks a record as inserted, removing its segment if it is no
+// longer needed.
+func (w *wal) confirm(r walRecord) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	w.unconfirm(r)
+}
+
+// unconfirm removes a record from the records which were not inserted yet.
+// Must be called with the lock held.
+func (w *wal) unconfirm(r walRecord) {
+	w.unconfirmed[r.segment]--
+	if w.unconfirmed[r.segment] <= 0 && r.segment != w.activeSeg {
+		w.removeSegment(r.segment)
+	}
+}
+
+func (w *wal) removeSegment(seg int) {
+	delete(w.unconfirmed, seg)
+	if err := os.Remove(w.segmentPath(seg)); err != nil && !os.IsNotExist(err) {
+		log.Warn("msg", "error removing WAL segment", "segment", seg, "err", err)
+	}
+}
+
+// replay passes the requests of the segments left over by a previous run to
+// insert, in order. A segment is removed once all of its requests were
+// inserted, the others are kept to be replayed again on the next start.
+// Records which are corrupted or cannot be decoded are skipped, as they would
+// fail on every start; their segment is moved to the quarantine directory
+// instead of being removed so that they can be inspected.
+func (w *wal) replay(insert func(*prompb.WriteRequest) error) {
+	for _, seg := range w.leftover {
+		numRecords := 0
+		failed := false
+		corrupt, err := readWALSegment(w.segmentPath(seg), func(data []byte) error {
+			wr := NewWriteRequest()
+			if err := wr.Unmarshal(data); err != nil {
+				return fmt.Errorf("decoding WAL record: %w", err)
+			}
+			numRecords++
+			if err := insert(wr); err != nil {
+				log.Error("msg", "error replaying WAL record, it will be retried on the next start", "segment", seg, "err", err)
+				failed = true
+			}
+			return nil
+		})
+		if err != nil {
+			log.Error("msg", "error reading WAL segment", "segment", seg, "err", err)
+			failed = true
+		}
+		if failed {
+			continue
+		}
+		log.Info("msg", "replayed WAL segment", "segment", seg, "requests", numRecords, "corrupted", corrupt)
+		w.mtx.Lock()
+		if corrupt > 0 {
+			w.quarantineSegment(seg)
+		} else {
+			w.removeSegment(seg)
+		}
+		w.mtx.Unlock()
+	}
+	w.leftover = nil
+}
+
+// quarantineSegment moves a segment with corrupted records out of the WAL.
+// Must be called with the lock held.
+func (w *wal) quarantineSegment(seg int) {
+	delete(w.unconfirmed, seg)
+	dir := filepath.Join(w.dir, walQuarantineDir)
+	err := os.MkdirAll(dir, 0750)
+	if err == nil {
+		err = os.Rename(w.segmentPath(seg), filepath.Join(dir, filepath.Base(w.segmentPath(seg))))
+	}
+	if err != nil {
+		log.Warn("msg", "error quarantining WAL segment, removing it", "segment", seg, "err", err)
+		w.removeSegment(seg)
+		return
+	}
+	log.Warn("msg", "WAL segment with corrupted records quarantined", "segment", seg, "dir", dir)
+}
+
+// readWALSegment passes the decoded payload of every record in the segment
+// to handle, and returns the number of corrupted records which were skipped:
+// records failing their checksum, or which handle fails to decode. A record
+// torn by a crash while it was written ends the segment, as does a length
+// past the end of the segment since the records after it cannot be found.
+func readWALSegment(path string, handle func([]byte) error) (corrupt int, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	remaining := info.Size()
+
+	r := bufio.NewReader(f)
+	header := make([]byte, walRecordHeaderSize)
+	for {
+		if _, err = io.ReadFull(r, header); err != nil {
+			if err == io.EOF {
+				return corrupt, nil
+			}
+			if err == io.ErrUnexpectedEOF {
+				log.Warn("msg", "WAL segment ends with a torn record", "path", path)
+				return corrupt, nil
+			}
+			return corrupt, err
+		}
+		remaining -= walRecordHeaderSize
+		length := int64(binary.BigEndian.Uint32(header[0:4]))
+		if length > remaining {
+			log.Warn("msg", "WAL segment ends with a torn or corrupted record", "path", path)
+			return corrupt, nil
+		}
+		payload := make([]byte, length)
+		if _, err = io.ReadFull(r, payload); err != nil {
+			return corrupt, err
+		}
+		remaining -= length
+
+		if crc32.Checksum(payload, walCastagnoli) != binary.BigEndian.Uint32(header[4:8]) {
+			log.Warn("msg", "skipping corrupted WAL record", "path", path)
+			corrupt++
+			continue
+		}
+		data, err := snappy.Decode(nil, payload)
+		if err == nil {
+			err = handle(data)
+		}
+		if err != nil {
+			log.Warn("msg", "skipping undecodable WAL record", "path", path, "err", err)
+			corrupt++
+		}
+	}
+}
+
+// close closes the active segment, removing it if all of its records were
+// inserted.
+func (w *wal) close() {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	for w.syncing {
+		w.syncDone.Wait()
+	}
+	if err := w.active.Close(); err != nil {
+		log.Warn("msg", "error closing WAL segment", "err", err)
+	}
+	if w.unconfirmed[w.activeSeg] <= 0 {
+		w.removeSegment(w.activeSeg)
+	}
+}
@@ -0,0 +1,382 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package ingestor
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+func walTestSeries(value float64) []prompb.TimeSeries {
+	return []prompb.TimeSeries{
+		{
+			Labels: []prompb.Label{
+				{Name: model.MetricNameLabelName, Value: "test"},
+				{Name: "foo", Value: "bar"},
+			},
+			Samples: []prompb.Sample{{Timestamp: 1, Value: value}},
+		},
+	}
+}
+
+func walSegments(t *testing.T, dir string) []int {
+	segments, err := listWALSegments(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return segments
+}
+
+func TestWALSegments(t *testing.T) {
+	dir, err := ioutil.TempDir("", "promscale-wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// a segment size that fits a single record.
+	w, err := openWAL(dir, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, err := w.log(walTestSeries(1), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := w.log(walTestSeries(2), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.segment != 0 || second.segment != 1 {
+		t.Fatalf("unexpected segments: %d, %d", first.segment, second.segment)
+	}
+	if !reflect.DeepEqual(walSegments(t, dir), []int{0, 1}) {
+		t.Fatalf("unexpected segment files: %v", walSegments(t, dir))
+	}
+
+	// confirming all the records of a closed segment removes it.
+	w.confirm(first)
+	if !reflect.DeepEqual(walSegments(t, dir), []int{1}) {
+		t.Fatalf("unexpected segment files: %v", walSegments(t, dir))
+	}
+
+	// the unconfirmed active segment is kept for the next start.
+	w.close()
+	if !reflect.DeepEqual(walSegments(t, dir), []int{1}) {
+		t.Fatalf("unexpected segment files: %v", walSegments(t, dir))
+	}
+
+	w, err = openWAL(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.activeSeg != 2 {
+		t.Errorf("new records must be appended after the leftover segments, got segment %d", w.activeSeg)
+	}
+	w.close()
+}
+
+func TestWALReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "promscale-wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w, err := openWAL(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err = w.log(walTestSeries(float64(i)), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w.close()
+
+	// a record torn by a crash ends the segment.
+	f, err := os.OpenFile(filepath.Join(dir, "00000000"), os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.Write([]byte{0, 0, 1}); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	replay := func(insertErr error) []float64 {
+		w, err := openWAL(dir, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer w.close()
+
+		var values []float64
+		w.replay(func(req *prompb.WriteRequest) error {
+			values = append(values, req.Timeseries[0].Samples[0].Value)
+			FinishWriteRequest(req)
+			return insertErr
+		})
+		return values
+	}
+
+	// failed replays keep the segment for the next start.
+	if values := replay(fmt.Errorf("some error")); !reflect.DeepEqual(values, []float64{0, 1, 2}) {
+		t.Fatalf("unexpected replayed values: %v", values)
+	}
+	if !reflect.DeepEqual(walSegments(t, dir), []int{0}) {
+		t.Fatalf("unexpected segment files: %v", walSegments(t, dir))
+	}
+
+	if values := replay(nil); !reflect.DeepEqual(values, []float64{0, 1, 2}) {
+		t.Fatalf("unexpected replayed values: %v", values)
+	}
+	if segments := walSegments(t, dir); len(segments) != 0 {
+		t.Fatalf("replayed segments must be removed, got %v", segments)
+	}
+}
+
+func TestWALReplayCorruptedRecords(t *testing.T) {
+	dir, err := ioutil.TempDir("", "promscale-wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w, err := openWAL(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err = w.log(walTestSeries(float64(i)), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w.close()
+
+	path := filepath.Join(dir, "00000000")
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// flip a byte of the payload of the first record, failing its checksum.
+	data[walRecordHeaderSize] ^= 0xff
+	// a record with a valid checksum which is not a write request.
+	payload := snappy.Encode(nil, []byte{0xff})
+	header := make([]byte, walRecordHeaderSize)
+	binary.BigEndian.PutUint32(header[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(header[4:8], crc32.Checksum(payload, walCastagnoli))
+	data = append(append(data, header...), payload...)
+	if err = ioutil.WriteFile(path, data, 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err = openWAL(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var values []float64
+	w.replay(func(req *prompb.WriteRequest) error {
+		values = append(values, req.Timeseries[0].Samples[0].Value)
+		FinishWriteRequest(req)
+		return nil
+	})
+	w.close()
+
+	if !reflect.DeepEqual(values, []float64{1, 2}) {
+		t.Fatalf("unexpected replayed values: %v", values)
+	}
+	if segments := walSegments(t, dir); len(segments) != 0 {
+		t.Fatalf("segments with corrupted records must not be replayed again, got %v", segments)
+	}
+	if _, err = os.Stat(filepath.Join(dir, walQuarantineDir, "00000000")); err != nil {
+		t.Fatalf("segment with corrupted records not quarantined: %v", err)
+	}
+}
+
+type mockAckingInserter struct {
+	model.MockInserter
+	ackErr error
+}
+
+func (m *mockAckingInserter) insertDataWithAck(rows map[string][]model.Samples, ack func(error)) (uint64, error) {
+	n, err := m.InsertData(rows)
+	ack(m.ackErr)
+	return n, err
+}
+
+func TestDBIngestorIngestWAL(t *testing.T) {
+	dir, err := ioutil.TempDir("", "promscale-wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	newIngestor := func(ackErr error) (*DBIngestor, *mockAckingInserter) {
+		w, err := openWAL(dir, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inserter := &mockAckingInserter{
+			MockInserter: model.MockInserter{InsertedSeries: make(map[string]model.SeriesID)},
+			ackErr:       ackErr,
+		}
+		return &DBIngestor{db: inserter, scache: cache.NewSeriesCache(100), wal: w, ackInserter: inserter}, inserter
+	}
+
+	// the insert fails after the request was acked.
+	i, _ := newIngestor(fmt.Errorf("some error"))
+	req := NewWriteRequest()
+	req.Metadata = []prompb.MetricMetadata{{Type: prompb.MetricMetadata_GAUGE, MetricFamilyName: "test"}}
+	if _, err = i.Ingest(walTestSeries(1), req); err != nil {
+		t.Fatal(err)
+	}
+	i.Close()
+	if segments := walSegments(t, dir); len(segments) != 1 {
+		t.Fatalf("the failed request must be kept in the WAL, got segments %v", segments)
+	}
+
+	// the request is inserted again on the next start.
+	i, inserter := newIngestor(nil)
+	i.replayWAL()
+	if len(inserter.InsertedData) != 1 || inserter.InsertedData[0]["test"][0].CountSamples() != 1 {
+		t.Fatalf("unexpected replayed data: %v", inserter.InsertedData)
+	}
+	if len(inserter.InsertedMetadata) != 1 || inserter.InsertedMetadata[0].MetricFamily != "test" {
+		t.Fatalf("unexpected replayed metadata: %v", inserter.InsertedMetadata)
+	}
+	if _, err = i.Ingest(walTestSeries(2), NewWriteRequest()); err != nil {
+		t.Fatal(err)
+	}
+	i.Close()
+	if segments := walSegments(t, dir); len(segments) != 0 {
+		t.Fatalf("inserted requests must be removed from the WAL, got segments %v", segments)
+	}
+}
+
+func TestDBIngestorIngestWALError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "promscale-wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w, err := openWAL(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// writing to the closed segment fails.
+	w.active.Close()
+	inserter := &mockAckingInserter{MockInserter: model.MockInserter{InsertedSeries: make(map[string]model.SeriesID)}}
+	i := &DBIngestor{db: inserter, scache: cache.NewSeriesCache(100), wal: w, ackInserter: inserter}
+
+	req := NewWriteRequest()
+	req.Timeseries = walTestSeries(1)
+	if _, err = i.Ingest(req.Timeseries, req); err == nil {
+		t.Fatal("expected the request to fail")
+	}
+	if len(req.Timeseries) != 0 {
+		t.Fatalf("failed request was not recycled")
+	}
+	if len(inserter.InsertedData) != 0 {
+		t.Fatalf("unexpected inserted data: %v", inserter.InsertedData)
+	}
+}
+
+func TestWALConcurrentLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "promscale-wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// a small segment size, so that the segments are rotated while other
+	// records are synced.
+	w, err := openWAL(dir, 512)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const numRecords = 100
+	var wg sync.WaitGroup
+	errs := make(chan error, numRecords)
+	for i := 0; i < numRecords; i++ {
+		wg.Add(1)
+		go func(value float64) {
+			defer wg.Done()
+			if _, err := w.log(walTestSeries(value), nil); err != nil {
+				errs <- err
+			}
+		}(float64(i))
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+	w.close()
+
+	w, err = openWAL(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.close()
+	var values []float64
+	w.replay(func(req *prompb.WriteRequest) error {
+		values = append(values, req.Timeseries[0].Samples[0].Value)
+		FinishWriteRequest(req)
+		return nil
+	})
+	sort.Float64s(values)
+	if len(values) != numRecords {
+		t.Fatalf("unexpected number of replayed records: got %d, wanted %d", len(values), numRecords)
+	}
+	for i, v := range values {
+		if v != float64(i) {
+			t.Fatalf("unexpected replayed values: %v", values)
+		}
+	}
+}
+
+func BenchmarkWALLog(b *testing.B) {
+	dir, err := ioutil.TempDir("", "promscale-wal")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w, err := openWAL(dir, 0)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer w.close()
+	tts := walTestSeries(1)
+
+	// the concurrent requests share the syncs of the WAL.
+	b.SetParallelism(16)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			r, err := w.log(tts, nil)
+			if err != nil {
+				b.Error(err)
+				return
+			}
+			w.confirm(r)
+		}
+	})
+}
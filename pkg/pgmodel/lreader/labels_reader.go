@@ -9,10 +9,14 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/jackc/pgtype"
 	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
 	"github.com/timescale/promscale/pkg/log"
 	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	"github.com/timescale/promscale/pkg/pgmodel/common/clauses"
 	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
 	"github.com/timescale/promscale/pkg/pgxconn"
 	"github.com/timescale/promscale/pkg/prompb"
 )
@@ -21,6 +25,25 @@ const (
 	getLabelNamesSQL  = "SELECT distinct key from " + schema.Catalog + ".label"
 	getLabelValuesSQL = "SELECT value from " + schema.Catalog + ".label WHERE key = $1"
 	getLabelsSQL      = "SELECT (labels_info($1::int[])).*"
+
+	getSeriesLabelNamesSQL  = "SELECT distinct key from " + schema.Catalog + ".label WHERE id IN (SELECT unnest(labels) FROM " + schema.Catalog + ".series WHERE id = ANY($1::bigint[]))"
+	getSeriesLabelValuesSQL = "SELECT value from " + schema.Catalog + ".label WHERE key = $1 AND id IN (SELECT unnest(labels) FROM " + schema.Catalog + ".series WHERE id = ANY($2::bigint[]))"
+
+	// Without matchers, the label names and values are those of the series
+	// of the metrics with samples in the time range, which only reads the
+	// chunks overlapping it.
+	getTimeRangeLabelNamesSQL  = "SELECT distinct key from " + schema.Catalog + ".label WHERE id IN (SELECT unnest(labels) FROM " + schema.Catalog + ".series WHERE metric_id IN (SELECT " + schema.Catalog + ".get_metric_ids_in_time_range($1, $2)))"
+	getTimeRangeLabelValuesSQL = "SELECT value from " + schema.Catalog + ".label WHERE key = $1 AND id IN (SELECT unnest(labels) FROM " + schema.Catalog + ".series WHERE metric_id IN (SELECT " + schema.Catalog + ".get_metric_ids_in_time_range($2, $3)))"
+
+	// getSeriesInTimeRangeSQL filters the supplied series IDs down to those
+	// having samples in the time range, with a query per metric run within
+	// the database.
+	getSeriesInTimeRangeSQL = "SELECT " + schema.Catalog + ".get_series_ids_in_time_range($1::bigint[], $2, $3)"
+)
+
+var (
+	minTimeMs = timestamp.FromTime(model.MinTime)
+	maxTimeMs = timestamp.FromTime(model.MaxTime)
 )
 
 // LabelsReader defines the methods for accessing labels data
@@ -29,6 +52,16 @@ type LabelsReader interface {
 	LabelNames() ([]string, error)
 	// LabelValues returns all the distinct values for a given label name.
 	LabelValues(labelName string) ([]string, error)
+	// MatchingLabelNames returns the distinct label names of the series
+	// matching the label matchers that have samples between mint and maxt.
+	// Without matchers, the label names of the series of the metrics with
+	// samples between mint and maxt are returned.
+	MatchingLabelNames(mint, maxt int64, matchers ...*labels.Matcher) ([]string, error)
+	// MatchingLabelValues returns the distinct values for a given label name
+	// of the series matching the label matchers that have samples between
+	// mint and maxt. Without matchers, the values of the series of the
+	// metrics with samples between mint and maxt are returned.
+	MatchingLabelValues(labelName string, mint, maxt int64, matchers ...*labels.Matcher) ([]string, error)
 	// PrompbLabelsForIds returns protobuf representation of the label names
 	// and values for supplied IDs.
 	PrompbLabelsForIds(ids []int64) (lls []prompb.Label, err error)
@@ -48,14 +81,65 @@ type labelsReader struct {
 // LabelValues implements the LabelsReader interface. It returns all distinct values
 // for a specified label name.
 func (lr *labelsReader) LabelValues(labelName string) ([]string, error) {
-	rows, err := lr.conn.Query(context.Background(), getLabelValuesSQL, labelName)
+	return lr.queryStrings(getLabelValuesSQL, labelName)
+}
+
+// LabelNames implements the LabelReader interface. It returns all distinct
+// label names available in the database.
+func (lr *labelsReader) LabelNames() ([]string, error) {
+	return lr.queryStrings(getLabelNamesSQL)
+}
+
+// MatchingLabelNames implements the LabelsReader interface. It returns the
+// distinct label names of the series matching the matchers with samples in
+// the time range.
+func (lr *labelsReader) MatchingLabelNames(mint, maxt int64, matchers ...*labels.Matcher) ([]string, error) {
+	if len(matchers) == 0 {
+		if isUnboundedRange(mint, maxt) {
+			return lr.LabelNames()
+		}
+		start, end := timeRangeArgs(mint, maxt)
+		return lr.queryStrings(getTimeRangeLabelNamesSQL, start, end)
+	}
+
+	seriesIDs, err := lr.matchingSeriesIDs(mint, maxt, matchers)
+	if err != nil {
+		return nil, err
+	}
+
+	return lr.queryStrings(getSeriesLabelNamesSQL, seriesIDs)
+}
+
+// MatchingLabelValues implements the LabelsReader interface. It returns the
+// distinct values for a label name of the series matching the matchers with
+// samples in the time range.
+func (lr *labelsReader) MatchingLabelValues(labelName string, mint, maxt int64, matchers ...*labels.Matcher) ([]string, error) {
+	if len(matchers) == 0 {
+		if isUnboundedRange(mint, maxt) {
+			return lr.LabelValues(labelName)
+		}
+		start, end := timeRangeArgs(mint, maxt)
+		return lr.queryStrings(getTimeRangeLabelValuesSQL, labelName, start, end)
+	}
+
+	seriesIDs, err := lr.matchingSeriesIDs(mint, maxt, matchers)
+	if err != nil {
+		return nil, err
+	}
+
+	return lr.queryStrings(getSeriesLabelValuesSQL, labelName, seriesIDs)
+}
+
+// queryStrings returns the sorted single column result of the query.
+func (lr *labelsReader) queryStrings(query string, args ...interface{}) ([]string, error) {
+	rows, err := lr.conn.Query(context.Background(), query, args...)
 	if err != nil {
 		return nil, err
 	}
 
 	defer rows.Close()
 
-	labelValues := make([]string, 0)
+	result := make([]string, 0)
 
 	for rows.Next() {
 		var value string
@@ -63,36 +147,58 @@ func (lr *labelsReader) LabelValues(labelName string) ([]string, error) {
 			return nil, err
 		}
 
-		labelValues = append(labelValues, value)
+		result = append(result, value)
 	}
 
-	sort.Strings(labelValues)
-	return labelValues, nil
+	sort.Strings(result)
+	return result, nil
 }
 
-// LabelNames implements the LabelReader interface. It returns all distinct
-// label names available in the database.
-func (lr *labelsReader) LabelNames() ([]string, error) {
-	rows, err := lr.conn.Query(context.Background(), getLabelNamesSQL)
+// matchingSeriesIDs returns the IDs of the series matching the matchers that
+// have samples in the time range.
+func (lr *labelsReader) matchingSeriesIDs(mint, maxt int64, matchers []*labels.Matcher) ([]int64, error) {
+	builder, err := clauses.BuildSubQueries(matchers)
+	if err != nil {
+		return nil, err
+	}
+	cases, values, err := builder.Build(true)
 	if err != nil {
 		return nil, err
 	}
 
-	defer rows.Close()
-
-	labelNames := make([]string, 0)
+	rows, err := lr.conn.Query(context.Background(), clauses.BuildMetricNameSeriesIDQuery(cases), values...)
+	if err != nil {
+		return nil, err
+	}
+	_, seriesIDs, err := clauses.GetSeriesPerMetric(rows)
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
 
-	for rows.Next() {
-		var labelName string
-		if err := rows.Scan(&labelName); err != nil {
-			return nil, err
+	result := make([]int64, 0)
+	for _, ids := range seriesIDs {
+		for _, id := range ids {
+			result = append(result, int64(id))
 		}
-
-		labelNames = append(labelNames, labelName)
 	}
+	if isUnboundedRange(mint, maxt) || len(result) == 0 {
+		return result, nil
+	}
+
+	start, end := timeRangeArgs(mint, maxt)
+	err = lr.conn.QueryRow(context.Background(), getSeriesInTimeRangeSQL, result, start, end).Scan(&result)
+	return result, err
+}
+
+// timeRangeArgs returns the query arguments of the bounds of the time range.
+func timeRangeArgs(mint, maxt int64) (pgtype.Timestamptz, pgtype.Timestamptz) {
+	return model.TimeToTimestamptz(timestamp.Time(mint)), model.TimeToTimestamptz(timestamp.Time(maxt))
+}
 
-	sort.Strings(labelNames)
-	return labelNames, nil
+// isUnboundedRange returns true if the time range covers all possible samples.
+func isUnboundedRange(mint, maxt int64) bool {
+	return mint <= minTimeMs && maxt >= maxTimeMs
 }
 
 // PrompbLabelsForIds returns protobuf representation of the label sets for
@@ -10,6 +10,9 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/jackc/pgtype"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
 	"github.com/timescale/promscale/pkg/pgmodel/model"
 )
 
@@ -186,3 +189,177 @@ func TestLabelsReaderLabelsValues(t *testing.T) {
 		})
 	}
 }
+
+func TestLabelsReaderMatchingLabels(t *testing.T) {
+	seriesQuery := model.SqlQuery{
+		Sql: `SELECT m.metric_name, array_agg(s.id)
+		FROM _prom_catalog.series s
+		INNER JOIN _prom_catalog.metric m
+		ON (m.id = s.metric_id)
+		WHERE labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)
+		GROUP BY m.metric_name
+		ORDER BY m.metric_name`,
+		Args:    []interface{}{"__name__", "foo"},
+		Results: model.RowResults{{"foo", []int64{1, 2}}},
+	}
+	timeRangeQuery := model.SqlQuery{
+		Sql: "SELECT _prom_catalog.get_series_ids_in_time_range($1::bigint[], $2, $3)",
+		Args: []interface{}{
+			[]int64{1, 2},
+			pgtype.Timestamptz{Time: timestamp.Time(1000), Status: pgtype.Present},
+			pgtype.Timestamptz{Status: pgtype.Present, InfinityModifier: pgtype.Infinity},
+		},
+		Results: model.RowResults{{[]int64{2}}},
+	}
+	matchers := []*labels.Matcher{labels.MustNewMatcher(labels.MatchEqual, "__name__", "foo")}
+	minTime, maxTime := timestamp.FromTime(model.MinTime), timestamp.FromTime(model.MaxTime)
+
+	testCases := []struct {
+		name        string
+		labelName   string
+		mint, maxt  int64
+		matchers    []*labels.Matcher
+		expectedRes []string
+		sqlQueries  []model.SqlQuery
+	}{
+		{
+			name:        "Label names, no matchers or time range",
+			mint:        minTime,
+			maxt:        maxTime,
+			expectedRes: []string{"a", "b"},
+			sqlQueries: []model.SqlQuery{
+				{
+					Sql:     "SELECT distinct key from _prom_catalog.label",
+					Results: model.RowResults{{"b"}, {"a"}},
+				},
+			},
+		},
+		{
+			name:        "Label names, time range without matchers",
+			mint:        1000,
+			maxt:        maxTime,
+			expectedRes: []string{"a", "b"},
+			sqlQueries: []model.SqlQuery{
+				{
+					Sql: "SELECT distinct key from _prom_catalog.label WHERE id IN (SELECT unnest(labels) FROM _prom_catalog.series WHERE metric_id IN (SELECT _prom_catalog.get_metric_ids_in_time_range($1, $2)))",
+					Args: []interface{}{
+						pgtype.Timestamptz{Time: timestamp.Time(1000), Status: pgtype.Present},
+						pgtype.Timestamptz{Status: pgtype.Present, InfinityModifier: pgtype.Infinity},
+					},
+					Results: model.RowResults{{"b"}, {"a"}},
+				},
+			},
+		},
+		{
+			name:        "Label values, time range without matchers",
+			labelName:   "a",
+			mint:        1000,
+			maxt:        maxTime,
+			expectedRes: []string{"x", "y"},
+			sqlQueries: []model.SqlQuery{
+				{
+					Sql: "SELECT value from _prom_catalog.label WHERE key = $1 AND id IN (SELECT unnest(labels) FROM _prom_catalog.series WHERE metric_id IN (SELECT _prom_catalog.get_metric_ids_in_time_range($2, $3)))",
+					Args: []interface{}{
+						"a",
+						pgtype.Timestamptz{Time: timestamp.Time(1000), Status: pgtype.Present},
+						pgtype.Timestamptz{Status: pgtype.Present, InfinityModifier: pgtype.Infinity},
+					},
+					Results: model.RowResults{{"y"}, {"x"}},
+				},
+			},
+		},
+		{
+			name:        "Label names, matchers",
+			mint:        minTime,
+			maxt:        maxTime,
+			matchers:    matchers,
+			expectedRes: []string{"__name__", "a"},
+			sqlQueries: []model.SqlQuery{
+				seriesQuery,
+				{
+					Sql:     "SELECT distinct key from _prom_catalog.label WHERE id IN (SELECT unnest(labels) FROM _prom_catalog.series WHERE id = ANY($1::bigint[]))",
+					Args:    []interface{}{[]int64{1, 2}},
+					Results: model.RowResults{{"a"}, {"__name__"}},
+				},
+			},
+		},
+		{
+			name:        "Label names, matchers and time range",
+			mint:        1000,
+			maxt:        maxTime,
+			matchers:    matchers,
+			expectedRes: []string{"__name__"},
+			sqlQueries: append([]model.SqlQuery{seriesQuery, timeRangeQuery},
+				model.SqlQuery{
+					Sql:     "SELECT distinct key from _prom_catalog.label WHERE id IN (SELECT unnest(labels) FROM _prom_catalog.series WHERE id = ANY($1::bigint[]))",
+					Args:    []interface{}{[]int64{2}},
+					Results: model.RowResults{{"__name__"}},
+				},
+			),
+		},
+		{
+			// the series of all the metrics are scoped by a single query.
+			name:        "Label names, matchers of several metrics and time range",
+			mint:        1000,
+			maxt:        maxTime,
+			matchers:    matchers,
+			expectedRes: []string{"__name__"},
+			sqlQueries: []model.SqlQuery{
+				{
+					Sql:     seriesQuery.Sql,
+					Args:    seriesQuery.Args,
+					Results: model.RowResults{{"bar", []int64{3}}, {"foo", []int64{1, 2}}},
+				},
+				{
+					Sql:     timeRangeQuery.Sql,
+					Args:    append([]interface{}{[]int64{3, 1, 2}}, timeRangeQuery.Args[1:]...),
+					Results: model.RowResults{{[]int64{3, 2}}},
+				},
+				{
+					Sql:     "SELECT distinct key from _prom_catalog.label WHERE id IN (SELECT unnest(labels) FROM _prom_catalog.series WHERE id = ANY($1::bigint[]))",
+					Args:    []interface{}{[]int64{3, 2}},
+					Results: model.RowResults{{"__name__"}},
+				},
+			},
+		},
+		{
+			name:        "Label values, matchers and time range",
+			labelName:   "a",
+			mint:        1000,
+			maxt:        maxTime,
+			matchers:    matchers,
+			expectedRes: []string{"x", "y"},
+			sqlQueries: append([]model.SqlQuery{seriesQuery, timeRangeQuery},
+				model.SqlQuery{
+					Sql:     "SELECT value from _prom_catalog.label WHERE key = $1 AND id IN (SELECT unnest(labels) FROM _prom_catalog.series WHERE id = ANY($2::bigint[]))",
+					Args:    []interface{}{"a", []int64{2}},
+					Results: model.RowResults{{"y"}, {"x"}},
+				},
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := model.NewSqlRecorder(tc.sqlQueries, t)
+			reader := labelsReader{conn: mock}
+
+			var (
+				res []string
+				err error
+			)
+			if tc.labelName == "" {
+				res, err = reader.MatchingLabelNames(tc.mint, tc.maxt, tc.matchers...)
+			} else {
+				res, err = reader.MatchingLabelValues(tc.labelName, tc.mint, tc.maxt, tc.matchers...)
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(tc.expectedRes, res) {
+				t.Errorf("expected: %v, got: %v", tc.expectedRes, res)
+			}
+		})
+	}
+}
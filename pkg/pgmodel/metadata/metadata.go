@@ -0,0 +1,58 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgxconn"
+)
+
+const getMetricMetadataSQL = "SELECT metric_family, coalesce(type, ''), coalesce(unit, ''), coalesce(help, '') FROM " + schema.Prom + ".get_metric_metadata($1) LIMIT $2"
+
+// Reader reads the metric metadata stored in the catalog.
+type Reader interface {
+	// MetricMetadata returns the metadata of the given metric, or of all
+	// metrics if metric is empty, keyed by metric family name. A limit <= 0
+	// returns metadata of all matching metrics.
+	MetricMetadata(metric string, limit int) (map[string][]model.Metadata, error)
+}
+
+// MetricQuery returns the metadata of the requested metric families from
+// the catalog.
+func MetricQuery(conn pgxconn.PgxConn, metric string, limit int) (map[string][]model.Metadata, error) {
+	var (
+		metricArg interface{}
+		limitArg  interface{}
+	)
+	if metric != "" {
+		metricArg = metric
+	}
+	if limit > 0 {
+		limitArg = int64(limit)
+	}
+
+	rows, err := conn.Query(context.Background(), getMetricMetadataSQL, metricArg, limitArg)
+	if err != nil {
+		return nil, fmt.Errorf("querying metric metadata: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string][]model.Metadata)
+	for rows.Next() {
+		var md model.Metadata
+		if err := rows.Scan(&md.MetricFamily, &md.Type, &md.Unit, &md.Help); err != nil {
+			return nil, fmt.Errorf("scanning metric metadata: %w", err)
+		}
+		result[md.MetricFamily] = append(result[md.MetricFamily], md)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading metric metadata: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,97 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package metadata
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+func TestMetricQuery(t *testing.T) {
+	const sql = "SELECT metric_family, coalesce(type, ''), coalesce(unit, ''), coalesce(help, '') FROM prom_api.get_metric_metadata($1) LIMIT $2"
+	testCases := []struct {
+		name        string
+		metric      string
+		limit       int
+		sqlQueries  []model.SqlQuery
+		expectedRes map[string][]model.Metadata
+		expectErr   bool
+	}{
+		{
+			name: "Error on query",
+			sqlQueries: []model.SqlQuery{
+				{
+					Sql:  sql,
+					Args: []interface{}{nil, nil},
+					Err:  fmt.Errorf("some error"),
+				},
+			},
+			expectErr: true,
+		}, {
+			name: "All metrics",
+			sqlQueries: []model.SqlQuery{
+				{
+					Sql:  sql,
+					Args: []interface{}{nil, nil},
+					Results: model.RowResults{
+						{"http_requests_total", "counter", "", "Total number of HTTP requests."},
+						{"memory_usage", "gauge", "bytes", ""},
+					},
+				},
+			},
+			expectedRes: map[string][]model.Metadata{
+				"http_requests_total": {{MetricFamily: "http_requests_total", Type: "counter", Help: "Total number of HTTP requests."}},
+				"memory_usage":        {{MetricFamily: "memory_usage", Type: "gauge", Unit: "bytes"}},
+			},
+		}, {
+			name:   "Single metric with limit",
+			metric: "memory_usage",
+			limit:  1,
+			sqlQueries: []model.SqlQuery{
+				{
+					Sql:     sql,
+					Args:    []interface{}{"memory_usage", int64(1)},
+					Results: model.RowResults{{"memory_usage", "gauge", "bytes", ""}},
+				},
+			},
+			expectedRes: map[string][]model.Metadata{
+				"memory_usage": {{MetricFamily: "memory_usage", Type: "gauge", Unit: "bytes"}},
+			},
+		}, {
+			name:   "Unknown metric",
+			metric: "unknown",
+			sqlQueries: []model.SqlQuery{
+				{
+					Sql:     sql,
+					Args:    []interface{}{"unknown", nil},
+					Results: model.RowResults{},
+				},
+			},
+			expectedRes: map[string][]model.Metadata{},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			mock := model.NewSqlRecorder(c.sqlQueries, t)
+			res, err := MetricQuery(mock, c.metric, c.limit)
+			if c.expectErr {
+				if err == nil {
+					t.Fatal("expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(res, c.expectedRes) {
+				t.Errorf("unexpected result:\ngot\n%+v\nwanted\n%+v", res, c.expectedRes)
+			}
+		})
+	}
+}
@@ -68,6 +68,18 @@ var (
 			Buckets:   prometheus.DefBuckets,
 		},
 	)
+	IngestLimitRejectedRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "ingest_limit_rejected_requests_total",
+			Help:      "Total number of write requests rejected by the ingest limits, by limit.",
+		}, []string{"limit"})
+	IngestLimitRejectedSamples = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "ingest_limit_rejected_samples_total",
+			Help:      "Total number of samples rejected by the ingest limits, by limit.",
+		}, []string{"limit"})
 )
 
 func init() {
@@ -80,5 +92,7 @@ func init() {
 		NumInsertsPerBatch,
 		NumRowsPerBatch,
 		DbBatchInsertDuration,
+		IngestLimitRejectedRequests,
+		IngestLimitRejectedSamples,
 	)
 }
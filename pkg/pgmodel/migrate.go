@@ -44,6 +44,7 @@ var (
 		"idempotent": {
 			"base.sql",
 			"matcher-functions.sql",
+			"promql-functions.sql",
 		},
 	}
 	migrateMutex = &sync.Mutex{}
@@ -374,6 +375,8 @@ func replaceSchemaNames(r io.ReadCloser) (string, error) {
 	s = strings.ReplaceAll(s, "SCHEMA_SERIES", schema.SeriesView)
 	s = strings.ReplaceAll(s, "SCHEMA_METRIC", schema.MetricView)
 	s = strings.ReplaceAll(s, "SCHEMA_DATA_SERIES", schema.DataSeries)
+	s = strings.ReplaceAll(s, "SCHEMA_DATA_EXEMPLAR", schema.DataExemplar)
+	s = strings.ReplaceAll(s, "SCHEMA_DATA_DOWNSAMPLE", schema.DataDownsample)
 	s = strings.ReplaceAll(s, "SCHEMA_DATA", schema.Data)
 	s = strings.ReplaceAll(s, "SCHEMA_INFO", schema.Info)
 	return s, err
@@ -28,6 +28,7 @@ var (
 // inserter is responsible for inserting label, series and data into the storage.
 type Inserter interface {
 	InsertNewData(rows map[string][]Samples) (uint64, error)
+	InsertMetadata(metadata []Metadata) (uint64, error)
 	CompleteMetricCreation() error
 	Close()
 }
@@ -57,6 +58,19 @@ func MetricTableName(conn pgxconn.PgxConn, metric string) (string, bool, error)
 	return tableName, possiblyNew, nil
 }
 
+// TimeToTimestamptz converts a time to a timestamptz, using infinity for the
+// times outside of [MinTime, MaxTime] at millisecond precision.
+func TimeToTimestamptz(t time.Time) pgtype.Timestamptz {
+	switch {
+	case !t.After(MinTime):
+		return pgtype.Timestamptz{Status: pgtype.Present, InfinityModifier: pgtype.NegativeInfinity}
+	case !t.Before(MaxTime.Truncate(time.Millisecond)):
+		return pgtype.Timestamptz{Status: pgtype.Present, InfinityModifier: pgtype.Infinity}
+	default:
+		return pgtype.Timestamptz{Time: t, Status: pgtype.Present}
+	}
+}
+
 func TimestamptzToMs(t pgtype.Timestamptz) int64 {
 	switch t.InfinityModifier {
 	case pgtype.NegativeInfinity:
@@ -0,0 +1,31 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package model
+
+import (
+	"strings"
+
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+// Metadata holds the TYPE, UNIT and HELP of a metric family.
+type Metadata struct {
+	MetricFamily string `json:"-"`
+	Type         string `json:"type"`
+	Unit         string `json:"unit"`
+	Help         string `json:"help"`
+}
+
+// NewMetadataFromProto converts the protobuf metadata sent in a remote-write
+// request. The metric type is lower-cased to match the values returned by
+// the Prometheus metadata API (e.g. "counter", "gaugehistogram").
+func NewMetadataFromProto(md prompb.MetricMetadata) Metadata {
+	return Metadata{
+		MetricFamily: md.MetricFamilyName,
+		Type:         strings.ToLower(md.Type.String()),
+		Unit:         md.Unit,
+		Help:         md.Help,
+	}
+}
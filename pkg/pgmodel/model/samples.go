@@ -5,6 +5,7 @@
 package model
 
 import (
+	"encoding/json"
 	"math"
 	"time"
 
@@ -16,15 +17,24 @@ type Samples interface {
 	GetSeries() *Series
 	CountSamples() int
 	getSample(int) *prompb.Sample
+	CountExemplars() int
+	getExemplar(int) *prompb.Exemplar
 }
 
 type promSample struct {
-	series  *Series
-	samples []prompb.Sample
+	series    *Series
+	samples   []prompb.Sample
+	exemplars []prompb.Exemplar
 }
 
 func NewPromSample(series *Series, samples []prompb.Sample) *promSample {
-	return &promSample{series, samples}
+	return &promSample{series: series, samples: samples}
+}
+
+// NewPromSampleWithExemplars creates the samples of a series along with the
+// exemplars sent for it.
+func NewPromSampleWithExemplars(series *Series, samples []prompb.Sample, exemplars []prompb.Exemplar) *promSample {
+	return &promSample{series: series, samples: samples, exemplars: exemplars}
 }
 
 func (t *promSample) GetSeries() *Series {
@@ -39,6 +49,14 @@ func (t *promSample) getSample(index int) *prompb.Sample {
 	return &t.samples[index]
 }
 
+func (t *promSample) CountExemplars() int {
+	return len(t.exemplars)
+}
+
+func (t *promSample) getExemplar(index int) *prompb.Exemplar {
+	return &t.exemplars[index]
+}
+
 // SamplesBatch is an iterator over a collection of sampleInfos that returns
 // data in the format expected for the data table row.
 type SamplesBatch struct {
@@ -81,6 +99,49 @@ func (t *SamplesBatch) CountSamples() int {
 	return c
 }
 
+func (t *SamplesBatch) CountExemplars() int {
+	c := 0
+	for i := range t.seriesSamples {
+		c += t.seriesSamples[i].CountExemplars()
+	}
+	return c
+}
+
+// Exemplars flattens the exemplars of the batch into the columns of the
+// exemplar table rows. The exemplar labels are encoded as JSON objects.
+func (t *SamplesBatch) Exemplars() (times []time.Time, vals []float64, series []int64, lbls []string, err error) {
+	numRows := t.CountExemplars()
+	times = make([]time.Time, 0, numRows)
+	vals = make([]float64, 0, numRows)
+	series = make([]int64, 0, numRows)
+	lbls = make([]string, 0, numRows)
+	for _, info := range t.seriesSamples {
+		if info.CountExemplars() == 0 {
+			continue
+		}
+		sid, _, err := info.GetSeries().GetSeriesID()
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		for i := 0; i < info.CountExemplars(); i++ {
+			exemplar := info.getExemplar(i)
+			labelSet := make(map[string]string, len(exemplar.Labels))
+			for _, l := range exemplar.Labels {
+				labelSet[l.Name] = l.Value
+			}
+			encoded, err := json.Marshal(labelSet)
+			if err != nil {
+				return nil, nil, nil, nil, err
+			}
+			times = append(times, model.Time(exemplar.Timestamp).Time())
+			vals = append(vals, exemplar.Value)
+			series = append(series, int64(sid))
+			lbls = append(lbls, string(encoded))
+		}
+	}
+	return times, vals, series, lbls, nil
+}
+
 //Append adds a sample info to the back of the iterator
 func (t *SamplesBatch) Append(s Samples) {
 	t.seriesSamples = append(t.seriesSamples, s)
@@ -102,7 +163,8 @@ func (t *SamplesBatch) ResetPosition() {
 // has occurred it returns false.
 func (t *SamplesBatch) Next() bool {
 	t.sampleIndex++
-	if t.seriesIndex < len(t.seriesSamples) && t.sampleIndex >= t.seriesSamples[t.seriesIndex].CountSamples() {
+	// series may only carry exemplars, so skip all of those without samples
+	for t.seriesIndex < len(t.seriesSamples) && t.sampleIndex >= t.seriesSamples[t.seriesIndex].CountSamples() {
 		t.seriesIndex++
 		t.sampleIndex = 0
 	}
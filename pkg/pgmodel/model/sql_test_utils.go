@@ -107,6 +107,12 @@ func (r *SqlRecorder) SendBatch(ctx context.Context, b pgxconn.PgxBatch) (pgx.Ba
 	return &MockBatchResult{queries: r.queries[start:r.nextQuery]}, nil
 }
 
+// Begin starts a mock transaction, whose queries are checked against the
+// recorded queries like any other.
+func (r *SqlRecorder) Begin(ctx context.Context) (pgx.Tx, error) {
+	return &MockTx{recorder: r}, nil
+}
+
 func (r *SqlRecorder) checkQuery(sql string, args ...interface{}) (RowResults, error) {
 	idx := r.nextQuery
 	if idx >= len(r.queries) {
@@ -129,6 +135,33 @@ func (r *SqlRecorder) checkQuery(sql string, args ...interface{}) (RowResults, e
 	return row.Results, row.Err
 }
 
+// MockTx is a transaction running its queries on a SqlRecorder. Only the
+// methods used by the code under test are implemented.
+type MockTx struct {
+	pgx.Tx
+	recorder *SqlRecorder
+}
+
+func (t *MockTx) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	return t.recorder.Exec(ctx, sql, arguments...)
+}
+
+func (t *MockTx) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return t.recorder.Query(ctx, sql, args...)
+}
+
+func (t *MockTx) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return t.recorder.QueryRow(ctx, sql, args...)
+}
+
+func (t *MockTx) Commit(ctx context.Context) error {
+	return nil
+}
+
+func (t *MockTx) Rollback(ctx context.Context) error {
+	return nil
+}
+
 type batchItem struct {
 	query     string
 	arguments []interface{}
@@ -300,6 +333,10 @@ func (m *MockRows) Scan(dest ...interface{}) error {
 			if d, ok := dest[i].(*time.Time); ok {
 				*d = s
 			}
+		case []byte:
+			if d, ok := dest[i].(*[]byte); ok {
+				*d = s
+			}
 		case float64:
 			if _, ok := dest[i].(float64); !ok {
 				return fmt.Errorf("wrong value type float64")
@@ -308,12 +345,12 @@ func (m *MockRows) Scan(dest ...interface{}) error {
 			dvp := reflect.Indirect(dv)
 			dvp.SetFloat(float64(m.results[m.idx][i].(float64)))
 		case int:
-			if _, ok := dest[i].(int); !ok {
+			if _, ok := dest[i].(*int); !ok {
 				return fmt.Errorf("wrong value type int")
 			}
 			dv := reflect.ValueOf(dest[i])
 			dvp := reflect.Indirect(dv)
-			dvp.SetInt(int64(m.results[m.idx][i].(int32)))
+			dvp.SetInt(int64(m.results[m.idx][i].(int)))
 		case int32:
 			if _, ok := dest[i].(int32); !ok {
 				return fmt.Errorf("wrong value type int32")
@@ -345,6 +382,10 @@ func (m *MockRows) Scan(dest ...interface{}) error {
 			dv := reflect.ValueOf(dest[i])
 			dvp := reflect.Indirect(dv)
 			dvp.SetString(m.results[m.idx][i].(string))
+		case bool:
+			if d, ok := dest[i].(*bool); ok {
+				*d = s
+			}
 		}
 	}
 
@@ -395,10 +436,12 @@ func (m *MockMetricCache) Set(metric string, tableName string) error {
 }
 
 type MockInserter struct {
-	InsertedSeries  map[string]SeriesID
-	InsertedData    []map[string][]Samples
-	InsertSeriesErr error
-	InsertDataErr   error
+	InsertedSeries    map[string]SeriesID
+	InsertedData      []map[string][]Samples
+	InsertedMetadata  []Metadata
+	InsertSeriesErr   error
+	InsertDataErr     error
+	InsertMetadataErr error
 }
 
 func (m *MockInserter) Close() {
@@ -409,6 +452,14 @@ func (m *MockInserter) InsertNewData(rows map[string][]Samples) (uint64, error)
 	return m.InsertData(rows)
 }
 
+func (m *MockInserter) InsertMetadata(metadata []Metadata) (uint64, error) {
+	if m.InsertMetadataErr != nil {
+		return 0, m.InsertMetadataErr
+	}
+	m.InsertedMetadata = append(m.InsertedMetadata, metadata...)
+	return uint64(len(metadata)), nil
+}
+
 func (m *MockInserter) CompleteMetricCreation() error {
 	return nil
 }
@@ -0,0 +1,156 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/timescale/promscale/pkg/pgxconn"
+)
+
+const (
+	// cursorMinRange is the time range from which the result rows of a query
+	// are fetched through a cursor, a batch at a time, instead of being sent
+	// all at once by the database.
+	cursorMinRange = 24 * time.Hour
+
+	// cursorFetchSize is the number of result rows, i.e. series, fetched from
+	// a cursor at a time.
+	cursorFetchSize = 100
+
+	declareCursorSQL = "DECLARE promscale_query NO SCROLL CURSOR FOR "
+	fetchCursorSQL   = "FETCH %d FROM promscale_query"
+)
+
+// tsRows is the part of pgx.Rows used to read the result rows of a query.
+type tsRows interface {
+	Next() bool
+	Scan(dest ...interface{}) error
+	Err() error
+	Close()
+}
+
+// lazyRows runs its query on the first call to Next, so that it only takes a
+// connection from the pool once its rows are read.
+type lazyRows struct {
+	// open runs the query, returning nil rows if there are no results.
+	open func() (tsRows, error)
+	rows tsRows
+	err  error
+}
+
+var _ tsRows = (*lazyRows)(nil)
+
+func (l *lazyRows) Next() bool {
+	if l.open != nil {
+		l.rows, l.err = l.open()
+		l.open = nil
+	}
+	return l.err == nil && l.rows != nil && l.rows.Next()
+}
+
+func (l *lazyRows) Scan(dest ...interface{}) error {
+	return l.rows.Scan(dest...)
+}
+
+func (l *lazyRows) Err() error {
+	if l.err != nil || l.rows == nil {
+		return l.err
+	}
+	return l.rows.Err()
+}
+
+// Close closes the rows if the query ran, or else makes sure it never runs.
+func (l *lazyRows) Close() {
+	l.open = nil
+	if l.rows != nil {
+		l.rows.Close()
+	}
+}
+
+// cursorRows reads the result rows of a query from a cursor, fetching them a
+// batch at a time as they are consumed. The cursor lives in a transaction of
+// its own, which holds on to a connection until the rows are closed.
+type cursorRows struct {
+	tx      pgx.Tx
+	batch   pgx.Rows
+	fetched int
+	err     error
+}
+
+var _ tsRows = (*cursorRows)(nil)
+
+// openCursor declares a cursor for the query and fetches its first batch.
+func openCursor(conn pgxconn.PgxConn, sqlQuery string, values ...interface{}) (*cursorRows, error) {
+	tx, err := conn.Begin(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	c := &cursorRows{tx: tx}
+	if _, err = tx.Exec(context.Background(), declareCursorSQL+sqlQuery, values...); err != nil {
+		c.Close()
+		return nil, err
+	}
+	if err = c.fetch(); err != nil {
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *cursorRows) fetch() error {
+	c.fetched = 0
+	batch, err := c.tx.Query(context.Background(), fmt.Sprintf(fetchCursorSQL, cursorFetchSize))
+	if err != nil {
+		return err
+	}
+	c.batch = batch
+	return nil
+}
+
+// Next advances to the next row, fetching the next batch from the cursor once
+// the current one is exhausted.
+func (c *cursorRows) Next() bool {
+	for c.err == nil && c.batch != nil {
+		if c.batch.Next() {
+			c.fetched++
+			return true
+		}
+		c.err = c.batch.Err()
+		c.batch.Close()
+		c.batch = nil
+		// A short batch is the last one.
+		if c.err != nil || c.fetched < cursorFetchSize {
+			return false
+		}
+		c.err = c.fetch()
+	}
+	return false
+}
+
+func (c *cursorRows) Scan(dest ...interface{}) error {
+	return c.batch.Scan(dest...)
+}
+
+func (c *cursorRows) Err() error {
+	return c.err
+}
+
+// Close closes the cursor along with its transaction, releasing the
+// connection. It is safe to call Close more than once.
+func (c *cursorRows) Close() {
+	if c.batch != nil {
+		c.batch.Close()
+		c.batch = nil
+	}
+	if c.tx != nil {
+		// The transaction only read, there is nothing to commit.
+		_ = c.tx.Rollback(context.Background())
+		c.tx = nil
+	}
+}
@@ -0,0 +1,90 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+func TestCursorRows(t *testing.T) {
+	fullBatch := make(model.RowResults, cursorFetchSize)
+	for i := range fullBatch {
+		fullBatch[i] = []interface{}{i}
+	}
+	fetchSQL := fmt.Sprintf(fetchCursorSQL, cursorFetchSize)
+
+	testCases := []struct {
+		name     string
+		queries  []model.SqlQuery
+		expected int
+		err      error
+	}{
+		{
+			name: "single batch",
+			queries: []model.SqlQuery{
+				{Sql: declareCursorSQL + "SELECT 1 WHERE $1", Args: []interface{}{true}},
+				{Sql: fetchSQL, Results: model.RowResults{{1}, {2}}},
+			},
+			expected: 2,
+		},
+		{
+			name: "several batches",
+			queries: []model.SqlQuery{
+				{Sql: declareCursorSQL + "SELECT 1 WHERE $1", Args: []interface{}{true}},
+				{Sql: fetchSQL, Results: fullBatch},
+				{Sql: fetchSQL, Results: model.RowResults{{1}}},
+			},
+			expected: cursorFetchSize + 1,
+		},
+		{
+			name: "full last batch",
+			queries: []model.SqlQuery{
+				{Sql: declareCursorSQL + "SELECT 1 WHERE $1", Args: []interface{}{true}},
+				{Sql: fetchSQL, Results: fullBatch},
+				{Sql: fetchSQL},
+			},
+			expected: cursorFetchSize,
+		},
+		{
+			name: "fetch error",
+			queries: []model.SqlQuery{
+				{Sql: declareCursorSQL + "SELECT 1 WHERE $1", Args: []interface{}{true}},
+				{Sql: fetchSQL, Results: fullBatch},
+				{Sql: fetchSQL, Err: fmt.Errorf("some error")},
+			},
+			expected: cursorFetchSize,
+			err:      fmt.Errorf("some error"),
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			mock := model.NewSqlRecorder(c.queries, t)
+			rows, err := openCursor(mock, "SELECT 1 WHERE $1", true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rows.Close()
+
+			count := 0
+			for rows.Next() {
+				var v int
+				if err := rows.Scan(&v); err != nil {
+					t.Fatal(err)
+				}
+				count++
+			}
+			if count != c.expected {
+				t.Errorf("unexpected number of rows: got %d wanted %d", count, c.expected)
+			}
+			if fmt.Sprint(rows.Err()) != fmt.Sprint(c.err) {
+				t.Errorf("unexpected error: got %v wanted %v", rows.Err(), c.err)
+			}
+		})
+	}
+}
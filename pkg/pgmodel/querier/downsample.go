@@ -0,0 +1,155 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
+)
+
+const (
+	getMetricDownsamplingSQL = "SELECT resolution_ms, view_name FROM " + schema.Catalog + ".get_metric_downsampling($1)"
+
+	// downsamplingCacheTTL is how long the downsampling resolutions of a
+	// metric are cached, so that resolutions added or removed with
+	// prom_api.add_downsampling and prom_api.remove_downsampling are
+	// picked up without a restart.
+	downsamplingCacheTTL = time.Minute
+
+	// defaultLookback is the lookback delta of the PromQL engine, within
+	// which an instant vector selector finds a sample.
+	defaultLookback = 5 * time.Minute
+)
+
+// downsampleResolution is a continuous aggregate of a metric at a resolution.
+type downsampleResolution struct {
+	resolution int64 // In milliseconds.
+	viewName   string
+}
+
+// downsampleView is the continuous aggregate a query reads, and the
+// aggregated column it reads as the value of the samples.
+type downsampleView struct {
+	downsampleResolution
+	column string
+}
+
+type downsamplingEntry struct {
+	resolutions []downsampleResolution
+	expires     time.Time
+}
+
+// downsamplingCache caches the downsampling resolutions of the metrics.
+type downsamplingCache struct {
+	mu      sync.Mutex
+	entries map[string]downsamplingEntry
+	ttl     time.Duration
+}
+
+func newDownsamplingCache(ttl time.Duration) *downsamplingCache {
+	return &downsamplingCache{
+		entries: make(map[string]downsamplingEntry),
+		ttl:     ttl,
+	}
+}
+
+func (c *downsamplingCache) get(metric string, now time.Time) ([]downsampleResolution, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[metric]
+	if !ok || now.After(e.expires) {
+		return nil, false
+	}
+	return e.resolutions, true
+}
+
+func (c *downsamplingCache) set(metric string, resolutions []downsampleResolution, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[metric] = downsamplingEntry{resolutions: resolutions, expires: now.Add(c.ttl)}
+}
+
+// getDownsampling returns the downsampling resolutions of a metric, ordered
+// from the finest to the coarsest.
+func (q *pgxQuerier) getDownsampling(metric string) ([]downsampleResolution, error) {
+	now := time.Now()
+	if resolutions, ok := q.downsampling.get(metric, now); ok {
+		return resolutions, nil
+	}
+
+	rows, err := q.conn.Query(context.Background(), getMetricDownsamplingSQL, metric)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var resolutions []downsampleResolution
+	for rows.Next() {
+		var r downsampleResolution
+		if err := rows.Scan(&r.resolution, &r.viewName); err != nil {
+			return nil, err
+		}
+		resolutions = append(resolutions, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	q.downsampling.set(metric, resolutions, now)
+	return resolutions, nil
+}
+
+// chooseDownsampling returns the coarsest resolution a query with the given
+// hints can read instead of the raw samples, or nil if there is none. A
+// resolution is never coarser than the query step, so every step still sees
+// its own samples, and must keep enough samples in the range of the function
+// applied to the selector for it to give a meaningful result. Counter
+// functions never read a resolution, as they would miss the counter resets
+// within the buckets.
+func chooseDownsampling(resolutions []downsampleResolution, hints *storage.SelectHints) *downsampleView {
+	if hints == nil || hints.Step <= 0 {
+		return nil
+	}
+
+	var (
+		limit  int64
+		column = "last"
+	)
+	switch hints.Func {
+	case "":
+		// An instant vector selector.
+		if hints.Range != 0 {
+			return nil
+		}
+		limit = defaultLookback.Milliseconds()
+	case "rate", "increase", "irate", "delta", "idelta", "deriv":
+		// The last value of every bucket hides the counter resets within
+		// it, which these would miss, so they read the raw samples.
+		return nil
+	case "min_over_time":
+		limit = hints.Range
+		column = "min"
+	case "max_over_time":
+		limit = hints.Range
+		column = "max"
+	default:
+		return nil
+	}
+	if hints.Step < limit {
+		limit = hints.Step
+	}
+
+	var chosen *downsampleView
+	for _, r := range resolutions {
+		if r.resolution > 0 && r.resolution <= limit && (chosen == nil || r.resolution > chosen.resolution) {
+			chosen = &downsampleView{downsampleResolution: r, column: column}
+		}
+	}
+	return chosen
+}
@@ -0,0 +1,148 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+)
+
+func TestChooseDownsampling(t *testing.T) {
+	minute := time.Minute.Milliseconds()
+	resolutions := []downsampleResolution{
+		{resolution: minute, viewName: "metric_60s"},
+		{resolution: 5 * minute, viewName: "metric_300s"},
+		{resolution: 60 * minute, viewName: "metric_3600s"},
+	}
+
+	testCases := []struct {
+		name     string
+		hints    *storage.SelectHints
+		expected *downsampleView
+	}{
+		{
+			name: "no hints",
+		},
+		{
+			name:  "instant query",
+			hints: &storage.SelectHints{Start: 0, End: 0, Range: 10 * minute, Func: "rate"},
+		},
+		{
+			name:     "instant vector selector",
+			hints:    &storage.SelectHints{Step: 120 * minute},
+			expected: &downsampleView{downsampleResolution: resolutions[1], column: "last"},
+		},
+		{
+			name:  "step finer than every resolution",
+			hints: &storage.SelectHints{Step: minute / 2},
+		},
+		{
+			name:  "rate",
+			hints: &storage.SelectHints{Step: 120 * minute, Range: 30 * minute, Func: "rate"},
+		},
+		{
+			name:  "increase",
+			hints: &storage.SelectHints{Step: 2 * minute, Range: 240 * minute, Func: "increase"},
+		},
+		{
+			name:  "deriv",
+			hints: &storage.SelectHints{Step: 120 * minute, Range: 30 * minute, Func: "deriv"},
+		},
+		{
+			name:     "min_over_time",
+			hints:    &storage.SelectHints{Step: 120 * minute, Range: 60 * minute, Func: "min_over_time"},
+			expected: &downsampleView{downsampleResolution: resolutions[2], column: "min"},
+		},
+		{
+			name:     "max_over_time",
+			hints:    &storage.SelectHints{Step: 10 * minute, Range: 60 * minute, Func: "max_over_time"},
+			expected: &downsampleView{downsampleResolution: resolutions[1], column: "max"},
+		},
+		{
+			name:  "unsupported function",
+			hints: &storage.SelectHints{Step: 120 * minute, Range: 60 * minute, Func: "avg_over_time"},
+		},
+		{
+			name:  "aggregation over a range",
+			hints: &storage.SelectHints{Step: 120 * minute, Range: 60 * minute, Func: ""},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			got := chooseDownsampling(resolutions, c.hints)
+			if !reflect.DeepEqual(got, c.expected) {
+				t.Errorf("unexpected downsampling:\ngot\n%+v\nwanted\n%+v", got, c.expected)
+			}
+		})
+	}
+}
+
+func TestBuildDownsampledQuery(t *testing.T) {
+	filter := metricTimeRangeFilter{
+		metric:    "metric_table",
+		startTime: toRFC3339Nano(1000),
+		endTime:   toRFC3339Nano(2000),
+	}
+	downsample := &downsampleView{
+		downsampleResolution: downsampleResolution{resolution: 60000, viewName: "metric_table_60s"},
+		column:               "max",
+	}
+
+	sql, _, node, err := buildTimeseriesByLabelClausesQuery(filter, []string{"true"}, nil, &storage.SelectHints{Step: 60000}, nil, downsample)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node != nil {
+		t.Errorf("unexpected pushed down node %v", node)
+	}
+	for _, expected := range []string{
+		`FROM "prom_data_downsample"."metric_table_60s" metric`,
+		`SELECT last_time as time, "max" as value`,
+		`AND metric.time >= '1970-01-01T00:00:01Z'::timestamptz - '60000 milliseconds'::interval`,
+		`AND last_time <= '1970-01-01T00:00:02Z'`,
+	} {
+		if !strings.Contains(sql, expected) {
+			t.Errorf("query does not contain %q:\n%s", expected, sql)
+		}
+	}
+
+	sql, _, _, err = buildTimeseriesByLabelClausesQuery(filter, []string{"true"}, nil, &storage.SelectHints{Step: 60000}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(sql, `FROM "prom_data"."metric_table" metric`) {
+		t.Errorf("query does not read the raw samples:\n%s", sql)
+	}
+}
+
+func TestGetDownsamplingCached(t *testing.T) {
+	mock := model.NewSqlRecorder([]model.SqlQuery{
+		{
+			Sql:     getMetricDownsamplingSQL,
+			Args:    []interface{}{"metric_1"},
+			Results: model.RowResults{{int64(60000), "metric_1_60s"}},
+		},
+	}, t)
+	querier := pgxQuerier{conn: mock, downsampling: newDownsamplingCache(time.Hour)}
+
+	expected := []downsampleResolution{{resolution: 60000, viewName: "metric_1_60s"}}
+	for i := 0; i < 2; i++ {
+		// The second call is served from the cache, the recorder fails the
+		// test on an unexpected query.
+		got, err := querier.getDownsampling("metric_1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("unexpected resolutions:\ngot\n%+v\nwanted\n%+v", got, expected)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"sync/atomic"
+
+	"github.com/timescale/promscale/pkg/promql"
+)
+
+const (
+	// sampleBytes is the estimated memory taken by a sample loaded from the
+	// database, decoded as a pgtype.Timestamptz and a pgtype.Float8.
+	sampleBytes = 48
+	// labelBytes is the memory taken by a label ID of a series.
+	labelBytes = 8
+
+	limitsEnv = "query execution"
+)
+
+// QueryLimits bounds what a single query may load from the database. A zero
+// limit is disabled.
+type QueryLimits struct {
+	// MaxSamples is the maximum number of samples a query may load.
+	MaxSamples int64
+	// MaxBytes is the maximum estimated memory, in bytes, taken by the
+	// series a query loads.
+	MaxBytes int64
+}
+
+// Enabled returns true if any of the limits is set.
+func (l QueryLimits) Enabled() bool {
+	return l.MaxSamples > 0 || l.MaxBytes > 0
+}
+
+// QueryBudget accounts for the series loaded by a query against its limits.
+// It is safe for concurrent use, and a nil budget is unlimited.
+type QueryBudget struct {
+	limits  QueryLimits
+	samples int64
+	bytes   int64
+}
+
+// NewQueryBudget returns the budget of a query with the given limits, or nil
+// if they are disabled.
+func NewQueryBudget(limits QueryLimits) *QueryBudget {
+	if !limits.Enabled() {
+		return nil
+	}
+	return &QueryBudget{limits: limits}
+}
+
+// add accounts for a series with the given number of samples and labels. It
+// returns the same error as the PromQL engine once the query loaded too many
+// samples or too much memory.
+func (b *QueryBudget) add(numSamples, numLabels int) error {
+	if b == nil {
+		return nil
+	}
+	samples := atomic.AddInt64(&b.samples, int64(numSamples))
+	bytes := atomic.AddInt64(&b.bytes, int64(numSamples)*sampleBytes+int64(numLabels)*labelBytes)
+	if (b.limits.MaxSamples > 0 && samples > b.limits.MaxSamples) ||
+		(b.limits.MaxBytes > 0 && bytes > b.limits.MaxBytes) {
+		return promql.ErrTooManySamples(limitsEnv)
+	}
+	return nil
+}
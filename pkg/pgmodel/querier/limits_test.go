@@ -0,0 +1,91 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/timescale/promscale/pkg/promql"
+)
+
+func TestQueryBudget(t *testing.T) {
+	testCases := []struct {
+		name    string
+		limits  QueryLimits
+		series  [][2]int
+		allowed int
+	}{
+		{
+			name:    "no limits",
+			series:  [][2]int{{1000, 3}, {1000, 3}},
+			allowed: 2,
+		},
+		{
+			name:    "samples within the limit",
+			limits:  QueryLimits{MaxSamples: 10},
+			series:  [][2]int{{5, 1}, {5, 1}},
+			allowed: 2,
+		},
+		{
+			name:    "too many samples",
+			limits:  QueryLimits{MaxSamples: 10},
+			series:  [][2]int{{5, 1}, {5, 1}, {1, 1}},
+			allowed: 2,
+		},
+		{
+			name:    "too much memory",
+			limits:  QueryLimits{MaxBytes: 2*sampleBytes + labelBytes},
+			series:  [][2]int{{2, 1}, {0, 1}},
+			allowed: 1,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			budget := NewQueryBudget(c.limits)
+			if (budget == nil) == c.limits.Enabled() {
+				t.Fatalf("unexpected budget %v for limits %+v", budget, c.limits)
+			}
+			for i, s := range c.series {
+				err := budget.add(s[0], s[1])
+				if i < c.allowed && err != nil {
+					t.Fatalf("unexpected error for series %d: %v", i, err)
+				}
+				if i >= c.allowed {
+					if _, ok := err.(promql.ErrTooManySamples); !ok {
+						t.Fatalf("unexpected error for series %d: got %v, wanted too many samples", i, err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSeriesSetExceedsBudget(t *testing.T) {
+	series := genSeries(
+		[]int64{1},
+		[]pgtype.Timestamptz{{}, {}, {}},
+		[]pgtype.Float8{{}, {}, {}},
+	)
+	rows := genPgxRows([][]seriesSetRow{{series, series}}, nil)
+	budget := NewQueryBudget(QueryLimits{MaxSamples: 4})
+
+	p := buildSeriesSet(rows, mapQuerier{}, budget)
+	if !p.Next() {
+		t.Fatal("first series exceeded the budget")
+	}
+	if p.Next() {
+		t.Fatal("second series did not exceed the budget")
+	}
+	var tooManySamples promql.ErrTooManySamples
+	if !errors.As(p.Err(), &tooManySamples) {
+		t.Fatalf("unexpected err: got %v, wanted too many samples", p.Err())
+	}
+	if !rows.closeCalled {
+		t.Fatal("rows not closed after the budget was exceeded")
+	}
+}
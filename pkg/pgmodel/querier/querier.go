@@ -7,16 +7,17 @@ package querier
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgtype"
-	"github.com/jackc/pgx/v4"
 	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/prometheus/prometheus/storage"
 	"github.com/timescale/promscale/pkg/log"
 	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	"github.com/timescale/promscale/pkg/pgmodel/common/clauses"
 	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
 	"github.com/timescale/promscale/pkg/pgmodel/lreader"
@@ -27,15 +28,27 @@ import (
 // Reader reads the data based on the provided read request.
 type Reader interface {
 	Read(*prompb.ReadRequest) (*prompb.ReadResponse, error)
+	// ReadChunked streams the data for the provided read request as
+	// XOR-encoded chunked series, calling handle with the index of the
+	// query each series belongs to.
+	ReadChunked(req *prompb.ReadRequest, handle func(queryIndex int64, series *prompb.ChunkedSeries) error) error
 }
 
 // Querier queries the data using the provided query data and returns the
 // matching timeseries.
 type Querier interface {
-	// Query returns resulting timeseries for a query.
-	Query(*prompb.Query) ([]*prompb.TimeSeries, error)
+	// Query returns resulting timeseries for a query. The series read are
+	// accounted for in the budget of the query, which may be nil.
+	Query(query *prompb.Query, budget *QueryBudget) ([]*prompb.TimeSeries, error)
+	// QueryChunked streams the resulting timeseries for a query as
+	// XOR-encoded chunked series, calling handle once per series as the
+	// rows are read from the database. The series read are accounted for
+	// in the budget of the query, which may be nil.
+	QueryChunked(query *prompb.Query, budget *QueryBudget, handle func(*prompb.ChunkedSeries) error) error
 	// Select returns a series set that matches the supplied query parameters.
-	Select(mint int64, maxt int64, sortSeries bool, hints *storage.SelectHints, path []parser.Node, ms ...*labels.Matcher) (storage.SeriesSet, parser.Node)
+	// The series read are accounted for in the budget of the query, which
+	// may be nil.
+	Select(mint int64, maxt int64, sortSeries bool, hints *storage.SelectHints, path []parser.Node, budget *QueryBudget, ms ...*labels.Matcher) (storage.SeriesSet, parser.Node)
 }
 
 const (
@@ -49,6 +62,7 @@ func NewQuerier(conn pgxconn.PgxConn, metricCache cache.MetricCache, labelsReade
 		conn:             conn,
 		labelsReader:     labelsReader,
 		metricTableNames: metricCache,
+		downsampling:     newDownsamplingCache(downsamplingCacheTTL),
 	}
 }
 
@@ -62,25 +76,34 @@ type pgxQuerier struct {
 	conn             pgxconn.PgxConn
 	metricTableNames cache.MetricCache
 	labelsReader     lreader.LabelsReader
+	// downsampling caches the downsampling resolutions of the metrics. The
+	// continuous aggregates are not used if it is nil.
+	downsampling *downsamplingCache
 }
 
 var _ Querier = (*pgxQuerier)(nil)
 
 // Select implements the Querier interface. It is the entry point for our
-// own version of the Prometheus engine.
-func (q *pgxQuerier) Select(mint int64, maxt int64, sortSeries bool, hints *storage.SelectHints, path []parser.Node, ms ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
-	rows, topNode, err := q.getResultRows(mint, maxt, hints, path, ms)
+// own version of the Prometheus engine. The returned series set only runs the
+// query once its first series is read, and reads the result rows as the
+// series are consumed. The engine selects the series of every selector of a
+// query before reading them, so they must not hold on to a connection until
+// then.
+func (q *pgxQuerier) Select(mint int64, maxt int64, sortSeries bool, hints *storage.SelectHints, path []parser.Node, budget *QueryBudget, ms ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	rows, topNode, err := q.openResultRows(mint, maxt, hints, path, ms)
 	if err != nil {
 		return errorSeriesSet{err: err}, nil
 	}
 
-	ss := buildSeriesSet(rows, q.labelsReader)
+	ss := buildSeriesSet(rows, q.labelsReader, budget)
 	return ss, topNode
 }
 
 // Query implements the Querier interface. It is the entry point for
-// remote-storage queries.
-func (q *pgxQuerier) Query(query *prompb.Query) ([]*prompb.TimeSeries, error) {
+// remote-storage queries. The read hints narrow the time range of the query,
+// and the functions and aggregations they describe are pushed down like the
+// ones of our own engine.
+func (q *pgxQuerier) Query(query *prompb.Query, budget *QueryBudget) ([]*prompb.TimeSeries, error) {
 	if query == nil {
 		return []*prompb.TimeSeries{}, nil
 	}
@@ -91,7 +114,8 @@ func (q *pgxQuerier) Query(query *prompb.Query) ([]*prompb.TimeSeries, error) {
 		return nil, err
 	}
 
-	rows, _, err := q.getResultRows(query.StartTimestampMs, query.EndTimestampMs, nil, nil, matchers)
+	start, end, hints, path := fromReadHints(query, matchers)
+	rows, _, err := q.getResultRows(start, end, hints, path, budget, matchers)
 
 	if err != nil {
 		return nil, err
@@ -102,6 +126,92 @@ func (q *pgxQuerier) Query(query *prompb.Query) ([]*prompb.TimeSeries, error) {
 	return results, err
 }
 
+// QueryChunked implements the Querier interface. It is the entry point for
+// streamed remote-storage queries. The read hints are used as with Query.
+func (q *pgxQuerier) QueryChunked(query *prompb.Query, budget *QueryBudget, handle func(*prompb.ChunkedSeries) error) error {
+	if query == nil {
+		return nil
+	}
+
+	matchers, err := fromLabelMatchers(query.Matchers)
+
+	if err != nil {
+		return err
+	}
+
+	start, end, hints, path := fromReadHints(query, matchers)
+	_, err = q.forEachResultRow(start, end, hints, path, budget, matchers, func(row timescaleRow) error {
+		series, err := buildChunkedSeries(row, q.labelsReader)
+		if err != nil {
+			return err
+		}
+		return handle(series)
+	})
+
+	return err
+}
+
+// readHintsAggregations are the aggregations of the read hints which can be
+// pushed down without a parameter.
+var readHintsAggregations = map[string]parser.ItemType{
+	"sum":   parser.SUM,
+	"avg":   parser.AVG,
+	"count": parser.COUNT,
+	"min":   parser.MIN,
+	"max":   parser.MAX,
+}
+
+// fromReadHints returns the time range of a remote-read query narrowed to the
+// one of its read hints, along with the select hints and the node path they
+// describe for the selector of the matchers, as the engine would pass them to
+// Select. The path is nil if the hints describe no function or aggregation
+// which can be pushed down, in which case the raw samples are returned.
+// Aggregations are assumed to be evaluated with the default lookback delta.
+func fromReadHints(query *prompb.Query, matchers []*labels.Matcher) (int64, int64, *storage.SelectHints, []parser.Node) {
+	start, end := query.StartTimestampMs, query.EndTimestampMs
+	rh := query.Hints
+	if rh == nil {
+		return start, end, nil, nil
+	}
+	if rh.StartMs > start && rh.StartMs <= end {
+		start = rh.StartMs
+	}
+	if rh.EndMs < end && rh.EndMs >= start {
+		end = rh.EndMs
+	}
+
+	hints := &storage.SelectHints{
+		Start:    rh.StartMs,
+		End:      rh.EndMs,
+		Step:     rh.StepMs,
+		Func:     rh.Func,
+		Grouping: rh.Grouping,
+		By:       rh.By,
+		Range:    rh.RangeMs,
+	}
+	vs := &parser.VectorSelector{LabelMatchers: matchers}
+	var (
+		path []parser.Node
+		rng  int64
+	)
+	if f, ok := parser.Functions[rh.Func]; ok && rh.RangeMs > 0 {
+		ms := &parser.MatrixSelector{VectorSelector: vs, Range: time.Duration(rh.RangeMs) * time.Millisecond}
+		path = []parser.Node{&parser.Call{Func: f, Args: parser.Expressions{ms}}, ms}
+		rng = rh.RangeMs
+	} else if op, ok := readHintsAggregations[rh.Func]; ok && rh.RangeMs == 0 {
+		path = []parser.Node{&parser.AggregateExpr{Op: op, Expr: vs, Grouping: rh.Grouping, Without: !rh.By}}
+		rng = defaultLookback.Milliseconds()
+	}
+
+	// The steps evaluated in SQL start a range after the start of the hints,
+	// which must fall on one of the steps of the caller.
+	steps := rh.EndMs - rh.StartMs - rng
+	if path == nil || steps < 0 || (rh.StepMs == 0 && steps != 0) || (rh.StepMs > 0 && steps%rh.StepMs != 0) {
+		return start, end, nil, nil
+	}
+	return start, end, hints, path
+}
+
 // fromLabelMatchers parses protobuf label matchers to Prometheus label matchers.
 func fromLabelMatchers(matchers []*prompb.LabelMatcher) ([]*labels.Matcher, error) {
 	result := make([]*labels.Matcher, 0, len(matchers))
@@ -135,11 +245,53 @@ type timescaleRow struct {
 	err      error
 }
 
+// rowHandler is called for every result row as it is read from the database.
+// Returning an error stops the iteration.
+type rowHandler func(row timescaleRow) error
+
 // getResultRows fetches the result row datasets from the database using the
-// supplied query parameters.
-func (q *pgxQuerier) getResultRows(startTimestamp int64, endTimestamp int64, hints *storage.SelectHints, path []parser.Node, matchers []*labels.Matcher) ([]timescaleRow, parser.Node, error) {
+// supplied query parameters, within the budget of the query.
+func (q *pgxQuerier) getResultRows(startTimestamp int64, endTimestamp int64, hints *storage.SelectHints, path []parser.Node, budget *QueryBudget, matchers []*labels.Matcher) ([]timescaleRow, parser.Node, error) {
+	// TODO this allocation assumes we usually have 1 row, if not, refactor
+	rows := make([]timescaleRow, 0, 1)
+	topNode, err := q.forEachResultRow(startTimestamp, endTimestamp, hints, path, budget, matchers, func(row timescaleRow) error {
+		rows = append(rows, row)
+		return row.err
+	})
+	if err != nil {
+		return rows, nil, err
+	}
+	return rows, topNode, nil
+}
+
+// forEachResultRow fetches the result rows from the database using the
+// supplied query parameters and passes them to handle one by one, without
+// holding on to them. It stops with an error once the rows read exceed the
+// budget of the query.
+func (q *pgxQuerier) forEachResultRow(startTimestamp int64, endTimestamp int64, hints *storage.SelectHints, path []parser.Node, budget *QueryBudget, matchers []*labels.Matcher, handle rowHandler) (parser.Node, error) {
+	rows, topNode, err := q.openResultRows(startTimestamp, endTimestamp, hints, path, matchers)
+	if err != nil || rows == nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return topNode, forEachTsRow(rows, func(row timescaleRow) error {
+		if row.err == nil {
+			if err := budget.add(len(row.times.Elements), len(row.labelIds)); err != nil {
+				return err
+			}
+		}
+		return handle(row)
+	})
+}
+
+// openResultRows returns the result rows of the query for the supplied query
+// parameters. The query runs on the first call to Next of the rows, which
+// are read as they are consumed and must be closed. The rows are nil if there
+// are no results.
+func (q *pgxQuerier) openResultRows(startTimestamp int64, endTimestamp int64, hints *storage.SelectHints, path []parser.Node, matchers []*labels.Matcher) (tsRows, parser.Node, error) {
 	// Build a subquery per metric matcher.
-	builder, err := BuildSubQueries(matchers)
+	builder, err := clauses.BuildSubQueries(matchers)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -152,6 +304,10 @@ func (q *pgxQuerier) getResultRows(startTimestamp int64, endTimestamp int64, hin
 		endTime:   toRFC3339Nano(endTimestamp),
 	}
 
+	// Results spanning a long time range are fetched through a cursor so
+	// that they are not all sent at once.
+	useCursor := time.Duration(endTimestamp-startTimestamp)*time.Millisecond >= cursorMinRange
+
 	// If all metric matchers match on a single metric (common case),
 	// we query only that single metric.
 	if metric != "" {
@@ -159,20 +315,21 @@ func (q *pgxQuerier) getResultRows(startTimestamp int64, endTimestamp int64, hin
 		if err != nil {
 			return nil, nil, err
 		}
-		return q.querySingleMetric(metric, filter, clauses, values, hints, path)
+		return q.querySingleMetric(metric, filter, clauses, values, hints, path, useCursor)
 	}
 
 	clauses, values, err := builder.Build(true)
 	if err != nil {
 		return nil, nil, err
 	}
-	return q.queryMultipleMetrics(filter, clauses, values)
+	rows, err := q.queryMultipleMetrics(filter, clauses, values, useCursor)
+	return rows, nil, err
 }
 
-// querySingleMetric returns all the result rows for a single metric using the
+// querySingleMetric returns the result rows for a single metric using the
 // supplied query parameters. It uses the hints and node path to try to push
 // down query functions where possible.
-func (q *pgxQuerier) querySingleMetric(metric string, filter metricTimeRangeFilter, cases []string, values []interface{}, hints *storage.SelectHints, path []parser.Node) ([]timescaleRow, parser.Node, error) {
+func (q *pgxQuerier) querySingleMetric(metric string, filter metricTimeRangeFilter, cases []string, values []interface{}, hints *storage.SelectHints, path []parser.Node, useCursor bool) (tsRows, parser.Node, error) {
 	tableName, err := q.getMetricTableName(metric)
 	if err != nil {
 		// If the metric table is missing, there are no results for this query.
@@ -184,89 +341,59 @@ func (q *pgxQuerier) querySingleMetric(metric string, filter metricTimeRangeFilt
 	}
 	filter.metric = tableName
 
-	sqlQuery, values, topNode, err := buildTimeseriesByLabelClausesQuery(filter, cases, values, hints, path)
+	var downsample *downsampleView
+	if q.downsampling != nil && hints != nil && hints.Step > 0 && !hasSubquery(path) {
+		resolutions, err := q.getDownsampling(metric)
+		if err != nil {
+			return nil, nil, err
+		}
+		downsample = chooseDownsampling(resolutions, hints)
+	}
+
+	sqlQuery, values, topNode, err := buildTimeseriesByLabelClausesQuery(filter, cases, values, hints, path, downsample)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	rows, err := q.conn.Query(context.Background(), sqlQuery, values...)
-	if err != nil {
+	rows := &lazyRows{open: func() (tsRows, error) {
+		rows, err := q.queryRows(sqlQuery, values, useCursor)
 		// If we are getting undefined table error, it means the query
 		// is looking for a metric which doesn't exist in the system.
-		if e, ok := err.(*pgconn.PgError); !ok || e.Code != pgerrcode.UndefinedTable {
-			return nil, nil, err
+		if e, ok := err.(*pgconn.PgError); ok && e.Code == pgerrcode.UndefinedTable {
+			return nil, nil
 		}
-	}
-
-	defer rows.Close()
-
-	// TODO this allocation assumes we usually have 1 row, if not, refactor
-	tsRows, err := appendTsRows(make([]timescaleRow, 0, 1), rows)
-	return tsRows, topNode, err
+		return rows, err
+	}}
+	return rows, topNode, nil
 }
 
-// queryMultipleMetrics returns all the result rows for across multiple metrics
-// using the supplied query parameters.
-func (q *pgxQuerier) queryMultipleMetrics(filter metricTimeRangeFilter, cases []string, values []interface{}) ([]timescaleRow, parser.Node, error) {
-	// First fetch series IDs per metric.
-	sqlQuery := BuildMetricNameSeriesIDQuery(cases)
-	rows, err := q.conn.Query(context.Background(), sqlQuery, values...)
-	if err != nil {
-		return nil, nil, err
-	}
-	defer rows.Close()
-
-	metrics, series, err := GetSeriesPerMetric(rows)
+// queryMultipleMetrics returns the result rows across multiple metrics using
+// the supplied query parameters. The series of all the metrics are read with
+// a single query.
+func (q *pgxQuerier) queryMultipleMetrics(filter metricTimeRangeFilter, cases []string, values []interface{}, useCursor bool) (tsRows, error) {
+	sqlQuery, values, err := buildMultipleMetricsQuery(filter, cases, values)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	// TODO this assume on average on row per-metric. Is this right?
-	results := make([]timescaleRow, 0, len(metrics))
-
-	numQueries := 0
-	batch := q.conn.NewBatch()
+	return &lazyRows{open: func() (tsRows, error) {
+		return q.queryRows(sqlQuery, values, useCursor)
+	}}, nil
+}
 
-	// Generate queries for each metric and send them in a single batch.
-	for i, metric := range metrics {
-		//TODO batch getMetricTableName
-		tableName, err := q.getMetricTableName(metric)
-		if err != nil {
-			// If the metric table is missing, there are no results for this query.
-			if err == errors.ErrMissingTableName {
-				continue
-			}
-			return nil, nil, err
-		}
-		filter.metric = tableName
-		sqlQuery = buildTimeseriesBySeriesIDQuery(filter, series[i])
-		batch.Queue(sqlQuery)
-		numQueries += 1
+// queryRows runs the query, through a cursor if useCursor is set.
+func (q *pgxQuerier) queryRows(sqlQuery string, values []interface{}, useCursor bool) (tsRows, error) {
+	if useCursor {
+		return openCursor(q.conn, sqlQuery, values...)
 	}
-
-	batchResults, err := q.conn.SendBatch(context.Background(), batch)
+	rows, err := q.conn.Query(context.Background(), sqlQuery, values...)
 	if err != nil {
-		return nil, nil, err
-	}
-	defer batchResults.Close()
-
-	for i := 0; i < numQueries; i++ {
-		rows, err = batchResults.Query()
-		if err != nil {
-			rows.Close()
-			return nil, nil, err
-		}
-		// Append all rows into results.
-		results, err = appendTsRows(results, rows)
-		// Can't defer because we need to Close before the next loop iteration.
-		rows.Close()
-		if err != nil {
+		if rows != nil {
 			rows.Close()
-			return nil, nil, err
 		}
+		return nil, err
 	}
-
-	return results, nil, nil
+	return rows, nil
 }
 
 // getMetricTableName gets the table name for a specific metric from internal
@@ -320,22 +447,25 @@ func (q *pgxQuerier) queryMetricTableName(metric string) (string, error) {
 	return tableName, nil
 }
 
-// appendTsRows adds new results rows to already existing result rows and
-// returns the as a result.
-func appendTsRows(out []timescaleRow, in pgx.Rows) ([]timescaleRow, error) {
+// forEachTsRow scans the result rows and passes them to handle. A row that
+// failed to scan is passed with its err set, and stops the iteration.
+func forEachTsRow(in tsRows, handle rowHandler) error {
 	if in.Err() != nil {
-		return out, in.Err()
+		return in.Err()
 	}
 	for in.Next() {
 		var row timescaleRow
 		row.err = in.Scan(&row.labelIds, &row.times, &row.values)
-		out = append(out, row)
 		if row.err != nil {
 			log.Error("err", row.err)
-			return out, row.err
+			_ = handle(row)
+			return row.err
+		}
+		if err := handle(row); err != nil {
+			return err
 		}
 	}
-	return out, in.Err()
+	return in.Err()
 }
 
 // errorSeriesSet represents an error result in a form of a series set.
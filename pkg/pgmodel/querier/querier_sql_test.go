@@ -4,21 +4,29 @@
 package querier
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/prometheus/prometheus/tsdb/chunkenc"
 	"github.com/timescale/promscale/pkg/clockcache"
 	"github.com/timescale/promscale/pkg/pgmodel/lreader"
 	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgxconn"
 	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/promql"
 )
 
 func TestPGXQuerierQuery(t *testing.T) {
 	testCases := []struct {
 		name       string
 		query      *prompb.Query
+		limits     QueryLimits
 		result     []*prompb.TimeSeries
 		err        error
 		sqlQueries []model.SqlQuery // XXX whitespace in these is significant
@@ -34,15 +42,19 @@ func TestPGXQuerierQuery(t *testing.T) {
 			},
 			sqlQueries: []model.SqlQuery{
 				{
-					Sql: "SELECT m.metric_name, array_agg(s.id)\n\t" +
-						"FROM _prom_catalog.series s\n\t" +
-						"INNER JOIN _prom_catalog.metric m\n\t" +
-						"ON (m.id = s.metric_id)\n\t" +
-						"WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)\n\t" +
-						"GROUP BY m.metric_name\n\t" +
-						"ORDER BY m.metric_name",
-					Args:    []interface{}{"__name__", "bar"},
-					Results: model.RowResults{{1, []int64{}}},
+					Sql: `SELECT result.labels, result.time_array, result.value_array
+					FROM (
+						SELECT m.metric_name, array_agg(s.id) AS series_ids
+						FROM _prom_catalog.series s
+						INNER JOIN _prom_catalog.metric m
+						ON (m.id = s.metric_id)
+						WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)
+						GROUP BY m.metric_name
+					) AS matched
+					INNER JOIN LATERAL _prom_catalog.get_metric_series_samples(matched.metric_name, matched.series_ids, $3, $4) AS result
+					ON TRUE`,
+					Args:    []interface{}{"__name__", "bar", "1970-01-01T00:00:01Z", "1970-01-01T00:00:02Z"},
+					Results: model.RowResults{{1, []time.Time{}, []float64{}}},
 					Err:     error(nil),
 				},
 			},
@@ -59,14 +71,18 @@ func TestPGXQuerierQuery(t *testing.T) {
 			},
 			sqlQueries: []model.SqlQuery{
 				{
-					Sql: "SELECT m.metric_name, array_agg(s.id)\n\t" +
-						"FROM _prom_catalog.series s\n\t" +
-						"INNER JOIN _prom_catalog.metric m\n\t" +
-						"ON (m.id = s.metric_id)\n\t" +
-						"WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)\n\t" +
-						"GROUP BY m.metric_name\n\t" +
-						"ORDER BY m.metric_name",
-					Args:    []interface{}{"__name__", "bar"},
+					Sql: `SELECT result.labels, result.time_array, result.value_array
+					FROM (
+						SELECT m.metric_name, array_agg(s.id) AS series_ids
+						FROM _prom_catalog.series s
+						INNER JOIN _prom_catalog.metric m
+						ON (m.id = s.metric_id)
+						WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)
+						GROUP BY m.metric_name
+					) AS matched
+					INNER JOIN LATERAL _prom_catalog.get_metric_series_samples(matched.metric_name, matched.series_ids, $3, $4) AS result
+					ON TRUE`,
+					Args:    []interface{}{"__name__", "bar", "1970-01-01T00:00:01Z", "1970-01-01T00:00:02Z"},
 					Results: model.RowResults{{"{}", []time.Time{}, []float64{}}},
 					Err:     fmt.Errorf("some error"),
 				},
@@ -83,67 +99,29 @@ func TestPGXQuerierQuery(t *testing.T) {
 			},
 			sqlQueries: []model.SqlQuery{
 				{
-					Sql: "SELECT m.metric_name, array_agg(s.id)\n\t" +
-						"FROM _prom_catalog.series s\n\t" +
-						"INNER JOIN _prom_catalog.metric m\n\t" +
-						"ON (m.id = s.metric_id)\n\t" +
-						"WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)\n\t" +
-						"GROUP BY m.metric_name\n\t" +
-						"ORDER BY m.metric_name",
-					Args:    []interface{}{"foo", "bar"},
-					Results: model.RowResults{{`foo`, []int64{1}}},
+					Sql: `SELECT result.labels, result.time_array, result.value_array
+					FROM (
+						SELECT m.metric_name, array_agg(s.id) AS series_ids
+						FROM _prom_catalog.series s
+						INNER JOIN _prom_catalog.metric m
+						ON (m.id = s.metric_id)
+						WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)
+						GROUP BY m.metric_name
+					) AS matched
+					INNER JOIN LATERAL _prom_catalog.get_metric_series_samples(matched.metric_name, matched.series_ids, $3, $4) AS result
+					ON TRUE`,
+					Args:    []interface{}{"foo", "bar", "1970-01-01T00:00:01Z", "1970-01-01T00:00:02Z"},
+					Results: model.RowResults{{[]int64{1}, []time.Time{time.Unix(0, 0)}, []float64{1}}},
 					Err:     error(nil),
 				},
 				{
-					Sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
-					Args:    []interface{}{"foo"},
-					Results: model.RowResults{{"foo"}},
+					Sql:     "SELECT (labels_info($1::int[])).*",
+					Args:    []interface{}{[]int64{1}},
+					Results: model.RowResults(nil),
 					Err:     fmt.Errorf("some error 2"),
 				},
 			},
 		},
-		{
-			name: "Error third query",
-			query: &prompb.Query{
-				StartTimestampMs: 1000,
-				EndTimestampMs:   2000,
-				Matchers: []*prompb.LabelMatcher{
-					{Type: prompb.LabelMatcher_NEQ, Name: "foo", Value: "bar"},
-				},
-			},
-			result: []*prompb.TimeSeries{},
-			sqlQueries: []model.SqlQuery{
-				{
-					Sql: "SELECT m.metric_name, array_agg(s.id)\n\t" +
-						"FROM _prom_catalog.series s\n\t" +
-						"INNER JOIN _prom_catalog.metric m\n\t" +
-						"ON (m.id = s.metric_id)\n\t" +
-						"WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)\n\t" +
-						"GROUP BY m.metric_name\n\t" +
-						"ORDER BY m.metric_name",
-					Args:    []interface{}{"foo", "bar"},
-					Results: model.RowResults{{"foo", []int64{1}}},
-					Err:     error(nil),
-				},
-				{
-					Sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
-					Args:    []interface{}{"foo"},
-					Results: model.RowResults{{"foo"}},
-					Err:     error(nil),
-				},
-				{
-					Sql: "SELECT s.labels, array_agg(m.time ORDER BY time), array_agg(m.value ORDER BY time)\n\t" +
-						"FROM \"prom_data\".\"foo\" m\n\t" +
-						"INNER JOIN \"prom_data_series\".\"foo\" s\n\t" +
-						"ON m.series_id = s.id\n\t" +
-						"WHERE m.series_id IN (1)\n\t" +
-						"AND time >= '1970-01-01T00:00:01Z'\n\t" +
-						"AND time <= '1970-01-01T00:00:02Z'\n\t" +
-						"GROUP BY s.id",
-					Args:    []interface{}(nil),
-					Results: model.RowResults(nil),
-					Err:     fmt.Errorf("some error 3")}},
-		},
 		{
 			name: "Error scan values",
 			query: &prompb.Query{
@@ -155,19 +133,23 @@ func TestPGXQuerierQuery(t *testing.T) {
 			},
 			sqlQueries: []model.SqlQuery{
 				{
-					Sql: "SELECT m.metric_name, array_agg(s.id)\n\t" +
-						"FROM _prom_catalog.series s\n\t" +
-						"INNER JOIN _prom_catalog.metric m\n\t" +
-						"ON (m.id = s.metric_id)\n\t" +
-						"WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)\n\t" +
-						"GROUP BY m.metric_name\n\t" +
-						"ORDER BY m.metric_name",
-					Args:    []interface{}{"__name__", "bar"},
+					Sql: `SELECT result.labels, result.time_array, result.value_array
+					FROM (
+						SELECT m.metric_name, array_agg(s.id) AS series_ids
+						FROM _prom_catalog.series s
+						INNER JOIN _prom_catalog.metric m
+						ON (m.id = s.metric_id)
+						WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)
+						GROUP BY m.metric_name
+					) AS matched
+					INNER JOIN LATERAL _prom_catalog.get_metric_series_samples(matched.metric_name, matched.series_ids, $3, $4) AS result
+					ON TRUE`,
+					Args:    []interface{}{"__name__", "bar", "1970-01-01T00:00:01Z", "1970-01-01T00:00:02Z"},
 					Results: model.RowResults{{0}},
 					Err:     error(nil),
 				},
 			},
-			err: fmt.Errorf("mock scanning error, missing results for scanning: got 1 []interface {}{0}\nwanted 2"),
+			err: fmt.Errorf("mock scanning error, missing results for scanning: got 1 []interface {}{0}\nwanted 3"),
 		},
 		{
 			name:   "Empty query",
@@ -185,14 +167,18 @@ func TestPGXQuerierQuery(t *testing.T) {
 			result: []*prompb.TimeSeries{},
 			sqlQueries: []model.SqlQuery{
 				{
-					Sql: "SELECT m.metric_name, array_agg(s.id)\n\t" +
-						"FROM _prom_catalog.series s\n\t" +
-						"INNER JOIN _prom_catalog.metric m\n\t" +
-						"ON (m.id = s.metric_id)\n\t" +
-						"WHERE labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)\n\t" +
-						"GROUP BY m.metric_name\n\t" +
-						"ORDER BY m.metric_name",
-					Args:    []interface{}{"foo", "bar"},
+					Sql: `SELECT result.labels, result.time_array, result.value_array
+					FROM (
+						SELECT m.metric_name, array_agg(s.id) AS series_ids
+						FROM _prom_catalog.series s
+						INNER JOIN _prom_catalog.metric m
+						ON (m.id = s.metric_id)
+						WHERE labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)
+						GROUP BY m.metric_name
+					) AS matched
+					INNER JOIN LATERAL _prom_catalog.get_metric_series_samples(matched.metric_name, matched.series_ids, $3, $4) AS result
+					ON TRUE`,
+					Args:    []interface{}{"foo", "bar", "1970-01-01T00:00:01Z", "1970-01-01T00:00:02Z"},
 					Results: model.RowResults(nil),
 					Err:     error(nil),
 				},
@@ -234,33 +220,18 @@ func TestPGXQuerierQuery(t *testing.T) {
 			},
 			sqlQueries: []model.SqlQuery{
 				{
-					Sql: "SELECT m.metric_name, array_agg(s.id)\n\t" +
-						"FROM _prom_catalog.series s\n\t" +
-						"INNER JOIN _prom_catalog.metric m\n\t" +
-						"ON (m.id = s.metric_id)\n\t" +
-						"WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)\n\t" +
-						"GROUP BY m.metric_name\n\t" +
-						"ORDER BY m.metric_name",
-					Args:    []interface{}{"__name__", "bar"},
-					Results: model.RowResults{{"foo", []int64{1}}},
-					Err:     error(nil),
-				},
-				{
-					Sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
-					Args:    []interface{}{"foo"},
-					Results: model.RowResults{{"foo"}},
-					Err:     error(nil),
-				},
-				{
-					Sql: "SELECT s.labels, array_agg(m.time ORDER BY time), array_agg(m.value ORDER BY time)\n\t" +
-						"FROM \"prom_data\".\"foo\" m\n\t" +
-						"INNER JOIN \"prom_data_series\".\"foo\" s\n\t" +
-						"ON m.series_id = s.id\n\t" +
-						"WHERE m.series_id IN (1)\n\t" +
-						"AND time >= '1970-01-01T00:00:01Z'\n\t" +
-						"AND time <= '1970-01-01T00:00:02Z'\n\t" +
-						"GROUP BY s.id",
-					Args:    []interface{}(nil),
+					Sql: `SELECT result.labels, result.time_array, result.value_array
+					FROM (
+						SELECT m.metric_name, array_agg(s.id) AS series_ids
+						FROM _prom_catalog.series s
+						INNER JOIN _prom_catalog.metric m
+						ON (m.id = s.metric_id)
+						WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)
+						GROUP BY m.metric_name
+					) AS matched
+					INNER JOIN LATERAL _prom_catalog.get_metric_series_samples(matched.metric_name, matched.series_ids, $3, $4) AS result
+					ON TRUE`,
+					Args:    []interface{}{"__name__", "bar", "1970-01-01T00:00:01Z", "1970-01-01T00:00:02Z"},
 					Results: model.RowResults{{[]int64{1}, []time.Time{time.Unix(0, 0)}, []float64{1}}},
 					Err:     error(nil),
 				},
@@ -323,43 +294,72 @@ func TestPGXQuerierQuery(t *testing.T) {
 			},
 		},
 		{
-			name: "Simple query, empty metric name matcher",
+			name: "Simple query, read hints with a function",
 			query: &prompb.Query{
-				StartTimestampMs: 1000,
-				EndTimestampMs:   2000,
+				StartTimestampMs: 0,
+				EndTimestampMs:   400000,
+				// rate is pushed down and the time range narrowed to the one of the hints.
+				Hints: &prompb.ReadHints{StartMs: 1000, EndMs: 361000, StepMs: 30000, RangeMs: 300000, Func: "rate"},
 				Matchers: []*prompb.LabelMatcher{
-					{Type: prompb.LabelMatcher_RE, Name: model.MetricNameLabelName, Value: ""},
+					{Type: prompb.LabelMatcher_EQ, Name: model.MetricNameLabelName, Value: "bar"},
 				},
 			},
 			result: []*prompb.TimeSeries{
-				{
-					Labels:  []prompb.Label{{Name: model.MetricNameLabelName, Value: "foo"}},
-					Samples: []prompb.Sample{{Timestamp: toMilis(time.Unix(0, 0)), Value: 1}},
-				},
 				{
 					Labels:  []prompb.Label{{Name: model.MetricNameLabelName, Value: "bar"}},
-					Samples: []prompb.Sample{{Timestamp: toMilis(time.Unix(0, 0)), Value: 1}},
+					Samples: []prompb.Sample{{Timestamp: 301000, Value: 0.5}, {Timestamp: 331000, Value: 0.5}, {Timestamp: 361000, Value: 0.5}},
 				},
 			},
 			sqlQueries: []model.SqlQuery{
 				{
-					Sql: "SELECT m.metric_name, array_agg(s.id)\n\t" +
-						"FROM _prom_catalog.series s\n\t" +
-						"INNER JOIN _prom_catalog.metric m\n\t" +
-						"ON (m.id = s.metric_id)\n\t" +
-						"WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value !~ $2)\n\t" +
-						"GROUP BY m.metric_name\n\t" +
-						"ORDER BY m.metric_name",
-					Args:    []interface{}{"__name__", "^$"},
-					Results: model.RowResults{{"foo", []int64{1}}, {"bar", []int64{1}}},
+					Sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
+					Args:    []interface{}{"bar"},
+					Results: model.RowResults{{"bar"}},
 					Err:     error(nil),
 				},
 				{
-					Sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
-					Args:    []interface{}{"foo"},
-					Results: model.RowResults{{"foo"}},
+					Sql: `SELECT series.labels,  result.time_array, result.value_array
+					FROM "prom_data_series"."bar" series
+					INNER JOIN LATERAL (
+							SELECT ARRAY(SELECT generate_series($1::timestamptz, $2::timestamptz, $3)) as time_array, _prom_catalog.prom_extrapolated_rate($4, $5, $6, $7, array_agg(time), array_agg(value), true, $8) as value_array
+							FROM
+							(
+									SELECT time, value
+									FROM "prom_data"."bar" metric
+									WHERE metric.series_id = series.id
+									AND time >= '1970-01-01T00:00:01Z'
+									AND time <= '1970-01-01T00:06:01Z'
+									ORDER BY time
+							) as time_ordered_rows
+					) as result ON (result.value_array is not null)
+					WHERE TRUE`,
+					Args: []interface{}{
+						time.Unix(301, 0), time.Unix(361, 0), 30 * time.Second,
+						time.Unix(301, 0), time.Unix(361, 0), int64(30000), int64(300000), true,
+					},
+					Results: model.RowResults{{[]int64{2}, []time.Time{time.Unix(301, 0), time.Unix(331, 0), time.Unix(361, 0)}, []float64{0.5, 0.5, 0.5}}},
+					Err:     error(nil),
+				},
+				{
+					Sql:     "SELECT (labels_info($1::int[])).*",
+					Args:    []interface{}{[]int64{2}},
+					Results: model.RowResults{{[]int64{2}, []string{"__name__"}, []string{"bar"}}},
 					Err:     error(nil),
 				},
+			},
+		},
+		{
+			name: "Simple query, exceeding the sample limit",
+			query: &prompb.Query{
+				StartTimestampMs: 1000,
+				EndTimestampMs:   2000,
+				Matchers: []*prompb.LabelMatcher{
+					{Type: prompb.LabelMatcher_EQ, Name: model.MetricNameLabelName, Value: "bar"},
+				},
+			},
+			limits: QueryLimits{MaxSamples: 1},
+			err:    promql.ErrTooManySamples(limitsEnv),
+			sqlQueries: []model.SqlQuery{
 				{
 					Sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
 					Args:    []interface{}{"bar"},
@@ -367,29 +367,61 @@ func TestPGXQuerierQuery(t *testing.T) {
 					Err:     error(nil),
 				},
 				{
-					Sql: "SELECT s.labels, array_agg(m.time ORDER BY time), array_agg(m.value ORDER BY time)\n\t" +
-						"FROM \"prom_data\".\"foo\" m\n\t" +
-						"INNER JOIN \"prom_data_series\".\"foo\" s\n\t" +
-						"ON m.series_id = s.id\n\t" +
-						"WHERE m.series_id IN (1)\n\t" +
-						"AND time >= '1970-01-01T00:00:01Z'\n\t" +
-						"AND time <= '1970-01-01T00:00:02Z'\n\t" +
-						"GROUP BY s.id",
-					Args:    []interface{}(nil),
-					Results: model.RowResults{{[]int64{3}, []time.Time{time.Unix(0, 0)}, []float64{1}}},
+					Sql: `SELECT series.labels,  result.time_array, result.value_array
+					FROM "prom_data_series"."bar" series
+					INNER JOIN LATERAL (
+							SELECT array_agg(time) as time_array, array_agg(value) as value_array
+							FROM
+							(
+									SELECT time, value
+									FROM "prom_data"."bar" metric
+									WHERE metric.series_id = series.id
+									AND time >= '1970-01-01T00:00:01Z'
+									AND time <= '1970-01-01T00:00:02Z'
+									ORDER BY time
+							) as time_ordered_rows
+					) as result ON (result.value_array is not null)
+					WHERE TRUE`,
+					Args:    nil,
+					Results: model.RowResults{{[]int64{2}, []time.Time{time.Unix(0, 0), time.Unix(1, 0)}, []float64{1, 2}}},
 					Err:     error(nil),
 				},
+			},
+		},
+		{
+			name: "Simple query, empty metric name matcher",
+			query: &prompb.Query{
+				StartTimestampMs: 1000,
+				EndTimestampMs:   2000,
+				Matchers: []*prompb.LabelMatcher{
+					{Type: prompb.LabelMatcher_RE, Name: model.MetricNameLabelName, Value: ""},
+				},
+			},
+			result: []*prompb.TimeSeries{
 				{
-					Sql: "SELECT s.labels, array_agg(m.time ORDER BY time), array_agg(m.value ORDER BY time)\n\t" +
-						"FROM \"prom_data\".\"bar\" m\n\t" +
-						"INNER JOIN \"prom_data_series\".\"bar\" s\n\t" +
-						"ON m.series_id = s.id\n\t" +
-						"WHERE m.series_id IN (1)\n\t" +
-						"AND time >= '1970-01-01T00:00:01Z'\n\t" +
-						"AND time <= '1970-01-01T00:00:02Z'\n\t" +
-						"GROUP BY s.id",
-					Args:    []interface{}(nil),
-					Results: model.RowResults{{[]int64{4}, []time.Time{time.Unix(0, 0)}, []float64{1}}},
+					Labels:  []prompb.Label{{Name: model.MetricNameLabelName, Value: "foo"}},
+					Samples: []prompb.Sample{{Timestamp: toMilis(time.Unix(0, 0)), Value: 1}},
+				},
+				{
+					Labels:  []prompb.Label{{Name: model.MetricNameLabelName, Value: "bar"}},
+					Samples: []prompb.Sample{{Timestamp: toMilis(time.Unix(0, 0)), Value: 1}},
+				},
+			},
+			sqlQueries: []model.SqlQuery{
+				{
+					Sql: `SELECT result.labels, result.time_array, result.value_array
+					FROM (
+						SELECT m.metric_name, array_agg(s.id) AS series_ids
+						FROM _prom_catalog.series s
+						INNER JOIN _prom_catalog.metric m
+						ON (m.id = s.metric_id)
+						WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value !~ $2)
+						GROUP BY m.metric_name
+					) AS matched
+					INNER JOIN LATERAL _prom_catalog.get_metric_series_samples(matched.metric_name, matched.series_ids, $3, $4) AS result
+					ON TRUE`,
+					Args:    []interface{}{"__name__", "^$", "1970-01-01T00:00:01Z", "1970-01-01T00:00:02Z"},
+					Results: model.RowResults{{[]int64{3}, []time.Time{time.Unix(0, 0)}, []float64{1}}, {[]int64{4}, []time.Time{time.Unix(0, 0)}, []float64{1}}},
 					Err:     error(nil),
 				},
 				{
@@ -452,33 +484,18 @@ func TestPGXQuerierQuery(t *testing.T) {
 			},
 			sqlQueries: []model.SqlQuery{
 				{
-					Sql: "SELECT m.metric_name, array_agg(s.id)\n\t" +
-						"FROM _prom_catalog.series s\n\t" +
-						"INNER JOIN _prom_catalog.metric m\n\t" +
-						"ON (m.id = s.metric_id)\n\t" +
-						"WHERE labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)\n\t" +
-						"GROUP BY m.metric_name\n\t" +
-						"ORDER BY m.metric_name",
-					Args:    []interface{}{"foo", "bar"},
-					Results: model.RowResults{{"metric", []int64{1, 99, 98}}},
-					Err:     error(nil),
-				},
-				{
-					Sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
-					Args:    []interface{}{"metric"},
-					Results: model.RowResults{{"metric"}},
-					Err:     error(nil),
-				},
-				{
-					Sql: "SELECT s.labels, array_agg(m.time ORDER BY time), array_agg(m.value ORDER BY time)\n\t" +
-						"FROM \"prom_data\".\"metric\" m\n\t" +
-						"INNER JOIN \"prom_data_series\".\"metric\" s\n\t" +
-						"ON m.series_id = s.id\n\t" +
-						"WHERE m.series_id IN (1,99,98)\n\t" +
-						"AND time >= '1970-01-01T00:00:01Z'\n\t" +
-						"AND time <= '1970-01-01T00:00:02Z'\n\t" +
-						"GROUP BY s.id",
-					Args:    []interface{}(nil),
+					Sql: `SELECT result.labels, result.time_array, result.value_array
+					FROM (
+						SELECT m.metric_name, array_agg(s.id) AS series_ids
+						FROM _prom_catalog.series s
+						INNER JOIN _prom_catalog.metric m
+						ON (m.id = s.metric_id)
+						WHERE labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2)
+						GROUP BY m.metric_name
+					) AS matched
+					INNER JOIN LATERAL _prom_catalog.get_metric_series_samples(matched.metric_name, matched.series_ids, $3, $4) AS result
+					ON TRUE`,
+					Args:    []interface{}{"foo", "bar", "1970-01-01T00:00:01Z", "1970-01-01T00:00:02Z"},
 					Results: model.RowResults{{[]int64{7}, []time.Time{time.Unix(0, 0)}, []float64{1}}},
 					Err:     error(nil),
 				},
@@ -513,33 +530,18 @@ func TestPGXQuerierQuery(t *testing.T) {
 			},
 			sqlQueries: []model.SqlQuery{
 				{
-					Sql: "SELECT m.metric_name, array_agg(s.id)\n\t" +
-						"FROM _prom_catalog.series s\n\t" +
-						"INNER JOIN _prom_catalog.metric m\n\t" +
-						"ON (m.id = s.metric_id)\n\t" +
-						"WHERE labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2) AND NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $3 and l.value = $4) AND labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $5 and l.value ~ $6) AND NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $7 and l.value ~ $8)\n\t" +
-						"GROUP BY m.metric_name\n\t" +
-						"ORDER BY m.metric_name",
-					Args:    []interface{}{"foo", "bar", "foo1", "bar1", "foo2", "^bar2$", "foo3", "^bar3$"},
-					Results: model.RowResults{{"metric", []int64{1, 4, 5}}},
-					Err:     error(nil),
-				},
-				{
-					Sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
-					Args:    []interface{}{"metric"},
-					Results: model.RowResults{{"metric"}},
-					Err:     error(nil),
-				},
-				{
-					Sql: "SELECT s.labels, array_agg(m.time ORDER BY time), array_agg(m.value ORDER BY time)\n\t" +
-						"FROM \"prom_data\".\"metric\" m\n\t" +
-						"INNER JOIN \"prom_data_series\".\"metric\" s\n\t" +
-						"ON m.series_id = s.id\n\t" +
-						"WHERE m.series_id IN (1,4,5)\n\t" +
-						"AND time >= '1970-01-01T00:00:01Z'\n\t" +
-						"AND time <= '1970-01-01T00:00:02Z'\n\t" +
-						"GROUP BY s.id",
-					Args:    []interface{}(nil),
+					Sql: `SELECT result.labels, result.time_array, result.value_array
+					FROM (
+						SELECT m.metric_name, array_agg(s.id) AS series_ids
+						FROM _prom_catalog.series s
+						INNER JOIN _prom_catalog.metric m
+						ON (m.id = s.metric_id)
+						WHERE labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value = $2) AND NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $3 and l.value = $4) AND labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $5 and l.value ~ $6) AND NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $7 and l.value ~ $8)
+						GROUP BY m.metric_name
+					) AS matched
+					INNER JOIN LATERAL _prom_catalog.get_metric_series_samples(matched.metric_name, matched.series_ids, $9, $10) AS result
+					ON TRUE`,
+					Args:    []interface{}{"foo", "bar", "foo1", "bar1", "foo2", "^bar2$", "foo3", "^bar3$", "1970-01-01T00:00:01Z", "1970-01-01T00:00:02Z"},
 					Results: model.RowResults{{[]int64{8, 9}, []time.Time{time.Unix(0, 0)}, []float64{1}}},
 					Err:     error(nil),
 				},
@@ -573,33 +575,18 @@ func TestPGXQuerierQuery(t *testing.T) {
 			},
 			sqlQueries: []model.SqlQuery{
 				{
-					Sql: "SELECT m.metric_name, array_agg(s.id)\n\t" +
-						"FROM _prom_catalog.series s\n\t" +
-						"INNER JOIN _prom_catalog.metric m\n\t" +
-						"ON (m.id = s.metric_id)\n\t" +
-						"WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value != $2) AND NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $3 and l.value = $4) AND labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $5 and l.value ~ $6) AND NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $7 and l.value ~ $8)\n\t" +
-						"GROUP BY m.metric_name\n\t" +
-						"ORDER BY m.metric_name",
-					Args:    []interface{}{"foo", "", "foo1", "bar1", "foo2", "^bar2$", "foo3", "^bar3$"},
-					Results: model.RowResults{{"metric", []int64{1, 2}}},
-					Err:     error(nil),
-				},
-				{
-					Sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
-					Args:    []interface{}{"metric"},
-					Results: model.RowResults{{"metric"}},
-					Err:     error(nil),
-				},
-				{
-					Sql: "SELECT s.labels, array_agg(m.time ORDER BY time), array_agg(m.value ORDER BY time)\n\t" +
-						"FROM \"prom_data\".\"metric\" m\n\t" +
-						"INNER JOIN \"prom_data_series\".\"metric\" s\n\t" +
-						"ON m.series_id = s.id\n\t" +
-						"WHERE m.series_id IN (1,2)\n\t" +
-						"AND time >= '1970-01-01T00:00:01Z'\n\t" +
-						"AND time <= '1970-01-01T00:00:02Z'\n\t" +
-						"GROUP BY s.id",
-					Args:    []interface{}(nil),
+					Sql: `SELECT result.labels, result.time_array, result.value_array
+					FROM (
+						SELECT m.metric_name, array_agg(s.id) AS series_ids
+						FROM _prom_catalog.series s
+						INNER JOIN _prom_catalog.metric m
+						ON (m.id = s.metric_id)
+						WHERE NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $1 and l.value != $2) AND NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $3 and l.value = $4) AND labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $5 and l.value ~ $6) AND NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $7 and l.value ~ $8)
+						GROUP BY m.metric_name
+					) AS matched
+					INNER JOIN LATERAL _prom_catalog.get_metric_series_samples(matched.metric_name, matched.series_ids, $9, $10) AS result
+					ON TRUE`,
+					Args:    []interface{}{"foo", "", "foo1", "bar1", "foo2", "^bar2$", "foo3", "^bar3$", "1970-01-01T00:00:01Z", "1970-01-01T00:00:02Z"},
 					Results: model.RowResults{{[]int64{10}, []time.Time{time.Unix(0, 0)}, []float64{1}}},
 					Err:     error(nil),
 				},
@@ -622,7 +609,7 @@ func TestPGXQuerierQuery(t *testing.T) {
 			}
 			querier := pgxQuerier{conn: mock, metricTableNames: mockMetrics, labelsReader: lreader.NewLabelsReader(mock, clockcache.WithMax(0))}
 
-			result, err := querier.Query(c.query)
+			result, err := querier.Query(c.query, NewQueryBudget(c.limits))
 
 			if err != nil {
 				switch {
@@ -651,3 +638,291 @@ func TestPGXQuerierQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestFromReadHints(t *testing.T) {
+	testCases := []struct {
+		name       string
+		hints      *prompb.ReadHints
+		start, end int64
+		// topNode is the node of the pushed down expression, if any.
+		topNode string
+	}{
+		{
+			name:  "no hints",
+			start: 0,
+			end:   400000,
+		},
+		{
+			name:    "range function",
+			hints:   &prompb.ReadHints{StartMs: 1000, EndMs: 361000, StepMs: 30000, RangeMs: 300000, Func: "rate"},
+			start:   1000,
+			end:     361000,
+			topNode: `rate({__name__="foo"}[5m])`,
+		},
+		{
+			name:    "range function, instant query",
+			hints:   &prompb.ReadHints{StartMs: 1000, EndMs: 301000, RangeMs: 300000, Func: "max_over_time"},
+			start:   1000,
+			end:     301000,
+			topNode: `max_over_time({__name__="foo"}[5m])`,
+		},
+		{
+			name:    "aggregation",
+			hints:   &prompb.ReadHints{StartMs: 1000, EndMs: 361000, StepMs: 30000, Func: "sum", By: true, Grouping: []string{"job"}},
+			start:   1000,
+			end:     361000,
+			topNode: `sum by(job) ({__name__="foo"})`,
+		},
+		{
+			name:  "steps not aligned with the hints",
+			hints: &prompb.ReadHints{StartMs: 1000, EndMs: 361000, StepMs: 7000, RangeMs: 300000, Func: "rate"},
+			start: 1000,
+			end:   361000,
+		},
+		{
+			name:  "function without a pushdown",
+			hints: &prompb.ReadHints{StartMs: 1000, EndMs: 361000, StepMs: 30000, RangeMs: 300000, Func: "resets"},
+			start: 1000,
+			end:   361000,
+		},
+		{
+			name:  "hints beyond the query",
+			hints: &prompb.ReadHints{StartMs: -1000, EndMs: 500000, StepMs: 30000, Func: "topk"},
+			start: 0,
+			end:   400000,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			query := &prompb.Query{StartTimestampMs: 0, EndTimestampMs: 400000, Hints: c.hints}
+			matchers := []*labels.Matcher{labels.MustNewMatcher(labels.MatchEqual, model.MetricNameLabelName, "foo")}
+			start, end, hints, path := fromReadHints(query, matchers)
+			if start != c.start || end != c.end {
+				t.Errorf("unexpected time range: got [%d, %d], wanted [%d, %d]", start, end, c.start, c.end)
+			}
+			_, topNode, err := getAggregators(hints, path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := ""
+			if topNode != nil {
+				got = topNode.String()
+			}
+			if got != c.topNode {
+				t.Errorf("unexpected pushed down node: got %q, wanted %q", got, c.topNode)
+			}
+		})
+	}
+}
+
+func TestPGXQuerierQueryChunked(t *testing.T) {
+	numSamples := maxSamplesPerChunk + 10
+	times := make([]time.Time, numSamples)
+	values := make([]float64, numSamples)
+	for i := range times {
+		times[i] = time.Unix(int64(i), 0)
+		values[i] = float64(i)
+	}
+
+	query := &prompb.Query{
+		StartTimestampMs: 1000,
+		EndTimestampMs:   2000,
+		Matchers: []*prompb.LabelMatcher{
+			{Type: prompb.LabelMatcher_EQ, Name: model.MetricNameLabelName, Value: "bar"},
+		},
+	}
+	sqlQueries := []model.SqlQuery{
+		{
+			Sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
+			Args:    []interface{}{"bar"},
+			Results: model.RowResults{{"bar"}},
+			Err:     error(nil),
+		},
+		{
+			Sql: `SELECT series.labels,  result.time_array, result.value_array
+			FROM "prom_data_series"."bar" series
+			INNER JOIN LATERAL (
+					SELECT array_agg(time) as time_array, array_agg(value) as value_array
+					FROM
+					(
+							SELECT time, value
+							FROM "prom_data"."bar" metric
+							WHERE metric.series_id = series.id
+							AND time >= '1970-01-01T00:00:01Z'
+							AND time <= '1970-01-01T00:00:02Z'
+							ORDER BY time
+					) as time_ordered_rows
+			) as result ON (result.value_array is not null)
+			WHERE TRUE`,
+			Args:    nil,
+			Results: model.RowResults{{[]int64{2}, times, values}},
+			Err:     error(nil),
+		},
+		{
+			Sql:     "SELECT (labels_info($1::int[])).*",
+			Args:    []interface{}{[]int64{2}},
+			Results: model.RowResults{{[]int64{2}, []string{"__name__"}, []string{"bar"}}},
+			Err:     error(nil),
+		},
+	}
+
+	mock := model.NewSqlRecorder(sqlQueries, t)
+	mockMetrics := &model.MockMetricCache{MetricCache: map[string]string{}}
+	querier := pgxQuerier{conn: mock, metricTableNames: mockMetrics, labelsReader: lreader.NewLabelsReader(mock, clockcache.WithMax(0))}
+
+	var result []*prompb.ChunkedSeries
+	err := querier.QueryChunked(query, nil, func(series *prompb.ChunkedSeries) error {
+		result = append(result, series)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 1 {
+		t.Fatalf("unexpected number of series: got %d wanted 1", len(result))
+	}
+	expLabels := []prompb.Label{{Name: model.MetricNameLabelName, Value: "bar"}}
+	if !reflect.DeepEqual(result[0].Labels, expLabels) {
+		t.Errorf("unexpected labels:\ngot\n%v\nwanted\n%v", result[0].Labels, expLabels)
+	}
+	if len(result[0].Chunks) != 2 {
+		t.Fatalf("unexpected number of chunks: got %d wanted 2", len(result[0].Chunks))
+	}
+
+	i := 0
+	for _, c := range result[0].Chunks {
+		if c.Type != prompb.Chunk_XOR {
+			t.Errorf("unexpected chunk encoding: %v", c.Type)
+		}
+		if c.MinTimeMs != toMilis(times[i]) {
+			t.Errorf("unexpected chunk min time: got %d wanted %d", c.MinTimeMs, toMilis(times[i]))
+		}
+		chk, err := chunkenc.FromData(chunkenc.EncXOR, c.Data)
+		if err != nil {
+			t.Fatalf("unexpected error decoding chunk: %v", err)
+		}
+		it := chk.Iterator(nil)
+		for it.Next() {
+			ts, v := it.At()
+			if ts != toMilis(times[i]) || v != values[i] {
+				t.Errorf("unexpected sample %d: got (%d, %f) wanted (%d, %f)", i, ts, v, toMilis(times[i]), values[i])
+			}
+			i++
+		}
+		if c.MaxTimeMs != toMilis(times[i-1]) {
+			t.Errorf("unexpected chunk max time: got %d wanted %d", c.MaxTimeMs, toMilis(times[i-1]))
+		}
+	}
+	if i != numSamples {
+		t.Errorf("unexpected number of samples: got %d wanted %d", i, numSamples)
+	}
+}
+
+func TestPGXQuerierSelectMoreSelectorsThanConnections(t *testing.T) {
+	dataSQL := `SELECT series.labels,  result.time_array, result.value_array
+			FROM "prom_data_series"."%[1]s" series
+			INNER JOIN LATERAL (
+					SELECT array_agg(time) as time_array, array_agg(value) as value_array
+					FROM
+					(
+							SELECT time, value
+							FROM "prom_data"."%[1]s" metric
+							WHERE metric.series_id = series.id
+							AND time >= '1970-01-01T00:00:01Z'
+							AND time <= '1970-01-01T00:00:02Z'
+							ORDER BY time
+					) as time_ordered_rows
+			) as result ON (result.value_array is not null)
+			WHERE TRUE`
+	metrics := []string{"foo", "bar", "baz"}
+	var sqlQueries []model.SqlQuery
+	for _, m := range metrics {
+		sqlQueries = append(sqlQueries, model.SqlQuery{
+			Sql:     "SELECT table_name FROM _prom_catalog.get_metric_table_name_if_exists($1)",
+			Args:    []interface{}{m},
+			Results: model.RowResults{{m}},
+		})
+	}
+	for i, m := range metrics {
+		id := int64(i + 1)
+		sqlQueries = append(sqlQueries,
+			model.SqlQuery{
+				Sql:     fmt.Sprintf(dataSQL, m),
+				Results: model.RowResults{{[]int64{id}, []time.Time{time.Unix(1, 0)}, []float64{1}}},
+			},
+			model.SqlQuery{
+				Sql:     "SELECT (labels_info($1::int[])).*",
+				Args:    []interface{}{[]int64{id}},
+				Results: model.RowResults{{[]int64{id}, []string{"__name__"}, []string{m}}},
+			},
+		)
+	}
+
+	mock := model.NewSqlRecorder(sqlQueries, t)
+	conn := &poolConn{PgxConn: mock, t: t, size: 1}
+	mockMetrics := &model.MockMetricCache{MetricCache: map[string]string{}}
+	querier := pgxQuerier{conn: conn, metricTableNames: mockMetrics, labelsReader: lreader.NewLabelsReader(mock, clockcache.WithMax(0))}
+
+	// As the engine does, select the series of every selector before
+	// reading any of them.
+	sets := make([]storage.SeriesSet, len(metrics))
+	for i, m := range metrics {
+		matcher := labels.MustNewMatcher(labels.MatchEqual, model.MetricNameLabelName, m)
+		sets[i], _ = querier.Select(1000, 2000, false, nil, nil, nil, matcher)
+	}
+	for i, set := range sets {
+		if !set.Next() {
+			t.Fatalf("no series for %s: %v", metrics[i], set.Err())
+		}
+		if got := set.At().Labels().Get(model.MetricNameLabelName); got != metrics[i] {
+			t.Errorf("unexpected series: got %s wanted %s", got, metrics[i])
+		}
+		if set.Next() {
+			t.Errorf("unexpected extra series for %s", metrics[i])
+		}
+		if err := set.Err(); err != nil {
+			t.Fatalf("unexpected error for %s: %v", metrics[i], err)
+		}
+	}
+	if conn.inUse != 0 {
+		t.Errorf("connections still in use: %d", conn.inUse)
+	}
+}
+
+// poolConn fails queries when more than size of their rows are open at once,
+// as a pool of size connections would block.
+type poolConn struct {
+	pgxconn.PgxConn
+	t     *testing.T
+	size  int
+	inUse int
+}
+
+func (c *poolConn) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	if c.inUse >= c.size {
+		c.t.Errorf("all %d connections in use by open rows", c.size)
+		return nil, fmt.Errorf("connection pool exhausted")
+	}
+	rows, err := c.PgxConn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	c.inUse++
+	return &poolRows{Rows: rows, conn: c}, nil
+}
+
+type poolRows struct {
+	pgx.Rows
+	conn   *poolConn
+	closed bool
+}
+
+func (r *poolRows) Close() {
+	if !r.closed {
+		r.closed = true
+		r.conn.inUse--
+	}
+	r.Rows.Close()
+}
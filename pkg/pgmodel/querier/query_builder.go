@@ -13,10 +13,11 @@ import (
 
 	"github.com/jackc/pgx/v4"
 	"github.com/prometheus/common/model"
-	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/pkg/timestamp"
 	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/prometheus/prometheus/storage"
+	"github.com/prometheus/prometheus/tsdb/chunkenc"
+	"github.com/timescale/promscale/pkg/pgmodel/common/clauses"
 	"github.com/timescale/promscale/pkg/pgmodel/common/errors"
 	"github.com/timescale/promscale/pkg/pgmodel/common/extension"
 	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
@@ -26,34 +27,25 @@ import (
 )
 
 const (
-	subQueryEQ            = "labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value = $%d)"
-	subQueryEQMatchEmpty  = "NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value != $%d)"
-	subQueryNEQ           = "labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value != $%d)"
-	subQueryNEQMatchEmpty = "NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value = $%d)"
-	subQueryRE            = "labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value ~ $%d)"
-	subQueryREMatchEmpty  = "NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value !~ $%d)"
-	subQueryNRE           = "labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value !~ $%d)"
-	subQueryNREMatchEmpty = "NOT labels && (SELECT COALESCE(array_agg(l.id), array[]::int[]) FROM _prom_catalog.label l WHERE l.key = $%d and l.value ~ $%d)"
+	// maxSamplesPerChunk is the maximum number of samples encoded into a
+	// single XOR chunk of a streamed remote-read response. It matches the
+	// chunk size used by the Prometheus TSDB.
+	maxSamplesPerChunk = 120
 
 	/* MULTIPLE METRIC PATH (less common case) */
-	/* The following two sql statements are for queries where the metric name is unknown in the query. The first query gets the
-	* metric name and series_id array and the second queries individual metrics while passing down the array */
-	metricNameSeriesIDSQLFormat = `SELECT m.metric_name, array_agg(s.id)
-	FROM _prom_catalog.series s
-	INNER JOIN _prom_catalog.metric m
-	ON (m.id = s.metric_id)
-	WHERE %s
-	GROUP BY m.metric_name
-	ORDER BY m.metric_name`
-
-	timeseriesBySeriesIDsSQLFormat = `SELECT s.labels, array_agg(m.time ORDER BY time), array_agg(m.value ORDER BY time)
-	FROM %[1]s m
-	INNER JOIN %[2]s s
-	ON m.series_id = s.id
-	WHERE m.series_id IN (%[3]s)
-	AND time >= '%[4]s'
-	AND time <= '%[5]s'
-	GROUP BY s.id`
+	/* Finds the matching series of every metric and reads their samples in a single statement. The data tables
+	* of the metrics are resolved in the database, and the series IDs are passed as arrays */
+	timeseriesByMetricSeriesSQLFormat = `SELECT result.labels, result.time_array, result.value_array
+	FROM (
+		SELECT m.metric_name, array_agg(s.id) AS series_ids
+		FROM ` + schema.Catalog + `.series s
+		INNER JOIN ` + schema.Catalog + `.metric m
+		ON (m.id = s.metric_id)
+		WHERE %[1]s
+		GROUP BY m.metric_name
+	) AS matched
+	INNER JOIN LATERAL ` + schema.Catalog + `.get_metric_series_samples(matched.metric_name, matched.series_ids, %[2]s) AS result
+	ON TRUE`
 
 	/* SINGLE METRIC PATH (common, performance critical case) */
 	/* The simpler query (which isn't used):
@@ -116,6 +108,69 @@ const (
 	) as result ON (result.value_array is not null)
 	WHERE
 	     %[3]s`
+
+	/* SINGLE METRIC PATH, reading a continuous aggregate of the metric (see prom_api.add_downsampling)
+	   instead of the raw samples. Every bucket is read as a single sample at the time of the last raw
+	   sample in it, so a bucket starting up to a resolution before the start of the query is read too. */
+	timeseriesByDownsampleSQLFormat = `SELECT series.labels,  result.time_array, result.value_array
+	FROM %[2]s series
+	INNER JOIN LATERAL (
+		SELECT %[6]s as time_array, %[7]s as value_array
+		FROM
+		(
+			SELECT last_time as time, %[8]s as value
+			FROM %[1]s metric
+			WHERE metric.series_id = series.id
+			AND metric.time >= '%[4]s'::timestamptz - '%[9]d milliseconds'::interval
+			AND metric.time <= '%[5]s'
+			AND last_time >= '%[4]s'
+			AND last_time <= '%[5]s'
+			ORDER BY last_time
+		) as time_ordered_rows
+	) as result ON (result.value_array is not null)
+	WHERE
+	     %[3]s`
+
+	/* An aggregation pushed down over the values of the series at every step, wrapping one of the queries above. */
+	timeseriesAggregatedSQLFormat = `SELECT grouped.labels, grouped.time_array, array_agg(grouped.value ORDER BY grouped.step)
+	FROM (
+		SELECT g.labels, g.time_array, s.step, %[3]s as value
+		FROM (
+			SELECT %[2]s as labels, series.time_array, series.value_array
+			FROM (%[1]s) as series
+		) as g, unnest(g.value_array) WITH ORDINALITY AS s(value, step)
+		GROUP BY g.labels, g.time_array, s.step
+	) as grouped
+	GROUP BY grouped.labels, grouped.time_array`
+
+	/* topk or bottomk pushed down over the values of the series at every step, wrapping one of the queries above.
+	   The series keep their labels, with a value at the steps they rank in. NaN ranks last as in PromQL. */
+	timeseriesRankedSQLFormat = `SELECT ranked.labels, ranked.time_array, array_agg(CASE WHEN ranked.rank <= %[3]s THEN ranked.value END ORDER BY ranked.step)
+	FROM (
+		SELECT g.labels, g.time_array, s.step, s.value,
+			row_number() OVER (PARTITION BY g.grouping, s.step ORDER BY s.value IS NULL, s.value = 'NaN', s.value %[4]s) as rank
+		FROM (
+			SELECT series.labels, %[2]s as grouping, series.time_array, series.value_array
+			FROM (%[1]s) as series
+		) as g, unnest(g.value_array) WITH ORDINALITY AS s(value, step)
+	) as ranked
+	GROUP BY ranked.labels, ranked.time_array`
+
+	/* histogram_quantile pushed down over the buckets at every step, wrapping one of the queries above. The buckets
+	   are grouped by their labels but le, and the metric name is dropped as in PromQL. */
+	timeseriesHistogramQuantileSQLFormat = `SELECT grouped.labels, grouped.time_array, array_agg(grouped.value ORDER BY grouped.step)
+	FROM (
+		SELECT g.labels, g.time_array, s.step, %[2]s as value
+		FROM (
+			SELECT ARRAY(SELECT l.id FROM unnest(series.labels) AS label_id INNER JOIN ` + schema.Catalog + `.label l ON (l.id = label_id) WHERE l.key NOT IN ('le', '__name__') ORDER BY l.id) as labels,
+				(SELECT ` + schema.Catalog + `.prom_parse_float(l.value) FROM unnest(series.labels) AS label_id INNER JOIN ` + schema.Catalog + `.label l ON (l.id = label_id) WHERE l.key = 'le') as upper_bound,
+				series.time_array, series.value_array
+			FROM (%[1]s) as series
+		) as g, unnest(g.value_array) WITH ORDINALITY AS s(value, step)
+		WHERE g.upper_bound IS NOT NULL
+		GROUP BY g.labels, g.time_array, s.step
+	) as grouped
+	GROUP BY grouped.labels, grouped.time_array`
 )
 
 var (
@@ -123,135 +178,6 @@ var (
 	maxTime = timestamp.FromTime(time.Unix(math.MaxInt64/1000-62135596801, 999999999).UTC())
 )
 
-func BuildSubQueries(matchers []*labels.Matcher) (*clauseBuilder, error) {
-	var err error
-	cb := &clauseBuilder{}
-
-	for _, m := range matchers {
-		// From the PromQL docs: "Label matchers that match
-		// empty label values also select all time series that
-		// do not have the specific label set at all."
-		matchesEmpty := m.Matches("")
-
-		switch m.Type {
-		case labels.MatchEqual:
-			if m.Name == pgmodel.MetricNameLabelName {
-				cb.SetMetricName(m.Value)
-				continue
-			}
-			sq := subQueryEQ
-			if matchesEmpty {
-				sq = subQueryEQMatchEmpty
-			}
-			err = cb.addClause(sq, m.Name, m.Value)
-		case labels.MatchNotEqual:
-			sq := subQueryNEQ
-			if matchesEmpty {
-				sq = subQueryNEQMatchEmpty
-			}
-			err = cb.addClause(sq, m.Name, m.Value)
-		case labels.MatchRegexp:
-			sq := subQueryRE
-			if matchesEmpty {
-				sq = subQueryREMatchEmpty
-			}
-			err = cb.addClause(sq, m.Name, anchorValue(m.Value))
-		case labels.MatchNotRegexp:
-			sq := subQueryNRE
-			if matchesEmpty {
-				sq = subQueryNREMatchEmpty
-			}
-			err = cb.addClause(sq, m.Name, anchorValue(m.Value))
-		}
-
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	return cb, err
-}
-
-/* Given a clause with %d placeholder for parameter numbers, and the existing and new parameters, return a clause with the parameters set to the appropriate $index and the full set of parameter values */
-func setParameterNumbers(clause string, existingArgs []interface{}, newArgs ...interface{}) (string, []interface{}, error) {
-	argIndex := len(existingArgs) + 1
-	argCountInClause := strings.Count(clause, "%d")
-
-	if argCountInClause != len(newArgs) {
-		return "", nil, fmt.Errorf("invalid number of args: in sql %d vs args %d", argCountInClause, len(newArgs))
-	}
-
-	argIndexes := make([]interface{}, 0, argCountInClause)
-
-	for argCountInClause > 0 {
-		argIndexes = append(argIndexes, argIndex)
-		argIndex++
-		argCountInClause--
-	}
-
-	newSQL := fmt.Sprintf(clause, argIndexes...)
-	resArgs := append(existingArgs, newArgs...)
-	return newSQL, resArgs, nil
-}
-
-type clauseBuilder struct {
-	metricName    string
-	contradiction bool
-	clauses       []string
-	args          []interface{}
-}
-
-func (c *clauseBuilder) SetMetricName(name string) {
-	if c.metricName == "" {
-		c.metricName = name
-		return
-	}
-
-	/* Impossible to have 2 different metric names at same time */
-	if c.metricName != name {
-		c.contradiction = true
-	}
-}
-
-func (c *clauseBuilder) GetMetricName() string {
-	return c.metricName
-}
-
-func (c *clauseBuilder) addClause(clause string, args ...interface{}) error {
-	clauseWithParameters, newArgs, err := setParameterNumbers(clause, c.args, args...)
-	if err != nil {
-		return err
-	}
-
-	c.clauses = append(c.clauses, clauseWithParameters)
-	c.args = newArgs
-	return nil
-}
-
-func (c *clauseBuilder) Build(includeMetricName bool) ([]string, []interface{}, error) {
-	if c.contradiction {
-		return []string{"FALSE"}, nil, nil
-	}
-
-	/* no support for queries across all data */
-	if len(c.clauses) == 0 && c.metricName == "" {
-		return nil, nil, errors.ErrNoClausesGen
-	}
-
-	if includeMetricName && c.metricName != "" {
-		nameClause, newArgs, err := setParameterNumbers(subQueryEQ, c.args, pgmodel.MetricNameLabelName, c.metricName)
-		if err != nil {
-			return nil, nil, err
-		}
-		return append(c.clauses, nameClause), newArgs, err
-	}
-
-	if len(c.clauses) == 0 {
-		return []string{"TRUE"}, nil, nil
-	}
-	return c.clauses, c.args, nil
-}
-
 func buildTimeSeries(rows []timescaleRow, lr lreader.LabelsReader) ([]*prompb.TimeSeries, error) {
 	results := make([]*prompb.TimeSeries, 0, len(rows))
 
@@ -291,50 +217,152 @@ func buildTimeSeries(rows []timescaleRow, lr lreader.LabelsReader) ([]*prompb.Ti
 	return results, nil
 }
 
-func BuildMetricNameSeriesIDQuery(cases []string) string {
-	return fmt.Sprintf(metricNameSeriesIDSQLFormat, strings.Join(cases, " AND "))
+// buildChunkedSeries converts a single result row into a series of
+// XOR-encoded chunks holding at most maxSamplesPerChunk samples each.
+func buildChunkedSeries(row timescaleRow, lr lreader.LabelsReader) (*prompb.ChunkedSeries, error) {
+	if row.err != nil {
+		return nil, row.err
+	}
+
+	if len(row.times.Elements) != len(row.values.Elements) {
+		return nil, errors.ErrQueryMismatchTimestampValue
+	}
+
+	promLabels, err := lr.PrompbLabelsForIds(row.labelIds)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(promLabels, func(i, j int) bool {
+		return promLabels[i].Name < promLabels[j].Name
+	})
+
+	numSamples := len(row.times.Elements)
+	result := &prompb.ChunkedSeries{
+		Labels: promLabels,
+		Chunks: make([]prompb.Chunk, 0, (numSamples+maxSamplesPerChunk-1)/maxSamplesPerChunk),
+	}
+
+	for start := 0; start < numSamples; start += maxSamplesPerChunk {
+		end := start + maxSamplesPerChunk
+		if end > numSamples {
+			end = numSamples
+		}
+
+		chk := chunkenc.NewXORChunk()
+		app, err := chk.Appender()
+		if err != nil {
+			return nil, err
+		}
+
+		for i := start; i < end; i++ {
+			app.Append(pgmodel.TimestamptzToMs(row.times.Elements[i]), row.values.Elements[i].Float)
+		}
+
+		result.Chunks = append(result.Chunks, prompb.Chunk{
+			MinTimeMs: pgmodel.TimestamptzToMs(row.times.Elements[start]),
+			MaxTimeMs: pgmodel.TimestamptzToMs(row.times.Elements[end-1]),
+			Type:      prompb.Chunk_XOR,
+			Data:      chk.Bytes(),
+		})
+	}
+
+	return result, nil
 }
 
-func buildTimeseriesBySeriesIDQuery(filter metricTimeRangeFilter, series []pgmodel.SeriesID) string {
-	s := make([]string, 0, len(series))
-	for _, sID := range series {
-		s = append(s, fmt.Sprintf("%d", sID))
-	}
-	return fmt.Sprintf(
-		timeseriesBySeriesIDsSQLFormat,
-		pgx.Identifier{schema.Data, filter.metric}.Sanitize(),
-		pgx.Identifier{schema.DataSeries, filter.metric}.Sanitize(),
-		strings.Join(s, ","),
-		filter.startTime,
-		filter.endTime,
-	)
+// buildMultipleMetricsQuery builds the query reading the series matching the
+// clauses across all the metrics.
+func buildMultipleMetricsQuery(filter metricTimeRangeFilter, cases []string, values []interface{}) (string, []interface{}, error) {
+	timeClause, values, err := clauses.SetParameterNumbers("$%d, $%d", values, filter.startTime, filter.endTime)
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf(timeseriesByMetricSeriesSQLFormat, strings.Join(cases, " AND "), timeClause), values, nil
 }
 
+// buildTimeseriesByLabelClausesQuery builds the query of a single metric. If
+// downsample is not nil, the query reads the continuous aggregate of the
+// metric instead of the raw samples, and a pushed down function is evaluated
+// over the aggregated samples.
 func buildTimeseriesByLabelClausesQuery(filter metricTimeRangeFilter, cases []string, values []interface{},
-	hints *storage.SelectHints, path []parser.Node) (string, []interface{}, parser.Node, error) {
+	hints *storage.SelectHints, path []parser.Node, downsample *downsampleView) (string, []interface{}, parser.Node, error) {
 	qf, node, err := getAggregators(hints, path)
 	if err != nil {
 		return "", nil, nil, err
 	}
 
-	timeClauseBound, values, err := setParameterNumbers(qf.timeClause, values, qf.timeParams...)
+	timeClauseBound, values, err := clauses.SetParameterNumbers(qf.timeClause, values, qf.timeParams...)
 	if err != nil {
 		return "", nil, nil, err
 	}
-	valueClauseBound, values, err := setParameterNumbers(qf.valueClause, values, qf.valueParams...)
+	valueClauseBound, values, err := clauses.SetParameterNumbers(qf.valueClause, values, qf.valueParams...)
 	if err != nil {
 		return "", nil, nil, err
 	}
 
-	finalSQL := fmt.Sprintf(timeseriesByMetricSQLFormat,
-		pgx.Identifier{schema.Data, filter.metric}.Sanitize(),
-		pgx.Identifier{schema.DataSeries, filter.metric}.Sanitize(),
-		strings.Join(cases, " AND "),
-		filter.startTime,
-		filter.endTime,
-		timeClauseBound,
-		valueClauseBound,
-	)
+	var finalSQL string
+	if downsample != nil {
+		finalSQL = fmt.Sprintf(timeseriesByDownsampleSQLFormat,
+			pgx.Identifier{schema.DataDownsample, downsample.viewName}.Sanitize(),
+			pgx.Identifier{schema.DataSeries, filter.metric}.Sanitize(),
+			strings.Join(cases, " AND "),
+			filter.startTime,
+			filter.endTime,
+			timeClauseBound,
+			valueClauseBound,
+			pgx.Identifier{downsample.column}.Sanitize(),
+			downsample.resolution,
+		)
+	} else {
+		finalSQL = fmt.Sprintf(timeseriesByMetricSQLFormat,
+			pgx.Identifier{schema.Data, filter.metric}.Sanitize(),
+			pgx.Identifier{schema.DataSeries, filter.metric}.Sanitize(),
+			strings.Join(cases, " AND "),
+			filter.startTime,
+			filter.endTime,
+			timeClauseBound,
+			valueClauseBound,
+		)
+	}
+
+	if qf.groupingClause != "" {
+		groupingClauseBound, groupingValues, err := clauses.SetParameterNumbers(qf.groupingClause, values, qf.groupingParams...)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		values = groupingValues
+		if qf.rankOrder != "" {
+			var rankLimit string
+			rankLimit, values, err = clauses.SetParameterNumbers("$%d", values, qf.rankParams...)
+			if err != nil {
+				return "", nil, nil, err
+			}
+			finalSQL = fmt.Sprintf(timeseriesRankedSQLFormat,
+				finalSQL,
+				groupingClauseBound,
+				rankLimit,
+				qf.rankOrder,
+			)
+		} else {
+			finalSQL = fmt.Sprintf(timeseriesAggregatedSQLFormat,
+				finalSQL,
+				groupingClauseBound,
+				qf.aggregateClause,
+			)
+		}
+	}
+
+	if qf.histogramClause != "" {
+		histogramClauseBound, histogramValues, err := clauses.SetParameterNumbers(qf.histogramClause, values, qf.histogramParams...)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		values = histogramValues
+		finalSQL = fmt.Sprintf(timeseriesHistogramQuantileSQLFormat,
+			finalSQL,
+			histogramClauseBound,
+		)
+	}
 
 	return finalSQL, values, node, nil
 }
@@ -349,45 +377,58 @@ func hasSubquery(path []parser.Node) bool {
 	return false
 }
 
+// hasSingleSelector returns true if the expression at the root of the path
+// has a single vector selector. The engine evaluates at most one pushed down
+// node per query, so the selectors of an expression with several of them
+// are never pushed down.
+func hasSingleSelector(path []parser.Node) bool {
+	selectors := 0
+	parser.Inspect(path[0], func(node parser.Node, _ []parser.Node) error {
+		if _, ok := node.(*parser.VectorSelector); ok {
+			selectors++
+		}
+		return nil
+	})
+	return selectors == 1
+}
+
 type aggregators struct {
 	timeClause  string
 	timeParams  []interface{}
 	valueClause string
 	valueParams []interface{}
+	// When an aggregation is pushed down, the series are grouped by the
+	// labels returned by groupingClause and their values at every step are
+	// aggregated with aggregateClause, or ranked in rankOrder for topk and
+	// bottomk, keeping the rankParams first ones.
+	groupingClause  string
+	groupingParams  []interface{}
+	aggregateClause string
+	rankOrder       string
+	rankParams      []interface{}
+	// When histogram_quantile is pushed down, the buckets at every step are
+	// aggregated with histogramClause.
+	histogramClause string
+	histogramParams []interface{}
 }
 
 /* The path is the list of ancestors (direct parent last) returned node is the most-ancestral node processed by the pushdown */
 func getAggregators(hints *storage.SelectHints, path []parser.Node) (*aggregators, parser.Node, error) {
-	if extension.ExtensionIsInstalled && path != nil && hints != nil && len(path) >= 2 && !hasSubquery(path) {
-		var topNode parser.Node
-
-		node := path[len(path)-2]
-		switch n := node.(type) {
-		case *parser.Call:
-			if n.Func.Name == "delta" {
-				topNode = node
-				queryStart := hints.Start + hints.Range
-				queryEnd := hints.End
-				stepDuration := time.Second
-				rangeDuration := time.Duration(hints.Range) * time.Millisecond
-
-				if hints.Step > 0 {
-					stepDuration = time.Duration(hints.Step) * time.Millisecond
-				} else {
-					if queryStart != queryEnd {
-						panic("query start should equal query end")
-					}
-				}
-				qf := aggregators{
-					timeClause:  "ARRAY(SELECT generate_series($%d::timestamptz, $%d::timestamptz, $%d))",
-					timeParams:  []interface{}{model.Time(queryStart).Time(), model.Time(queryEnd).Time(), stepDuration},
-					valueClause: "prom_delta($%d, $%d,$%d, $%d, time, value)",
-					valueParams: []interface{}{model.Time(hints.Start).Time(), model.Time(queryEnd).Time(), int64(stepDuration.Milliseconds()), int64(rangeDuration.Milliseconds())},
-				}
-				return &qf, topNode, nil
+	if len(path) > 0 && hints != nil && !hasSubquery(path) && !hasModifiers(path) && hasSingleSelector(path) {
+		qf, topNode, parent := getFunctionPushdown(hints, path)
+		if qf != nil && parent >= 0 {
+			if agg, ok := path[parent].(*parser.AggregateExpr); ok && qf.setAggregate(agg) {
+				topNode = agg
+				parent--
 			}
-		default:
-			//No pushdown optimization by default
+		}
+		if qf != nil && parent >= 0 {
+			if call, ok := path[parent].(*parser.Call); ok && qf.setHistogramQuantile(call) {
+				topNode = call
+			}
+		}
+		if topNode != nil {
+			return qf, topNode, nil
 		}
 	}
 
@@ -398,54 +439,233 @@ func getAggregators(hints *storage.SelectHints, path []parser.Node) (*aggregator
 	return &qf, nil, nil
 }
 
-func GetSeriesPerMetric(rows pgx.Rows) ([]string, [][]pgmodel.SeriesID, error) {
-	metrics := make([]string, 0)
-	series := make([][]pgmodel.SeriesID, 0)
-
-	for rows.Next() {
-		var (
-			metricName string
-			seriesIDs  []int64
-		)
-		if err := rows.Scan(&metricName, &seriesIDs); err != nil {
-			return nil, nil, err
+// getFunctionPushdown returns the evaluation in SQL of the function applied
+// to the selector the path leads to, along with the node of the function and
+// the index of its parent in the path. An instant vector selector is only
+// evaluated in SQL when it is aggregated or passed to histogram_quantile, in
+// which case the returned node is nil.
+func getFunctionPushdown(hints *storage.SelectHints, path []parser.Node) (*aggregators, parser.Node, int) {
+	if isInstantSelectorParent(path[len(path)-1]) {
+		if hints.Range != 0 {
+			return nil, nil, -1
+		}
+		w := newPushdownWindow(hints, defaultLookback)
+		qf := aggregators{
+			timeClause:  w.timeClause(),
+			timeParams:  w.timeParams(),
+			valueClause: schema.Catalog + ".prom_vector_selector($%d, $%d, $%d, $%d, array_agg(time), array_agg(value))",
+			valueParams: w.valueParams(),
 		}
+		return &qf, nil, len(path) - 1
+	}
 
-		sIDs := make([]pgmodel.SeriesID, 0, len(seriesIDs))
+	if len(path) < 2 {
+		return nil, nil, -1
+	}
+	if _, ok := path[len(path)-1].(*parser.MatrixSelector); !ok {
+		return nil, nil, -1
+	}
+	call, ok := path[len(path)-2].(*parser.Call)
+	if !ok {
+		return nil, nil, -1
+	}
 
-		for _, v := range seriesIDs {
-			sIDs = append(sIDs, pgmodel.SeriesID(v))
+	w := newPushdownWindow(hints, time.Duration(hints.Range)*time.Millisecond)
+	qf := aggregators{
+		timeClause: w.timeClause(),
+		timeParams: w.timeParams(),
+	}
+	switch call.Func.Name {
+	case "delta":
+		if !extension.ExtensionIsInstalled {
+			return nil, nil, -1
+		}
+		qf.valueClause = "prom_delta($%d, $%d,$%d, $%d, time, value)"
+		qf.valueParams = []interface{}{model.Time(hints.Start).Time(), model.Time(w.end).Time(), int64(w.step.Milliseconds()), int64(w.rng.Milliseconds())}
+	case "rate", "increase":
+		qf.valueClause = schema.Catalog + ".prom_extrapolated_rate($%d, $%d, $%d, $%d, array_agg(time), array_agg(value), true, $%d)"
+		qf.valueParams = append(w.valueParams(), call.Func.Name == "rate")
+	case "avg_over_time", "min_over_time", "max_over_time", "sum_over_time", "count_over_time", "last_over_time":
+		qf.valueClause = schema.Catalog + ".prom_over_time($%d, $%d, $%d, $%d, array_agg(time), array_agg(value), $%d)"
+		qf.valueParams = append(w.valueParams(), call.Func.Name)
+	case "quantile_over_time":
+		q, ok := numberLiteral(call.Args[0])
+		if !ok {
+			return nil, nil, -1
 		}
+		qf.valueClause = schema.Catalog + ".prom_quantile_over_time($%d, $%d, $%d, $%d, array_agg(time), array_agg(value), $%d)"
+		qf.valueParams = append(w.valueParams(), q)
+	case "irate":
+		qf.valueClause = schema.Catalog + ".prom_instant_rate($%d, $%d, $%d, $%d, array_agg(time), array_agg(value), true)"
+		qf.valueParams = w.valueParams()
+	default:
+		return nil, nil, -1
+	}
+	return &qf, call, len(path) - 3
+}
 
-		metrics = append(metrics, metricName)
-		series = append(series, sIDs)
+// isInstantSelectorParent returns true if the node applies an aggregation or
+// histogram_quantile directly to an instant vector selector.
+func isInstantSelectorParent(node parser.Node) bool {
+	switch n := node.(type) {
+	case *parser.AggregateExpr:
+		_, ok := n.Expr.(*parser.VectorSelector)
+		return ok
+	case *parser.Call:
+		if n.Func.Name != "histogram_quantile" {
+			return false
+		}
+		_, ok := n.Args[1].(*parser.VectorSelector)
+		return ok
 	}
+	return false
+}
 
-	return metrics, series, nil
+// numberLiteral returns the value of a number literal parameter.
+func numberLiteral(expr parser.Expr) (float64, bool) {
+	switch e := expr.(type) {
+	case *parser.NumberLiteral:
+		return e.Val, true
+	case *parser.ParenExpr:
+		return numberLiteral(e.Expr)
+	case *parser.StepInvariantExpr:
+		return numberLiteral(e.Expr)
+	}
+	return 0, false
 }
 
-// anchorValue adds anchors to values in regexps since PromQL docs
-// states that "Regex-matches are fully anchored."
-func anchorValue(str string) string {
-	l := len(str)
+// setAggregate sets the aggregation of the series, if it can be evaluated in
+// SQL. The series are grouped by label ids sorted by id, so that the series
+// with the same grouping labels have equal arrays.
+func (qf *aggregators) setAggregate(agg *parser.AggregateExpr) bool {
+	var (
+		aggregateClause string
+		rankOrder       string
+		rankParams      []interface{}
+	)
+	switch agg.Op {
+	case parser.SUM:
+		aggregateClause = "sum(s.value)"
+	case parser.AVG:
+		aggregateClause = "avg(s.value)"
+	case parser.COUNT:
+		// No sample, rather than 0, at the steps without any value.
+		aggregateClause = "nullif(count(s.value), 0)::double precision"
+	case parser.MIN:
+		// NaN is greater than any number in PostgreSQL, but is only kept by
+		// PromQL if all the values are NaN.
+		aggregateClause = "coalesce(min(s.value) FILTER (WHERE s.value <> 'NaN'), min(s.value))"
+	case parser.MAX:
+		aggregateClause = "coalesce(max(s.value) FILTER (WHERE s.value <> 'NaN'), max(s.value))"
+	case parser.TOPK, parser.BOTTOMK:
+		k, ok := numberLiteral(agg.Param)
+		// Let the engine report the k overflowing an int64.
+		if !ok || math.IsNaN(k) || k >= math.MaxInt64 || k <= math.MinInt64 {
+			return false
+		}
+		rankOrder = "DESC"
+		if agg.Op == parser.BOTTOMK {
+			rankOrder = "ASC"
+		}
+		rankParams = []interface{}{int64(k)}
+	default:
+		return false
+	}
 
-	if l == 0 {
-		return "^$"
+	grouping := append([]string{}, agg.Grouping...)
+	if agg.Without {
+		grouping = append(grouping, pgmodel.MetricNameLabelName)
+		qf.groupingClause = "ARRAY(SELECT l.id FROM unnest(series.labels) AS label_id INNER JOIN " + schema.Catalog + ".label l ON (l.id = label_id) WHERE l.key <> ALL($%d::text[]) ORDER BY l.id)"
+	} else {
+		for _, name := range grouping {
+			if name == pgmodel.MetricNameLabelName {
+				// The metric name of the series is dropped by the engine.
+				return false
+			}
+		}
+		qf.groupingClause = "ARRAY(SELECT l.id FROM unnest(series.labels) AS label_id INNER JOIN " + schema.Catalog + ".label l ON (l.id = label_id) WHERE l.key = ANY($%d::text[]) ORDER BY l.id)"
 	}
+	qf.groupingParams = []interface{}{grouping}
+	qf.aggregateClause = aggregateClause
+	qf.rankOrder = rankOrder
+	qf.rankParams = rankParams
+	return true
+}
 
-	if str[0] == '^' && str[l-1] == '$' {
-		return str
+// setHistogramQuantile sets the evaluation of histogram_quantile over the
+// buckets, if it can be evaluated in SQL.
+func (qf *aggregators) setHistogramQuantile(call *parser.Call) bool {
+	if call.Func.Name != "histogram_quantile" || qf.rankOrder != "" {
+		return false
+	}
+	q, ok := numberLiteral(call.Args[0])
+	if !ok {
+		return false
 	}
+	qf.histogramClause = schema.Catalog + ".prom_bucket_quantile($%d, array_agg(g.upper_bound), array_agg(s.value))"
+	qf.histogramParams = []interface{}{q}
+	return true
+}
 
-	if str[0] == '^' {
-		return fmt.Sprintf("%s$", str)
+// hasModifiers returns true if the selector the path leads to has an offset
+// or an @ modifier, whose evaluation times differ from the ones of the query.
+func hasModifiers(path []parser.Node) bool {
+	var selector parser.Expr
+	switch n := path[len(path)-1].(type) {
+	case *parser.MatrixSelector:
+		selector = n.VectorSelector
+	case *parser.AggregateExpr:
+		selector = n.Expr
+	case *parser.Call:
+		for _, arg := range n.Args {
+			if vs, ok := arg.(*parser.VectorSelector); ok {
+				selector = vs
+			}
+		}
 	}
+	vs, ok := selector.(*parser.VectorSelector)
+	return ok && (vs.OriginalOffset != 0 || vs.Timestamp != nil)
+}
 
-	if str[l-1] == '$' {
-		return fmt.Sprintf("^%s", str)
+// pushdownWindow holds the evaluation steps of a range function pushed down
+// into the database.
+type pushdownWindow struct {
+	start int64 // Timestamp of the first step, in milliseconds.
+	end   int64 // Timestamp of the last step, in milliseconds.
+	step  time.Duration
+	rng   time.Duration
+}
+
+// newPushdownWindow returns the evaluation steps of a selector over the range
+// rng, which is the lookback delta for an instant vector selector.
+func newPushdownWindow(hints *storage.SelectHints, rng time.Duration) pushdownWindow {
+	w := pushdownWindow{
+		start: hints.Start + rng.Milliseconds(),
+		end:   hints.End,
+		step:  time.Second,
+		rng:   rng,
 	}
+	if hints.Step > 0 {
+		w.step = time.Duration(hints.Step) * time.Millisecond
+	} else if w.start != w.end {
+		panic("query start should equal query end")
+	}
+	return w
+}
+
+// timeClause returns the timestamps of the steps.
+func (w pushdownWindow) timeClause() string {
+	return "ARRAY(SELECT generate_series($%d::timestamptz, $%d::timestamptz, $%d))"
+}
+
+func (w pushdownWindow) timeParams() []interface{} {
+	return []interface{}{model.Time(w.start).Time(), model.Time(w.end).Time(), w.step}
+}
 
-	return fmt.Sprintf("^%s$", str)
+// valueParams returns the parameters of the functions evaluating the steps
+// in SQL: the first and the last step, the step and the range.
+func (w pushdownWindow) valueParams() []interface{} {
+	return []interface{}{model.Time(w.start).Time(), model.Time(w.end).Time(), w.step.Milliseconds(), w.rng.Milliseconds()}
 }
 
 func toMilis(t time.Time) int64 {
@@ -0,0 +1,369 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package querier
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/promql"
+)
+
+// selectorPath returns the path to the only vector selector of the expression.
+func selectorPath(t *testing.T, expr string) []parser.Node {
+	e, err := parser.ParseExpr(expr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var selectorPath []parser.Node
+	parser.Inspect(e, func(node parser.Node, path []parser.Node) error {
+		if _, ok := node.(*parser.VectorSelector); ok {
+			selectorPath = append([]parser.Node{}, path...)
+		}
+		return nil
+	})
+	return selectorPath
+}
+
+func TestGetAggregatorsPushdown(t *testing.T) {
+	hints := &storage.SelectHints{Start: 1000, End: 61000, Step: 30000, Range: 30000}
+	queryStart := model.Time(31000).Time()
+	queryEnd := model.Time(61000).Time()
+
+	testCases := []struct {
+		expr        string
+		pushdown    bool
+		valueClause string
+		valueParams []interface{}
+		// aggregated is set if the aggregation at the root of the
+		// expression is pushed down too.
+		aggregated      bool
+		aggregateClause string
+		rankOrder       string
+		grouping        []string
+	}{
+		{
+			expr:        "rate(metric[30s])",
+			pushdown:    true,
+			valueClause: "_prom_catalog.prom_extrapolated_rate($%d, $%d, $%d, $%d, array_agg(time), array_agg(value), true, $%d)",
+			valueParams: []interface{}{queryStart, queryEnd, int64(30000), int64(30000), true},
+		},
+		{
+			expr:            "sum(increase(metric[30s]))",
+			pushdown:        true,
+			valueClause:     "_prom_catalog.prom_extrapolated_rate($%d, $%d, $%d, $%d, array_agg(time), array_agg(value), true, $%d)",
+			valueParams:     []interface{}{queryStart, queryEnd, int64(30000), int64(30000), false},
+			aggregated:      true,
+			aggregateClause: "sum(s.value)",
+			grouping:        []string{},
+		},
+		{
+			expr:        "topk(3, increase(metric[30s]))",
+			pushdown:    true,
+			valueClause: "_prom_catalog.prom_extrapolated_rate($%d, $%d, $%d, $%d, array_agg(time), array_agg(value), true, $%d)",
+			valueParams: []interface{}{queryStart, queryEnd, int64(30000), int64(30000), false},
+			aggregated:  true,
+			rankOrder:   "DESC",
+			grouping:    []string{},
+		},
+		{
+			expr:        "bottomk by (job) (3, increase(metric[30s]))",
+			pushdown:    true,
+			valueClause: "_prom_catalog.prom_extrapolated_rate($%d, $%d, $%d, $%d, array_agg(time), array_agg(value), true, $%d)",
+			valueParams: []interface{}{queryStart, queryEnd, int64(30000), int64(30000), false},
+			aggregated:  true,
+			rankOrder:   "ASC",
+			grouping:    []string{"job"},
+		},
+		{
+			expr:        "quantile(0.9, increase(metric[30s]))",
+			pushdown:    true,
+			valueClause: "_prom_catalog.prom_extrapolated_rate($%d, $%d, $%d, $%d, array_agg(time), array_agg(value), true, $%d)",
+			valueParams: []interface{}{queryStart, queryEnd, int64(30000), int64(30000), false},
+		},
+		{
+			expr:        "sum by (__name__) (increase(metric[30s]))",
+			pushdown:    true,
+			valueClause: "_prom_catalog.prom_extrapolated_rate($%d, $%d, $%d, $%d, array_agg(time), array_agg(value), true, $%d)",
+			valueParams: []interface{}{queryStart, queryEnd, int64(30000), int64(30000), false},
+		},
+		{
+			expr:        "irate(metric[30s])",
+			pushdown:    true,
+			valueClause: "_prom_catalog.prom_instant_rate($%d, $%d, $%d, $%d, array_agg(time), array_agg(value), true)",
+			valueParams: []interface{}{queryStart, queryEnd, int64(30000), int64(30000)},
+		},
+		{
+			expr:        "avg_over_time(metric[30s])",
+			pushdown:    true,
+			valueClause: "_prom_catalog.prom_over_time($%d, $%d, $%d, $%d, array_agg(time), array_agg(value), $%d)",
+			valueParams: []interface{}{queryStart, queryEnd, int64(30000), int64(30000), "avg_over_time"},
+		},
+		{
+			expr:            "max by (job) (last_over_time(metric[30s]))",
+			pushdown:        true,
+			valueClause:     "_prom_catalog.prom_over_time($%d, $%d, $%d, $%d, array_agg(time), array_agg(value), $%d)",
+			valueParams:     []interface{}{queryStart, queryEnd, int64(30000), int64(30000), "last_over_time"},
+			aggregated:      true,
+			aggregateClause: "coalesce(max(s.value) FILTER (WHERE s.value <> 'NaN'), max(s.value))",
+			grouping:        []string{"job"},
+		},
+		{
+			expr:        "quantile_over_time(0.9, metric[30s])",
+			pushdown:    true,
+			valueClause: "_prom_catalog.prom_quantile_over_time($%d, $%d, $%d, $%d, array_agg(time), array_agg(value), $%d)",
+			valueParams: []interface{}{queryStart, queryEnd, int64(30000), int64(30000), 0.9},
+		},
+		{
+			expr: "quantile_over_time(scalar(other), metric[30s])",
+		},
+		{
+			expr: "rate(metric[30s] offset 1m)",
+		},
+		{
+			expr: "max_over_time(rate(metric[30s])[5m:1m])",
+		},
+		{
+			expr: "metric",
+		},
+		{
+			expr: "abs(metric)",
+		},
+		{
+			expr: "count without (instance) (metric offset 1m)",
+		},
+		{
+			expr: "rate(other[30s]) / rate(metric[30s])",
+		},
+		{
+			expr: "sum(rate(other[30s])) / sum(rate(metric[30s]))",
+		},
+		{
+			expr: "max_over_time(other[30s]) - min_over_time(metric[30s])",
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.expr, func(t *testing.T) {
+			path := selectorPath(t, c.expr)
+			qf, node, err := getAggregators(hints, path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !c.pushdown {
+				if node != nil {
+					t.Errorf("unexpected pushdown of %v", node)
+				}
+				if qf.valueClause != "array_agg(value)" {
+					t.Errorf("unexpected value clause %s", qf.valueClause)
+				}
+				return
+			}
+
+			expectedNode := path[len(path)-2]
+			if c.aggregated {
+				expectedNode = path[0]
+			}
+			if node != expectedNode {
+				t.Errorf("unexpected pushed down node: got %v wanted %v", node, expectedNode)
+			}
+			if qf.rankOrder != c.rankOrder {
+				t.Errorf("unexpected rank order: got %q wanted %q", qf.rankOrder, c.rankOrder)
+			}
+			if qf.aggregateClause != c.aggregateClause {
+				t.Errorf("unexpected aggregate clause:\ngot\n%s\nwanted\n%s", qf.aggregateClause, c.aggregateClause)
+			}
+			if c.aggregated && !reflect.DeepEqual(qf.groupingParams, []interface{}{c.grouping}) {
+				t.Errorf("unexpected grouping params:\ngot\n%v\nwanted\n%v", qf.groupingParams, c.grouping)
+			}
+			expectedTimeParams := []interface{}{queryStart, queryEnd, 30 * time.Second}
+			if !reflect.DeepEqual(qf.timeParams, expectedTimeParams) {
+				t.Errorf("unexpected time params:\ngot\n%v\nwanted\n%v", qf.timeParams, expectedTimeParams)
+			}
+			if qf.valueClause != c.valueClause {
+				t.Errorf("unexpected value clause:\ngot\n%s\nwanted\n%s", qf.valueClause, c.valueClause)
+			}
+			if !reflect.DeepEqual(qf.valueParams, c.valueParams) {
+				t.Errorf("unexpected value params:\ngot\n%v\nwanted\n%v", qf.valueParams, c.valueParams)
+			}
+		})
+	}
+}
+
+func TestGetAggregatorsVectorSelectorPushdown(t *testing.T) {
+	// An instant vector selector selects the lookback delta before the start.
+	hints := &storage.SelectHints{Start: 1000 - defaultLookback.Milliseconds(), End: 61000, Step: 30000, Func: "count"}
+	path := selectorPath(t, "count without (instance) (metric)")
+
+	qf, node, err := getAggregators(hints, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node != path[0] {
+		t.Errorf("unexpected pushed down node: got %v wanted %v", node, path[0])
+	}
+	expectedValueParams := []interface{}{model.Time(1000).Time(), model.Time(61000).Time(), int64(30000), defaultLookback.Milliseconds()}
+	if !reflect.DeepEqual(qf.valueParams, expectedValueParams) {
+		t.Errorf("unexpected value params:\ngot\n%v\nwanted\n%v", qf.valueParams, expectedValueParams)
+	}
+	if qf.aggregateClause != "nullif(count(s.value), 0)::double precision" {
+		t.Errorf("unexpected aggregate clause %s", qf.aggregateClause)
+	}
+	expectedGrouping := []interface{}{[]string{"instance", "__name__"}}
+	if !reflect.DeepEqual(qf.groupingParams, expectedGrouping) {
+		t.Errorf("unexpected grouping params:\ngot\n%v\nwanted\n%v", qf.groupingParams, expectedGrouping)
+	}
+}
+
+func TestBuildAggregatedQuery(t *testing.T) {
+	filter := metricTimeRangeFilter{
+		metric:    "metric_table",
+		startTime: toRFC3339Nano(1000),
+		endTime:   toRFC3339Nano(61000),
+	}
+	hints := &storage.SelectHints{Start: 1000, End: 61000, Step: 30000, Range: 30000}
+	path := selectorPath(t, "sum by (job) (rate(metric[30s]))")
+
+	sql, values, node, err := buildTimeseriesByLabelClausesQuery(filter, []string{"labels && $1"}, []interface{}{"label"}, hints, path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node != path[0] {
+		t.Errorf("unexpected pushed down node: got %v wanted %v", node, path[0])
+	}
+	// The selector parameter, 3 for the steps, 5 for rate and the grouping.
+	if len(values) != 10 || !reflect.DeepEqual(values[9], []string{"job"}) {
+		t.Errorf("unexpected values %v", values)
+	}
+	for _, expected := range []string{
+		"SELECT grouped.labels, grouped.time_array, array_agg(grouped.value ORDER BY grouped.step)",
+		"SELECT ARRAY(SELECT l.id FROM unnest(series.labels) AS label_id INNER JOIN _prom_catalog.label l ON (l.id = label_id) WHERE l.key = ANY($10::text[]) ORDER BY l.id) as labels",
+		"SELECT g.labels, g.time_array, s.step, sum(s.value) as value",
+		`FROM "prom_data"."metric_table" metric`,
+	} {
+		if !strings.Contains(sql, expected) {
+			t.Errorf("query does not contain %q:\n%s", expected, sql)
+		}
+	}
+}
+
+func TestGetAggregatorsHistogramQuantilePushdown(t *testing.T) {
+	testCases := []struct {
+		expr      string
+		hints     *storage.SelectHints
+		pushdown  bool
+		aggregate string
+	}{
+		{
+			expr:      "histogram_quantile(0.9, sum by (le, job) (rate(metric_bucket[30s])))",
+			hints:     &storage.SelectHints{Start: 1000, End: 61000, Step: 30000, Range: 30000},
+			pushdown:  true,
+			aggregate: "sum(s.value)",
+		},
+		{
+			expr:     "histogram_quantile(0.9, rate(metric_bucket[30s]))",
+			hints:    &storage.SelectHints{Start: 1000, End: 61000, Step: 30000, Range: 30000},
+			pushdown: true,
+		},
+		{
+			expr:     "histogram_quantile(0.9, metric_bucket)",
+			hints:    &storage.SelectHints{Start: 1000 - defaultLookback.Milliseconds(), End: 61000, Step: 30000},
+			pushdown: true,
+		},
+		{
+			expr:  "histogram_quantile(0.9, topk(3, rate(metric_bucket[30s])))",
+			hints: &storage.SelectHints{Start: 1000, End: 61000, Step: 30000, Range: 30000},
+		},
+		{
+			expr:  "histogram_quantile(0.9, metric_bucket offset 1m)",
+			hints: &storage.SelectHints{Start: 1000 - defaultLookback.Milliseconds(), End: 61000, Step: 30000},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.expr, func(t *testing.T) {
+			path := selectorPath(t, c.expr)
+			qf, node, err := getAggregators(c.hints, path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !c.pushdown {
+				if node == path[0] {
+					t.Errorf("unexpected pushdown of %v", node)
+				}
+				return
+			}
+			if node != path[0] {
+				t.Errorf("unexpected pushed down node: got %v wanted %v", node, path[0])
+			}
+			if qf.aggregateClause != c.aggregate {
+				t.Errorf("unexpected aggregate clause: got %q wanted %q", qf.aggregateClause, c.aggregate)
+			}
+			if !reflect.DeepEqual(qf.histogramParams, []interface{}{0.9}) {
+				t.Errorf("unexpected histogram params %v", qf.histogramParams)
+			}
+		})
+	}
+}
+
+func TestPushdownSelectorsOfBinaryExpression(t *testing.T) {
+	engine := promql.NewEngine(promql.EngineOpts{
+		Logger:     log.NewNopLogger(),
+		MaxSamples: 1000,
+		Timeout:    time.Minute,
+	})
+	for _, expr := range []string{
+		"rate(a[5m]) / rate(b[5m])",
+		"sum(a) / sum(b)",
+		"sum(rate(a[5m])) / sum(rate(b[5m]))",
+		"quantile_over_time(0.9, a[5m]) - histogram_quantile(0.9, rate(b[5m]))",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			qry, err := engine.NewRangeQuery(pushdownQueryable{}, expr, model.Time(0).Time(), model.Time(600000).Time(), time.Minute)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res := qry.Exec(context.Background()); res.Err != nil {
+				t.Fatal(res.Err)
+			}
+		})
+	}
+}
+
+// pushdownQueryable selects no series, pushing down the nodes of the path as
+// the querier does.
+type pushdownQueryable struct{}
+
+func (pushdownQueryable) Querier(context.Context, int64, int64) (promql.Querier, error) {
+	return pushdownQueryable{}, nil
+}
+
+func (pushdownQueryable) LabelValues(string, ...*labels.Matcher) ([]string, storage.Warnings, error) {
+	return nil, nil, nil
+}
+
+func (pushdownQueryable) LabelNames(...*labels.Matcher) ([]string, storage.Warnings, error) {
+	return nil, nil, nil
+}
+
+func (pushdownQueryable) Close() error {
+	return nil
+}
+
+func (pushdownQueryable) Select(_ bool, hints *storage.SelectHints, path []parser.Node, _ ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	_, node, err := getAggregators(hints, path)
+	if err != nil {
+		return storage.ErrSeriesSet(err), nil
+	}
+	return storage.EmptySeriesSet(), node
+}
@@ -23,47 +23,84 @@ const (
 	PostgresUnixEpoch = -946684800000
 )
 
-// pgxSeriesSet implements storage.SeriesSet.
+// SeriesSetCloser is implemented by the series sets that hold on to database
+// resources until they are exhausted. Close releases them early, and is safe
+// to call more than once.
+type SeriesSetCloser interface {
+	Close()
+}
+
+// pgxSeriesSet implements storage.SeriesSet. It reads the result rows as the
+// series are consumed, holding on to the current row only, and closes them
+// once they are exhausted or the budget of the query is exceeded.
 type pgxSeriesSet struct {
-	rowIdx  int
-	rows    []timescaleRow
+	rows    tsRows
+	row     timescaleRow
+	done    bool
 	err     error
 	querier labelQuerier
+	budget  *QueryBudget
 }
 
-// pgxSeriesSet must implement storage.SeriesSet
-var _ storage.SeriesSet = (*pgxSeriesSet)(nil)
+// pgxSeriesSet must implement storage.SeriesSet and SeriesSetCloser
+var (
+	_ storage.SeriesSet = (*pgxSeriesSet)(nil)
+	_ SeriesSetCloser   = (*pgxSeriesSet)(nil)
+)
 
-func buildSeriesSet(rows []timescaleRow, querier labelQuerier) storage.SeriesSet {
+// buildSeriesSet returns a series set over the rows, which may be nil if
+// there are no results. The series read are accounted for in the budget.
+func buildSeriesSet(rows tsRows, querier labelQuerier, budget *QueryBudget) storage.SeriesSet {
 	return &pgxSeriesSet{
 		rows:    rows,
 		querier: querier,
-		rowIdx:  -1,
+		budget:  budget,
+		done:    rows == nil,
 	}
 }
 
 // Next forwards the internal cursor to next storage.Series
 func (p *pgxSeriesSet) Next() bool {
-	if p.rowIdx >= len(p.rows) {
+	if p.done {
 		return false
 	}
-	p.rowIdx += 1
-	if p.rowIdx >= len(p.rows) {
+	p.row = timescaleRow{}
+	if p.err != nil || !p.rows.Next() {
+		if p.err == nil {
+			p.err = p.rows.Err()
+		}
+		p.Close()
 		return false
 	}
-	if p.err == nil {
-		p.err = p.rows[p.rowIdx].err
+	p.row.err = p.rows.Scan(&p.row.labelIds, &p.row.times, &p.row.values)
+	if p.row.err != nil {
+		log.Error("err", p.row.err)
+		p.err = p.row.err
+		return true
+	}
+	if err := p.budget.add(len(p.row.times.Elements), len(p.row.labelIds)); err != nil {
+		p.err = err
+		p.Close()
+		return false
 	}
 	return true
 }
 
+// Close implements SeriesSetCloser.
+func (p *pgxSeriesSet) Close() {
+	p.done = true
+	if p.rows != nil {
+		p.rows.Close()
+	}
+}
+
 // At returns the current storage.Series.
 func (p *pgxSeriesSet) At() storage.Series {
-	if p.rowIdx >= len(p.rows) {
+	if p.done {
 		return nil
 	}
 
-	row := &p.rows[p.rowIdx]
+	row := &p.row
 
 	if row.err != nil {
 		return nil
@@ -248,7 +248,8 @@ func TestPgxSeriesSet(t *testing.T) {
 				c.input = [][]seriesSetRow{{
 					genSeries(labels, c.ts, c.vs)}}
 			}
-			p := buildSeriesSet(genPgxRows(c.input, c.rowErr), mapQuerier{labelMapping})
+			rows := genPgxRows(c.input, c.rowErr)
+			p := buildSeriesSet(rows, mapQuerier{labelMapping}, nil)
 
 			for c.rowCount > 0 {
 				c.rowCount--
@@ -365,6 +366,10 @@ func TestPgxSeriesSet(t *testing.T) {
 			if !errors.Is(p.Err(), c.err) {
 				t.Fatalf("unexpected err: got %s, wanted %s", p.Err(), c.err)
 			}
+
+			if !rows.closeCalled {
+				t.Fatal("rows not closed after all rows were iterated on")
+			}
 		})
 	}
 }
@@ -403,36 +408,16 @@ func genRows(count int) [][][]byte {
 	return result
 }
 
-func genPgxRows(m [][]seriesSetRow, err error) []timescaleRow {
-	var result []timescaleRow
+func genPgxRows(m [][]seriesSetRow, err error) *mockPgxRows {
+	var result []seriesSetRow
 
 	for _, mm := range m {
-		for _, r := range mm {
-			result = append(result, timescaleRow{
-				labelIds: r.labels,
-				times:    toTimestampTzArray(r.timestamps),
-				values:   toFloat8Array(r.values),
-				err:      err,
-			})
-		}
+		result = append(result, mm...)
 	}
 
-	return result
-}
-
-func toTimestampTzArray(times []pgtype.Timestamptz) pgtype.TimestamptzArray {
-	return pgtype.TimestamptzArray{
-		Elements:   times,
-		Dimensions: nil,
-		Status:     pgtype.Present,
-	}
-}
-
-func toFloat8Array(values []pgtype.Float8) pgtype.Float8Array {
-	return pgtype.Float8Array{
-		Elements:   values,
-		Dimensions: nil,
-		Status:     pgtype.Present,
+	return &mockPgxRows{
+		results: result,
+		err:     err,
 	}
 }
 
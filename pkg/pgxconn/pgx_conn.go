@@ -25,6 +25,7 @@ type PgxConn interface {
 	CopyFromRows(rows [][]interface{}) pgx.CopyFromSource
 	NewBatch() PgxBatch
 	SendBatch(ctx context.Context, b PgxBatch) (pgx.BatchResults, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
 }
 
 func NewPgxConn(pool *pgxpool.Pool) PgxConn {
@@ -70,3 +71,7 @@ func (p *connImpl) NewBatch() PgxBatch {
 func (p *connImpl) SendBatch(ctx context.Context, b PgxBatch) (pgx.BatchResults, error) {
 	return p.Conn.SendBatch(ctx, b.(*pgx.Batch)), nil
 }
+
+func (p *connImpl) Begin(ctx context.Context) (pgx.Tx, error) {
+	return p.Conn.Begin(ctx)
+}
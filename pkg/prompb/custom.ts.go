@@ -5,5 +5,5 @@ func (m *WriteRequest) Reset() {
 	*m = WriteRequest{Timeseries: m.Timeseries[:0], Metadata: m.Metadata[:0]}
 }
 func (m *TimeSeries) Reset() {
-	*m = TimeSeries{Labels: m.Labels[:0], Samples: m.Samples[:0]}
+	*m = TimeSeries{Labels: m.Labels[:0], Samples: m.Samples[:0], Exemplars: m.Exemplars[:0]}
 }
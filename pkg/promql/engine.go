@@ -55,10 +55,14 @@ type Queryable interface {
 type Querier interface {
 	// LabelValues returns all potential values for a label name.
 	// It is not safe to use the strings beyond the lifefime of the querier.
-	LabelValues(name string) ([]string, storage.Warnings, error)
+	// If matchers are specified the returned result set is reduced
+	// to label values of metrics matching the matchers.
+	LabelValues(name string, matchers ...*labels.Matcher) ([]string, storage.Warnings, error)
 
 	// LabelNames returns all the unique label names present in the block in sorted order.
-	LabelNames() ([]string, storage.Warnings, error)
+	// If matchers are specified the returned result set is reduced
+	// to label names of metrics matching the matchers.
+	LabelNames(matchers ...*labels.Matcher) ([]string, storage.Warnings, error)
 
 	// Close releases the resources of the Querier.
 	Close() error
@@ -908,6 +912,13 @@ func (ev *evaluator) recover(ws *storage.Warnings, errp *error) {
 	default:
 		*errp = e.(error)
 	}
+
+	// The storage returns the same error as the engine when a query loads
+	// too many samples; report it as is rather than wrapped.
+	var tooManySamples ErrTooManySamples
+	if errors.As(*errp, &tooManySamples) {
+		*errp = tooManySamples
+	}
 }
 
 func (ev *evaluator) Eval(expr parser.Expr) (v parser.Value, ws storage.Warnings, err error) {
@@ -1133,11 +1144,15 @@ func (ev *evaluator) evalSubquery(subq *parser.SubqueryExpr) (*parser.MatrixSele
 	return ms, totalSamples, ws
 }
 
-func (ev *evaluator) getPushdownResult(vs *parser.VectorSelector, numSteps int) Matrix {
+func (ev *evaluator) getPushdownResult(vs *parser.VectorSelector, numSteps int, keepMetricName bool) Matrix {
 	mat := make(Matrix, 0, len(vs.Series))
 	for i, s := range vs.Series {
+		metric := vs.Series[i].Labels()
+		if !keepMetricName {
+			metric = dropMetricName(metric)
+		}
 		ss := Series{
-			Metric: dropMetricName(vs.Series[i].Labels()),
+			Metric: metric,
 			Points: getPointSlice(numSteps),
 		}
 
@@ -1155,6 +1170,25 @@ func (ev *evaluator) getPushdownResult(vs *parser.VectorSelector, numSteps int)
 	return mat
 }
 
+// pushdownKeepsMetricName returns true if the result of the pushed down
+// expression keeps the metric name of the series.
+func pushdownKeepsMetricName(expr parser.Expr) bool {
+	switch e := expr.(type) {
+	case *parser.Call:
+		// The last_over_time function acts like offset; thus, it
+		// should keep the metric name.
+		return e.Func.Name == "last_over_time"
+	case *parser.AggregateExpr:
+		// topk and bottomk keep the labels of their input.
+		if e.Op != parser.TOPK && e.Op != parser.BOTTOMK {
+			return false
+		}
+		_, ok := e.Expr.(*parser.VectorSelector)
+		return ok || pushdownKeepsMetricName(e.Expr)
+	}
+	return false
+}
+
 // eval evaluates the given expression as the given AST expression node requires.
 func (ev *evaluator) eval(expr parser.Expr) (parser.Value, storage.Warnings) {
 	// This is the top-level evaluation method.
@@ -1185,7 +1219,7 @@ func (ev *evaluator) eval(expr parser.Expr) (parser.Value, storage.Warnings) {
 					err = fmt.Errorf("Matrix is already filled in")
 					return err
 				}
-				mat = ev.getPushdownResult(n, numSteps)
+				mat = ev.getPushdownResult(n, numSteps, pushdownKeepsMetricName(expr))
 			}
 			return nil
 		})
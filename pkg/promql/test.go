@@ -98,10 +98,14 @@ func (t *QuerierWrapper) Select(b bool, sh *storage.SelectHints, _ []parser.Node
 	return ss, nil
 }
 
-func (t *QuerierWrapper) LabelValues(n string) ([]string, storage.Warnings, error) {
+func (t *QuerierWrapper) LabelValues(n string, _ ...*labels.Matcher) ([]string, storage.Warnings, error) {
 	return nil, nil, nil
 }
 
+func (t *QuerierWrapper) LabelNames(...*labels.Matcher) ([]string, storage.Warnings, error) {
+	return t.Querier.LabelNames()
+}
+
 // Test is a sequence of read and write commands that are run
 // against a test storage.
 type Test struct {
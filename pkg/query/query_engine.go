@@ -14,11 +14,16 @@ import (
 	"github.com/timescale/promscale/pkg/promql"
 )
 
-func NewEngine(logger log.Logger, queryTimeout time.Duration, subqueryDefaultStepInterval time.Duration, enabledFeatures []string) (*promql.Engine, error) {
+// NewEngine returns a PromQL engine. A query loading more than maxSamples
+// samples at once is aborted, unless maxSamples is 0.
+func NewEngine(logger log.Logger, queryTimeout time.Duration, subqueryDefaultStepInterval time.Duration, maxSamples int, enabledFeatures []string) (*promql.Engine, error) {
+	if maxSamples <= 0 {
+		maxSamples = math.MaxInt32
+	}
 	engineOpts := promql.EngineOpts{
 		Logger:                   logger,
 		Reg:                      prometheus.NewRegistry(),
-		MaxSamples:               math.MaxInt32,
+		MaxSamples:               maxSamples,
 		Timeout:                  queryTimeout,
 		NoStepSubqueryIntervalFn: func(int64) int64 { return durationMilliseconds(subqueryDefaultStepInterval) },
 	}
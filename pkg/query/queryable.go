@@ -6,6 +6,7 @@ package query
 
 import (
 	"context"
+	"sync"
 
 	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/promql/parser"
@@ -15,13 +16,16 @@ import (
 	"github.com/timescale/promscale/pkg/promql"
 )
 
-func NewQueryable(q pgQuerier.Querier, labelsReader lreader.LabelsReader) promql.Queryable {
-	return &queryable{querier: q, labelsReader: labelsReader}
+// NewQueryable returns a queryable whose queries may each load from the
+// database up to the given limits.
+func NewQueryable(q pgQuerier.Querier, labelsReader lreader.LabelsReader, limits pgQuerier.QueryLimits) promql.Queryable {
+	return &queryable{querier: q, labelsReader: labelsReader, limits: limits}
 }
 
 type queryable struct {
 	querier      pgQuerier.Querier
 	labelsReader lreader.LabelsReader
+	limits       pgQuerier.QueryLimits
 }
 
 func (q queryable) Querier(ctx context.Context, mint, maxt int64) (promql.Querier, error) {
@@ -29,30 +33,72 @@ func (q queryable) Querier(ctx context.Context, mint, maxt int64) (promql.Querie
 		ctx: ctx, mint: mint, maxt: maxt,
 		metricsReader: q.querier,
 		labelsReader:  q.labelsReader,
+		budget:        q.budget(ctx),
 	}, nil
 }
 
+// budget returns the budget shared by the queries of the context, if any, or
+// else the budget of a single query.
+func (q queryable) budget(ctx context.Context) *pgQuerier.QueryBudget {
+	shared, ok := ctx.Value(sharedBudgetKey{}).(*sharedBudget)
+	if !ok {
+		return pgQuerier.NewQueryBudget(q.limits)
+	}
+	shared.once.Do(func() {
+		shared.budget = pgQuerier.NewQueryBudget(q.limits)
+	})
+	return shared.budget
+}
+
+type sharedBudgetKey struct{}
+
+type sharedBudget struct {
+	once   sync.Once
+	budget *pgQuerier.QueryBudget
+}
+
+// withSharedBudget returns a context whose queries all load from the
+// database within a single budget, as the parts of a split query.
+func withSharedBudget(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sharedBudgetKey{}, &sharedBudget{})
+}
+
 type querier struct {
 	ctx           context.Context
 	mint, maxt    int64
 	metricsReader pgQuerier.Querier
 	labelsReader  lreader.LabelsReader
+	// budget accounts for the series loaded by all the selects of the
+	// query.
+	budget *pgQuerier.QueryBudget
+	// sets are the series sets selected so far. They read their rows
+	// lazily and are closed along with the querier, in case they were
+	// not exhausted.
+	sets []storage.SeriesSet
 }
 
-func (q querier) LabelValues(name string) ([]string, storage.Warnings, error) {
-	lVals, err := q.labelsReader.LabelValues(name)
+func (q querier) LabelValues(name string, matchers ...*labels.Matcher) ([]string, storage.Warnings, error) {
+	lVals, err := q.labelsReader.MatchingLabelValues(name, q.mint, q.maxt, matchers...)
 	return lVals, nil, err
 }
 
-func (q querier) LabelNames() ([]string, storage.Warnings, error) {
-	lNames, err := q.labelsReader.LabelNames()
+func (q querier) LabelNames(matchers ...*labels.Matcher) ([]string, storage.Warnings, error) {
+	lNames, err := q.labelsReader.MatchingLabelNames(q.mint, q.maxt, matchers...)
 	return lNames, nil, err
 }
 
-func (q querier) Close() error {
+func (q *querier) Close() error {
+	for _, set := range q.sets {
+		if c, ok := set.(pgQuerier.SeriesSetCloser); ok {
+			c.Close()
+		}
+	}
+	q.sets = nil
 	return nil
 }
 
-func (q querier) Select(sortSeries bool, hints *storage.SelectHints, path []parser.Node, matchers ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
-	return q.metricsReader.Select(q.mint, q.maxt, sortSeries, hints, path, matchers...)
+func (q *querier) Select(sortSeries bool, hints *storage.SelectHints, path []parser.Node, matchers ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	set, topNode := q.metricsReader.Select(q.mint, q.maxt, sortSeries, hints, path, q.budget, matchers...)
+	q.sets = append(q.sets, set)
+	return set, topNode
 }
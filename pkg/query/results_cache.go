@@ -0,0 +1,302 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package query
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/clockcache"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/promql"
+	"github.com/timescale/promscale/pkg/tenancy"
+	"github.com/timescale/promscale/pkg/util"
+)
+
+const (
+	hourInterval = time.Hour
+	dayInterval  = 24 * time.Hour
+)
+
+var resultsCacheRequests = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: util.PromNamespace,
+		Name:      "results_cache_requests_total",
+		Help:      "Total number of completed range query intervals looked up in the results cache, by result (hit or miss).",
+	},
+	[]string{"result"},
+)
+
+func init() {
+	prometheus.MustRegister(resultsCacheRequests)
+}
+
+// ResultsCacheConfig configures the cache of range query results.
+type ResultsCacheConfig struct {
+	// Enabled turns the cache on.
+	Enabled bool
+	// Size is the maximum number of intervals kept in memory.
+	Size uint64
+	// MaxFreshness is how recent an interval may end and still be cached,
+	// leaving time for late samples to be written.
+	MaxFreshness time.Duration
+	// TTL is how long a cached interval is served for.
+	TTL time.Duration
+	// Persist also stores the cached intervals in the database, sharing them
+	// between connectors and restarts.
+	Persist bool
+}
+
+// ResultsStore persists the cached intervals of range queries.
+type ResultsStore interface {
+	// Get returns the result stored under the key along with the time it
+	// was stored, if it is younger than the TTL.
+	Get(key string) (result promql.Matrix, created time.Time, ok bool, err error)
+	// Put stores the result under the key.
+	Put(key string, result promql.Matrix) error
+}
+
+// ResultsCache caches the results of range queries. A query is split into
+// step-aligned intervals of an hour or a day; the intervals which are over
+// are served from the cache, and only the others, usually the trailing
+// interval, are executed. A nil cache executes every query as is.
+type ResultsCache struct {
+	cache        *clockcache.Cache
+	store        ResultsStore
+	maxFreshness time.Duration
+	ttl          time.Duration
+	now          func() time.Time
+}
+
+// cachedResult is stored in the in-memory cache within an atomic.Value, so
+// that an expired result can be replaced in place.
+type cachedResult struct {
+	result  promql.Matrix
+	created time.Time
+}
+
+// interval is a range of evaluation timestamps of a query.
+type interval struct {
+	start, end time.Time
+	// cacheable is true if the interval is over and covered by the query
+	// as a whole.
+	cacheable bool
+}
+
+// NewResultsCache returns a results cache backed by store, which may be
+// nil, or nil if the cache is disabled.
+func NewResultsCache(cfg ResultsCacheConfig, store ResultsStore) *ResultsCache {
+	if !cfg.Enabled {
+		return nil
+	}
+	return &ResultsCache{
+		cache:        clockcache.WithMax(cfg.Size),
+		store:        store,
+		maxFreshness: cfg.MaxFreshness,
+		ttl:          cfg.TTL,
+		now:          time.Now,
+	}
+}
+
+// Exec executes the range query, reusing the cached results of its
+// intervals. The error is returned if the query could not be created, while
+// execution errors are returned in the result, as with promql.Query.
+func (c *ResultsCache) Exec(ctx context.Context, ng *promql.Engine, q promql.Queryable, qs string, start, end time.Time, step time.Duration) (*promql.Result, error) {
+	var intervals []interval
+	if c != nil {
+		intervals = c.split(qs, start, end, step)
+	}
+	if intervals == nil {
+		qry, err := ng.NewRangeQuery(q, qs, start, end, step)
+		if err != nil {
+			return nil, err
+		}
+		return qry.Exec(ctx), nil
+	}
+
+	tenant, _ := tenancy.FromContext(ctx)
+	// The intervals are parts of the same query, which must load no more
+	// than the limits of a query over all of them.
+	ctx = withSharedBudget(ctx)
+	var (
+		result   promql.Matrix
+		index    = make(map[string]int)
+		warnings storage.Warnings
+	)
+	for _, i := range intervals {
+		key := resultsKey(tenant, qs, i, step)
+		if i.cacheable {
+			if cached, ok := c.get(key); ok {
+				resultsCacheRequests.WithLabelValues("hit").Inc()
+				result = mergeMatrix(result, index, cached)
+				continue
+			}
+			resultsCacheRequests.WithLabelValues("miss").Inc()
+		}
+
+		qry, err := ng.NewRangeQuery(q, qs, i.start, i.end, step)
+		if err != nil {
+			return nil, err
+		}
+		res := qry.Exec(ctx)
+		if res.Err != nil {
+			return res, nil
+		}
+		m, ok := res.Value.(promql.Matrix)
+		if !ok {
+			return &promql.Result{Err: fmt.Errorf("unexpected range query result type %s", res.Value.Type())}, nil
+		}
+		warnings = append(warnings, res.Warnings...)
+		// Results with warnings may be partial.
+		if i.cacheable && len(res.Warnings) == 0 {
+			c.put(key, m)
+		}
+		result = mergeMatrix(result, index, m)
+	}
+	sort.Sort(result)
+	return &promql.Result{Value: result, Warnings: warnings}, nil
+}
+
+// split returns the intervals of the query, or nil if it cannot be split:
+// the evaluation timestamps must be multiples of the step for the intervals
+// to be shared between queries, and the query must not depend on its start
+// or end through the @ modifier.
+func (c *ResultsCache) split(qs string, start, end time.Time, step time.Duration) []interval {
+	stepMs := step.Milliseconds()
+	startMs, endMs := timestamp.FromTime(start), timestamp.FromTime(end)
+	if stepMs <= 0 || mod(startMs, stepMs) != 0 || step > dayInterval || usesStartOrEnd(qs) {
+		return nil
+	}
+
+	length := hourInterval
+	if end.Sub(start) > dayInterval || step > hourInterval {
+		length = dayInterval
+	}
+	lengthMs := length.Milliseconds()
+	freshMs := timestamp.FromTime(c.now().Add(-c.maxFreshness))
+
+	var intervals []interval
+	for iStart := startMs - mod(startMs, lengthMs); iStart <= endMs; iStart += lengthMs {
+		iEnd := iStart + lengthMs
+		first := iStart + mod(-iStart, stepMs)
+		last := iEnd - 1 - mod(iEnd-1, stepMs)
+		i := interval{cacheable: first >= startMs && last <= endMs && iEnd <= freshMs}
+		if first < startMs {
+			first = startMs
+		}
+		if last > endMs {
+			last = endMs
+		}
+		if first > last {
+			continue
+		}
+		i.start, i.end = timestamp.Time(first), timestamp.Time(last)
+		intervals = append(intervals, i)
+	}
+	return intervals
+}
+
+func (c *ResultsCache) get(key string) (promql.Matrix, bool) {
+	if v, ok := c.cache.Get(key); ok {
+		cached := v.(*atomic.Value).Load().(cachedResult)
+		if c.now().Sub(cached.created) < c.ttl {
+			return cached.result, true
+		}
+	}
+	if c.store == nil {
+		return nil, false
+	}
+	result, created, ok, err := c.store.Get(key)
+	if err != nil {
+		log.Warn("msg", "error reading the results cache", "err", err)
+		return nil, false
+	}
+	if ok {
+		c.insert(key, cachedResult{result: result, created: created})
+	}
+	return result, ok
+}
+
+func (c *ResultsCache) insert(key string, cached cachedResult) {
+	v := &atomic.Value{}
+	v.Store(cached)
+	if canonical, _ := c.cache.Insert(key, v); canonical != v {
+		canonical.(*atomic.Value).Store(cached)
+	}
+}
+
+func (c *ResultsCache) put(key string, result promql.Matrix) {
+	c.insert(key, cachedResult{result: result, created: c.now()})
+	if c.store == nil {
+		return
+	}
+	if err := c.store.Put(key, result); err != nil {
+		log.Warn("msg", "error writing the results cache", "err", err)
+	}
+}
+
+// resultsKey identifies the result of a query over an interval.
+func resultsKey(tenant, qs string, i interval, step time.Duration) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%d\x00%d\x00%d", tenant, qs, timestamp.FromTime(i.start), timestamp.FromTime(i.end), step.Milliseconds())
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// mergeMatrix appends the points of the series of m to the series with the
+// same labels in result. The points of cached results are copied, never
+// shared.
+func mergeMatrix(result promql.Matrix, index map[string]int, m promql.Matrix) promql.Matrix {
+	for _, s := range m {
+		key := s.Metric.String()
+		if idx, ok := index[key]; ok {
+			result[idx].Points = append(result[idx].Points, s.Points...)
+			continue
+		}
+		index[key] = len(result)
+		result = append(result, promql.Series{
+			Metric: s.Metric,
+			Points: append([]promql.Point(nil), s.Points...),
+		})
+	}
+	return result
+}
+
+// usesStartOrEnd returns true if the query uses @ start() or @ end(), or
+// cannot be parsed.
+func usesStartOrEnd(qs string) bool {
+	expr, err := parser.ParseExpr(qs)
+	if err != nil {
+		return true
+	}
+	found := false
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		switch n := node.(type) {
+		case *parser.VectorSelector:
+			found = found || n.StartOrEnd != 0
+		case *parser.SubqueryExpr:
+			found = found || n.StartOrEnd != 0
+		}
+		return nil
+	})
+	return found
+}
+
+// mod returns the non-negative remainder of a divided by b.
+func mod(a, b int64) int64 {
+	r := a % b
+	if r < 0 {
+		r += b
+	}
+	return r
+}
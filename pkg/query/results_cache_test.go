@@ -0,0 +1,358 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package query
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+	pgQuerier "github.com/timescale/promscale/pkg/pgmodel/querier"
+	"github.com/timescale/promscale/pkg/promql"
+	"github.com/timescale/promscale/pkg/tenancy"
+)
+
+var cacheTestTime = time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+
+func at(d time.Duration) time.Time {
+	return cacheTestTime.Add(d)
+}
+
+func TestResultsCacheSplit(t *testing.T) {
+	testCases := []struct {
+		name     string
+		query    string
+		start    time.Time
+		end      time.Time
+		step     time.Duration
+		now      time.Time
+		expected []interval
+	}{
+		{
+			name:  "start not aligned to the step",
+			query: "up",
+			start: at(30 * time.Second),
+			end:   at(3 * time.Hour),
+			step:  time.Minute,
+			now:   at(4 * time.Hour),
+		},
+		{
+			name:  "@ start()",
+			query: "up @ start()",
+			start: at(0),
+			end:   at(3 * time.Hour),
+			step:  time.Minute,
+			now:   at(4 * time.Hour),
+		},
+		{
+			name:  "step longer than a day",
+			query: "up",
+			start: at(-10 * time.Hour),
+			end:   at(30 * 24 * time.Hour),
+			step:  48 * time.Hour,
+			now:   at(60 * 24 * time.Hour),
+		},
+		{
+			name:  "hour intervals",
+			query: "up",
+			start: at(0),
+			end:   at(150 * time.Minute),
+			step:  time.Minute,
+			now:   at(3 * time.Hour),
+			expected: []interval{
+				{start: at(0), end: at(59 * time.Minute), cacheable: true},
+				{start: at(time.Hour), end: at(119 * time.Minute), cacheable: true},
+				{start: at(2 * time.Hour), end: at(150 * time.Minute)},
+			},
+		},
+		{
+			name:  "partial first interval",
+			query: "up",
+			start: at(30 * time.Minute),
+			end:   at(150 * time.Minute),
+			step:  time.Minute,
+			now:   at(3 * time.Hour),
+			expected: []interval{
+				{start: at(30 * time.Minute), end: at(59 * time.Minute)},
+				{start: at(time.Hour), end: at(119 * time.Minute), cacheable: true},
+				{start: at(2 * time.Hour), end: at(150 * time.Minute)},
+			},
+		},
+		{
+			name:  "interval too fresh",
+			query: "up",
+			start: at(0),
+			end:   at(119 * time.Minute),
+			step:  time.Minute,
+			now:   at(125 * time.Minute),
+			expected: []interval{
+				{start: at(0), end: at(59 * time.Minute), cacheable: true},
+				{start: at(time.Hour), end: at(119 * time.Minute)},
+			},
+		},
+		{
+			name:  "day intervals",
+			query: "up",
+			start: at(-10 * time.Hour),
+			end:   at(30 * time.Hour),
+			step:  2 * time.Hour,
+			now:   at(40 * time.Hour),
+			expected: []interval{
+				{start: at(-10 * time.Hour), end: at(12 * time.Hour), cacheable: true},
+				{start: at(14 * time.Hour), end: at(30 * time.Hour)},
+			},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			cache := NewResultsCache(ResultsCacheConfig{Enabled: true, Size: 10, MaxFreshness: 10 * time.Minute, TTL: time.Hour}, nil)
+			cache.now = func() time.Time { return c.now }
+
+			got := cache.split(c.query, c.start, c.end, c.step)
+			if !reflect.DeepEqual(got, c.expected) {
+				t.Errorf("unexpected intervals:\ngot\n%+v\nwanted\n%+v", got, c.expected)
+			}
+		})
+	}
+}
+
+func TestResultsCacheExec(t *testing.T) {
+	_ = log.Init(log.Config{
+		Level: "debug",
+	})
+	engine, err := NewEngine(log.GetLogger(), time.Minute, time.Minute, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const query = "up or vector(time())"
+	start, end, step := at(0), at(150*time.Minute), time.Minute
+
+	q := &recordingQueryable{}
+	qry, err := engine.NewRangeQuery(q, query, start, end, step)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := qry.Exec(context.Background())
+	if expected.Err != nil {
+		t.Fatal(expected.Err)
+	}
+
+	store := &mapResultsStore{results: make(map[string]promql.Matrix)}
+	newCache := func() *ResultsCache {
+		cache := NewResultsCache(ResultsCacheConfig{Enabled: true, Size: 10, MaxFreshness: 10 * time.Minute, TTL: time.Hour}, store)
+		cache.now = func() time.Time { return at(3 * time.Hour) }
+		return cache
+	}
+	cache := newCache()
+
+	steps := []struct {
+		name     string
+		cache    *ResultsCache
+		tenant   string
+		executed []time.Time
+	}{
+		{
+			name:     "empty cache",
+			cache:    cache,
+			executed: []time.Time{at(59 * time.Minute), at(119 * time.Minute), end},
+		},
+		{
+			name:     "cached",
+			cache:    cache,
+			executed: []time.Time{end},
+		},
+		{
+			name:     "other tenant",
+			cache:    cache,
+			tenant:   "tenant",
+			executed: []time.Time{at(59 * time.Minute), at(119 * time.Minute), end},
+		},
+		{
+			name:     "stored",
+			cache:    newCache(),
+			executed: []time.Time{end},
+		},
+	}
+	for _, s := range steps {
+		t.Run(s.name, func(t *testing.T) {
+			ctx := context.Background()
+			if s.tenant != "" {
+				ctx = tenancy.WithTenant(ctx, s.tenant)
+			}
+			q.ends = nil
+
+			res, err := s.cache.Exec(ctx, engine, q, query, start, end, step)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Err != nil {
+				t.Fatal(res.Err)
+			}
+			if !reflect.DeepEqual(res.Value, expected.Value) {
+				t.Errorf("unexpected result:\ngot\n%v\nwanted\n%v", res.Value, expected.Value)
+			}
+			if !reflect.DeepEqual(q.ends, s.executed) {
+				t.Errorf("unexpected intervals executed:\ngot\n%v\nwanted\n%v", q.ends, s.executed)
+			}
+		})
+	}
+}
+
+func TestResultsCacheSharedBudget(t *testing.T) {
+	engine, err := NewEngine(log.GetLogger(), time.Minute, time.Minute, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := NewResultsCache(ResultsCacheConfig{Enabled: true, Size: 10, MaxFreshness: 10 * time.Minute, TTL: time.Hour}, nil)
+	cache.now = func() time.Time { return at(3 * time.Hour) }
+	mock := &budgetQuerier{}
+	q := NewQueryable(mock, nil, pgQuerier.QueryLimits{MaxSamples: 10})
+
+	// The second query reads the full hour from the cache and only runs the
+	// partial intervals.
+	for _, selects := range []int{3, 2} {
+		mock.budgets = nil
+		res, err := cache.Exec(context.Background(), engine, q, "up", at(30*time.Minute), at(150*time.Minute), time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Err != nil {
+			t.Fatal(res.Err)
+		}
+		if len(mock.budgets) != selects {
+			t.Fatalf("unexpected number of selects: got %d wanted %d", len(mock.budgets), selects)
+		}
+		for _, b := range mock.budgets {
+			if b == nil || b != mock.budgets[0] {
+				t.Fatalf("intervals do not share a budget: %v", mock.budgets)
+			}
+		}
+	}
+}
+
+func TestResultsCacheDisabled(t *testing.T) {
+	if cache := NewResultsCache(ResultsCacheConfig{Size: 10}, nil); cache != nil {
+		t.Fatalf("unexpected cache %v", cache)
+	}
+}
+
+func TestPgResultsStore(t *testing.T) {
+	result := promql.Matrix{
+		{
+			Metric: labels.FromStrings("__name__", "up"),
+			Points: []promql.Point{{T: 1000, V: 1}, {T: 2000, V: 0}},
+		},
+	}
+	var data bytes.Buffer
+	if err := gob.NewEncoder(&data).Encode(result); err != nil {
+		t.Fatal(err)
+	}
+	created := at(0)
+
+	mock := model.NewSqlRecorder([]model.SqlQuery{
+		{
+			Sql:  putCachedResultSQL,
+			Args: []interface{}{"key", data.Bytes()},
+		},
+		{
+			Sql:  deleteExpiredResultsSQL,
+			Args: []interface{}{"3600000 milliseconds"},
+		},
+		{
+			// The expired results were just deleted.
+			Sql:  putCachedResultSQL,
+			Args: []interface{}{"key", data.Bytes()},
+		},
+		{
+			Sql:     getCachedResultSQL,
+			Args:    []interface{}{"key", "3600000 milliseconds"},
+			Results: model.RowResults{{data.Bytes(), created}},
+		},
+	}, t)
+	store := NewResultsStore(mock, time.Hour)
+
+	for i := 0; i < 2; i++ {
+		if err := store.Put("key", result); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, gotCreated, ok, err := store.Get("key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("stored result not found")
+	}
+	if !reflect.DeepEqual(got, result) {
+		t.Errorf("unexpected result:\ngot\n%v\nwanted\n%v", got, result)
+	}
+	if !gotCreated.Equal(created) {
+		t.Errorf("unexpected creation time: got %v, wanted %v", gotCreated, created)
+	}
+}
+
+// recordingQueryable records the end of the range of the queries executed.
+type recordingQueryable struct {
+	ends []time.Time
+}
+
+func (r *recordingQueryable) Querier(_ context.Context, _, _ int64) (promql.Querier, error) {
+	return recordingQuerier{r}, nil
+}
+
+type recordingQuerier struct {
+	*recordingQueryable
+}
+
+func (r recordingQuerier) LabelValues(string, ...*labels.Matcher) ([]string, storage.Warnings, error) {
+	return nil, nil, nil
+}
+
+func (r recordingQuerier) LabelNames(...*labels.Matcher) ([]string, storage.Warnings, error) {
+	return nil, nil, nil
+}
+
+func (r recordingQuerier) Close() error {
+	return nil
+}
+
+func (r recordingQuerier) Select(_ bool, hints *storage.SelectHints, _ []parser.Node, _ ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	r.ends = append(r.ends, time.Unix(0, hints.End*int64(time.Millisecond)).UTC())
+	return storage.EmptySeriesSet(), nil
+}
+
+// budgetQuerier records the budgets of the selects.
+type budgetQuerier struct {
+	pgQuerier.Querier
+	budgets []*pgQuerier.QueryBudget
+}
+
+func (b *budgetQuerier) Select(_, _ int64, _ bool, _ *storage.SelectHints, _ []parser.Node, budget *pgQuerier.QueryBudget, _ ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	b.budgets = append(b.budgets, budget)
+	return storage.EmptySeriesSet(), nil
+}
+
+type mapResultsStore struct {
+	results map[string]promql.Matrix
+}
+
+func (m *mapResultsStore) Get(key string) (promql.Matrix, time.Time, bool, error) {
+	result, ok := m.results[key]
+	return result, at(3 * time.Hour), ok, nil
+}
+
+func (m *mapResultsStore) Put(key string, result promql.Matrix) error {
+	m.results[key] = result
+	return nil
+}
@@ -0,0 +1,86 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package query
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/timescale/promscale/pkg/pgmodel/common/schema"
+	"github.com/timescale/promscale/pkg/pgxconn"
+	"github.com/timescale/promscale/pkg/promql"
+)
+
+const (
+	getCachedResultSQL      = "SELECT result, created_at FROM " + schema.Catalog + ".query_result_cache WHERE key = $1 AND created_at > now() - $2::interval"
+	putCachedResultSQL      = "INSERT INTO " + schema.Catalog + ".query_result_cache (key, result) VALUES ($1, $2) ON CONFLICT (key) DO UPDATE SET result = excluded.result, created_at = excluded.created_at"
+	deleteExpiredResultsSQL = "DELETE FROM " + schema.Catalog + ".query_result_cache WHERE created_at <= now() - $1::interval"
+)
+
+// pgResultsStore stores cached results in the query_result_cache table.
+type pgResultsStore struct {
+	// lastCleanup is the Unix time in nanoseconds at which the expired
+	// results were last deleted. It is the first word of the struct to be
+	// aligned on 32-bit systems.
+	lastCleanup int64
+	conn        pgxconn.PgxConn
+	ttl         time.Duration
+	ttlInterval string
+	now         func() time.Time
+}
+
+// NewResultsStore returns a results store in the database, keeping the
+// results for ttl.
+func NewResultsStore(conn pgxconn.PgxConn, ttl time.Duration) ResultsStore {
+	return &pgResultsStore{
+		conn:        conn,
+		ttl:         ttl,
+		ttlInterval: fmt.Sprintf("%d milliseconds", ttl.Milliseconds()),
+		now:         time.Now,
+	}
+}
+
+func (s *pgResultsStore) Get(key string) (promql.Matrix, time.Time, bool, error) {
+	var (
+		data    []byte
+		created time.Time
+	)
+	err := s.conn.QueryRow(context.Background(), getCachedResultSQL, key, s.ttlInterval).Scan(&data, &created)
+	if err == pgx.ErrNoRows {
+		return nil, time.Time{}, false, nil
+	}
+	if err != nil {
+		return nil, time.Time{}, false, err
+	}
+	var result promql.Matrix
+	if err = gob.NewDecoder(bytes.NewReader(data)).Decode(&result); err != nil {
+		return nil, time.Time{}, false, fmt.Errorf("decoding cached result: %w", err)
+	}
+	return result, created, true, nil
+}
+
+// Put stores the result, deleting the expired results at most once per TTL.
+func (s *pgResultsStore) Put(key string, result promql.Matrix) error {
+	var data bytes.Buffer
+	if err := gob.NewEncoder(&data).Encode(result); err != nil {
+		return fmt.Errorf("encoding result: %w", err)
+	}
+	if _, err := s.conn.Exec(context.Background(), putCachedResultSQL, key, data.Bytes()); err != nil {
+		return err
+	}
+
+	now := s.now().UnixNano()
+	last := atomic.LoadInt64(&s.lastCleanup)
+	if now-last < s.ttl.Nanoseconds() || !atomic.CompareAndSwapInt64(&s.lastCleanup, last, now) {
+		return nil
+	}
+	_, err := s.conn.Exec(context.Background(), deleteExpiredResultsSQL, s.ttlInterval)
+	return err
+}
@@ -0,0 +1,244 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	prometheusPromql "github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/template"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/promql"
+)
+
+const (
+	// alertMetricName is the metric recording the active alerts.
+	alertMetricName = "ALERTS"
+	alertStateLabel = "alertstate"
+	alertNameLabel  = "alertname"
+
+	// resendDelay is the minimum delay before an alert still firing is sent
+	// again to the Alertmanagers.
+	resendDelay = time.Minute
+	// resolvedRetention is how long the resolved alerts keep being sent, so
+	// that the Alertmanagers missing the first notification learn about it.
+	resolvedRetention = 15 * time.Minute
+)
+
+type alertState int
+
+const (
+	stateInactive alertState = iota
+	statePending
+	stateFiring
+)
+
+func (s alertState) String() string {
+	switch s {
+	case statePending:
+		return "pending"
+	case stateFiring:
+		return "firing"
+	}
+	return "inactive"
+}
+
+type alert struct {
+	state       alertState
+	labels      labels.Labels
+	annotations labels.Labels
+	value       float64
+
+	// activeAt is when the alert became pending, which is the start of the
+	// alert sent to the Alertmanagers, as in Prometheus.
+	activeAt   time.Time
+	resolvedAt time.Time
+	lastSentAt time.Time
+	validUntil time.Time
+}
+
+// needsSending returns whether the alert has to be sent to the Alertmanagers
+// at ts.
+func (a *alert) needsSending(ts time.Time) bool {
+	if a.state == statePending {
+		return false
+	}
+	// a resolved alert which was not sent since it was resolved.
+	if a.resolvedAt.After(a.lastSentAt) {
+		return true
+	}
+	return !a.lastSentAt.Add(resendDelay).After(ts)
+}
+
+// alertingRule fires an alert for every series of the result of its
+// expression, once the series has been there for the hold duration.
+type alertingRule struct {
+	name        string
+	expr        string
+	holdDur     time.Duration
+	labels      labels.Labels
+	annotations labels.Labels
+
+	active map[uint64]*alert
+	stale  staleness
+}
+
+func newAlertingRule(name, expr string, holdDur time.Duration, lbls, annotations labels.Labels) *alertingRule {
+	return &alertingRule{
+		name:        name,
+		expr:        expr,
+		holdDur:     holdDur,
+		labels:      lbls,
+		annotations: annotations,
+		active:      make(map[uint64]*alert),
+	}
+}
+
+func (r *alertingRule) ruleName() string {
+	return r.name
+}
+
+// eval updates the state of the alerts of the rule, and returns the ALERTS
+// series of the active ones.
+func (r *alertingRule) eval(ctx context.Context, ts time.Time, query queryFunc) (promql.Vector, error) {
+	res, err := query(ctx, r.expr, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint64]struct{}, len(res))
+	for _, s := range res {
+		lbls, annotations := r.expand(ctx, ts, query, s)
+		h := lbls.Hash()
+		if _, ok := seen[h]; ok {
+			return nil, fmt.Errorf("vector contains metrics with the same labelset after applying alert labels")
+		}
+		seen[h] = struct{}{}
+
+		if a, ok := r.active[h]; ok && a.state != stateInactive {
+			a.value = s.V
+			a.annotations = annotations
+			continue
+		}
+		r.active[h] = &alert{
+			state:       statePending,
+			labels:      lbls,
+			annotations: annotations,
+			value:       s.V,
+			activeAt:    ts,
+		}
+	}
+
+	var vector promql.Vector
+	for h, a := range r.active {
+		if _, ok := seen[h]; !ok {
+			// a pending alert is dropped as soon as it stops, a firing one
+			// is resolved and kept to be sent.
+			if a.state == statePending || (!a.resolvedAt.IsZero() && ts.Sub(a.resolvedAt) > resolvedRetention) {
+				delete(r.active, h)
+			}
+			if a.state != stateInactive {
+				a.state = stateInactive
+				a.resolvedAt = ts
+			}
+			continue
+		}
+		if a.state == statePending && ts.Sub(a.activeAt) >= r.holdDur {
+			a.state = stateFiring
+		}
+
+		lb := labels.NewBuilder(a.labels)
+		lb.Set(labels.MetricName, alertMetricName)
+		lb.Set(alertStateLabel, a.state.String())
+		vector = append(vector, promql.Sample{
+			Point:  promql.Point{T: timestamp.FromTime(ts), V: 1},
+			Metric: lb.Labels(),
+		})
+	}
+	return r.stale.markStale(vector, ts), nil
+}
+
+// expand returns the labels and the annotations of the alert of a sample,
+// expanding their templates.
+func (r *alertingRule) expand(ctx context.Context, ts time.Time, query queryFunc, s promql.Sample) (labels.Labels, labels.Labels) {
+	l := make(map[string]string, len(s.Metric))
+	for _, lbl := range s.Metric {
+		l[lbl.Name] = lbl.Value
+	}
+	// the variables Prometheus defines for the alert templates.
+	defs := "{{$labels := .Labels}}{{$externalLabels := .ExternalLabels}}{{$value := .Value}}"
+	data := template.AlertTemplateData(l, nil, s.V)
+	expand := func(text string) string {
+		tmpl := template.NewTemplateExpander(ctx, defs+text, "__alert_"+r.name, data,
+			model.Time(timestamp.FromTime(ts)), templateQueryFunc(query), nil)
+		result, err := tmpl.Expand()
+		if err != nil {
+			result = fmt.Sprintf("<error expanding template: %s>", err)
+			log.Warn("msg", "Expanding alert template failed", "alert", r.name, "err", err)
+		}
+		return result
+	}
+
+	lb := labels.NewBuilder(s.Metric).Del(labels.MetricName)
+	for _, lbl := range r.labels {
+		lb.Set(lbl.Name, expand(lbl.Value))
+	}
+	lb.Set(alertNameLabel, r.name)
+
+	annotations := make(labels.Labels, 0, len(r.annotations))
+	for _, a := range r.annotations {
+		annotations = append(annotations, labels.Label{Name: a.Name, Value: expand(a.Value)})
+	}
+	return lb.Labels(), annotations
+}
+
+// alertsToSend returns the alerts which have to be sent to the Alertmanagers
+// at ts.
+func (r *alertingRule) alertsToSend(ts time.Time, interval time.Duration) []*alert {
+	var alerts []*alert
+	for _, a := range r.active {
+		if !a.needsSending(ts) {
+			continue
+		}
+		delta := resendDelay
+		if interval > resendDelay {
+			delta = interval
+		}
+		a.lastSentAt = ts
+		// the alert resolves itself in the Alertmanagers if it is not sent
+		// again, e.g. when the connector stops.
+		a.validUntil = ts.Add(4 * delta)
+		alerts = append(alerts, a)
+	}
+	return alerts
+}
+
+func (r *alertingRule) reset() {
+	r.active = make(map[uint64]*alert)
+	r.stale = staleness{}
+}
+
+// templateQueryFunc adapts a query function to the Prometheus templates.
+func templateQueryFunc(query queryFunc) template.QueryFunc {
+	return func(ctx context.Context, q string, ts time.Time) (prometheusPromql.Vector, error) {
+		vector, err := query(ctx, q, ts)
+		if err != nil {
+			return nil, err
+		}
+		result := make(prometheusPromql.Vector, 0, len(vector))
+		for _, s := range vector {
+			result = append(result, prometheusPromql.Sample{
+				Point:  prometheusPromql.Point{T: s.T, V: s.V},
+				Metric: s.Metric,
+			})
+		}
+		return result, nil
+	}
+}
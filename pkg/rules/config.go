@@ -0,0 +1,60 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package rules
+
+import (
+	"flag"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Config for the rules manager.
+type Config struct {
+	// RuleFiles are the paths of the Prometheus rule files, which can be
+	// glob patterns. No rule files disables the rules manager.
+	RuleFiles           []string
+	EvaluationInterval  time.Duration
+	AlertmanagerURLs    []string
+	AlertmanagerTimeout time.Duration
+}
+
+func ParseFlags(fs *flag.FlagSet, cfg *Config) *Config {
+	fs.Var((*stringList)(&cfg.RuleFiles), "rules-files", "Paths of the Prometheus rule files to evaluate, separated by commas. Glob patterns are supported. The recording rules results are written to the database and the alerts are sent to the Alertmanagers. Empty disables the rules evaluation.")
+	fs.DurationVar(&cfg.EvaluationInterval, "rules-evaluation-interval", time.Minute, "Interval at which the rule groups without an interval of their own are evaluated.")
+	fs.Var((*stringList)(&cfg.AlertmanagerURLs), "rules-alertmanager-urls", "URLs of the Alertmanagers the alerts are sent to, separated by commas. Example: 'http://localhost:9093'.")
+	fs.DurationVar(&cfg.AlertmanagerTimeout, "rules-alertmanager-timeout", 10*time.Second, "Timeout for sending alerts to an Alertmanager.")
+	return cfg
+}
+
+func Validate(cfg *Config) error {
+	if cfg.EvaluationInterval <= 0 {
+		return fmt.Errorf("invalid rules evaluation interval %v, must be positive", cfg.EvaluationInterval)
+	}
+	for _, u := range cfg.AlertmanagerURLs {
+		if _, err := url.Parse(u); err != nil {
+			return fmt.Errorf("invalid Alertmanager URL %s: %w", u, err)
+		}
+	}
+	return nil
+}
+
+// stringList is a flag holding a list of strings separated by commas.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = nil
+	for _, s := range strings.Split(value, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			*l = append(*l, s)
+		}
+	}
+	return nil
+}
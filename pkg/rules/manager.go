@@ -0,0 +1,259 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+// Package rules evaluates Prometheus recording and alerting rules in the
+// connector. The results of the recording rules are written to the database
+// like any other series, and the alerts are sent to the Alertmanagers.
+package rules
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel/ingestor"
+	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/promql"
+	"github.com/timescale/promscale/pkg/util"
+)
+
+// queryFunc evaluates an instant query.
+type queryFunc func(ctx context.Context, qs string, ts time.Time) (promql.Vector, error)
+
+type rule interface {
+	ruleName() string
+	// eval evaluates the rule at ts, returning the series to write.
+	eval(ctx context.Context, ts time.Time, query queryFunc) (promql.Vector, error)
+	// reset forgets the state of the rule.
+	reset()
+}
+
+// group is a list of rules evaluated sequentially at the same interval.
+type group struct {
+	name     string
+	interval time.Duration
+	rules    []rule
+}
+
+// Manager evaluates the rule groups on schedule. When the connector runs in
+// a high-availability group, only the leader evaluates the rules.
+type Manager struct {
+	groups   []*group
+	query    queryFunc
+	inserter ingestor.DBInserter
+	elector  *util.Elector
+	notifier *notifier
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewManager loads the rule files of the config. The rules are evaluated with
+// the engine over the queryable, and their results written with the inserter.
+func NewManager(cfg *Config, engine *promql.Engine, queryable promql.Queryable, inserter ingestor.DBInserter, elector *util.Elector) (*Manager, error) {
+	groups, err := loadGroups(cfg.RuleFiles, cfg.EvaluationInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{
+		groups:   groups,
+		query:    engineQueryFunc(engine, queryable),
+		inserter: inserter,
+		elector:  elector,
+		notifier: newNotifier(cfg.AlertmanagerURLs, cfg.AlertmanagerTimeout),
+		done:     make(chan struct{}),
+	}, nil
+}
+
+// loadGroups parses the rule groups of the files matching the patterns.
+func loadGroups(patterns []string, interval time.Duration) ([]*group, error) {
+	var groups []*group
+	for _, pattern := range patterns {
+		files, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule files pattern %s: %w", pattern, err)
+		}
+		for _, file := range files {
+			rgs, errs := rulefmt.ParseFile(file)
+			if len(errs) > 0 {
+				return nil, fmt.Errorf("error loading rule file %s: %w", file, errs[0])
+			}
+			for _, rg := range rgs.Groups {
+				g, err := newGroup(file, rg, interval)
+				if err != nil {
+					return nil, fmt.Errorf("error loading rule file %s: %w", file, err)
+				}
+				groups = append(groups, g)
+			}
+		}
+	}
+	return groups, nil
+}
+
+func newGroup(file string, rg rulefmt.RuleGroup, interval time.Duration) (*group, error) {
+	g := &group{
+		name:     file + ";" + rg.Name,
+		interval: interval,
+		rules:    make([]rule, 0, len(rg.Rules)),
+	}
+	if rg.Interval != 0 {
+		g.interval = time.Duration(rg.Interval)
+	}
+	for _, r := range rg.Rules {
+		expr, err := parser.ParseExpr(r.Expr.Value)
+		if err != nil {
+			return nil, fmt.Errorf("group %q: %w", rg.Name, err)
+		}
+		if r.Alert.Value != "" {
+			g.rules = append(g.rules, newAlertingRule(r.Alert.Value, expr.String(), time.Duration(r.For),
+				labels.FromMap(r.Labels), labels.FromMap(r.Annotations)))
+			continue
+		}
+		g.rules = append(g.rules, &recordingRule{
+			name:   r.Record.Value,
+			expr:   expr.String(),
+			labels: labels.FromMap(r.Labels),
+		})
+	}
+	return g, nil
+}
+
+// engineQueryFunc returns a query function evaluating instant queries with
+// the engine.
+func engineQueryFunc(engine *promql.Engine, queryable promql.Queryable) queryFunc {
+	return func(ctx context.Context, qs string, ts time.Time) (promql.Vector, error) {
+		q, err := engine.NewInstantQuery(queryable, qs, ts)
+		if err != nil {
+			return nil, err
+		}
+		defer q.Close()
+
+		res := q.Exec(ctx)
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		switch v := res.Value.(type) {
+		case promql.Vector:
+			return v, nil
+		case promql.Scalar:
+			return promql.Vector{promql.Sample{Point: promql.Point{T: v.T, V: v.V}}}, nil
+		default:
+			return nil, fmt.Errorf("rule result is not a vector or scalar")
+		}
+	}
+}
+
+// Run starts evaluating the rule groups in the background.
+func (m *Manager) Run() {
+	go m.notifier.run()
+	for _, g := range m.groups {
+		m.wg.Add(1)
+		go func(g *group) {
+			defer m.wg.Done()
+			m.run(g)
+		}(g)
+	}
+	log.Info("msg", "Started the rules manager", "groups", len(m.groups))
+}
+
+// Stop stops evaluating the rules, and waits for the pending alerts to be
+// sent.
+func (m *Manager) Stop() {
+	close(m.done)
+	m.wg.Wait()
+	m.notifier.stop()
+}
+
+func (m *Manager) run(g *group) {
+	ticker := time.NewTicker(g.interval)
+	defer ticker.Stop()
+
+	evaluating := false
+	for {
+		select {
+		case <-m.done:
+			return
+		case ts := <-ticker.C:
+			leader, err := m.isLeader()
+			if err != nil {
+				log.Error("msg", "Rules evaluation skipped, checking the leadership failed", "err", err)
+				continue
+			}
+			if !leader {
+				if evaluating {
+					// another connector evaluates the rules from now on, so
+					// the state of the alerts will be stale if this one
+					// becomes the leader again.
+					g.reset()
+					evaluating = false
+				}
+				continue
+			}
+			evaluating = true
+			m.eval(g, ts)
+		}
+	}
+}
+
+func (m *Manager) isLeader() (bool, error) {
+	if m.elector == nil {
+		return true, nil
+	}
+	return m.elector.IsLeader()
+}
+
+// eval evaluates the rules of the group at ts, writes their results and sends
+// the alerts.
+func (m *Manager) eval(g *group, ts time.Time) {
+	begin := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), g.interval)
+	defer cancel()
+
+	for _, r := range g.rules {
+		ruleEvaluations.WithLabelValues(g.name).Inc()
+		vector, err := r.eval(ctx, ts, m.query)
+		if err != nil {
+			ruleEvaluationFailures.WithLabelValues(g.name).Inc()
+			log.Warn("msg", "Evaluating rule failed", "group", g.name, "rule", r.ruleName(), "err", err)
+			continue
+		}
+		if len(vector) > 0 {
+			if _, err := m.inserter.Ingest(toTimeSeries(vector), ingestor.NewWriteRequest()); err != nil {
+				ruleEvaluationFailures.WithLabelValues(g.name).Inc()
+				log.Warn("msg", "Writing rule results failed", "group", g.name, "rule", r.ruleName(), "err", err)
+			}
+		}
+		if ar, ok := r.(*alertingRule); ok {
+			m.notifier.send(ar.alertsToSend(ts, g.interval))
+		}
+	}
+	ruleGroupDuration.WithLabelValues(g.name).Observe(time.Since(begin).Seconds())
+}
+
+func (g *group) reset() {
+	for _, r := range g.rules {
+		r.reset()
+	}
+}
+
+func toTimeSeries(vector promql.Vector) []prompb.TimeSeries {
+	tts := make([]prompb.TimeSeries, 0, len(vector))
+	for _, s := range vector {
+		lbls := make([]prompb.Label, 0, len(s.Metric))
+		for _, l := range s.Metric {
+			lbls = append(lbls, prompb.Label{Name: l.Name, Value: l.Value})
+		}
+		tts = append(tts, prompb.TimeSeries{
+			Labels:  lbls,
+			Samples: []prompb.Sample{{Timestamp: s.T, Value: s.V}},
+		})
+	}
+	return tts
+}
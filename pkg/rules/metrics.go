@@ -0,0 +1,72 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package rules
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/timescale/promscale/pkg/util"
+)
+
+var (
+	ruleEvaluations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "rule_evaluations_total",
+			Help:      "Total number of rule evaluations.",
+		},
+		[]string{"rule_group"},
+	)
+	ruleEvaluationFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "rule_evaluation_failures_total",
+			Help:      "Total number of rule evaluations which failed, including the failures to write the results.",
+		},
+		[]string{"rule_group"},
+	)
+	ruleGroupDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: util.PromNamespace,
+			Name:      "rule_group_duration_seconds",
+			Help:      "Duration of the evaluations of the rule groups.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"rule_group"},
+	)
+	notificationsSent = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "alert_notifications_sent_total",
+			Help:      "Total number of alerts sent to the Alertmanagers.",
+		},
+		[]string{"alertmanager"},
+	)
+	notificationErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "alert_notification_errors_total",
+			Help:      "Total number of alerts which could not be sent to the Alertmanagers.",
+		},
+		[]string{"alertmanager"},
+	)
+	notificationsDropped = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: util.PromNamespace,
+			Name:      "alert_notifications_dropped_total",
+			Help:      "Total number of alerts dropped because the notification queue was full.",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		ruleEvaluations,
+		ruleEvaluationFailures,
+		ruleGroupDuration,
+		notificationsSent,
+		notificationErrors,
+		notificationsDropped,
+	)
+}
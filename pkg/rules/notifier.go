@@ -0,0 +1,134 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package rules
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/timescale/promscale/pkg/log"
+)
+
+const (
+	alertmanagerAPIPath = "/api/v2/alerts"
+	// notificationQueueCapacity is the number of batches of alerts waiting to
+	// be sent, further ones are dropped.
+	notificationQueueCapacity = 100
+)
+
+// amAlert is an alert in the format of the Alertmanager API.
+type amAlert struct {
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL,omitempty"`
+}
+
+func newAMAlert(a *alert) amAlert {
+	endsAt := a.validUntil
+	if !a.resolvedAt.IsZero() {
+		endsAt = a.resolvedAt
+	}
+	return amAlert{
+		Labels:      a.labels.Map(),
+		Annotations: a.annotations.Map(),
+		StartsAt:    a.activeAt,
+		EndsAt:      endsAt,
+	}
+}
+
+// notifier sends the alerts to the Alertmanagers in the background.
+type notifier struct {
+	urls    []string
+	timeout time.Duration
+	client  *http.Client
+	queue   chan []amAlert
+	done    chan struct{}
+}
+
+func newNotifier(urls []string, timeout time.Duration) *notifier {
+	return &notifier{
+		urls:    urls,
+		timeout: timeout,
+		client:  &http.Client{},
+		queue:   make(chan []amAlert, notificationQueueCapacity),
+		done:    make(chan struct{}),
+	}
+}
+
+// send queues the alerts to be sent. The alerts are dropped if the queue is
+// full, as they are sent again on the following evaluations.
+func (n *notifier) send(alerts []*alert) {
+	if len(alerts) == 0 || len(n.urls) == 0 {
+		return
+	}
+	batch := make([]amAlert, 0, len(alerts))
+	for _, a := range alerts {
+		batch = append(batch, newAMAlert(a))
+	}
+	select {
+	case n.queue <- batch:
+	default:
+		notificationsDropped.Add(float64(len(batch)))
+		log.Warn("msg", "Alert notification queue full, dropping alerts", "count", len(batch))
+	}
+}
+
+func (n *notifier) run() {
+	defer close(n.done)
+	for batch := range n.queue {
+		payload, err := json.Marshal(batch)
+		if err != nil {
+			log.Error("msg", "Encoding alerts failed", "err", err)
+			continue
+		}
+		for _, u := range n.urls {
+			if err := n.post(u, payload); err != nil {
+				notificationErrors.WithLabelValues(u).Add(float64(len(batch)))
+				log.Error("msg", "Sending alerts to Alertmanager failed", "alertmanager", u, "err", err)
+				continue
+			}
+			notificationsSent.WithLabelValues(u).Add(float64(len(batch)))
+		}
+	}
+}
+
+func (n *notifier) post(url string, payload []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), n.timeout)
+	defer cancel()
+
+	url = strings.TrimSuffix(url, "/") + alertmanagerAPIPath
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// stop sends the alerts left in the queue and stops the notifier.
+func (n *notifier) stop() {
+	close(n.queue)
+	<-n.done
+}
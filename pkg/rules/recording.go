@@ -0,0 +1,83 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/prometheus/prometheus/pkg/value"
+	"github.com/timescale/promscale/pkg/promql"
+)
+
+// recordingRule records the result of its expression as a new metric.
+type recordingRule struct {
+	name   string
+	expr   string
+	labels labels.Labels
+	stale  staleness
+}
+
+func (r *recordingRule) ruleName() string {
+	return r.name
+}
+
+func (r *recordingRule) eval(ctx context.Context, ts time.Time, query queryFunc) (promql.Vector, error) {
+	vector, err := query(ctx, r.expr, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint64]struct{}, len(vector))
+	for i := range vector {
+		s := &vector[i]
+		lb := labels.NewBuilder(s.Metric)
+		lb.Set(labels.MetricName, r.name)
+		for _, l := range r.labels {
+			lb.Set(l.Name, l.Value)
+		}
+		s.Metric = lb.Labels()
+
+		h := s.Metric.Hash()
+		if _, ok := seen[h]; ok {
+			return nil, fmt.Errorf("vector contains metrics with the same labelset after applying rule labels")
+		}
+		seen[h] = struct{}{}
+	}
+	return r.stale.markStale(vector, ts), nil
+}
+
+func (r *recordingRule) reset() {
+	r.stale = staleness{}
+}
+
+// staleness tracks the series a rule records, so that the series it stops
+// recording are ended with a stale marker like Prometheus does.
+type staleness struct {
+	previous map[uint64]labels.Labels
+}
+
+// markStale appends a stale marker for the series recorded in the previous
+// evaluation which are missing from vector.
+func (s *staleness) markStale(vector promql.Vector, ts time.Time) promql.Vector {
+	current := make(map[uint64]labels.Labels, len(vector))
+	for _, sample := range vector {
+		current[sample.Metric.Hash()] = sample.Metric
+	}
+	for h, lbls := range s.previous {
+		if _, ok := current[h]; !ok {
+			vector = append(vector, promql.Sample{
+				Point:  promql.Point{T: timestamp.FromTime(ts), V: math.Float64frombits(value.StaleNaN)},
+				Metric: lbls,
+			})
+		}
+	}
+	s.previous = current
+	return vector
+}
@@ -0,0 +1,287 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/prometheus/prometheus/pkg/value"
+	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/promql"
+)
+
+const testRuleFile = `
+groups:
+- name: recording
+  interval: 30s
+  rules:
+  - record: job:up:sum
+    expr: sum by (job) (up)
+    labels:
+      source: rule
+- name: alerting
+  rules:
+  - alert: InstanceDown
+    expr: up == 0
+    for: 5m
+    labels:
+      severity: page
+    annotations:
+      summary: "{{ $labels.instance }} is down"
+`
+
+func writeRuleFile(t *testing.T, dir, name, contents string) string {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadGroups(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rules")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := writeRuleFile(t, dir, "rules.yml", testRuleFile)
+
+	groups, err := loadGroups([]string{filepath.Join(dir, "*.yml")}, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 2 {
+		t.Fatalf("unexpected groups: %v", groups)
+	}
+	if groups[0].name != path+";recording" || groups[0].interval != 30*time.Second {
+		t.Errorf("unexpected recording group: %+v", groups[0])
+	}
+	if groups[1].interval != time.Minute {
+		t.Errorf("unexpected alerting group interval: %v", groups[1].interval)
+	}
+	rr, ok := groups[0].rules[0].(*recordingRule)
+	if !ok || rr.name != "job:up:sum" || rr.expr != "sum by(job) (up)" {
+		t.Errorf("unexpected recording rule: %+v", groups[0].rules[0])
+	}
+	ar, ok := groups[1].rules[0].(*alertingRule)
+	if !ok || ar.name != "InstanceDown" || ar.holdDur != 5*time.Minute {
+		t.Errorf("unexpected alerting rule: %+v", groups[1].rules[0])
+	}
+
+	writeRuleFile(t, dir, "invalid.yml", "groups:\n- name: invalid\n  rules:\n  - record: foo\n    expr: sum(\n")
+	if _, err := loadGroups([]string{filepath.Join(dir, "*.yml")}, time.Minute); err == nil {
+		t.Fatal("expected an error loading an invalid rule file")
+	}
+}
+
+// testQuery returns a query function answering every query with the samples
+// of results.
+func testQuery(results *[]promql.Sample) queryFunc {
+	return func(_ context.Context, _ string, ts time.Time) (promql.Vector, error) {
+		vector := make(promql.Vector, 0, len(*results))
+		for _, s := range *results {
+			s.T = timestamp.FromTime(ts)
+			vector = append(vector, s)
+		}
+		return vector, nil
+	}
+}
+
+func testSample(v float64, lbls ...string) promql.Sample {
+	return promql.Sample{Point: promql.Point{V: v}, Metric: labels.FromStrings(lbls...)}
+}
+
+func TestRecordingRule(t *testing.T) {
+	results := []promql.Sample{testSample(1, "job", "api"), testSample(2, "job", "db")}
+	query := testQuery(&results)
+	r := &recordingRule{name: "job:up:sum", labels: labels.FromStrings("source", "rule")}
+	ts := time.Unix(1000, 0)
+
+	vector, err := r.eval(context.Background(), ts, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := promql.Vector{
+		{Point: promql.Point{T: 1000000, V: 1}, Metric: labels.FromStrings("__name__", "job:up:sum", "job", "api", "source", "rule")},
+		{Point: promql.Point{T: 1000000, V: 2}, Metric: labels.FromStrings("__name__", "job:up:sum", "job", "db", "source", "rule")},
+	}
+	if !reflect.DeepEqual(vector, expected) {
+		t.Fatalf("unexpected result:\ngot\n%v\nwanted\n%v", vector, expected)
+	}
+
+	// the series which disappear are marked stale.
+	results = results[:1]
+	vector, err = r.eval(context.Background(), ts.Add(time.Minute), query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vector) != 2 || !value.IsStaleNaN(vector[1].V) || vector[1].Metric.Get("job") != "db" {
+		t.Fatalf("expected a stale marker, got %v", vector)
+	}
+
+	results = []promql.Sample{testSample(1, "job", "api", "source", "a"), testSample(1, "job", "api", "source", "b")}
+	if _, err = r.eval(context.Background(), ts, query); err == nil {
+		t.Fatal("expected an error on duplicated series")
+	}
+}
+
+func TestAlertingRule(t *testing.T) {
+	results := []promql.Sample{testSample(0, "__name__", "up", "instance", "a")}
+	query := testQuery(&results)
+	r := newAlertingRule("InstanceDown", "up == 0", 5*time.Minute,
+		labels.FromStrings("severity", "page"), labels.FromStrings("summary", "{{ $labels.instance }} is down"))
+	ts := time.Unix(1000, 0)
+	alertLabels := labels.FromStrings("alertname", "InstanceDown", "instance", "a", "severity", "page")
+
+	eval := func(ts time.Time) promql.Vector {
+		vector, err := r.eval(context.Background(), ts, query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return vector
+	}
+	alertSeries := func(state string) labels.Labels {
+		return labels.NewBuilder(alertLabels).Set("__name__", "ALERTS").Set("alertstate", state).Labels()
+	}
+
+	activeAt := ts
+	vector := eval(ts)
+	if len(vector) != 1 || !labels.Equal(vector[0].Metric, alertSeries("pending")) {
+		t.Fatalf("expected a pending alert, got %v", vector)
+	}
+	if alerts := r.alertsToSend(ts, time.Minute); len(alerts) != 0 {
+		t.Fatalf("pending alerts must not be sent: %v", alerts)
+	}
+
+	ts = ts.Add(5 * time.Minute)
+	vector = eval(ts)
+	if len(vector) != 2 || !labels.Equal(vector[0].Metric, alertSeries("firing")) || !value.IsStaleNaN(vector[1].V) {
+		t.Fatalf("expected a firing alert and the end of the pending one, got %v", vector)
+	}
+	alerts := r.alertsToSend(ts, time.Minute)
+	if len(alerts) != 1 {
+		t.Fatalf("expected a firing alert to send, got %v", alerts)
+	}
+	sent := newAMAlert(alerts[0])
+	expected := amAlert{
+		Labels:      alertLabels.Map(),
+		Annotations: map[string]string{"summary": "a is down"},
+		StartsAt:    activeAt,
+		EndsAt:      ts.Add(4 * resendDelay),
+	}
+	if !reflect.DeepEqual(sent, expected) {
+		t.Fatalf("unexpected alert:\ngot\n%+v\nwanted\n%+v", sent, expected)
+	}
+	// the alert is only sent again after the resend delay.
+	if alerts := r.alertsToSend(ts.Add(30*time.Second), time.Minute); len(alerts) != 0 {
+		t.Fatalf("unexpected alerts to send: %v", alerts)
+	}
+
+	results = nil
+	ts = ts.Add(time.Minute)
+	eval(ts)
+	alerts = r.alertsToSend(ts, time.Minute)
+	if len(alerts) != 1 || newAMAlert(alerts[0]).EndsAt != ts {
+		t.Fatalf("expected a resolved alert to send, got %v", alerts)
+	}
+
+	eval(ts.Add(resolvedRetention + time.Minute))
+	if len(r.active) != 0 {
+		t.Fatalf("resolved alerts must be forgotten after the retention: %v", r.active)
+	}
+}
+
+type mockInserter struct {
+	mtx sync.Mutex
+	tts []prompb.TimeSeries
+}
+
+func (m *mockInserter) Ingest(tts []prompb.TimeSeries, _ *prompb.WriteRequest) (uint64, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.tts = append(m.tts, tts...)
+	return uint64(len(tts)), nil
+}
+
+func TestManagerEval(t *testing.T) {
+	var received []amAlert
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != alertmanagerAPIPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var alerts []amAlert
+		if err := json.NewDecoder(r.Body).Decode(&alerts); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received = append(received, alerts...)
+	}))
+	defer server.Close()
+
+	results := []promql.Sample{testSample(0, "__name__", "up", "instance", "a")}
+	inserter := &mockInserter{}
+	m := &Manager{
+		query:    testQuery(&results),
+		inserter: inserter,
+		notifier: newNotifier([]string{server.URL}, time.Second),
+		done:     make(chan struct{}),
+	}
+	g := &group{
+		name:     "test",
+		interval: time.Minute,
+		rules: []rule{
+			&recordingRule{name: "down"},
+			newAlertingRule("InstanceDown", "up == 0", 0, nil, nil),
+		},
+	}
+	go m.notifier.run()
+
+	m.eval(g, time.Unix(1000, 0))
+	m.notifier.stop()
+
+	expected := []prompb.TimeSeries{
+		{
+			Labels:  []prompb.Label{{Name: "__name__", Value: "down"}, {Name: "instance", Value: "a"}},
+			Samples: []prompb.Sample{{Timestamp: 1000000, Value: 0}},
+		},
+		{
+			Labels:  []prompb.Label{{Name: "__name__", Value: "ALERTS"}, {Name: "alertname", Value: "InstanceDown"}, {Name: "alertstate", Value: "firing"}, {Name: "instance", Value: "a"}},
+			Samples: []prompb.Sample{{Timestamp: 1000000, Value: 1}},
+		},
+	}
+	if !reflect.DeepEqual(inserter.tts, expected) {
+		t.Fatalf("unexpected series written:\ngot\n%v\nwanted\n%v", inserter.tts, expected)
+	}
+	if len(received) != 1 || received[0].Labels["alertname"] != "InstanceDown" {
+		t.Fatalf("unexpected alerts received: %v", received)
+	}
+}
+
+func TestStaleness(t *testing.T) {
+	var s staleness
+	ts := time.Unix(1, 0)
+	s.markStale(promql.Vector{testSample(1, "a", "1"), testSample(1, "a", "2")}, ts)
+	vector := s.markStale(promql.Vector{testSample(1, "a", "1")}, ts)
+	if len(vector) != 2 || !math.IsNaN(vector[1].V) || vector[1].T != 1000 {
+		t.Fatalf("unexpected vector: %v", vector)
+	}
+	if vector = s.markStale(nil, ts); len(vector) != 1 {
+		t.Fatalf("unexpected vector: %v", vector)
+	}
+}
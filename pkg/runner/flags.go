@@ -4,25 +4,37 @@
 package runner
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/peterbourgon/ff/v3"
 	"github.com/peterbourgon/ff/v3/ffyaml"
+	"github.com/prometheus/prometheus/pkg/relabel"
 	"github.com/timescale/promscale/pkg/api"
 	"github.com/timescale/promscale/pkg/log"
 	"github.com/timescale/promscale/pkg/pgclient"
+	"github.com/timescale/promscale/pkg/pgmodel/querier"
+	"github.com/timescale/promscale/pkg/rules"
 	"github.com/timescale/promscale/pkg/util"
+	"gopkg.in/yaml.v2"
 )
 
+// relabelConfigsKey is the configuration file section holding the ingest
+// relabeling rules. Unlike the other keys, it does not map to a flag.
+const relabelConfigsKey = "relabel_configs"
+
 type Config struct {
 	ListenAddr                  string
 	PgmodelCfg                  pgclient.Config
 	LogCfg                      log.Config
 	APICfg                      api.Config
+	RulesCfg                    rules.Config
 	ConfigFile                  string
 	TLSCertFile                 string
 	TLSKeyFile                  string
@@ -47,6 +59,7 @@ func ParseFlags(cfg *Config, args []string) (*Config, error) {
 	pgclient.ParseFlags(fs, &cfg.PgmodelCfg)
 	log.ParseFlags(fs, &cfg.LogCfg)
 	api.ParseFlags(fs, &cfg.APICfg)
+	rules.ParseFlags(fs, &cfg.RulesCfg)
 
 	fs.StringVar(&cfg.ConfigFile, "config", "config.yml", "YAML configuration file path for Promscale.")
 	fs.StringVar(&cfg.ListenAddr, "web-listen-address", ":9201", "Address to listen on for web endpoints.")
@@ -71,7 +84,7 @@ func ParseFlags(cfg *Config, args []string) (*Config, error) {
 
 	if err := ff.Parse(fs, args,
 		ff.WithConfigFileFlag("config"),
-		ff.WithConfigFileParser(ffyaml.Parser),
+		ff.WithConfigFileParser(configFileParser(cfg)),
 		ff.WithAllowMissingConfigFile(true),
 	); err != nil {
 		return nil, fmt.Errorf("configuration error: %w", err)
@@ -92,6 +105,19 @@ func ParseFlags(cfg *Config, args []string) (*Config, error) {
 		return nil, fmt.Errorf("error validating API configuration: %w", err)
 	}
 
+	if err := rules.Validate(&cfg.RulesCfg); err != nil {
+		return nil, fmt.Errorf("error validating rules configuration: %w", err)
+	}
+	if len(cfg.RulesCfg.RuleFiles) > 0 && cfg.APICfg.TenantHeader != "" {
+		// the rules see the series of every tenant, and their results
+		// would not belong to any of them.
+		return nil, fmt.Errorf("Cannot evaluate rules with multi-tenancy")
+	}
+
+	if cfg.PgmodelCfg.DeleteJobsPollInterval <= 0 {
+		return nil, fmt.Errorf("invalid delete jobs poll interval %v, must be positive", cfg.PgmodelCfg.DeleteJobsPollInterval)
+	}
+
 	cfg.StopAfterMigrate = false
 	if strings.EqualFold(migrateOption, "true") {
 		cfg.Migrate = true
@@ -113,6 +139,9 @@ func ParseFlags(cfg *Config, args []string) (*Config, error) {
 		if flagset["leader-election-pg-advisory-lock-id"] && cfg.HaGroupLockID != 0 {
 			return nil, fmt.Errorf("Invalid option for HA group lock ID, cannot enable HA mode and read-only mode")
 		}
+		if len(cfg.RulesCfg.RuleFiles) > 0 {
+			return nil, fmt.Errorf("Cannot evaluate rules in read-only mode")
+		}
 		if flagset["install-extensions"] && cfg.InstallExtensions {
 			return nil, fmt.Errorf("Cannot install or update TimescaleDB extension in read-only mode")
 		}
@@ -124,5 +153,41 @@ func ParseFlags(cfg *Config, args []string) (*Config, error) {
 	}
 
 	cfg.PgmodelCfg.UsesHA = cfg.HaGroupLockID != 0
+	cfg.PgmodelCfg.QueryLimits = querier.QueryLimits{
+		MaxSamples: int64(cfg.APICfg.MaxSamples),
+		MaxBytes:   cfg.APICfg.MaxQueryBytes,
+	}
 	return cfg, nil
 }
+
+// configFileParser returns a parser of the YAML configuration file which loads
+// the relabel_configs section into cfg, and passes the other keys to ffyaml
+// to set the flags.
+func configFileParser(cfg *Config) ff.ConfigFileParser {
+	return func(r io.Reader, set func(name, value string) error) error {
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+
+		var keys map[string]interface{}
+		if err := yaml.Unmarshal(data, &keys); err != nil {
+			return ffyaml.ParseError{Inner: err}
+		}
+		if _, ok := keys[relabelConfigsKey]; ok {
+			var section struct {
+				RelabelConfigs []*relabel.Config `yaml:"relabel_configs"`
+			}
+			if err := yaml.Unmarshal(data, &section); err != nil {
+				return fmt.Errorf("invalid %s: %w", relabelConfigsKey, err)
+			}
+			cfg.PgmodelCfg.RelabelConfigs = section.RelabelConfigs
+
+			delete(keys, relabelConfigsKey)
+			if data, err = yaml.Marshal(keys); err != nil {
+				return err
+			}
+		}
+		return ffyaml.Parser(bytes.NewReader(data), set)
+	}
+}
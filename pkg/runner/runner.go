@@ -14,6 +14,9 @@ import (
 	_ "github.com/jackc/pgx/v4/stdlib"
 	"github.com/timescale/promscale/pkg/api"
 	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgclient"
+	"github.com/timescale/promscale/pkg/query"
+	"github.com/timescale/promscale/pkg/rules"
 	"github.com/timescale/promscale/pkg/util"
 	"github.com/timescale/promscale/pkg/version"
 )
@@ -50,6 +53,22 @@ func Run(cfg *Config) error {
 
 	defer client.Close()
 
+	// Delete jobs modify the database, read-only connectors leave them to
+	// the other connectors.
+	if !cfg.APICfg.ReadOnly {
+		client.StartDeleteJobs(cfg.PgmodelCfg.DeleteJobsPollInterval)
+	}
+
+	if len(cfg.RulesCfg.RuleFiles) > 0 {
+		manager, err := createRulesManager(cfg, client)
+		if err != nil {
+			log.Error("msg", "aborting startup due to error", "err", fmt.Sprintf("rules manager: %s", err.Error()))
+			return startupError
+		}
+		manager.Run()
+		defer manager.Stop()
+	}
+
 	router, err := api.GenerateRouter(&cfg.APICfg, promMetrics, client, elector)
 	if err != nil {
 		log.Error("msg", "aborting startup due to error", "err", fmt.Sprintf("generate router: %s", err.Error()))
@@ -75,3 +94,13 @@ func Run(cfg *Config) error {
 
 	return nil
 }
+
+// createRulesManager creates the manager evaluating the rules with an engine
+// of its own, over all the series regardless of their tenant.
+func createRulesManager(cfg *Config, client *pgclient.Client) (*rules.Manager, error) {
+	engine, err := query.NewEngine(log.GetLogger(), cfg.APICfg.MaxQueryTimeout, cfg.APICfg.SubQueryStepInterval, cfg.APICfg.MaxSamples, cfg.APICfg.EnabledFeaturesList)
+	if err != nil {
+		return nil, fmt.Errorf("creating query-engine: %w", err)
+	}
+	return rules.NewManager(&cfg.RulesCfg, engine, client.Queryable(), client, elector)
+}
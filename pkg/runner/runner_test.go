@@ -12,6 +12,8 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/pkg/relabel"
 	"github.com/timescale/promscale/pkg/api"
 	"github.com/timescale/promscale/pkg/log"
 )
@@ -122,6 +124,30 @@ func TestParseFlags(t *testing.T) {
 			},
 			shouldError: true,
 		},
+		{
+			name: "Rule files",
+			args: []string{"-rules-files", "rules/*.yml, alerts.yml"},
+			result: func(c Config) Config {
+				c.RulesCfg.RuleFiles = []string{"rules/*.yml", "alerts.yml"}
+				return c
+			},
+		},
+		{
+			name: "Evaluating rules and multi-tenancy error",
+			args: []string{
+				"-rules-files", "rules.yml",
+				"-tenant-header", "X-Scope-OrgID",
+			},
+			shouldError: true,
+		},
+		{
+			name: "Evaluating rules and read-only error",
+			args: []string{
+				"-rules-files", "rules.yml",
+				"-read-only",
+			},
+			shouldError: true,
+		},
 		{
 			name: "invalid TLS setup, missing key file",
 			args: []string{
@@ -193,6 +219,26 @@ func TestParseFlagsConfigPrecedence(t *testing.T) {
 				return c
 			},
 		},
+		{
+			name: "Config file with relabel configs",
+			configFileContents: `web-listen-address: localhost:9201
+relabel_configs:
+- source_labels: [job]
+  regex: debug
+  action: drop
+`,
+			result: func(c Config) Config {
+				c.ListenAddr = "localhost:9201"
+				c.PgmodelCfg.RelabelConfigs = []*relabel.Config{{
+					SourceLabels: model.LabelNames{"job"},
+					Separator:    relabel.DefaultRelabelConfig.Separator,
+					Regex:        relabel.MustNewRegexp("debug"),
+					Replacement:  relabel.DefaultRelabelConfig.Replacement,
+					Action:       relabel.Drop,
+				}}
+				return c
+			},
+		},
 		{
 			name: "Env variable only, TS_PROM prefix",
 			env: map[string]string{
@@ -0,0 +1,63 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package tenancy
+
+import (
+	"context"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/promql"
+)
+
+// NewQueryable returns a queryable restricting every query to the series of
+// the tenant of the query context. Queries without a tenant are rejected.
+func NewQueryable(q promql.Queryable) promql.Queryable {
+	return &queryable{queryable: q}
+}
+
+type queryable struct {
+	queryable promql.Queryable
+}
+
+func (q queryable) Querier(ctx context.Context, mint, maxt int64) (promql.Querier, error) {
+	tenant, ok := FromContext(ctx)
+	if !ok {
+		return nil, ErrMissingTenant
+	}
+	qr, err := q.queryable.Querier(ctx, mint, maxt)
+	if err != nil {
+		return nil, err
+	}
+	return &querier{querier: qr, matcher: Matcher(tenant)}, nil
+}
+
+type querier struct {
+	querier promql.Querier
+	matcher *labels.Matcher
+}
+
+func (q querier) enforce(ms []*labels.Matcher) []*labels.Matcher {
+	enforced := make([]*labels.Matcher, 0, len(ms)+1)
+	enforced = append(enforced, ms...)
+	return append(enforced, q.matcher)
+}
+
+func (q querier) LabelValues(name string, matchers ...*labels.Matcher) ([]string, storage.Warnings, error) {
+	return q.querier.LabelValues(name, q.enforce(matchers)...)
+}
+
+func (q querier) LabelNames(matchers ...*labels.Matcher) ([]string, storage.Warnings, error) {
+	return q.querier.LabelNames(q.enforce(matchers)...)
+}
+
+func (q querier) Close() error {
+	return q.querier.Close()
+}
+
+func (q querier) Select(sortSeries bool, hints *storage.SelectHints, path []parser.Node, matchers ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	return q.querier.Select(sortSeries, hints, path, q.enforce(matchers)...)
+}
@@ -0,0 +1,106 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+// Package tenancy isolates the data of the tenants sharing a Promscale
+// instance. The tenant of a request is taken from a configurable header, and
+// stored in the reserved __tenant__ label of every series it writes. Every
+// read of the tenant is then restricted to the series with its label.
+package tenancy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+// LabelName is the reserved label holding the tenant of a series.
+const LabelName = "__tenant__"
+
+var ErrMissingTenant = fmt.Errorf("no tenant in the request")
+
+type tenantKey struct{}
+
+// WithTenant returns a copy of ctx holding the tenant of the request.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// FromContext returns the tenant of the request, if it was set with
+// WithTenant.
+func FromContext(ctx context.Context) (string, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(string)
+	return tenant, ok
+}
+
+// FromRequest returns the tenant sent in the header of the request.
+func FromRequest(r *http.Request, header string) (string, error) {
+	tenant := r.Header.Get(header)
+	if tenant == "" {
+		return "", fmt.Errorf("%w: missing %s header", ErrMissingTenant, header)
+	}
+	return tenant, nil
+}
+
+// AddLabel sets the tenant label of the time series, replacing any value
+// sent by the client so that a tenant cannot write to another one.
+func AddLabel(tts []prompb.TimeSeries, tenant string) {
+	for i := range tts {
+		t := &tts[i]
+		found := false
+		for j := range t.Labels {
+			if t.Labels[j].Name == LabelName {
+				t.Labels[j].Value = tenant
+				found = true
+			}
+		}
+		if !found {
+			t.Labels = append(t.Labels, prompb.Label{Name: LabelName, Value: tenant})
+		}
+	}
+}
+
+// Matcher returns the matcher selecting the series of the tenant.
+func Matcher(tenant string) *labels.Matcher {
+	return labels.MustNewMatcher(labels.MatchEqual, LabelName, tenant)
+}
+
+// EnforceMatchers restricts the matchers to the series of the tenant of ctx,
+// if any. The matchers passed are not modified.
+func EnforceMatchers(ctx context.Context, ms []*labels.Matcher) []*labels.Matcher {
+	tenant, ok := FromContext(ctx)
+	if !ok {
+		return ms
+	}
+	enforced := make([]*labels.Matcher, 0, len(ms)+1)
+	enforced = append(enforced, ms...)
+	return append(enforced, Matcher(tenant))
+}
+
+// EnforceMatcherSets restricts every set of matchers like EnforceMatchers.
+func EnforceMatcherSets(ctx context.Context, matcherSets [][]*labels.Matcher) [][]*labels.Matcher {
+	enforced := make([][]*labels.Matcher, 0, len(matcherSets))
+	for _, ms := range matcherSets {
+		enforced = append(enforced, EnforceMatchers(ctx, ms))
+	}
+	return enforced
+}
+
+// EnforceReadRequest restricts the queries of a remote read request to the
+// series of the tenant of ctx, if any.
+func EnforceReadRequest(ctx context.Context, req *prompb.ReadRequest) {
+	tenant, ok := FromContext(ctx)
+	if !ok {
+		return
+	}
+	for _, q := range req.Queries {
+		q.Matchers = append(q.Matchers, &prompb.LabelMatcher{
+			Type:  prompb.LabelMatcher_EQ,
+			Name:  LabelName,
+			Value: tenant,
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+
+package tenancy
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/promql"
+)
+
+func TestAddLabel(t *testing.T) {
+	tts := []prompb.TimeSeries{
+		{Labels: []prompb.Label{{Name: "__name__", Value: "up"}}},
+		{Labels: []prompb.Label{{Name: "__name__", Value: "up"}, {Name: LabelName, Value: "team-b"}}},
+	}
+	AddLabel(tts, "team-a")
+
+	expected := []prompb.TimeSeries{
+		{Labels: []prompb.Label{{Name: "__name__", Value: "up"}, {Name: LabelName, Value: "team-a"}}},
+		{Labels: []prompb.Label{{Name: "__name__", Value: "up"}, {Name: LabelName, Value: "team-a"}}},
+	}
+	if !reflect.DeepEqual(tts, expected) {
+		t.Errorf("unexpected time series:\ngot\n%v\nwanted\n%v", tts, expected)
+	}
+}
+
+func TestEnforceMatchers(t *testing.T) {
+	ms := []*labels.Matcher{labels.MustNewMatcher(labels.MatchEqual, "job", "api")}
+
+	if enforced := EnforceMatchers(context.Background(), ms); !reflect.DeepEqual(enforced, ms) {
+		t.Errorf("matchers without a tenant must not change, got %v", enforced)
+	}
+
+	ctx := WithTenant(context.Background(), "team-a")
+	enforced := EnforceMatcherSets(ctx, [][]*labels.Matcher{ms})
+	expected := [][]*labels.Matcher{{ms[0], Matcher("team-a")}}
+	if !reflect.DeepEqual(enforced, expected) {
+		t.Errorf("unexpected matchers: got %v wanted %v", enforced, expected)
+	}
+	if len(ms) != 1 {
+		t.Errorf("the passed matchers were modified: %v", ms)
+	}
+
+	req := &prompb.ReadRequest{Queries: []*prompb.Query{{}}}
+	EnforceReadRequest(ctx, req)
+	expectedMatchers := []*prompb.LabelMatcher{{Type: prompb.LabelMatcher_EQ, Name: LabelName, Value: "team-a"}}
+	if !reflect.DeepEqual(req.Queries[0].Matchers, expectedMatchers) {
+		t.Errorf("unexpected read request matchers: %v", req.Queries[0].Matchers)
+	}
+}
+
+type mockQuerier struct {
+	matchers []*labels.Matcher
+}
+
+func (m *mockQuerier) Querier(context.Context, int64, int64) (promql.Querier, error) {
+	return m, nil
+}
+
+func (m *mockQuerier) LabelValues(_ string, ms ...*labels.Matcher) ([]string, storage.Warnings, error) {
+	m.matchers = ms
+	return nil, nil, nil
+}
+
+func (m *mockQuerier) LabelNames(ms ...*labels.Matcher) ([]string, storage.Warnings, error) {
+	m.matchers = ms
+	return nil, nil, nil
+}
+
+func (m *mockQuerier) Close() error {
+	return nil
+}
+
+func (m *mockQuerier) Select(_ bool, _ *storage.SelectHints, _ []parser.Node, ms ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	m.matchers = ms
+	return nil, nil
+}
+
+func TestQueryable(t *testing.T) {
+	mock := &mockQuerier{}
+	q := NewQueryable(mock)
+
+	if _, err := q.Querier(context.Background(), 0, 1); err != ErrMissingTenant {
+		t.Fatalf("expected a missing tenant error, got %v", err)
+	}
+
+	querier, err := q.Querier(WithTenant(context.Background(), "team-a"), 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	matcher := labels.MustNewMatcher(labels.MatchEqual, "job", "api")
+	expected := []*labels.Matcher{matcher, Matcher("team-a")}
+
+	querier.Select(false, nil, nil, matcher)
+	if !reflect.DeepEqual(mock.matchers, expected) {
+		t.Errorf("unexpected select matchers: %v", mock.matchers)
+	}
+	_, _, _ = querier.LabelNames(matcher)
+	if !reflect.DeepEqual(mock.matchers, expected) {
+		t.Errorf("unexpected label names matchers: %v", mock.matchers)
+	}
+	_, _, _ = querier.LabelValues("job", matcher)
+	if !reflect.DeepEqual(mock.matchers, expected) {
+		t.Errorf("unexpected label values matchers: %v", mock.matchers)
+	}
+}
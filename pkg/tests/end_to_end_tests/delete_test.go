@@ -10,6 +10,7 @@ import (
 	"math"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,12 +19,14 @@ import (
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/pkg/errors"
 	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
 	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/stretchr/testify/require"
 	pgDel "github.com/timescale/promscale/pkg/pgmodel/delete"
 	ingstr "github.com/timescale/promscale/pkg/pgmodel/ingestor"
 	"github.com/timescale/promscale/pkg/pgmodel/model"
 	"github.com/timescale/promscale/pkg/pgxconn"
+	"github.com/timescale/promscale/pkg/prompb"
 )
 
 type deleteStr struct {
@@ -398,6 +401,219 @@ func TestDeleteMixins(t *testing.T) {
 	})
 }
 
+func TestDeleteInTimeRange(t *testing.T) {
+	if *useMultinode && !*extendedTest {
+		t.Skip("delete tests run in extended mode only for multi-node configuration")
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		ts := generateSmallTimeseries()
+		ingestor, err := ingstr.NewPgxIngestor(pgxconn.NewPgxConn(db))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ingestor.Close()
+		if _, err := ingestor.Ingest(copyMetrics(ts), ingstr.NewWriteRequest()); err != nil {
+			t.Fatal(err)
+		}
+		pgDelete := &pgDel.PgDelete{Conn: pgxconn.NewPgxConn(db)}
+		matcher, err := getMatchers(`{__name__="firstMetric"}`)
+		require.NoError(t, err)
+
+		// Deleting part of the samples must keep the series.
+		touchedMetrics, deletedSeriesIDs, rowsDeleted, err := pgDelete.DeleteSeries(matcher, timestamp.Time(2), timestamp.Time(4))
+		require.NoError(t, err)
+		require.Equal(t, []string{"firstMetric"}, touchedMetrics)
+		require.Equal(t, 0, len(deletedSeriesIDs))
+		require.Equal(t, 3, rowsDeleted)
+
+		var count int
+		err = db.QueryRow(context.Background(), `SELECT count(*) FROM prom_data."firstMetric"`).Scan(&count)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		// Deleting the remaining samples must remove the series as well.
+		_, deletedSeriesIDs, rowsDeleted, err = pgDelete.DeleteSeries(matcher, timestamp.Time(1), timestamp.Time(5))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(deletedSeriesIDs))
+		require.Equal(t, 2, rowsDeleted)
+
+		// Other metrics must not be affected.
+		err = db.QueryRow(context.Background(), `SELECT count(*) FROM prom_data."secondMetric"`).Scan(&count)
+		require.NoError(t, err)
+		require.Equal(t, 5, count)
+	})
+}
+
+func TestDeleteInTimeRangeLongMetricName(t *testing.T) {
+	if *useMultinode && !*extendedTest {
+		t.Skip("delete tests run in extended mode only for multi-node configuration")
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		// metric names longer than the identifier limit, sharing the same
+		// prefix, get table names different from their names.
+		prefix := strings.Repeat("long_metric_name_", 5)
+		names := []string{prefix + "first", prefix + "second"}
+		ts := make([]prompb.TimeSeries, 0, len(names))
+		for _, name := range names {
+			ts = append(ts, prompb.TimeSeries{
+				Labels:  []prompb.Label{{Name: model.MetricNameLabelName, Value: name}, {Name: "foo", Value: "bar"}},
+				Samples: []prompb.Sample{{Timestamp: 1, Value: 0.1}, {Timestamp: 2, Value: 0.2}},
+			})
+		}
+		ingestor, err := ingstr.NewPgxIngestor(pgxconn.NewPgxConn(db))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ingestor.Close()
+		if _, err := ingestor.Ingest(ts, ingstr.NewWriteRequest()); err != nil {
+			t.Fatal(err)
+		}
+		pgDelete := &pgDel.PgDelete{Conn: pgxconn.NewPgxConn(db)}
+
+		for _, name := range names {
+			matcher, err := getMatchers(fmt.Sprintf(`{__name__="%s"}`, name))
+			require.NoError(t, err)
+			_, deletedSeriesIDs, rowsDeleted, err := pgDelete.DeleteSeries(matcher, timestamp.Time(1), timestamp.Time(2))
+			require.NoError(t, err)
+			require.Equal(t, 1, len(deletedSeriesIDs))
+			require.Equal(t, 2, rowsDeleted)
+
+			// The removed series must be marked for deletion.
+			var marked int
+			err = db.QueryRow(context.Background(),
+				`SELECT count(*) FROM _prom_catalog.series s INNER JOIN _prom_catalog.metric m ON (m.id = s.metric_id)
+				 WHERE m.metric_name = $1 AND s.delete_epoch IS NOT NULL`, name).Scan(&marked)
+			require.NoError(t, err)
+			require.Equal(t, 1, marked, "series of %s not marked for deletion", name)
+		}
+	})
+}
+
+func TestEstimateDeleteSeries(t *testing.T) {
+	if *useMultinode && !*extendedTest {
+		t.Skip("delete tests run in extended mode only for multi-node configuration")
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		ts := generateSmallTimeseries()
+		ingestor, err := ingstr.NewPgxIngestor(pgxconn.NewPgxConn(db))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ingestor.Close()
+		if _, err := ingestor.Ingest(copyMetrics(ts), ingstr.NewWriteRequest()); err != nil {
+			t.Fatal(err)
+		}
+		pgDelete := &pgDel.PgDelete{Conn: pgxconn.NewPgxConn(db)}
+		first, err := getMatchers(`{__name__="firstMetric"}`)
+		require.NoError(t, err)
+		common, err := getMatchers(`{common="tag"}`)
+		require.NoError(t, err)
+
+		estimates, err := pgDelete.EstimateDeleteSeries([][]*labels.Matcher{first, common}, model.MinTime, model.MaxTime)
+		require.NoError(t, err)
+		require.Len(t, estimates, 2)
+		require.Equal(t, "firstMetric", estimates[0].MetricName)
+		require.Equal(t, 1, estimates[0].SeriesCount)
+		require.Equal(t, "secondMetric", estimates[1].MetricName)
+		require.Equal(t, 1, estimates[1].SeriesCount)
+
+		// A dry run must not delete anything.
+		var count int
+		err = db.QueryRow(context.Background(), `SELECT count(*) FROM prom_data."firstMetric"`).Scan(&count)
+		require.NoError(t, err)
+		require.Equal(t, 5, count)
+	})
+}
+
+func TestDeleteJobs(t *testing.T) {
+	if *useMultinode && !*extendedTest {
+		t.Skip("delete tests run in extended mode only for multi-node configuration")
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		ts := generateSmallTimeseries()
+		ingestor, err := ingstr.NewPgxIngestor(pgxconn.NewPgxConn(db))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ingestor.Close()
+		if _, err := ingestor.Ingest(copyMetrics(ts), ingstr.NewWriteRequest()); err != nil {
+			t.Fatal(err)
+		}
+		conn := pgxconn.NewPgxConn(db)
+		jobs := &pgDel.Jobs{Conn: conn}
+		worker := pgDel.NewWorker(conn, time.Minute)
+
+		job, err := jobs.Enqueue("", []string{`{__name__="firstMetric"}`}, model.MinTime, model.MaxTime)
+		require.NoError(t, err)
+		require.Equal(t, pgDel.JobPending, job.Status)
+		require.Nil(t, job.Start)
+		require.Nil(t, job.End)
+
+		ran, err := worker.RunNext(context.Background())
+		require.NoError(t, err)
+		require.True(t, ran)
+
+		job, err = jobs.Get("", job.ID)
+		require.NoError(t, err)
+		require.Equal(t, pgDel.JobSucceeded, job.Status)
+		require.Equal(t, []string{"firstMetric"}, job.MetricsTouched)
+		require.Equal(t, int64(1), job.SeriesDeleted)
+		require.Equal(t, int64(5), job.RowsDeleted)
+		require.NotNil(t, job.FinishedAt)
+
+		// Cancelled jobs are never run.
+		job, err = jobs.Enqueue("", []string{`{__name__="secondMetric"}`}, timestamp.Time(1), timestamp.Time(2))
+		require.NoError(t, err)
+		require.NotNil(t, job.Start)
+		job, err = jobs.Cancel("", job.ID)
+		require.NoError(t, err)
+		require.Equal(t, pgDel.JobCancelled, job.Status)
+
+		ran, err = worker.RunNext(context.Background())
+		require.NoError(t, err)
+		require.False(t, ran)
+
+		var count int
+		err = db.QueryRow(context.Background(), `SELECT count(*) FROM prom_data."secondMetric"`).Scan(&count)
+		require.NoError(t, err)
+		require.Equal(t, 5, count)
+
+		_, err = jobs.Get("", job.ID+1)
+		require.True(t, errors.Is(err, pgDel.ErrJobNotFound))
+
+		// The jobs of a tenant are only visible to that tenant.
+		job, err = jobs.Enqueue("team-a", []string{`{__name__="secondMetric",__tenant__="team-a"}`}, model.MinTime, model.MaxTime)
+		require.NoError(t, err)
+		_, err = jobs.Get("team-b", job.ID)
+		require.True(t, errors.Is(err, pgDel.ErrJobNotFound))
+		_, err = jobs.Cancel("team-b", job.ID)
+		require.True(t, errors.Is(err, pgDel.ErrJobNotFound))
+		job, err = jobs.Cancel("team-a", job.ID)
+		require.NoError(t, err)
+		require.Equal(t, pgDel.JobCancelled, job.Status)
+
+		// An abandoned job runs again, counting its progress from scratch.
+		job, err = jobs.Enqueue("", []string{`{__name__="secondMetric"}`}, model.MinTime, model.MaxTime)
+		require.NoError(t, err)
+		_, err = db.Exec(context.Background(), `UPDATE _prom_catalog.delete_job
+			SET status = 'running', started_at = now() - interval '1 hour', heartbeat_at = now() - interval '1 hour',
+				metrics_touched = '{secondMetric}', series_deleted = 1, rows_deleted = 3
+			WHERE id = $1`, job.ID)
+		require.NoError(t, err)
+
+		ran, err = worker.RunNext(context.Background())
+		require.NoError(t, err)
+		require.True(t, ran)
+
+		job, err = jobs.Get("", job.ID)
+		require.NoError(t, err)
+		require.Equal(t, pgDel.JobSucceeded, job.Status)
+		require.Equal(t, []string{"secondMetric"}, job.MetricsTouched)
+		require.Equal(t, int64(1), job.SeriesDeleted)
+		require.Equal(t, int64(5), job.RowsDeleted)
+	})
+}
+
 var (
 	minTime          = time.Unix(math.MinInt64/1000+62135596801, 0).UTC()
 	maxTime          = time.Unix(math.MaxInt64/1000-62135596801, 999999999).UTC()
@@ -438,3 +654,48 @@ func getMatchers(s string) ([]*labels.Matcher, error) {
 	}
 	return matchers, nil
 }
+
+func TestCleanTombstones(t *testing.T) {
+	if *useMultinode && !*extendedTest {
+		t.Skip("delete tests run in extended mode only for multi-node configuration")
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		ts := generateSmallTimeseries()
+		ingestor, err := ingstr.NewPgxIngestor(pgxconn.NewPgxConn(db))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ingestor.Close()
+		if _, err := ingestor.Ingest(copyMetrics(ts), ingstr.NewWriteRequest()); err != nil {
+			t.Fatal(err)
+		}
+		pgDelete := &pgDel.PgDelete{Conn: pgxconn.NewPgxConn(db)}
+		matcher, err := getMatchers(`{__name__="firstMetric"}`)
+		require.NoError(t, err)
+		_, deletedSeriesIDs, _, err := pgDelete.DeleteSeries(matcher, model.MinTime, model.MaxTime)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(deletedSeriesIDs))
+
+		// Pretend the series were marked for deletion long enough ago.
+		_, err = db.Exec(context.Background(), `UPDATE _prom_catalog.ids_epoch SET current_epoch = current_epoch + 5, last_update_time = '1970-01-01 00:00:00 UTC'`)
+		require.NoError(t, err)
+
+		require.NoError(t, pgDelete.CleanTombstones())
+
+		var count int
+		err = db.QueryRow(context.Background(), `SELECT count(*) FROM prom_data_series."firstMetric"`).Scan(&count)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+		err = db.QueryRow(context.Background(), `SELECT count(*) FROM prom_data_series."secondMetric"`).Scan(&count)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		var epochUpdated bool
+		err = db.QueryRow(context.Background(), `SELECT last_update_time > now() - interval '1 hour' FROM _prom_catalog.ids_epoch`).Scan(&epochUpdated)
+		require.NoError(t, err)
+		require.True(t, epochUpdated)
+
+		// Cleaning up again within the hour does nothing, and says so.
+		require.True(t, errors.Is(pgDelete.CleanTombstones(), pgDel.ErrCleanedRecently))
+	})
+}
@@ -0,0 +1,110 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+package end_to_end_tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/stretchr/testify/require"
+	"github.com/timescale/promscale/pkg/pgclient"
+	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	"github.com/timescale/promscale/pkg/pgmodel/exemplar"
+	ingstr "github.com/timescale/promscale/pkg/pgmodel/ingestor"
+	"github.com/timescale/promscale/pkg/pgmodel/model"
+	"github.com/timescale/promscale/pkg/pgxconn"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+func TestSQLIngestQueryExemplars(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		client, err := pgclient.NewClientWithPool(&pgclient.Config{
+			LabelsCacheSize:  10000,
+			MetricsCacheSize: cache.DefaultMetricCacheSize,
+			SeriesCacheSize:  cache.DefaultSeriesCacheSize,
+		}, 1, pgxconn.NewPgxConn(db))
+		require.NoError(t, err)
+		defer client.Close()
+
+		ts := []prompb.TimeSeries{
+			{
+				Labels: []prompb.Label{
+					{Name: "__name__", Value: "http_request_duration_seconds_bucket"},
+					{Name: "le", Value: "0.5"},
+				},
+				Samples: []prompb.Sample{{Timestamp: 1000, Value: 10}},
+				Exemplars: []prompb.Exemplar{
+					{Labels: []prompb.Label{{Name: "trace_id", Value: "abc"}}, Value: 0.3, Timestamp: 1000},
+				},
+			},
+			{
+				// exemplars can be sent without any samples of their series
+				Labels: []prompb.Label{
+					{Name: "__name__", Value: "http_request_duration_seconds_bucket"},
+					{Name: "le", Value: "1"},
+				},
+				Exemplars: []prompb.Exemplar{
+					{Labels: []prompb.Label{{Name: "trace_id", Value: "def"}}, Value: 0.7, Timestamp: 2000},
+				},
+			},
+		}
+		rows, err := client.Ingest(ts, ingstr.NewWriteRequest())
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), rows)
+
+		var count int
+		err = db.QueryRow(context.Background(), `SELECT count(*) FROM prom_data_exemplar.http_request_duration_seconds_bucket`).Scan(&count)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		matchers := []*labels.Matcher{labels.MustNewMatcher(labels.MatchEqual, "__name__", "http_request_duration_seconds_bucket")}
+		res, err := client.QueryExemplars(time.Unix(0, 0), time.Unix(1, 500e6), [][]*labels.Matcher{matchers})
+		require.NoError(t, err)
+		require.Equal(t, []exemplar.QueryResult{
+			{
+				SeriesLabels: labels.FromStrings("__name__", "http_request_duration_seconds_bucket", "le", "0.5"),
+				Exemplars: []exemplar.Exemplar{
+					{Labels: labels.FromStrings("trace_id", "abc"), Value: 0.3, Timestamp: 1000},
+				},
+			},
+		}, res)
+
+		// Without start or end the API falls back to the widest time range,
+		// which must still match every exemplar.
+		res, err = client.QueryExemplars(model.MinTime, model.MaxTime, [][]*labels.Matcher{matchers})
+		require.NoError(t, err)
+		require.Equal(t, []exemplar.QueryResult{
+			{
+				SeriesLabels: labels.FromStrings("__name__", "http_request_duration_seconds_bucket", "le", "0.5"),
+				Exemplars: []exemplar.Exemplar{
+					{Labels: labels.FromStrings("trace_id", "abc"), Value: 0.3, Timestamp: 1000},
+				},
+			},
+			{
+				SeriesLabels: labels.FromStrings("__name__", "http_request_duration_seconds_bucket", "le", "1"),
+				Exemplars: []exemplar.Exemplar{
+					{Labels: labels.FromStrings("trace_id", "def"), Value: 0.7, Timestamp: 2000},
+				},
+			},
+		}, res)
+
+		// A missing end alone must not narrow the window either.
+		res, err = client.QueryExemplars(time.Unix(1, 500e6), model.MaxTime, [][]*labels.Matcher{matchers})
+		require.NoError(t, err)
+		require.Equal(t, []exemplar.QueryResult{
+			{
+				SeriesLabels: labels.FromStrings("__name__", "http_request_duration_seconds_bucket", "le", "1"),
+				Exemplars: []exemplar.Exemplar{
+					{Labels: labels.FromStrings("trace_id", "def"), Value: 0.7, Timestamp: 2000},
+				},
+			},
+		}, res)
+	})
+}
@@ -7,7 +7,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"reflect"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -16,7 +18,18 @@ import (
 
 	_ "github.com/jackc/pgx/v4/stdlib"
 	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/timescale/promscale/pkg/clockcache"
+	"github.com/timescale/promscale/pkg/log"
+	"github.com/timescale/promscale/pkg/pgmodel/cache"
+	"github.com/timescale/promscale/pkg/pgmodel/lreader"
+	"github.com/timescale/promscale/pkg/pgmodel/querier"
+	"github.com/timescale/promscale/pkg/pgxconn"
 	"github.com/timescale/promscale/pkg/prompb"
+	"github.com/timescale/promscale/pkg/promql"
+	"github.com/timescale/promscale/pkg/query"
 )
 
 func TestSQLJsonLabelArray(t *testing.T) {
@@ -536,3 +549,349 @@ func TestExtensionGapfillIncrease(t *testing.T) {
 		}
 	})
 }
+
+func TestSQLExtrapolatedRate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	startTime, err := time.Parse(time.RFC3339, "2000-01-02T15:00:00Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A sample every 5 minutes, with a counter reset at 15:20.
+	times := make([]time.Time, 7)
+	for i := range times {
+		times[i] = startTime.Add(time.Duration(i) * 5 * time.Minute)
+	}
+	vals := []float64{0, 1, 2, 3, 2, 3, 4}
+	window := int64(30 * 60 * 1000)
+	end := startTime.Add(30 * time.Minute)
+
+	testCases := []struct {
+		name   string
+		query  string
+		result []float64
+	}{
+		{
+			name:   "increase",
+			query:  "SELECT _prom_catalog.prom_extrapolated_rate($1, $1, $2, $2, $3::TIMESTAMPTZ[], $4::DOUBLE PRECISION[], true, false)",
+			result: []float64{7},
+		},
+		{
+			name:   "rate",
+			query:  "SELECT _prom_catalog.prom_extrapolated_rate($1, $1, $2, $2, $3::TIMESTAMPTZ[], $4::DOUBLE PRECISION[], true, true)",
+			result: []float64{7.0 / 1800},
+		},
+		{
+			name:   "delta",
+			query:  "SELECT _prom_catalog.prom_extrapolated_rate($1, $1, $2, $2, $3::TIMESTAMPTZ[], $4::DOUBLE PRECISION[], false, false)",
+			result: []float64{4},
+		},
+		{
+			name:   "irate",
+			query:  "SELECT _prom_catalog.prom_instant_rate($1, $1, $2, $2, $3::TIMESTAMPTZ[], $4::DOUBLE PRECISION[], true)",
+			result: []float64{1.0 / 300},
+		},
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, tb testing.TB) {
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				var res []float64
+				err := db.QueryRow(context.Background(), testCase.query, end, window, times, vals).Scan(&res)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(res, testCase.result) {
+					t.Errorf("wrong result. Expected\n\t%v\nfound\n\t%v\n", testCase.result, res)
+				}
+			})
+		}
+	})
+}
+
+func TestSQLOverTime(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	startTime, err := time.Parse(time.RFC3339, "2000-01-02T15:00:00Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A sample every 5 minutes, evaluated every 10 minutes over 15 minutes.
+	times := make([]time.Time, 7)
+	for i := range times {
+		times[i] = startTime.Add(time.Duration(i) * 5 * time.Minute)
+	}
+	vals := []float64{1, 5, 2, 4, 3, 8, 6}
+	step := int64(10 * 60 * 1000)
+	window := int64(15 * 60 * 1000)
+	lowest := startTime.Add(10 * time.Minute)
+	greatest := startTime.Add(30 * time.Minute)
+
+	testCases := []struct {
+		fn     string
+		result []float64
+	}{
+		// The windows are [14:55, 15:10], [15:05, 15:20] and [15:15, 15:30].
+		{fn: "avg_over_time", result: []float64{8.0 / 3, 14.0 / 4, 21.0 / 4}},
+		{fn: "min_over_time", result: []float64{1, 2, 3}},
+		{fn: "max_over_time", result: []float64{5, 5, 8}},
+		{fn: "sum_over_time", result: []float64{8, 14, 21}},
+		{fn: "count_over_time", result: []float64{3, 4, 4}},
+		{fn: "last_over_time", result: []float64{2, 3, 6}},
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, tb testing.TB) {
+		for _, testCase := range testCases {
+			t.Run(testCase.fn, func(t *testing.T) {
+				var res []float64
+				err := db.QueryRow(context.Background(),
+					"SELECT _prom_catalog.prom_over_time($1, $2, $3, $4, $5::TIMESTAMPTZ[], $6::DOUBLE PRECISION[], $7)",
+					lowest, greatest, step, window, times, vals, testCase.fn).Scan(&res)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(res, testCase.result) {
+					t.Errorf("wrong result. Expected\n\t%v\nfound\n\t%v\n", testCase.result, res)
+				}
+			})
+		}
+	})
+}
+
+func TestSQLVectorSelector(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	startTime, err := time.Parse(time.RFC3339, "2000-01-02T15:00:00Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	times := []time.Time{startTime, startTime.Add(2 * time.Minute), startTime.Add(20 * time.Minute)}
+	vals := []float64{1, 2, 3}
+	step := int64(5 * 60 * 1000)
+	lookback := int64(5 * 60 * 1000)
+
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		var res string
+		err := db.QueryRow(context.Background(),
+			"SELECT _prom_catalog.prom_vector_selector($1, $2, $3, $4, $5::TIMESTAMPTZ[], $6::DOUBLE PRECISION[])::TEXT",
+			startTime, startTime.Add(20*time.Minute), step, lookback, times, vals).Scan(&res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The sample at 15:02 is out of the lookback at 15:10 and 15:15.
+		expected := "{1,2,NULL,NULL,3}"
+		if res != expected {
+			t.Errorf("wrong result. Expected\n\t%s\nfound\n\t%s\n", expected, res)
+		}
+	})
+}
+
+func TestSQLQuantileOverTime(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	startTime, err := time.Parse(time.RFC3339, "2000-01-02T15:00:00Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	times := make([]time.Time, 7)
+	for i := range times {
+		times[i] = startTime.Add(time.Duration(i) * 5 * time.Minute)
+	}
+	vals := []float64{1, 5, 2, 4, 3, 8, 6}
+	step := int64(10 * 60 * 1000)
+	window := int64(15 * 60 * 1000)
+	lowest := startTime.Add(10 * time.Minute)
+	greatest := startTime.Add(30 * time.Minute)
+
+	nan := math.NaN()
+
+	testCases := []struct {
+		name     string
+		quantile float64
+		vals     []float64
+		result   []float64
+	}{
+		// The windows are [1, 5, 2], [5, 2, 4, 3] and [4, 3, 8, 6].
+		{name: "median", quantile: 0.5, result: []float64{2, 3.5, 5}},
+		{name: "min", quantile: 0, result: []float64{1, 2, 3}},
+		{name: "max", quantile: 1, result: []float64{5, 5, 8}},
+		{name: "negative quantile", quantile: -1, result: []float64{math.Inf(-1), math.Inf(-1), math.Inf(-1)}},
+		{name: "quantile above one", quantile: 2, result: []float64{math.Inf(1), math.Inf(1), math.Inf(1)}},
+		{name: "NaN quantile", quantile: nan, result: []float64{nan, nan, nan}},
+		// The NaN samples are sorted first, as in the engine: the windows
+		// are [NaN, 1, 2], [NaN, 2, 3, 4] and [3, 4, 6, 8].
+		{name: "NaN sample", quantile: 0.5, vals: []float64{1, nan, 2, 4, 3, 8, 6}, result: []float64{1, 2.5, 5}},
+		{name: "NaN sample min", quantile: 0, vals: []float64{1, nan, 2, 4, 3, 8, 6}, result: []float64{nan, nan, 3}},
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, tb testing.TB) {
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				samples := vals
+				if testCase.vals != nil {
+					samples = testCase.vals
+				}
+				var res []float64
+				err := db.QueryRow(context.Background(),
+					"SELECT _prom_catalog.prom_quantile_over_time($1, $2, $3, $4, $5::TIMESTAMPTZ[], $6::DOUBLE PRECISION[], $7)",
+					lowest, greatest, step, window, times, samples, testCase.quantile).Scan(&res)
+				if err != nil {
+					t.Fatal(err)
+				}
+				equal := len(res) == len(testCase.result)
+				for i := 0; equal && i < len(res); i++ {
+					equal = res[i] == testCase.result[i] || (math.IsNaN(res[i]) && math.IsNaN(testCase.result[i]))
+				}
+				if !equal {
+					t.Errorf("wrong result. Expected\n\t%v\nfound\n\t%v\n", testCase.result, res)
+				}
+			})
+		}
+	})
+}
+
+func TestSQLBucketQuantile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	testCases := []struct {
+		name     string
+		quantile float64
+		bounds   []float64
+		counts   []float64
+		result   float64
+	}{
+		{name: "median", quantile: 0.5, bounds: []float64{0.1, 0.5, 1, math.Inf(1)}, counts: []float64{1, 3, 8, 10}, result: 0.7},
+		{name: "first bucket", quantile: 0.05, bounds: []float64{0.1, 0.5, 1, math.Inf(1)}, counts: []float64{1, 3, 8, 10}, result: 0.05},
+		{name: "infinite bucket", quantile: 0.99, bounds: []float64{0.1, 0.5, 1, math.Inf(1)}, counts: []float64{1, 3, 8, 10}, result: 1},
+		{name: "unordered buckets", quantile: 0.5, bounds: []float64{1, math.Inf(1), 0.5, 0.1}, counts: []float64{8, 10, 3, 1}, result: 0.7},
+		{name: "no infinite bucket", quantile: 0.5, bounds: []float64{0.1, 0.5, 1}, counts: []float64{1, 3, 8}, result: math.NaN()},
+		{name: "empty histogram", quantile: 0.5, bounds: []float64{0.1, math.Inf(1)}, counts: []float64{0, 0}, result: math.NaN()},
+		{name: "NaN quantile", quantile: math.NaN(), bounds: []float64{0.1, 0.5, 1, math.Inf(1)}, counts: []float64{1, 3, 8, 10}, result: math.NaN()},
+		{name: "non monotonic counts", quantile: 0.5, bounds: []float64{0.1, 0.5, 1, math.Inf(1)}, counts: []float64{1, 3, 2, 10}, result: 1},
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, tb testing.TB) {
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				var res float64
+				err := db.QueryRow(context.Background(),
+					"SELECT _prom_catalog.prom_bucket_quantile($1, $2::DOUBLE PRECISION[], $3::DOUBLE PRECISION[])",
+					testCase.quantile, testCase.bounds, testCase.counts).Scan(&res)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if math.IsNaN(testCase.result) {
+					if !math.IsNaN(res) {
+						t.Errorf("wrong result. Expected NaN, found %v", res)
+					}
+					return
+				}
+				if math.Abs(res-testCase.result) > 1e-9 {
+					t.Errorf("wrong result. Expected %v, found %v", testCase.result, res)
+				}
+			})
+		}
+	})
+}
+
+// noPushdownQueryable selects the series without the path of the expression,
+// so that the engine evaluates every function itself.
+type noPushdownQueryable struct {
+	promql.Queryable
+}
+
+func (q noPushdownQueryable) Querier(ctx context.Context, mint, maxt int64) (promql.Querier, error) {
+	qr, err := q.Queryable.Querier(ctx, mint, maxt)
+	return noPushdownQuerier{qr}, err
+}
+
+type noPushdownQuerier struct {
+	promql.Querier
+}
+
+func (q noPushdownQuerier) Select(sortSeries bool, hints *storage.SelectHints, _ []parser.Node, matchers ...*labels.Matcher) (storage.SeriesSet, parser.Node) {
+	return q.Querier.Select(sortSeries, hints, nil, matchers...)
+}
+
+func TestQuantilePushdownMatchesEngine(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	bounds := []string{"0.1", "0.5", "1", "+Inf"}
+	metrics := make([]prompb.TimeSeries, 0, len(bounds)+1)
+	for i, bound := range bounds {
+		samples := make([]prompb.Sample, 0, 10)
+		for j := 0; j < 10; j++ {
+			samples = append(samples, prompb.Sample{Timestamp: int64(j) * 30000, Value: float64((i + 1) * (j + 1))})
+		}
+		metrics = append(metrics, prompb.TimeSeries{
+			Labels:  []prompb.Label{{Name: "__name__", Value: "quantile_test_bucket"}, {Name: "le", Value: bound}},
+			Samples: samples,
+		})
+	}
+	gauge := prompb.TimeSeries{Labels: []prompb.Label{{Name: "__name__", Value: "quantile_test_gauge"}}}
+	for j, v := range []float64{1, 5, 2, math.NaN(), 3, 8, 6, 4, 7, 9} {
+		gauge.Samples = append(gauge.Samples, prompb.Sample{Timestamp: int64(j) * 30000, Value: v})
+	}
+	metrics = append(metrics, gauge)
+
+	queries := []string{
+		`histogram_quantile(0.5, quantile_test_bucket)`,
+		`histogram_quantile(NaN, quantile_test_bucket)`,
+		`histogram_quantile(-1, quantile_test_bucket)`,
+		`histogram_quantile(2, quantile_test_bucket)`,
+		`quantile_over_time(0.5, quantile_test_gauge[2m])`,
+		`quantile_over_time(0, quantile_test_gauge[2m])`,
+		`quantile_over_time(NaN, quantile_test_gauge[2m])`,
+		`quantile_over_time(-1, quantile_test_gauge[2m])`,
+		`quantile_over_time(2, quantile_test_gauge[2m])`,
+	}
+
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, tb testing.TB) {
+		ingestQueryTestDataset(db, t, metrics)
+
+		dbConn := pgxconn.NewPgxConn(db)
+		labelsReader := lreader.NewLabelsReader(dbConn, clockcache.WithMax(100))
+		mCache := &cache.MetricNameCache{Metrics: clockcache.WithMax(cache.DefaultMetricCacheSize)}
+		queryable := query.NewQueryable(querier.NewQuerier(dbConn, mCache, labelsReader), labelsReader, querier.QueryLimits{})
+		engine, err := query.NewEngine(log.GetLogger(), time.Minute, time.Minute, 0, []string{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		eval := func(t *testing.T, q promql.Queryable, qs string, ts time.Time) promql.Vector {
+			qry, err := engine.NewInstantQuery(q, qs, ts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := qry.Exec(context.Background())
+			if res.Err != nil {
+				t.Fatal(res.Err)
+			}
+			vector, err := res.Vector()
+			if err != nil {
+				t.Fatal(err)
+			}
+			sort.Slice(vector, func(i, j int) bool { return labels.Compare(vector[i].Metric, vector[j].Metric) < 0 })
+			return vector
+		}
+
+		for _, qs := range queries {
+			t.Run(qs, func(t *testing.T) {
+				for _, ts := range []time.Time{time.Unix(120, 0), time.Unix(270, 0)} {
+					pushed := eval(t, queryable, qs, ts)
+					expected := eval(t, noPushdownQueryable{queryable}, qs, ts)
+					equal := len(pushed) == len(expected)
+					for i := 0; equal && i < len(pushed); i++ {
+						equal = labels.Equal(pushed[i].Metric, expected[i].Metric) &&
+							(pushed[i].V == expected[i].V || (math.IsNaN(pushed[i].V) && math.IsNaN(expected[i].V)))
+					}
+					if !equal {
+						t.Errorf("pushed down result differs from the engine at %v. Expected\n\t%v\nfound\n\t%v\n", ts, expected, pushed)
+					}
+				}
+			})
+		}
+	})
+}
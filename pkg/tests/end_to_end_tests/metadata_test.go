@@ -0,0 +1,51 @@
+// This file and its contents are licensed under the Apache License 2.0.
+// Please see the included NOTICE for copyright information and
+// LICENSE for a copy of the license.
+package end_to_end_tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/stretchr/testify/require"
+	ingstr "github.com/timescale/promscale/pkg/pgmodel/ingestor"
+	"github.com/timescale/promscale/pkg/pgxconn"
+	"github.com/timescale/promscale/pkg/prompb"
+)
+
+func TestSQLIngestMetadata(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		ingestor, err := ingstr.NewPgxIngestor(pgxconn.NewPgxConn(db))
+		require.NoError(t, err)
+		defer ingestor.Close()
+
+		ingest := func(help string) {
+			req := ingstr.NewWriteRequest()
+			req.Metadata = []prompb.MetricMetadata{
+				{Type: prompb.MetricMetadata_COUNTER, MetricFamilyName: "http_requests_total", Help: help},
+				{Type: prompb.MetricMetadata_GAUGE, MetricFamilyName: "memory_usage", Unit: "bytes", Help: "Memory usage."},
+			}
+			_, err := ingestor.Ingest(nil, req)
+			require.NoError(t, err)
+		}
+		ingest("Total requests.")
+		// the latest metadata of a metric family must replace the old one.
+		ingest("Total number of HTTP requests.")
+
+		var count int
+		err = db.QueryRow(context.Background(), "SELECT count(*) FROM _prom_catalog.metadata").Scan(&count)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		var typ, unit, help string
+		err = db.QueryRow(context.Background(), "SELECT type, unit, help FROM prom_api.get_metric_metadata('http_requests_total')").Scan(&typ, &unit, &help)
+		require.NoError(t, err)
+		require.Equal(t, "counter", typ)
+		require.Equal(t, "", unit)
+		require.Equal(t, "Total number of HTTP requests.", help)
+	})
+}
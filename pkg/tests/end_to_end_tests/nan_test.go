@@ -126,7 +126,7 @@ func TestSQLStaleNaN(t *testing.T) {
 			dbConn := pgxconn.NewPgxConn(db)
 			labelsReader := lreader.NewLabelsReader(dbConn, lCache)
 			r := querier.NewQuerier(dbConn, mCache, labelsReader)
-			resp, err := r.Query(c.query)
+			resp, err := r.Query(c.query, nil)
 			if err != nil {
 				t.Fatalf("unexpected error while ingesting test dataset: %s", err)
 			}
@@ -15,10 +15,14 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/stretchr/testify/require"
 	"github.com/timescale/promscale/pkg/clockcache"
 	"github.com/timescale/promscale/pkg/internal/testhelpers"
+	ingstr "github.com/timescale/promscale/pkg/pgmodel/ingestor"
 	"github.com/timescale/promscale/pkg/pgmodel/lreader"
 	"github.com/timescale/promscale/pkg/pgxconn"
+	"github.com/timescale/promscale/pkg/prompb"
 )
 
 type labelsResponse struct {
@@ -152,3 +156,51 @@ func labelsResultComparator(promContent []byte, tsContent []byte, log string) er
 
 	return nil
 }
+
+func TestSQLLabelsInTimeRange(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	withDB(t, *testDatabase, func(db *pgxpool.Pool, t testing.TB) {
+		ingestor, err := ingstr.NewPgxIngestor(pgxconn.NewPgxConn(db))
+		require.NoError(t, err)
+		defer ingestor.Close()
+
+		series := []prompb.TimeSeries{
+			{
+				Labels:  []prompb.Label{{Name: "__name__", Value: "old"}, {Name: "job", Value: "old_job"}},
+				Samples: []prompb.Sample{{Timestamp: 1000, Value: 1}},
+			},
+			{
+				Labels:  []prompb.Label{{Name: "__name__", Value: "recent"}, {Name: "instance", Value: "a"}},
+				Samples: []prompb.Sample{{Timestamp: 100000, Value: 1}},
+			},
+			{
+				Labels:  []prompb.Label{{Name: "__name__", Value: "recent"}, {Name: "instance", Value: "b"}},
+				Samples: []prompb.Sample{{Timestamp: 2000, Value: 1}},
+			},
+		}
+		_, err = ingestor.Ingest(series, ingstr.NewWriteRequest())
+		require.NoError(t, err)
+
+		reader := lreader.NewLabelsReader(pgxconn.NewPgxConn(db), clockcache.WithMax(100))
+		// without matchers, the labels are those of the metrics with
+		// samples in the range.
+		names, err := reader.MatchingLabelNames(50000, 200000)
+		require.NoError(t, err)
+		require.Equal(t, []string{"__name__", "instance"}, names)
+		values, err := reader.MatchingLabelValues("__name__", 0, 5000)
+		require.NoError(t, err)
+		require.Equal(t, []string{"old", "recent"}, values)
+
+		// with matchers, the labels are those of the series with samples in
+		// the range.
+		matcher := labels.MustNewMatcher(labels.MatchRegexp, "__name__", "old|recent")
+		values, err = reader.MatchingLabelValues("instance", 50000, 200000, matcher)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a"}, values)
+		names, err = reader.MatchingLabelNames(0, 5000, matcher)
+		require.NoError(t, err)
+		require.Equal(t, []string{"__name__", "instance", "job"}, names)
+	})
+}
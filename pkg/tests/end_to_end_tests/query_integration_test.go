@@ -627,7 +627,7 @@ func TestSQLQuery(t *testing.T) {
 		r := querier.NewQuerier(dbConn, mCache, labelsReader)
 		for _, c := range testCases {
 			tester.Run(c.name, func(t *testing.T) {
-				resp, err := r.Query(c.query)
+				resp, err := r.Query(c.query, nil)
 
 				if err != nil && (c.expectErr == nil || err.Error() != c.expectErr.Error()) {
 					t.Fatalf("unexpected error returned:\ngot\n%s\nwanted\n%s", err, c.expectErr)
@@ -963,7 +963,7 @@ func TestPromQL(t *testing.T) {
 		r := querier.NewQuerier(dbConn, mCache, labelsReader)
 		for _, c := range testCases {
 			tester.Run(c.name, func(t *testing.T) {
-				connResp, connErr := r.Query(c.query)
+				connResp, connErr := r.Query(c.query, nil)
 				promResp, promErr := promClient.Read(&prompb.ReadRequest{
 					Queries: []*prompb.Query{c.query},
 				})
@@ -1132,8 +1132,8 @@ func TestPushdown(t *testing.T) {
 		dbConn := pgxconn.NewPgxConn(readOnly)
 		labelsReader := lreader.NewLabelsReader(dbConn, lCache)
 		r := querier.NewQuerier(dbConn, mCache, labelsReader)
-		queryable := query.NewQueryable(r, labelsReader)
-		queryEngine, err := query.NewEngine(log.GetLogger(), time.Minute, time.Minute, []string{})
+		queryable := query.NewQueryable(r, labelsReader, querier.QueryLimits{})
+		queryEngine, err := query.NewEngine(log.GetLogger(), time.Minute, time.Minute, 0, []string{})
 		if err != nil {
 			t.Fatal(err)
 		}
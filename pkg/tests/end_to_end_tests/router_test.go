@@ -18,21 +18,26 @@ import (
 
 var (
 	routes = map[string]string{
-		"/write":                         "POST",
-		"/read":                          "GET,POST",
-		"/delete_series":                 "PUT,POST",
-		"/api/v1/query":                  "GET,POST",
-		"/api/v1/query_range":            "GET,POST",
-		"/api/v1/series":                 "GET,POST",
-		"/api/v1/labels":                 "GET,POST",
-		"/api/v1/label/foo/values":       "GET",
-		"/healthz":                       "GET",
-		"/debug/pprof":                   "GET",
-		"/debug/pprof/cmdline":           "GET",
-		"/debug/pprof/profile?seconds=1": "GET",
-		"/debug/pprof/symbol":            "GET",
-		"/debug/pprof/trace":             "GET",
-		"/metrics":                       "GET",
+		"/write":                              "POST",
+		"/read":                               "GET,POST",
+		"/delete_series":                      "PUT,POST",
+		"/api/v1/admin/delete_jobs/1":         "GET",
+		"/api/v1/admin/delete_jobs/1/cancel":  "PUT,POST",
+		"/api/v1/admin/tsdb/delete_series":    "PUT,POST",
+		"/api/v1/admin/tsdb/clean_tombstones": "PUT,POST",
+		"/api/v1/query":                       "GET,POST",
+		"/api/v1/query_range":                 "GET,POST",
+		"/api/v1/query_exemplars":             "GET,POST",
+		"/api/v1/series":                      "GET,POST",
+		"/api/v1/labels":                      "GET,POST",
+		"/api/v1/label/foo/values":            "GET",
+		"/healthz":                            "GET",
+		"/debug/pprof":                        "GET",
+		"/debug/pprof/cmdline":                "GET",
+		"/debug/pprof/profile?seconds=1":      "GET",
+		"/debug/pprof/symbol":                 "GET",
+		"/debug/pprof/trace":                  "GET",
+		"/metrics":                            "GET",
 	}
 )
 
@@ -38,6 +38,8 @@ var schemas = []string{
 	"pg_toast_temp_1",
 	"prom_api",
 	"prom_data",
+	"prom_data_downsample",
+	"prom_data_exemplar",
 	"prom_data_series",
 	"prom_info",
 	"prom_metric",
@@ -56,6 +58,8 @@ var schemasWOTimescaleDB = []string{
 	"pg_toast_temp_1",
 	"prom_api",
 	"prom_data",
+	"prom_data_downsample",
+	"prom_data_exemplar",
 	"prom_data_series",
 	"prom_info",
 	"prom_metric",
@@ -69,6 +73,8 @@ var ourSchemas = []string{
 	"_prom_ext",
 	"prom_api",
 	"prom_data",
+	"prom_data_downsample",
+	"prom_data_exemplar",
 	"prom_data_series",
 	"prom_info",
 	"prom_metric",